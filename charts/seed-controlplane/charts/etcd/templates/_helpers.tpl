@@ -0,0 +1,12 @@
+{{- define "etcd.peer-service" -}}
+etcd-{{ .Values.role }}-peer.{{ .Release.Namespace }}.svc
+{{- end -}}
+
+{{- define "etcd.initial-cluster" -}}
+{{- $members := list -}}
+{{- range $i := until (int .Values.highAvailability.members) -}}
+{{- $member := printf "etcd-%s-%d" $.Values.role $i -}}
+{{- $members = append $members (printf "%s=https://%s.%s:%v" $member $member (include "etcd.peer-service" $) $.Values.servicePorts.server) -}}
+{{- end -}}
+{{ join "," $members }}
+{{- end -}}
@@ -70,6 +70,12 @@
   path: {{ include "kube-apiserver.admissionPluginConfigFileDir" . }}/{{ lower $plugin.name }}.yaml
 {{- end }}
 {{- end }}
+{{- if .Values.admissionWebhookConfig }}
+{{- range $i, $plugin := list "MutatingAdmissionWebhook" "ValidatingAdmissionWebhook" }}
+- name: {{ $plugin }}
+  path: {{ include "kube-apiserver.admissionPluginConfigFileDir" . }}/webhookadmission.yaml
+{{- end }}
+{{- end }}
 {{- end -}}
 
 {{- define "kube-apiserver.auditversion" -}}
@@ -91,6 +97,66 @@ rules:
 {{- end -}}
 {{- end -}}
 
+{{- define "kube-apiserver.auditBackend" }}
+{{- if .Values.auditConfig.webhook }}
+- --audit-webhook-config-file=/etc/kubernetes/audit-webhook/kubeconfig.yaml
+{{- if .Values.auditConfig.webhook.initialBackoff }}
+- --audit-webhook-initial-backoff={{ .Values.auditConfig.webhook.initialBackoff }}
+{{- end }}
+{{- include "kube-apiserver.auditBuffering" (dict "backend" "webhook" "buffering" .Values.auditConfig.webhook.buffering) }}
+{{- else }}
+- --audit-log-path=/var/lib/audit.log
+- --audit-log-maxsize={{ .Values.auditConfig.log.maxSize }}
+- --audit-log-maxbackup={{ .Values.auditConfig.log.maxBackups }}
+{{- if .Values.auditConfig.log.maxAge }}
+- --audit-log-maxage={{ .Values.auditConfig.log.maxAge }}
+{{- end }}
+{{- include "kube-apiserver.auditBuffering" (dict "backend" "log" "buffering" .Values.auditConfig.log.buffering) }}
+{{- end }}
+{{- end -}}
+
+{{- define "kube-apiserver.auditBuffering" }}
+{{- if .buffering }}
+- --audit-{{ .backend }}-mode={{ .buffering.mode }}
+{{- if .buffering.bufferSize }}
+- --audit-{{ .backend }}-batch-buffer-size={{ .buffering.bufferSize }}
+{{- end }}
+{{- if .buffering.maxBatchSize }}
+- --audit-{{ .backend }}-batch-max-size={{ .buffering.maxBatchSize }}
+{{- end }}
+{{- if .buffering.maxBatchWait }}
+- --audit-{{ .backend }}-batch-max-wait={{ .buffering.maxBatchWait }}
+{{- end }}
+{{- if or .buffering.throttleQPS .buffering.throttleBurst }}
+- --audit-{{ .backend }}-batch-throttle-enable=true
+{{- end }}
+{{- if .buffering.throttleQPS }}
+- --audit-{{ .backend }}-batch-throttle-qps={{ .buffering.throttleQPS }}
+{{- end }}
+{{- if .buffering.throttleBurst }}
+- --audit-{{ .backend }}-batch-throttle-burst={{ .buffering.throttleBurst }}
+{{- end }}
+{{- end }}
+{{- end -}}
+
+{{- define "kube-apiserver.webhooks" }}
+{{- if .Values.authenticationWebhook }}
+- --authentication-token-webhook-config-file=/etc/kubernetes/authentication-webhook/kubeconfig.yaml
+{{- if .Values.authenticationWebhook.cacheTTL }}
+- --authentication-token-webhook-cache-ttl={{ .Values.authenticationWebhook.cacheTTL }}
+{{- end }}
+{{- end }}
+{{- if .Values.authorizationWebhook }}
+- --authorization-webhook-config-file=/etc/kubernetes/authorization-webhook/kubeconfig.yaml
+{{- if .Values.authorizationWebhook.cacheAuthorizedTTL }}
+- --authorization-webhook-cache-authorized-ttl={{ .Values.authorizationWebhook.cacheAuthorizedTTL }}
+{{- end }}
+{{- if .Values.authorizationWebhook.cacheUnauthorizedTTL }}
+- --authorization-webhook-cache-unauthorized-ttl={{ .Values.authorizationWebhook.cacheUnauthorizedTTL }}
+{{- end }}
+{{- end }}
+{{- end -}}
+
 {{- define "kube-apiserver.serviceAccountConfig" -}}
 {{- if .Values.serviceAccountConfig }}
 {{- if .Values.serviceAccountConfig.issuer }}
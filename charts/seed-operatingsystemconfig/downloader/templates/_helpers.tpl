@@ -0,0 +1,7 @@
+{{- define "cri-name" -}}
+{{- if .Values.cri -}}
+{{ .Values.cri.name }}
+{{- else -}}
+docker
+{{- end -}}
+{{- end -}}
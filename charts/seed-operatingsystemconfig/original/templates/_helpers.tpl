@@ -0,0 +1,7 @@
+{{- define "cri-name" -}}
+{{- if .Values.osc.cri -}}
+{{ .Values.osc.cri.name }}
+{{- else -}}
+docker
+{{- end -}}
+{{- end -}}
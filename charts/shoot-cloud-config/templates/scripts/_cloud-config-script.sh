@@ -7,6 +7,9 @@ DIR_CLOUDCONFIG="$DIR_CLOUDCONFIG_DOWNLOADER/downloads"
 
 PATH_CLOUDCONFIG_DOWNLOADER_SERVER="$DIR_CLOUDCONFIG_DOWNLOADER/credentials/server"
 PATH_CLOUDCONFIG_DOWNLOADER_CA_CERT="$DIR_CLOUDCONFIG_DOWNLOADER/credentials/ca.crt"
+PATH_CLOUDCONFIG_DOWNLOADER_CLIENT_CERT="$DIR_CLOUDCONFIG_DOWNLOADER/credentials/client.crt"
+PATH_CLOUDCONFIG_DOWNLOADER_CLIENT_KEY="$DIR_CLOUDCONFIG_DOWNLOADER/credentials/client.key"
+PATH_KUBELET_CLIENT_CA_CHECKSUM="$DIR_KUBELET/client-ca-checksum"
 PATH_CLOUDCONFIG="{{ .configFilePath }}"
 PATH_CLOUDCONFIG_OLD="${PATH_CLOUDCONFIG}.old"
 
@@ -24,14 +27,57 @@ function docker-preload() {
   fi
 }
 
+function containerd-preload() {
+  name="$1"
+  image="$2"
+  echo "Checking whether to preload $name from $image"
+  if [ -z $(ctr --namespace k8s.io images list -q "name==$image") ]; then
+    echo "Preloading $name from $image"
+    ctr --namespace k8s.io images pull "$image"
+  else
+    echo "No need to preload $name from $image"
+  fi
+}
+
 {{ range $name, $image := (required ".images is required" .images) -}}
+{{- if eq (default "docker" $.worker.cri) "containerd" -}}
+containerd-preload "{{ $name }}" "{{ $image }}"
+{{- else -}}
 docker-preload "{{ $name }}" "{{ $image }}"
+{{- end }}
 {{ end }}
 
 cat << 'EOF' | base64 -d > "$PATH_CLOUDCONFIG"
 {{ .worker.cloudConfig | b64enc }}
 EOF
 
+{{- if .cloudConfigDownloader }}
+
+# The credentials of the cloud-config-downloader change when the certificate authorities of the cluster are rotated.
+cat << 'EOF' | base64 -d > "$PATH_CLOUDCONFIG_DOWNLOADER_CA_CERT"
+{{ .cloudConfigDownloader.caCert | b64enc }}
+EOF
+cat << 'EOF' | base64 -d > "$PATH_CLOUDCONFIG_DOWNLOADER_CLIENT_CERT"
+{{ .cloudConfigDownloader.clientCert | b64enc }}
+EOF
+cat << 'EOF' | base64 -d > "$PATH_CLOUDCONFIG_DOWNLOADER_CLIENT_KEY"
+{{ .cloudConfigDownloader.clientKey | b64enc }}
+EOF
+{{- end }}
+
+{{- if .kubeletClientCAChecksum }}
+
+# The kubelet has to bootstrap a new client certificate if the certificate authority signing it has been rotated.
+# Removing the old cloud config enforces a restart of all units.
+if [[ ! -f "$PATH_KUBELET_CLIENT_CA_CHECKSUM" ]]; then
+  echo "{{ .kubeletClientCAChecksum }}" > "$PATH_KUBELET_CLIENT_CA_CHECKSUM"
+elif [[ "$(cat "$PATH_KUBELET_CLIENT_CA_CHECKSUM")" != "{{ .kubeletClientCAChecksum }}" ]]; then
+  echo "Seen rotated certificate authority, bootstrapping new kubelet client certificate"
+  rm -f "$DIR_KUBELET/kubeconfig-real" "$DIR_KUBELET"/pki/kubelet-client*.pem "$PATH_CLOUDCONFIG_OLD"
+  echo "{{ .kubeletClientCAChecksum }}" > "$PATH_KUBELET_CLIENT_CA_CHECKSUM"
+fi
+{{- end }}
+
 if [ ! -f "$PATH_CLOUDCONFIG_OLD" ]; then
   touch "$PATH_CLOUDCONFIG_OLD"
 fi
@@ -69,7 +115,7 @@ if ! diff "$PATH_CLOUDCONFIG" "$PATH_CLOUDCONFIG_OLD" >/dev/null; then
     echo "Successfully applied new cloud config version"
     systemctl daemon-reload
 {{- range $name := (required ".worker.units is required" .worker.units) }}
-{{- if ne $name "docker.service" }}
+{{- if and (ne $name "docker.service") (ne $name "containerd.service") }}
     systemctl enable {{ $name }} && systemctl restart --no-block {{ $name }}
 {{- end }}
 {{- end }}
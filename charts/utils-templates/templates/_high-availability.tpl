@@ -0,0 +1,44 @@
+{{/*
+util-templates.high-availability.affinity returns a pod anti-affinity which spreads the replicas of a control plane
+component across the failure domains of the seed. It expects the high availability values (.highAvailability) and the
+labels of the component's pods (.labels). If .required is set, replicas must never share a failure domain.
+*/}}
+{{- define "util-templates.high-availability.affinity" -}}
+affinity:
+  podAntiAffinity:
+    {{- if .required }}
+    requiredDuringSchedulingIgnoredDuringExecution:
+    - topologyKey: {{ .highAvailability.topologyKey }}
+      labelSelector:
+        matchLabels:
+          {{- toYaml .labels | trim | nindent 10 }}
+    {{- else }}
+    preferredDuringSchedulingIgnoredDuringExecution:
+    - weight: 100
+      podAffinityTerm:
+        topologyKey: {{ .highAvailability.topologyKey }}
+        labelSelector:
+          matchLabels:
+            {{- toYaml .labels | trim | nindent 12 }}
+    {{- end }}
+{{- end -}}
+
+{{/*
+util-templates.high-availability.pod-disruption-budget returns a pod disruption budget which allows only one replica
+of a control plane component to be voluntarily disrupted at a time. It expects the name (.name), the namespace
+(.namespace) and the labels of the component's pods (.labels).
+*/}}
+{{- define "util-templates.high-availability.pod-disruption-budget" -}}
+apiVersion: policy/v1beta1
+kind: PodDisruptionBudget
+metadata:
+  name: {{ .name }}
+  namespace: {{ .namespace }}
+  labels:
+    {{- toYaml .labels | trim | nindent 4 }}
+spec:
+  maxUnavailable: 1
+  selector:
+    matchLabels:
+      {{- toYaml .labels | trim | nindent 6 }}
+{{- end -}}
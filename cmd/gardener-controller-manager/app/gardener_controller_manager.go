@@ -30,6 +30,7 @@ import (
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
 	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/controllermanager/apis/config/v1alpha1"
+	configvalidation "github.com/gardener/gardener/pkg/controllermanager/apis/config/validation"
 	"github.com/gardener/gardener/pkg/controllermanager/controller"
 	"github.com/gardener/gardener/pkg/controllermanager/features"
 	"github.com/gardener/gardener/pkg/controllermanager/server/handlers/webhooks"
@@ -151,6 +152,11 @@ func (o *Options) run(ctx context.Context, cancel context.CancelFunc) error {
 		if err != nil {
 			return err
 		}
+
+		if errs := configvalidation.ValidateControllerManagerConfiguration(c); len(errs) > 0 {
+			return fmt.Errorf("errors validating the configuration: %+v", errs)
+		}
+
 		o.config = c
 	}
 
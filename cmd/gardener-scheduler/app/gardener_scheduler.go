@@ -36,6 +36,7 @@ import (
 	"github.com/gardener/gardener/pkg/server"
 	"github.com/gardener/gardener/pkg/server/handlers"
 
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
@@ -262,7 +263,7 @@ func (g *GardenerScheduler) startScheduler(ctx context.Context) {
 
 	// Initialize the Controller metrics collection.
 	gardenmetrics.RegisterControllerMetrics(
-		scheduler.ControllerWorkerSum,
+		[]*prometheus.Desc{scheduler.ControllerWorkerSum},
 		scheduler.ScrapeFailures,
 		shootScheduler,
 		// backupBucketScheduler,
@@ -53,6 +53,8 @@ type BackupBucketSpec struct {
 	SecretRef corev1.SecretReference
 	// SeedName holds the name of the seed allocated to BackupBucket for running controller.
 	SeedName *string
+	// Secondary is a secondary location into which the objects of the bucket are replicated.
+	Secondary *SecondaryBackupLocation
 }
 
 // BackupBucketStatus holds the most recently observed status of the Backup Bucket.
@@ -76,3 +78,9 @@ type BackupBucketProvider struct {
 	// Region is the region of the bucket.
 	Region string
 }
+
+// SecondaryBackupLocation contains information about a secondary location into which the backups are replicated.
+type SecondaryBackupLocation struct {
+	// Region is the region of the secondary location. It must differ from the region of the primary location.
+	Region string
+}
@@ -54,6 +54,16 @@ type BackupEntrySpec struct {
 	BucketName string
 	// SeedName holds the name of the seed allocated to BackupBucket for running controller.
 	SeedName *string
+	// RetentionPolicy defines how long the snapshots in this Backup Entry are kept.
+	RetentionPolicy *BackupRetentionPolicy
+}
+
+// BackupRetentionPolicy defines how long the snapshots of a backup are kept.
+type BackupRetentionPolicy struct {
+	// DailySnapshots is the number of days for which the latest snapshot of each day is kept.
+	DailySnapshots *int32
+	// WeeklySnapshots is the number of weeks for which the latest snapshot of each week is kept.
+	WeeklySnapshots *int32
 }
 
 // BackupEntryStatus holds the most recently observed status of the Backup Entry.
@@ -65,4 +75,7 @@ type BackupEntryStatus struct {
 	// ObservedGeneration is the most recent generation observed for this BackupEntry. It corresponds to the
 	// BackupEntry's generation, which is updated on mutation by the API Server.
 	ObservedGeneration int64
+	// ReplicaBucketName is the name of the bucket into which the objects of this Backup Entry are replicated. It is
+	// only set if the Backup Bucket has a secondary location.
+	ReplicaBucketName *string
 }
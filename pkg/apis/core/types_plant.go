@@ -49,6 +49,12 @@ const (
 	PlantEveryNodeReady ConditionType = "EveryNodeReady"
 	// PlantAPIServerAvailable is a constant for a condition type indicating that the Plant cluster API server is available.
 	PlantAPIServerAvailable ConditionType = "APIServerAvailable"
+	// PlantKubeconfigValid is a constant for a condition type indicating that the credentials of the kubeconfig used to
+	// access the Plant cluster are valid and do not expire soon.
+	PlantKubeconfigValid ConditionType = "KubeconfigValid"
+	// PlantInventoryCollected is a constant for a condition type indicating that the inventory of the Plant cluster
+	// (nodes and workloads) could be collected.
+	PlantInventoryCollected ConditionType = "InventoryCollected"
 )
 
 // PlantSpec is the specification of a Plant.
@@ -87,6 +93,12 @@ type ClusterInfo struct {
 	Cloud CloudInfo
 	// Kubernetes describes kubernetes meta information (e.g., version)
 	Kubernetes KubernetesInfo
+	// Nodes describes the nodes of the Plant cluster
+	Nodes *NodesInfo
+	// Workloads describes the health of the deployments and statefulsets of the Plant cluster
+	Workloads *WorkloadsInfo
+	// Kubeconfig describes the kubeconfig used to access the Plant cluster
+	Kubeconfig *KubeconfigInfo
 }
 
 // CloudInfo contains information about the cloud
@@ -101,4 +113,40 @@ type CloudInfo struct {
 type KubernetesInfo struct {
 	// Version is the semantic Kubernetes version to use for the Plant cluster.
 	Version string
+	// NodeVersions are the distinct kubelet versions of the nodes of the Plant cluster.
+	NodeVersions []string
+	// NodeVersionSkew is the maximum number of minor versions the kubelets of the Plant cluster are behind its control plane.
+	NodeVersionSkew *int32
+}
+
+// NodesInfo contains information about the nodes of the Plant cluster
+type NodesInfo struct {
+	// Count is the number of nodes registered to the cluster
+	Count int32
+	// Capacity is the total capacity of all nodes registered to the cluster
+	Capacity corev1.ResourceList
+}
+
+// WorkloadsInfo contains a health summary of the workloads of the Plant cluster
+type WorkloadsInfo struct {
+	// Deployments is the health summary of the deployments
+	Deployments WorkloadHealthInfo
+	// StatefulSets is the health summary of the statefulsets
+	StatefulSets WorkloadHealthInfo
+}
+
+// WorkloadHealthInfo contains the number of total and healthy workloads of a kind
+type WorkloadHealthInfo struct {
+	// Total is the total number of workloads
+	Total int32
+	// Healthy is the number of healthy workloads
+	Healthy int32
+}
+
+// KubeconfigInfo contains information about the kubeconfig used to access the Plant cluster
+type KubeconfigInfo struct {
+	// CertificateExpirationTime is the time when the client certificate of the kubeconfig expires
+	CertificateExpirationTime *metav1.Time
+	// TokenExpirationTime is the time when the bearer token of the kubeconfig expires
+	TokenExpirationTime *metav1.Time
 }
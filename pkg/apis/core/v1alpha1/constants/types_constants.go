@@ -94,6 +94,15 @@ const (
 	// the kibana-logging pod.
 	DeploymentNameKibana = "kibana-logging"
 
+	// VolumeNameKMSPluginSocket is a constant for the name of the volume in the kube-apiserver deployment which is
+	// shared with the KMS plugin sidecar and contains its socket.
+	VolumeNameKMSPluginSocket = "kms-plugin-socket"
+	// VolumeMountPathKMSPluginSocket is a constant for the path at which the KMS plugin socket volume is mounted.
+	VolumeMountPathKMSPluginSocket = "/var/run/kms-plugin"
+	// KMSPluginEndpoint is a constant for the endpoint at which the KMS plugin sidecar of the kube-apiserver must
+	// listen.
+	KMSPluginEndpoint = "unix://" + VolumeMountPathKMSPluginSocket + "/socket.sock"
+
 	// StatefulSetNameAlertManager is a constant for the name of a Kubernetes stateful set object that contains
 	// the alertmanager pod.
 	StatefulSetNameAlertManager = "alertmanager"
@@ -164,12 +173,23 @@ const (
 	// SeedResourceManagerClass is the resource-class managed by the Gardener-Resource-Manager
 	// instance in the garden namespace on the seeds.
 	SeedResourceManagerClass = "seed"
+	// AnnotationETCDBackupMember is the annotation on the etcd statefulsets of highly available control planes which
+	// contains the name of the only member pod whose backup-restore sidecar shall take snapshots.
+	AnnotationETCDBackupMember = "etcd.gardener.cloud/backup-member"
 	// LabelBackupProvider is used to identify the backup provider.
 	LabelBackupProvider = "backup.gardener.cloud/provider"
 	// LabelSeedProvider is used to identify the seed provider.
 	LabelSeedProvider = "seed.gardener.cloud/provider"
 	// LabelShootProvider is used to identify the shoot provider.
 	LabelShootProvider = "shoot.gardener.cloud/provider"
+	// LabelWorkerPool is used to identify the worker pool a node belongs to.
+	LabelWorkerPool = "worker.gardener.cloud/pool"
+	// LabelExtensionProjectRole is used to identify the extension project role a ClusterRole or RoleBinding was
+	// created for by the project controller.
+	LabelExtensionProjectRole = "project.gardener.cloud/extension-role"
+	// LabelAggregateToExtensionProjectRole is used to aggregate the rules of ClusterRoles into the ClusterRole of
+	// the extension project role with the given name.
+	LabelAggregateToExtensionProjectRole = "rbac.gardener.cloud/aggregate-to-extension-role"
 	// LabelNetworkingProvider is used to identify the networking provider for the cni plugin.
 	LabelNetworkingProvider = "networking.shoot.gardener.cloud/provider"
 	// LabelExtensionConfiguration is used to identify the provider's configuration which will be added to Gardener configuration
@@ -206,6 +226,9 @@ const (
 	// LabelNetworkPolicyFromPrometheus allows Ingress from Prometheus to pods labeled with 'networking.gardener.cloud/from-prometheus=allowed' and ports
 	// named 'metrics' in the PodSpecification.
 	LabelNetworkPolicyFromPrometheus = "networking.gardener.cloud/from-prometheus"
+	// LabelNetworkPolicyShootRule is a constant for a label key on NetworkPolicies in the Shoot namespace which have been
+	// generated for one of the network policy rules of the Shoot. Its value is the name of the rule.
+	LabelNetworkPolicyShootRule = "networking.gardener.cloud/shoot-rule"
 	// LabelNetworkPolicyAllowed is a constant for allowing a network policy.
 	LabelNetworkPolicyAllowed = "allowed"
 	// LabelNetworkPolicyDisallowed is a constant for disallowing a network policy.
@@ -207,6 +207,7 @@ func Convert_v1alpha1_ProjectSpec_To_garden_ProjectSpec(in *ProjectSpec, out *ga
 		out.ProjectMembers = append(out.ProjectMembers, garden.ProjectMember{
 			Subject: member.Subject,
 			Role:    member.Role,
+			Roles:   member.Roles,
 		})
 	}
 
@@ -222,6 +223,7 @@ func Convert_garden_ProjectSpec_To_v1alpha1_ProjectSpec(in *garden.ProjectSpec,
 		out.Members = append(out.Members, ProjectMember{
 			Subject: member.Subject,
 			Role:    member.Role,
+			Roles:   member.Roles,
 		})
 	}
 
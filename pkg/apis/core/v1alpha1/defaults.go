@@ -16,11 +16,13 @@ package v1alpha1
 
 import (
 	"math"
+	"time"
 
 	"github.com/gardener/gardener/pkg/utils"
 
 	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -94,6 +96,9 @@ func SetDefaults_Shoot(obj *Shoot) {
 			obj.Spec.Kubernetes.KubeAPIServer.EnableBasicAuthentication = &falseVar
 		}
 	}
+	if rotation := obj.Spec.Kubernetes.KubeAPIServer.StaticCredentialsRotation; rotation != nil && rotation.TransitionPeriod == nil {
+		rotation.TransitionPeriod = &metav1.Duration{Duration: 24 * time.Hour}
+	}
 
 	if obj.Spec.Kubernetes.KubeControllerManager == nil {
 		obj.Spec.Kubernetes.KubeControllerManager = &KubeControllerManagerConfig{}
@@ -19,6 +19,7 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
 	v1alpha1constants "github.com/gardener/gardener/pkg/apis/core/v1alpha1/constants"
@@ -134,6 +135,85 @@ func MergeConditions(oldConditions []gardencorev1alpha1.Condition, newConditions
 	return out
 }
 
+// UpdatedConditionHistories records the current status of the given <conditions> in the given <histories>. A new
+// transition is only recorded if the status differs from the last recorded one. Transitions which happened before the
+// given <window> are dropped, except for the latest of them which is kept as reference for the status at the beginning
+// of the window. At most <maxTransitions> transitions are kept per condition, in addition to the entry preceding them
+// which serves as reference for their initial status.
+func UpdatedConditionHistories(histories []gardencorev1alpha1.ConditionHistory, window time.Duration, maxTransitions int, conditions ...gardencorev1alpha1.Condition) []gardencorev1alpha1.ConditionHistory {
+	var (
+		out         = make([]gardencorev1alpha1.ConditionHistory, 0, len(histories)+len(conditions))
+		typeToIndex = make(map[gardencorev1alpha1.ConditionType]int, len(histories))
+		windowStart = Now().Add(-window)
+	)
+
+	for i, history := range histories {
+		out = append(out, *history.DeepCopy())
+		typeToIndex[history.Type] = i
+	}
+
+	for _, condition := range conditions {
+		index, ok := typeToIndex[condition.Type]
+		if !ok {
+			out = append(out, gardencorev1alpha1.ConditionHistory{Type: condition.Type})
+			index = len(out) - 1
+			typeToIndex[condition.Type] = index
+		}
+
+		transitions := out[index].Transitions
+		if len(transitions) == 0 || transitions[len(transitions)-1].Status != condition.Status {
+			transitions = append(transitions, gardencorev1alpha1.ConditionTransition{
+				Status: condition.Status,
+				Time:   condition.LastTransitionTime,
+			})
+		}
+
+		first := 0
+		for i := range transitions {
+			if transitions[i].Time.Time.Before(windowStart) {
+				first = i
+			}
+		}
+		if maxTransitions > 0 && len(transitions)-first > maxTransitions+1 {
+			first = len(transitions) - maxTransitions - 1
+		}
+
+		out[index].Transitions = transitions[first:]
+	}
+
+	return out
+}
+
+// GetConditionHistory returns the condition history with the given <conditionType> out of the list of <histories>.
+// In case the required type could not be found, it returns nil.
+func GetConditionHistory(histories []gardencorev1alpha1.ConditionHistory, conditionType gardencorev1alpha1.ConditionType) *gardencorev1alpha1.ConditionHistory {
+	for _, history := range histories {
+		if history.Type == conditionType {
+			h := history
+			return &h
+		}
+	}
+	return nil
+}
+
+// CountConditionTransitions returns the number of status transitions of the given <history> which happened within the
+// given <window>. The first recorded entry is not counted as it does not mark a transition but the status preceding the
+// following transitions, i.e., the initially observed status or the status at the beginning of the window (see
+// UpdatedConditionHistories).
+func CountConditionTransitions(history gardencorev1alpha1.ConditionHistory, window time.Duration) int {
+	var (
+		count       = 0
+		windowStart = Now().Add(-window)
+	)
+
+	for i := 1; i < len(history.Transitions); i++ {
+		if !history.Transitions[i].Time.Time.Before(windowStart) {
+			count++
+		}
+	}
+	return count
+}
+
 // ConditionsNeedUpdate returns true if the <existingConditions> must be updated based on <newConditions>.
 func ConditionsNeedUpdate(existingConditions, newConditions []gardencorev1alpha1.Condition) bool {
 	return existingConditions == nil || !apiequality.Semantic.DeepEqual(newConditions, existingConditions)
@@ -559,6 +639,128 @@ func ShootWantsBasicAuthentication(shoot *gardencorev1alpha1.Shoot) bool {
 	return *kubeAPIServerConfig.EnableBasicAuthentication
 }
 
+// GetShootKMSProvider returns the KMS provider for the etcd encryption of the given Shoot, or nil if the resources
+// are encrypted with the aescbc provider.
+func GetShootKMSProvider(shoot *gardencorev1alpha1.Shoot) *gardencorev1alpha1.KMSProvider {
+	kubeAPIServerConfig := shoot.Spec.Kubernetes.KubeAPIServer
+	if kubeAPIServerConfig == nil || kubeAPIServerConfig.EncryptionConfig == nil {
+		return nil
+	}
+	return kubeAPIServerConfig.EncryptionConfig.KMS
+}
+
+// GetBackupRetentionPolicy returns the retention policy for the backup of the given Shoot. The policy of the Shoot
+// takes precedence over the default policy of its Project. It returns nil if neither of them defines a policy.
+func GetBackupRetentionPolicy(project *gardencorev1alpha1.Project, shoot *gardencorev1alpha1.Shoot) *gardencorev1alpha1.BackupRetentionPolicy {
+	if shoot.Spec.Backup != nil && shoot.Spec.Backup.RetentionPolicy != nil {
+		return shoot.Spec.Backup.RetentionPolicy
+	}
+	if project != nil {
+		return project.Spec.BackupRetentionPolicy
+	}
+	return nil
+}
+
+// GetShootFailureToleranceType returns the failure tolerance type of the control plane of the given Shoot, or nil if
+// the control plane is not highly available.
+func GetShootFailureToleranceType(shoot *gardencorev1alpha1.Shoot) *gardencorev1alpha1.FailureToleranceType {
+	if shoot.Spec.ControlPlane == nil || shoot.Spec.ControlPlane.HighAvailability == nil {
+		return nil
+	}
+	return &shoot.Spec.ControlPlane.HighAvailability.FailureTolerance.Type
+}
+
+// IsShootHighlyAvailable returns true if the control plane of the given Shoot is highly available.
+func IsShootHighlyAvailable(shoot *gardencorev1alpha1.Shoot) bool {
+	return GetShootFailureToleranceType(shoot) != nil
+}
+
+// GetShootCARotationPhase returns the phase of the certificate authority rotation of the given credentials status. It
+// returns an empty phase if the certificate authorities have never been rotated.
+func GetShootCARotationPhase(credentials *gardencorev1alpha1.ShootCredentials) gardencorev1alpha1.CredentialsRotationPhase {
+	if credentials != nil && credentials.Rotation != nil && credentials.Rotation.CertificateAuthorities != nil {
+		return credentials.Rotation.CertificateAuthorities.Phase
+	}
+	return ""
+}
+
+// MutateShootCARotation mutates the certificate authority rotation status of the given Shoot with the given function.
+// The status is initialized if it does not exist yet.
+func MutateShootCARotation(shoot *gardencorev1alpha1.Shoot, f func(rotation *gardencorev1alpha1.CARotation)) {
+	if shoot.Status.Credentials == nil {
+		shoot.Status.Credentials = &gardencorev1alpha1.ShootCredentials{}
+	}
+	if shoot.Status.Credentials.Rotation == nil {
+		shoot.Status.Credentials.Rotation = &gardencorev1alpha1.ShootCredentialsRotation{}
+	}
+	if shoot.Status.Credentials.Rotation.CertificateAuthorities == nil {
+		shoot.Status.Credentials.Rotation.CertificateAuthorities = &gardencorev1alpha1.CARotation{}
+	}
+
+	f(shoot.Status.Credentials.Rotation.CertificateAuthorities)
+}
+
+// MutateShootStaticCredentialsRotation mutates the static credentials rotation status of the given Shoot with the
+// given function. The status is initialized if it does not exist yet.
+func MutateShootStaticCredentialsRotation(shoot *gardencorev1alpha1.Shoot, f func(rotation *gardencorev1alpha1.StaticCredentialsRotation)) {
+	if shoot.Status.Credentials == nil {
+		shoot.Status.Credentials = &gardencorev1alpha1.ShootCredentials{}
+	}
+	if shoot.Status.Credentials.Rotation == nil {
+		shoot.Status.Credentials.Rotation = &gardencorev1alpha1.ShootCredentialsRotation{}
+	}
+	if shoot.Status.Credentials.Rotation.StaticCredentials == nil {
+		shoot.Status.Credentials.Rotation.StaticCredentials = &gardencorev1alpha1.StaticCredentialsRotation{}
+	}
+
+	f(shoot.Status.Credentials.Rotation.StaticCredentials)
+}
+
+// GetShootETCDEncryptionKeyRotationPhase returns the phase of the etcd encryption key rotation of the given credentials
+// status. It returns an empty phase if the etcd encryption key has never been rotated.
+func GetShootETCDEncryptionKeyRotationPhase(credentials *gardencorev1alpha1.ShootCredentials) gardencorev1alpha1.CredentialsRotationPhase {
+	if credentials != nil && credentials.Rotation != nil && credentials.Rotation.ETCDEncryptionKey != nil {
+		return credentials.Rotation.ETCDEncryptionKey.Phase
+	}
+	return ""
+}
+
+// IsShootETCDEncryptionKeyRotationInProgress returns true if the etcd encryption key rotation of the given credentials
+// status has been started but not yet completed.
+func IsShootETCDEncryptionKeyRotationInProgress(credentials *gardencorev1alpha1.ShootCredentials) bool {
+	switch GetShootETCDEncryptionKeyRotationPhase(credentials) {
+	case gardencorev1alpha1.RotationPreparing, gardencorev1alpha1.RotationPrepared, gardencorev1alpha1.RotationCompleting:
+		return true
+	}
+	return false
+}
+
+// MutateShootETCDEncryptionKeyRotation mutates the etcd encryption key rotation status of the given Shoot with the
+// given function. The status is initialized if it does not exist yet.
+func MutateShootETCDEncryptionKeyRotation(shoot *gardencorev1alpha1.Shoot, f func(rotation *gardencorev1alpha1.ETCDEncryptionKeyRotation)) {
+	if shoot.Status.Credentials == nil {
+		shoot.Status.Credentials = &gardencorev1alpha1.ShootCredentials{}
+	}
+	if shoot.Status.Credentials.Rotation == nil {
+		shoot.Status.Credentials.Rotation = &gardencorev1alpha1.ShootCredentialsRotation{}
+	}
+	if shoot.Status.Credentials.Rotation.ETCDEncryptionKey == nil {
+		shoot.Status.Credentials.Rotation.ETCDEncryptionKey = &gardencorev1alpha1.ETCDEncryptionKeyRotation{}
+	}
+
+	f(shoot.Status.Credentials.Rotation.ETCDEncryptionKey)
+}
+
+// MutateShootETCDBackup mutates the etcd backup status of the given Shoot with the given function. The status is
+// initialized if it does not exist yet.
+func MutateShootETCDBackup(shoot *gardencorev1alpha1.Shoot, f func(backup *gardencorev1alpha1.ShootETCDBackup)) {
+	if shoot.Status.ETCDBackup == nil {
+		shoot.Status.ETCDBackup = &gardencorev1alpha1.ShootETCDBackup{}
+	}
+
+	f(shoot.Status.ETCDBackup)
+}
+
 // ShootUsesUnmanagedDNS returns true if the shoot's DNS section is marked as 'unmanaged'.
 func ShootUsesUnmanagedDNS(shoot *gardencorev1alpha1.Shoot) bool {
 	return shoot.Spec.DNS != nil && len(shoot.Spec.DNS.Providers) > 0 && shoot.Spec.DNS.Providers[0].Type != nil && *shoot.Spec.DNS.Providers[0].Type == "unmanaged"
@@ -727,3 +929,16 @@ func GetDefaultMachineImageFromCloudProfile(profile gardencorev1alpha1.CloudProf
 	}
 	return &profile.Spec.MachineImages[0]
 }
+
+// GetIPFamilies returns the given IP families or only IPv4 if the list is empty.
+func GetIPFamilies(ipFamilies []gardencorev1alpha1.IPFamily) []gardencorev1alpha1.IPFamily {
+	if len(ipFamilies) == 0 {
+		return []gardencorev1alpha1.IPFamily{gardencorev1alpha1.IPFamilyIPv4}
+	}
+	return ipFamilies
+}
+
+// IsDualStack returns true if the given IP families configure dual-stack networking.
+func IsDualStack(ipFamilies []gardencorev1alpha1.IPFamily) bool {
+	return len(GetIPFamilies(ipFamilies)) > 1
+}
@@ -167,6 +167,122 @@ var _ = Describe("helper", func() {
 			})
 		})
 
+		Describe("#UpdatedConditionHistories", func() {
+			var (
+				now    = metav1.NewTime(time.Unix(10000, 0))
+				minute = func(m int64) metav1.Time { return metav1.NewTime(now.Add(time.Duration(m) * time.Minute)) }
+				tmp    func() metav1.Time
+			)
+
+			BeforeEach(func() {
+				tmp = Now
+				Now = func() metav1.Time { return now }
+			})
+
+			AfterEach(func() {
+				Now = tmp
+			})
+
+			It("should initialize the history of a new condition", func() {
+				condition := gardencorev1alpha1.Condition{Type: "foo", Status: gardencorev1alpha1.ConditionTrue, LastTransitionTime: minute(-1)}
+
+				Expect(UpdatedConditionHistories(nil, time.Hour, 10, condition)).To(Equal([]gardencorev1alpha1.ConditionHistory{
+					{Type: "foo", Transitions: []gardencorev1alpha1.ConditionTransition{{Status: gardencorev1alpha1.ConditionTrue, Time: minute(-1)}}},
+				}))
+			})
+
+			It("should not record a transition if the status did not change", func() {
+				var (
+					histories = []gardencorev1alpha1.ConditionHistory{
+						{Type: "foo", Transitions: []gardencorev1alpha1.ConditionTransition{{Status: gardencorev1alpha1.ConditionTrue, Time: minute(-5)}}},
+					}
+					condition = gardencorev1alpha1.Condition{Type: "foo", Status: gardencorev1alpha1.ConditionTrue, LastTransitionTime: minute(-5)}
+				)
+
+				Expect(UpdatedConditionHistories(histories, time.Hour, 10, condition)).To(Equal(histories))
+			})
+
+			It("should record a transition and drop the transitions outside of the window except the latest one", func() {
+				var (
+					histories = []gardencorev1alpha1.ConditionHistory{
+						{Type: "foo", Transitions: []gardencorev1alpha1.ConditionTransition{
+							{Status: gardencorev1alpha1.ConditionTrue, Time: minute(-120)},
+							{Status: gardencorev1alpha1.ConditionFalse, Time: minute(-90)},
+							{Status: gardencorev1alpha1.ConditionTrue, Time: minute(-30)},
+						}},
+					}
+					condition = gardencorev1alpha1.Condition{Type: "foo", Status: gardencorev1alpha1.ConditionFalse, LastTransitionTime: minute(0)}
+				)
+
+				Expect(UpdatedConditionHistories(histories, time.Hour, 10, condition)).To(Equal([]gardencorev1alpha1.ConditionHistory{
+					{Type: "foo", Transitions: []gardencorev1alpha1.ConditionTransition{
+						{Status: gardencorev1alpha1.ConditionFalse, Time: minute(-90)},
+						{Status: gardencorev1alpha1.ConditionTrue, Time: minute(-30)},
+						{Status: gardencorev1alpha1.ConditionFalse, Time: minute(0)},
+					}},
+				}))
+			})
+
+			It("should keep at most the maximum number of transitions and the entry preceding them", func() {
+				var (
+					histories = []gardencorev1alpha1.ConditionHistory{
+						{Type: "foo", Transitions: []gardencorev1alpha1.ConditionTransition{
+							{Status: gardencorev1alpha1.ConditionTrue, Time: minute(-3)},
+							{Status: gardencorev1alpha1.ConditionFalse, Time: minute(-2)},
+							{Status: gardencorev1alpha1.ConditionTrue, Time: minute(-1)},
+						}},
+					}
+					condition = gardencorev1alpha1.Condition{Type: "foo", Status: gardencorev1alpha1.ConditionFalse, LastTransitionTime: minute(0)}
+				)
+
+				Expect(UpdatedConditionHistories(histories, time.Hour, 2, condition)).To(Equal([]gardencorev1alpha1.ConditionHistory{
+					{Type: "foo", Transitions: []gardencorev1alpha1.ConditionTransition{
+						{Status: gardencorev1alpha1.ConditionFalse, Time: minute(-2)},
+						{Status: gardencorev1alpha1.ConditionTrue, Time: minute(-1)},
+						{Status: gardencorev1alpha1.ConditionFalse, Time: minute(0)},
+					}},
+				}))
+			})
+		})
+
+		Describe("#CountConditionTransitions", func() {
+			It("should only count the transitions within the window", func() {
+				tmp := Now
+				Now = func() metav1.Time { return metav1.NewTime(time.Unix(10000, 0)) }
+				defer func() { Now = tmp }()
+
+				history := gardencorev1alpha1.ConditionHistory{Type: "foo", Transitions: []gardencorev1alpha1.ConditionTransition{
+					{Status: gardencorev1alpha1.ConditionTrue, Time: metav1.NewTime(time.Unix(10000-7200, 0))},
+					{Status: gardencorev1alpha1.ConditionFalse, Time: metav1.NewTime(time.Unix(10000-4000, 0))},
+					{Status: gardencorev1alpha1.ConditionTrue, Time: metav1.NewTime(time.Unix(10000-600, 0))},
+					{Status: gardencorev1alpha1.ConditionFalse, Time: metav1.NewTime(time.Unix(10000-60, 0))},
+				}}
+
+				Expect(CountConditionTransitions(history, time.Hour)).To(Equal(2))
+			})
+
+			It("should count all transitions of a history which was capped to the maximum number of transitions", func() {
+				tmp := Now
+				Now = func() metav1.Time { return metav1.NewTime(time.Unix(10000, 0)) }
+				defer func() { Now = tmp }()
+
+				var (
+					minute    = func(m int64) metav1.Time { return metav1.NewTime(time.Unix(10000+m*60, 0)) }
+					histories = []gardencorev1alpha1.ConditionHistory{
+						{Type: "foo", Transitions: []gardencorev1alpha1.ConditionTransition{
+							{Status: gardencorev1alpha1.ConditionTrue, Time: minute(-4)},
+							{Status: gardencorev1alpha1.ConditionFalse, Time: minute(-3)},
+							{Status: gardencorev1alpha1.ConditionTrue, Time: minute(-2)},
+						}},
+					}
+					condition = gardencorev1alpha1.Condition{Type: "foo", Status: gardencorev1alpha1.ConditionFalse, LastTransitionTime: minute(-1)}
+				)
+
+				history := UpdatedConditionHistories(histories, time.Hour, 2, condition)[0]
+				Expect(CountConditionTransitions(history, time.Hour)).To(Equal(2))
+			})
+		})
+
 		DescribeTable("#IsResourceSupported",
 			func(resources []gardencorev1alpha1.ControllerResource, resourceKind, resourceType string, expectation bool) {
 				Expect(IsResourceSupported(resources, resourceKind, resourceType)).To(Equal(expectation))
@@ -850,4 +966,77 @@ var _ = Describe("helper", func() {
 			false,
 		),
 	)
+
+	Describe("#GetBackupRetentionPolicy", func() {
+		var (
+			daily, weekly = int32(7), int32(4)
+			shootPolicy   = &gardencorev1alpha1.BackupRetentionPolicy{DailySnapshots: &daily}
+			projectPolicy = &gardencorev1alpha1.BackupRetentionPolicy{WeeklySnapshots: &weekly}
+			project       *gardencorev1alpha1.Project
+			shoot         *gardencorev1alpha1.Shoot
+		)
+
+		BeforeEach(func() {
+			project = &gardencorev1alpha1.Project{Spec: gardencorev1alpha1.ProjectSpec{BackupRetentionPolicy: projectPolicy}}
+			shoot = &gardencorev1alpha1.Shoot{Spec: gardencorev1alpha1.ShootSpec{Backup: &gardencorev1alpha1.ShootBackup{RetentionPolicy: shootPolicy}}}
+		})
+
+		It("should return the policy of the shoot", func() {
+			Expect(GetBackupRetentionPolicy(project, shoot)).To(Equal(shootPolicy))
+		})
+
+		It("should return the policy of the project if the shoot does not define one", func() {
+			shoot.Spec.Backup = nil
+
+			Expect(GetBackupRetentionPolicy(project, shoot)).To(Equal(projectPolicy))
+		})
+
+		It("should return nil if neither the shoot nor the project define a policy", func() {
+			shoot.Spec.Backup = nil
+
+			Expect(GetBackupRetentionPolicy(nil, shoot)).To(BeNil())
+		})
+	})
+
+	Describe("#GetShootFailureToleranceType", func() {
+		It("should return nil if the shoot does not configure a highly available control plane", func() {
+			shoot := &gardencorev1alpha1.Shoot{Spec: gardencorev1alpha1.ShootSpec{ControlPlane: &gardencorev1alpha1.ControlPlane{}}}
+
+			Expect(GetShootFailureToleranceType(shoot)).To(BeNil())
+			Expect(IsShootHighlyAvailable(shoot)).To(BeFalse())
+		})
+
+		It("should return the failure tolerance type of the highly available control plane", func() {
+			shoot := &gardencorev1alpha1.Shoot{Spec: gardencorev1alpha1.ShootSpec{ControlPlane: &gardencorev1alpha1.ControlPlane{
+				HighAvailability: &gardencorev1alpha1.HighAvailability{
+					FailureTolerance: gardencorev1alpha1.FailureTolerance{Type: gardencorev1alpha1.FailureToleranceTypeZone},
+				},
+			}}}
+
+			Expect(GetShootFailureToleranceType(shoot)).To(PointTo(Equal(gardencorev1alpha1.FailureToleranceTypeZone)))
+			Expect(IsShootHighlyAvailable(shoot)).To(BeTrue())
+		})
+	})
+
+	Describe("#GetIPFamilies", func() {
+		It("should default to IPv4", func() {
+			Expect(GetIPFamilies(nil)).To(Equal([]gardencorev1alpha1.IPFamily{gardencorev1alpha1.IPFamilyIPv4}))
+		})
+
+		It("should return the given IP families", func() {
+			ipFamilies := []gardencorev1alpha1.IPFamily{gardencorev1alpha1.IPFamilyIPv6, gardencorev1alpha1.IPFamilyIPv4}
+			Expect(GetIPFamilies(ipFamilies)).To(Equal(ipFamilies))
+		})
+	})
+
+	Describe("#IsDualStack", func() {
+		It("should return false for single-stack networks", func() {
+			Expect(IsDualStack(nil)).To(BeFalse())
+			Expect(IsDualStack([]gardencorev1alpha1.IPFamily{gardencorev1alpha1.IPFamilyIPv6})).To(BeFalse())
+		})
+
+		It("should return true for dual-stack networks", func() {
+			Expect(IsDualStack([]gardencorev1alpha1.IPFamily{gardencorev1alpha1.IPFamilyIPv6, gardencorev1alpha1.IPFamilyIPv4})).To(BeTrue())
+		})
+	})
 })
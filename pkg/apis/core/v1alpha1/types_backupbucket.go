@@ -55,6 +55,9 @@ type BackupBucketSpec struct {
 	// Seed holds the name of the seed allocated to BackupBucket for running controller.
 	// +optional
 	Seed *string `json:"seed,omitempty"`
+	// Secondary is a secondary location into which the objects of the bucket are replicated.
+	// +optional
+	Secondary *SecondaryBackupLocation `json:"secondary,omitempty"`
 }
 
 // BackupBucketStatus holds the most recently observed status of the Backup Bucket.
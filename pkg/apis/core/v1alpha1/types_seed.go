@@ -107,6 +107,16 @@ type SeedBackup struct {
 	// the object store where backups should be stored. It should have enough privileges to manipulate
 	// the objects as well as buckets.
 	SecretRef corev1.SecretReference `json:"secretRef"`
+	// Secondary is a secondary location into which the backups are replicated. It uses the same provider and
+	// credentials as the primary location.
+	// +optional
+	Secondary *SecondaryBackupLocation `json:"secondary,omitempty"`
+}
+
+// SecondaryBackupLocation contains information about a secondary location into which the backups are replicated.
+type SecondaryBackupLocation struct {
+	// Region is the region of the secondary location. It must differ from the region of the primary location.
+	Region string `json:"region"`
 }
 
 // SeedDNS contains DNS-relevant information about this seed cluster.
@@ -127,6 +137,15 @@ type SeedNetworks struct {
 	// ShootDefaults contains the default networks CIDRs for shoots.
 	// +optional
 	ShootDefaults *ShootNetworks `json:"shootDefaults,omitempty"`
+	// ShootPools contains network pools from which non-overlapping networks are allocated to shoots which do not
+	// specify their node or pod network.
+	// +optional
+	ShootPools *ShootNetworkPools `json:"shootPools,omitempty"`
+	// IPFamilies specifies the IP protocol versions used by the networks of the Seed. The first family is the primary
+	// one. Dual-stack networking is configured by specifying both IPv4 and IPv6, in this case the pod, node and service
+	// networks contain one CIDR per IP family, separated by a comma and in the same order. Defaults to IPv4 if empty.
+	// +optional
+	IPFamilies []IPFamily `json:"ipFamilies,omitempty"`
 }
 
 // ShootNetworks contains the default networks CIDRs for shoots.
@@ -139,6 +158,25 @@ type ShootNetworks struct {
 	Services *string `json:"services,omitempty"`
 }
 
+// ShootNetworkPools contains network pools from which networks are allocated to shoots.
+type ShootNetworkPools struct {
+	// Nodes is the pool from which the node networks of shoots are allocated.
+	// +optional
+	Nodes *NetworkPool `json:"nodes,omitempty"`
+	// Pods is the pool from which the pod networks of shoots are allocated.
+	// +optional
+	Pods *NetworkPool `json:"pods,omitempty"`
+}
+
+// NetworkPool is a network from which equally sized networks are allocated.
+type NetworkPool struct {
+	// CIDR is the network from which the networks are allocated.
+	CIDR string `json:"cidr"`
+	// PrefixLength is the prefix length of the allocated networks. It must not be smaller than the prefix length of
+	// the pool CIDR.
+	PrefixLength int32 `json:"prefixLength"`
+}
+
 // SeedProvider defines the provider type and region for this Seed cluster.
 type SeedProvider struct {
 	// Type is the name of the provider.
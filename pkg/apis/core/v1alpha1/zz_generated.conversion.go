@@ -25,10 +25,10 @@ import (
 
 	core "github.com/gardener/gardener/pkg/apis/core"
 	garden "github.com/gardener/gardener/pkg/apis/garden"
-	v1 "k8s.io/api/core/v1"
+	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	resource "k8s.io/apimachinery/pkg/api/resource"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 	types "k8s.io/apimachinery/pkg/types"
@@ -72,6 +72,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*AdmissionWebhookConfig)(nil), (*garden.AdmissionWebhookConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_AdmissionWebhookConfig_To_garden_AdmissionWebhookConfig(a.(*AdmissionWebhookConfig), b.(*garden.AdmissionWebhookConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.AdmissionWebhookConfig)(nil), (*AdmissionWebhookConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_AdmissionWebhookConfig_To_v1alpha1_AdmissionWebhookConfig(a.(*garden.AdmissionWebhookConfig), b.(*AdmissionWebhookConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Alerting)(nil), (*garden.Alerting)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_Alerting_To_garden_Alerting(a.(*Alerting), b.(*garden.Alerting), scope)
 	}); err != nil {
@@ -82,6 +92,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*AuditBackend)(nil), (*garden.AuditBackend)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_AuditBackend_To_garden_AuditBackend(a.(*AuditBackend), b.(*garden.AuditBackend), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.AuditBackend)(nil), (*AuditBackend)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_AuditBackend_To_v1alpha1_AuditBackend(a.(*garden.AuditBackend), b.(*AuditBackend), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AuditBuffering)(nil), (*garden.AuditBuffering)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_AuditBuffering_To_garden_AuditBuffering(a.(*AuditBuffering), b.(*garden.AuditBuffering), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.AuditBuffering)(nil), (*AuditBuffering)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_AuditBuffering_To_v1alpha1_AuditBuffering(a.(*garden.AuditBuffering), b.(*AuditBuffering), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*AuditConfig)(nil), (*garden.AuditConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_AuditConfig_To_garden_AuditConfig(a.(*AuditConfig), b.(*garden.AuditConfig), scope)
 	}); err != nil {
@@ -92,6 +122,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*AuditLogBackend)(nil), (*garden.AuditLogBackend)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_AuditLogBackend_To_garden_AuditLogBackend(a.(*AuditLogBackend), b.(*garden.AuditLogBackend), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.AuditLogBackend)(nil), (*AuditLogBackend)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_AuditLogBackend_To_v1alpha1_AuditLogBackend(a.(*garden.AuditLogBackend), b.(*AuditLogBackend), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*AuditPolicy)(nil), (*garden.AuditPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_AuditPolicy_To_garden_AuditPolicy(a.(*AuditPolicy), b.(*garden.AuditPolicy), scope)
 	}); err != nil {
@@ -102,6 +142,46 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*AuditWebhookBackend)(nil), (*garden.AuditWebhookBackend)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_AuditWebhookBackend_To_garden_AuditWebhookBackend(a.(*AuditWebhookBackend), b.(*garden.AuditWebhookBackend), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.AuditWebhookBackend)(nil), (*AuditWebhookBackend)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_AuditWebhookBackend_To_v1alpha1_AuditWebhookBackend(a.(*garden.AuditWebhookBackend), b.(*AuditWebhookBackend), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AuthenticationWebhook)(nil), (*garden.AuthenticationWebhook)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_AuthenticationWebhook_To_garden_AuthenticationWebhook(a.(*AuthenticationWebhook), b.(*garden.AuthenticationWebhook), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.AuthenticationWebhook)(nil), (*AuthenticationWebhook)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_AuthenticationWebhook_To_v1alpha1_AuthenticationWebhook(a.(*garden.AuthenticationWebhook), b.(*AuthenticationWebhook), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AuthorizationWebhook)(nil), (*garden.AuthorizationWebhook)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_AuthorizationWebhook_To_garden_AuthorizationWebhook(a.(*AuthorizationWebhook), b.(*garden.AuthorizationWebhook), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.AuthorizationWebhook)(nil), (*AuthorizationWebhook)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_AuthorizationWebhook_To_v1alpha1_AuthorizationWebhook(a.(*garden.AuthorizationWebhook), b.(*AuthorizationWebhook), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AvailabilityRatio)(nil), (*garden.AvailabilityRatio)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_AvailabilityRatio_To_garden_AvailabilityRatio(a.(*AvailabilityRatio), b.(*garden.AvailabilityRatio), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.AvailabilityRatio)(nil), (*AvailabilityRatio)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_AvailabilityRatio_To_v1alpha1_AvailabilityRatio(a.(*garden.AvailabilityRatio), b.(*AvailabilityRatio), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*AvailabilityZone)(nil), (*garden.AvailabilityZone)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_AvailabilityZone_To_garden_AvailabilityZone(a.(*AvailabilityZone), b.(*garden.AvailabilityZone), scope)
 	}); err != nil {
@@ -202,6 +282,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*BackupRetentionPolicy)(nil), (*core.BackupRetentionPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_BackupRetentionPolicy_To_core_BackupRetentionPolicy(a.(*BackupRetentionPolicy), b.(*core.BackupRetentionPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.BackupRetentionPolicy)(nil), (*BackupRetentionPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_BackupRetentionPolicy_To_v1alpha1_BackupRetentionPolicy(a.(*core.BackupRetentionPolicy), b.(*BackupRetentionPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*CARotation)(nil), (*garden.CARotation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_CARotation_To_garden_CARotation(a.(*CARotation), b.(*garden.CARotation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.CARotation)(nil), (*CARotation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_CARotation_To_v1alpha1_CARotation(a.(*garden.CARotation), b.(*CARotation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*CRI)(nil), (*garden.CRI)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_CRI_To_garden_CRI(a.(*CRI), b.(*garden.CRI), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.CRI)(nil), (*CRI)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_CRI_To_v1alpha1_CRI(a.(*garden.CRI), b.(*CRI), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*CloudInfo)(nil), (*core.CloudInfo)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_CloudInfo_To_core_CloudInfo(a.(*CloudInfo), b.(*core.CloudInfo), scope)
 	}); err != nil {
@@ -272,6 +382,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ConditionHistory)(nil), (*garden.ConditionHistory)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ConditionHistory_To_garden_ConditionHistory(a.(*ConditionHistory), b.(*garden.ConditionHistory), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.ConditionHistory)(nil), (*ConditionHistory)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_ConditionHistory_To_v1alpha1_ConditionHistory(a.(*garden.ConditionHistory), b.(*ConditionHistory), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ConditionTransition)(nil), (*garden.ConditionTransition)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ConditionTransition_To_garden_ConditionTransition(a.(*ConditionTransition), b.(*garden.ConditionTransition), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.ConditionTransition)(nil), (*ConditionTransition)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_ConditionTransition_To_v1alpha1_ConditionTransition(a.(*garden.ConditionTransition), b.(*ConditionTransition), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ControlPlane)(nil), (*garden.ControlPlane)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ControlPlane_To_garden_ControlPlane(a.(*ControlPlane), b.(*garden.ControlPlane), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.ControlPlane)(nil), (*ControlPlane)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_ControlPlane_To_v1alpha1_ControlPlane(a.(*garden.ControlPlane), b.(*ControlPlane), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ControllerDeployment)(nil), (*core.ControllerDeployment)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ControllerDeployment_To_core_ControllerDeployment(a.(*ControllerDeployment), b.(*core.ControllerDeployment), scope)
 	}); err != nil {
@@ -392,6 +532,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ETCDBackupOperation)(nil), (*garden.ETCDBackupOperation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ETCDBackupOperation_To_garden_ETCDBackupOperation(a.(*ETCDBackupOperation), b.(*garden.ETCDBackupOperation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.ETCDBackupOperation)(nil), (*ETCDBackupOperation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_ETCDBackupOperation_To_v1alpha1_ETCDBackupOperation(a.(*garden.ETCDBackupOperation), b.(*ETCDBackupOperation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ETCDEncryptionKeyRotation)(nil), (*garden.ETCDEncryptionKeyRotation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ETCDEncryptionKeyRotation_To_garden_ETCDEncryptionKeyRotation(a.(*ETCDEncryptionKeyRotation), b.(*garden.ETCDEncryptionKeyRotation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.ETCDEncryptionKeyRotation)(nil), (*ETCDEncryptionKeyRotation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_ETCDEncryptionKeyRotation_To_v1alpha1_ETCDEncryptionKeyRotation(a.(*garden.ETCDEncryptionKeyRotation), b.(*ETCDEncryptionKeyRotation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*EncryptionConfig)(nil), (*garden.EncryptionConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_EncryptionConfig_To_garden_EncryptionConfig(a.(*EncryptionConfig), b.(*garden.EncryptionConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.EncryptionConfig)(nil), (*EncryptionConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_EncryptionConfig_To_v1alpha1_EncryptionConfig(a.(*garden.EncryptionConfig), b.(*EncryptionConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Endpoint)(nil), (*core.Endpoint)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_Endpoint_To_core_Endpoint(a.(*Endpoint), b.(*core.Endpoint), scope)
 	}); err != nil {
@@ -432,6 +602,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*FailureTolerance)(nil), (*garden.FailureTolerance)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_FailureTolerance_To_garden_FailureTolerance(a.(*FailureTolerance), b.(*garden.FailureTolerance), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.FailureTolerance)(nil), (*FailureTolerance)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_FailureTolerance_To_v1alpha1_FailureTolerance(a.(*garden.FailureTolerance), b.(*FailureTolerance), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Gardener)(nil), (*garden.Gardener)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_Gardener_To_garden_Gardener(a.(*Gardener), b.(*garden.Gardener), scope)
 	}); err != nil {
@@ -472,6 +652,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*HighAvailability)(nil), (*garden.HighAvailability)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_HighAvailability_To_garden_HighAvailability(a.(*HighAvailability), b.(*garden.HighAvailability), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.HighAvailability)(nil), (*HighAvailability)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_HighAvailability_To_v1alpha1_HighAvailability(a.(*garden.HighAvailability), b.(*HighAvailability), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*HorizontalPodAutoscalerConfig)(nil), (*garden.HorizontalPodAutoscalerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_HorizontalPodAutoscalerConfig_To_garden_HorizontalPodAutoscalerConfig(a.(*HorizontalPodAutoscalerConfig), b.(*garden.HorizontalPodAutoscalerConfig), scope)
 	}); err != nil {
@@ -482,6 +672,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*KMSProvider)(nil), (*garden.KMSProvider)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_KMSProvider_To_garden_KMSProvider(a.(*KMSProvider), b.(*garden.KMSProvider), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.KMSProvider)(nil), (*KMSProvider)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_KMSProvider_To_v1alpha1_KMSProvider(a.(*garden.KMSProvider), b.(*KMSProvider), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*KubeAPIServerConfig)(nil), (*garden.KubeAPIServerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_KubeAPIServerConfig_To_garden_KubeAPIServerConfig(a.(*KubeAPIServerConfig), b.(*garden.KubeAPIServerConfig), scope)
 	}); err != nil {
@@ -492,6 +692,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*KubeControllerManagerConcurrentSyncs)(nil), (*garden.KubeControllerManagerConcurrentSyncs)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_KubeControllerManagerConcurrentSyncs_To_garden_KubeControllerManagerConcurrentSyncs(a.(*KubeControllerManagerConcurrentSyncs), b.(*garden.KubeControllerManagerConcurrentSyncs), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.KubeControllerManagerConcurrentSyncs)(nil), (*KubeControllerManagerConcurrentSyncs)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_KubeControllerManagerConcurrentSyncs_To_v1alpha1_KubeControllerManagerConcurrentSyncs(a.(*garden.KubeControllerManagerConcurrentSyncs), b.(*KubeControllerManagerConcurrentSyncs), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*KubeControllerManagerConfig)(nil), (*garden.KubeControllerManagerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_KubeControllerManagerConfig_To_garden_KubeControllerManagerConfig(a.(*KubeControllerManagerConfig), b.(*garden.KubeControllerManagerConfig), scope)
 	}); err != nil {
@@ -522,6 +732,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*KubeconfigInfo)(nil), (*core.KubeconfigInfo)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_KubeconfigInfo_To_core_KubeconfigInfo(a.(*KubeconfigInfo), b.(*core.KubeconfigInfo), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.KubeconfigInfo)(nil), (*KubeconfigInfo)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_KubeconfigInfo_To_v1alpha1_KubeconfigInfo(a.(*core.KubeconfigInfo), b.(*KubeconfigInfo), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*KubeletConfig)(nil), (*garden.KubeletConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_KubeletConfig_To_garden_KubeletConfig(a.(*KubeletConfig), b.(*garden.KubeletConfig), scope)
 	}); err != nil {
@@ -562,6 +782,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*KubeletConfigFieldDrift)(nil), (*garden.KubeletConfigFieldDrift)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_KubeletConfigFieldDrift_To_garden_KubeletConfigFieldDrift(a.(*KubeletConfigFieldDrift), b.(*garden.KubeletConfigFieldDrift), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.KubeletConfigFieldDrift)(nil), (*KubeletConfigFieldDrift)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_KubeletConfigFieldDrift_To_v1alpha1_KubeletConfigFieldDrift(a.(*garden.KubeletConfigFieldDrift), b.(*KubeletConfigFieldDrift), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Kubernetes)(nil), (*garden.Kubernetes)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_Kubernetes_To_garden_Kubernetes(a.(*Kubernetes), b.(*garden.Kubernetes), scope)
 	}); err != nil {
@@ -712,6 +942,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*NetworkPolicyRule)(nil), (*garden.NetworkPolicyRule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NetworkPolicyRule_To_garden_NetworkPolicyRule(a.(*NetworkPolicyRule), b.(*garden.NetworkPolicyRule), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.NetworkPolicyRule)(nil), (*NetworkPolicyRule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_NetworkPolicyRule_To_v1alpha1_NetworkPolicyRule(a.(*garden.NetworkPolicyRule), b.(*NetworkPolicyRule), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NetworkPolicyRulePort)(nil), (*garden.NetworkPolicyRulePort)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NetworkPolicyRulePort_To_garden_NetworkPolicyRulePort(a.(*NetworkPolicyRulePort), b.(*garden.NetworkPolicyRulePort), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.NetworkPolicyRulePort)(nil), (*NetworkPolicyRulePort)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_NetworkPolicyRulePort_To_v1alpha1_NetworkPolicyRulePort(a.(*garden.NetworkPolicyRulePort), b.(*NetworkPolicyRulePort), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NetworkPool)(nil), (*garden.NetworkPool)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NetworkPool_To_garden_NetworkPool(a.(*NetworkPool), b.(*garden.NetworkPool), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.NetworkPool)(nil), (*NetworkPool)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_NetworkPool_To_v1alpha1_NetworkPool(a.(*garden.NetworkPool), b.(*NetworkPool), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Networking)(nil), (*garden.Networking)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_Networking_To_garden_Networking(a.(*Networking), b.(*garden.Networking), scope)
 	}); err != nil {
@@ -732,6 +992,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*NodeKubeletConfigDrift)(nil), (*garden.NodeKubeletConfigDrift)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NodeKubeletConfigDrift_To_garden_NodeKubeletConfigDrift(a.(*NodeKubeletConfigDrift), b.(*garden.NodeKubeletConfigDrift), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.NodeKubeletConfigDrift)(nil), (*NodeKubeletConfigDrift)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_NodeKubeletConfigDrift_To_v1alpha1_NodeKubeletConfigDrift(a.(*garden.NodeKubeletConfigDrift), b.(*NodeKubeletConfigDrift), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NodesInfo)(nil), (*core.NodesInfo)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NodesInfo_To_core_NodesInfo(a.(*NodesInfo), b.(*core.NodesInfo), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.NodesInfo)(nil), (*NodesInfo)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_NodesInfo_To_v1alpha1_NodesInfo(a.(*core.NodesInfo), b.(*NodesInfo), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*OIDCConfig)(nil), (*garden.OIDCConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_OIDCConfig_To_garden_OIDCConfig(a.(*OIDCConfig), b.(*garden.OIDCConfig), scope)
 	}); err != nil {
@@ -902,6 +1182,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*SecondaryBackupLocation)(nil), (*core.SecondaryBackupLocation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SecondaryBackupLocation_To_core_SecondaryBackupLocation(a.(*SecondaryBackupLocation), b.(*core.SecondaryBackupLocation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.SecondaryBackupLocation)(nil), (*SecondaryBackupLocation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_SecondaryBackupLocation_To_v1alpha1_SecondaryBackupLocation(a.(*core.SecondaryBackupLocation), b.(*SecondaryBackupLocation), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*SecretBinding)(nil), (*garden.SecretBinding)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_SecretBinding_To_garden_SecretBinding(a.(*SecretBinding), b.(*garden.SecretBinding), scope)
 	}); err != nil {
@@ -1042,6 +1332,56 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ShootAvailability)(nil), (*garden.ShootAvailability)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ShootAvailability_To_garden_ShootAvailability(a.(*ShootAvailability), b.(*garden.ShootAvailability), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.ShootAvailability)(nil), (*ShootAvailability)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_ShootAvailability_To_v1alpha1_ShootAvailability(a.(*garden.ShootAvailability), b.(*ShootAvailability), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ShootBackup)(nil), (*garden.ShootBackup)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ShootBackup_To_garden_ShootBackup(a.(*ShootBackup), b.(*garden.ShootBackup), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.ShootBackup)(nil), (*ShootBackup)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_ShootBackup_To_v1alpha1_ShootBackup(a.(*garden.ShootBackup), b.(*ShootBackup), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ShootCredentials)(nil), (*garden.ShootCredentials)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ShootCredentials_To_garden_ShootCredentials(a.(*ShootCredentials), b.(*garden.ShootCredentials), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.ShootCredentials)(nil), (*ShootCredentials)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_ShootCredentials_To_v1alpha1_ShootCredentials(a.(*garden.ShootCredentials), b.(*ShootCredentials), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ShootCredentialsRotation)(nil), (*garden.ShootCredentialsRotation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ShootCredentialsRotation_To_garden_ShootCredentialsRotation(a.(*ShootCredentialsRotation), b.(*garden.ShootCredentialsRotation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.ShootCredentialsRotation)(nil), (*ShootCredentialsRotation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_ShootCredentialsRotation_To_v1alpha1_ShootCredentialsRotation(a.(*garden.ShootCredentialsRotation), b.(*ShootCredentialsRotation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ShootETCDBackup)(nil), (*garden.ShootETCDBackup)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ShootETCDBackup_To_garden_ShootETCDBackup(a.(*ShootETCDBackup), b.(*garden.ShootETCDBackup), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.ShootETCDBackup)(nil), (*ShootETCDBackup)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_ShootETCDBackup_To_v1alpha1_ShootETCDBackup(a.(*garden.ShootETCDBackup), b.(*ShootETCDBackup), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ShootList)(nil), (*garden.ShootList)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ShootList_To_garden_ShootList(a.(*ShootList), b.(*garden.ShootList), scope)
 	}); err != nil {
@@ -1062,6 +1402,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ShootNetworkPools)(nil), (*garden.ShootNetworkPools)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ShootNetworkPools_To_garden_ShootNetworkPools(a.(*ShootNetworkPools), b.(*garden.ShootNetworkPools), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.ShootNetworkPools)(nil), (*ShootNetworkPools)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_ShootNetworkPools_To_v1alpha1_ShootNetworkPools(a.(*garden.ShootNetworkPools), b.(*ShootNetworkPools), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ShootNetworks)(nil), (*garden.ShootNetworks)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ShootNetworks_To_garden_ShootNetworks(a.(*ShootNetworks), b.(*garden.ShootNetworks), scope)
 	}); err != nil {
@@ -1122,6 +1472,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*StaticCredentialsRotation)(nil), (*garden.StaticCredentialsRotation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_StaticCredentialsRotation_To_garden_StaticCredentialsRotation(a.(*StaticCredentialsRotation), b.(*garden.StaticCredentialsRotation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.StaticCredentialsRotation)(nil), (*StaticCredentialsRotation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_StaticCredentialsRotation_To_v1alpha1_StaticCredentialsRotation(a.(*garden.StaticCredentialsRotation), b.(*StaticCredentialsRotation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*StaticCredentialsRotationConfig)(nil), (*garden.StaticCredentialsRotationConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_StaticCredentialsRotationConfig_To_garden_StaticCredentialsRotationConfig(a.(*StaticCredentialsRotationConfig), b.(*garden.StaticCredentialsRotationConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.StaticCredentialsRotationConfig)(nil), (*StaticCredentialsRotationConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_StaticCredentialsRotationConfig_To_v1alpha1_StaticCredentialsRotationConfig(a.(*garden.StaticCredentialsRotationConfig), b.(*StaticCredentialsRotationConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Volume)(nil), (*garden.Volume)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_Volume_To_garden_Volume(a.(*Volume), b.(*garden.Volume), scope)
 	}); err != nil {
@@ -1162,6 +1532,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*WorkloadHealthInfo)(nil), (*core.WorkloadHealthInfo)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_WorkloadHealthInfo_To_core_WorkloadHealthInfo(a.(*WorkloadHealthInfo), b.(*core.WorkloadHealthInfo), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.WorkloadHealthInfo)(nil), (*WorkloadHealthInfo)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_WorkloadHealthInfo_To_v1alpha1_WorkloadHealthInfo(a.(*core.WorkloadHealthInfo), b.(*WorkloadHealthInfo), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*WorkloadsInfo)(nil), (*core.WorkloadsInfo)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_WorkloadsInfo_To_core_WorkloadsInfo(a.(*WorkloadsInfo), b.(*core.WorkloadsInfo), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.WorkloadsInfo)(nil), (*WorkloadsInfo)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_WorkloadsInfo_To_v1alpha1_WorkloadsInfo(a.(*core.WorkloadsInfo), b.(*WorkloadsInfo), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*core.BackupBucketSpec)(nil), (*BackupBucketSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_core_BackupBucketSpec_To_v1alpha1_BackupBucketSpec(a.(*core.BackupBucketSpec), b.(*BackupBucketSpec), scope)
 	}); err != nil {
@@ -1374,6 +1764,26 @@ func Convert_garden_AdmissionPlugin_To_v1alpha1_AdmissionPlugin(in *garden.Admis
 	return autoConvert_garden_AdmissionPlugin_To_v1alpha1_AdmissionPlugin(in, out, s)
 }
 
+func autoConvert_v1alpha1_AdmissionWebhookConfig_To_garden_AdmissionWebhookConfig(in *AdmissionWebhookConfig, out *garden.AdmissionWebhookConfig, s conversion.Scope) error {
+	out.SecretRef = in.SecretRef
+	return nil
+}
+
+// Convert_v1alpha1_AdmissionWebhookConfig_To_garden_AdmissionWebhookConfig is an autogenerated conversion function.
+func Convert_v1alpha1_AdmissionWebhookConfig_To_garden_AdmissionWebhookConfig(in *AdmissionWebhookConfig, out *garden.AdmissionWebhookConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_AdmissionWebhookConfig_To_garden_AdmissionWebhookConfig(in, out, s)
+}
+
+func autoConvert_garden_AdmissionWebhookConfig_To_v1alpha1_AdmissionWebhookConfig(in *garden.AdmissionWebhookConfig, out *AdmissionWebhookConfig, s conversion.Scope) error {
+	out.SecretRef = in.SecretRef
+	return nil
+}
+
+// Convert_garden_AdmissionWebhookConfig_To_v1alpha1_AdmissionWebhookConfig is an autogenerated conversion function.
+func Convert_garden_AdmissionWebhookConfig_To_v1alpha1_AdmissionWebhookConfig(in *garden.AdmissionWebhookConfig, out *AdmissionWebhookConfig, s conversion.Scope) error {
+	return autoConvert_garden_AdmissionWebhookConfig_To_v1alpha1_AdmissionWebhookConfig(in, out, s)
+}
+
 func autoConvert_v1alpha1_Alerting_To_garden_Alerting(in *Alerting, out *garden.Alerting, s conversion.Scope) error {
 	out.EmailReceivers = *(*[]string)(unsafe.Pointer(&in.EmailReceivers))
 	return nil
@@ -1394,8 +1804,61 @@ func Convert_garden_Alerting_To_v1alpha1_Alerting(in *garden.Alerting, out *Aler
 	return autoConvert_garden_Alerting_To_v1alpha1_Alerting(in, out, s)
 }
 
+func autoConvert_v1alpha1_AuditBackend_To_garden_AuditBackend(in *AuditBackend, out *garden.AuditBackend, s conversion.Scope) error {
+	out.Log = (*garden.AuditLogBackend)(unsafe.Pointer(in.Log))
+	out.Webhook = (*garden.AuditWebhookBackend)(unsafe.Pointer(in.Webhook))
+	return nil
+}
+
+// Convert_v1alpha1_AuditBackend_To_garden_AuditBackend is an autogenerated conversion function.
+func Convert_v1alpha1_AuditBackend_To_garden_AuditBackend(in *AuditBackend, out *garden.AuditBackend, s conversion.Scope) error {
+	return autoConvert_v1alpha1_AuditBackend_To_garden_AuditBackend(in, out, s)
+}
+
+func autoConvert_garden_AuditBackend_To_v1alpha1_AuditBackend(in *garden.AuditBackend, out *AuditBackend, s conversion.Scope) error {
+	out.Log = (*AuditLogBackend)(unsafe.Pointer(in.Log))
+	out.Webhook = (*AuditWebhookBackend)(unsafe.Pointer(in.Webhook))
+	return nil
+}
+
+// Convert_garden_AuditBackend_To_v1alpha1_AuditBackend is an autogenerated conversion function.
+func Convert_garden_AuditBackend_To_v1alpha1_AuditBackend(in *garden.AuditBackend, out *AuditBackend, s conversion.Scope) error {
+	return autoConvert_garden_AuditBackend_To_v1alpha1_AuditBackend(in, out, s)
+}
+
+func autoConvert_v1alpha1_AuditBuffering_To_garden_AuditBuffering(in *AuditBuffering, out *garden.AuditBuffering, s conversion.Scope) error {
+	out.Mode = garden.AuditMode(in.Mode)
+	out.BufferSize = (*int32)(unsafe.Pointer(in.BufferSize))
+	out.MaxBatchSize = (*int32)(unsafe.Pointer(in.MaxBatchSize))
+	out.MaxBatchWait = (*v1.Duration)(unsafe.Pointer(in.MaxBatchWait))
+	out.ThrottleQPS = (*int32)(unsafe.Pointer(in.ThrottleQPS))
+	out.ThrottleBurst = (*int32)(unsafe.Pointer(in.ThrottleBurst))
+	return nil
+}
+
+// Convert_v1alpha1_AuditBuffering_To_garden_AuditBuffering is an autogenerated conversion function.
+func Convert_v1alpha1_AuditBuffering_To_garden_AuditBuffering(in *AuditBuffering, out *garden.AuditBuffering, s conversion.Scope) error {
+	return autoConvert_v1alpha1_AuditBuffering_To_garden_AuditBuffering(in, out, s)
+}
+
+func autoConvert_garden_AuditBuffering_To_v1alpha1_AuditBuffering(in *garden.AuditBuffering, out *AuditBuffering, s conversion.Scope) error {
+	out.Mode = AuditMode(in.Mode)
+	out.BufferSize = (*int32)(unsafe.Pointer(in.BufferSize))
+	out.MaxBatchSize = (*int32)(unsafe.Pointer(in.MaxBatchSize))
+	out.MaxBatchWait = (*v1.Duration)(unsafe.Pointer(in.MaxBatchWait))
+	out.ThrottleQPS = (*int32)(unsafe.Pointer(in.ThrottleQPS))
+	out.ThrottleBurst = (*int32)(unsafe.Pointer(in.ThrottleBurst))
+	return nil
+}
+
+// Convert_garden_AuditBuffering_To_v1alpha1_AuditBuffering is an autogenerated conversion function.
+func Convert_garden_AuditBuffering_To_v1alpha1_AuditBuffering(in *garden.AuditBuffering, out *AuditBuffering, s conversion.Scope) error {
+	return autoConvert_garden_AuditBuffering_To_v1alpha1_AuditBuffering(in, out, s)
+}
+
 func autoConvert_v1alpha1_AuditConfig_To_garden_AuditConfig(in *AuditConfig, out *garden.AuditConfig, s conversion.Scope) error {
 	out.AuditPolicy = (*garden.AuditPolicy)(unsafe.Pointer(in.AuditPolicy))
+	out.Backend = (*garden.AuditBackend)(unsafe.Pointer(in.Backend))
 	return nil
 }
 
@@ -1406,6 +1869,7 @@ func Convert_v1alpha1_AuditConfig_To_garden_AuditConfig(in *AuditConfig, out *ga
 
 func autoConvert_garden_AuditConfig_To_v1alpha1_AuditConfig(in *garden.AuditConfig, out *AuditConfig, s conversion.Scope) error {
 	out.AuditPolicy = (*AuditPolicy)(unsafe.Pointer(in.AuditPolicy))
+	out.Backend = (*AuditBackend)(unsafe.Pointer(in.Backend))
 	return nil
 }
 
@@ -1414,8 +1878,34 @@ func Convert_garden_AuditConfig_To_v1alpha1_AuditConfig(in *garden.AuditConfig,
 	return autoConvert_garden_AuditConfig_To_v1alpha1_AuditConfig(in, out, s)
 }
 
+func autoConvert_v1alpha1_AuditLogBackend_To_garden_AuditLogBackend(in *AuditLogBackend, out *garden.AuditLogBackend, s conversion.Scope) error {
+	out.MaxAge = (*int32)(unsafe.Pointer(in.MaxAge))
+	out.MaxBackups = (*int32)(unsafe.Pointer(in.MaxBackups))
+	out.MaxSize = (*int32)(unsafe.Pointer(in.MaxSize))
+	out.Buffering = (*garden.AuditBuffering)(unsafe.Pointer(in.Buffering))
+	return nil
+}
+
+// Convert_v1alpha1_AuditLogBackend_To_garden_AuditLogBackend is an autogenerated conversion function.
+func Convert_v1alpha1_AuditLogBackend_To_garden_AuditLogBackend(in *AuditLogBackend, out *garden.AuditLogBackend, s conversion.Scope) error {
+	return autoConvert_v1alpha1_AuditLogBackend_To_garden_AuditLogBackend(in, out, s)
+}
+
+func autoConvert_garden_AuditLogBackend_To_v1alpha1_AuditLogBackend(in *garden.AuditLogBackend, out *AuditLogBackend, s conversion.Scope) error {
+	out.MaxAge = (*int32)(unsafe.Pointer(in.MaxAge))
+	out.MaxBackups = (*int32)(unsafe.Pointer(in.MaxBackups))
+	out.MaxSize = (*int32)(unsafe.Pointer(in.MaxSize))
+	out.Buffering = (*AuditBuffering)(unsafe.Pointer(in.Buffering))
+	return nil
+}
+
+// Convert_garden_AuditLogBackend_To_v1alpha1_AuditLogBackend is an autogenerated conversion function.
+func Convert_garden_AuditLogBackend_To_v1alpha1_AuditLogBackend(in *garden.AuditLogBackend, out *AuditLogBackend, s conversion.Scope) error {
+	return autoConvert_garden_AuditLogBackend_To_v1alpha1_AuditLogBackend(in, out, s)
+}
+
 func autoConvert_v1alpha1_AuditPolicy_To_garden_AuditPolicy(in *AuditPolicy, out *garden.AuditPolicy, s conversion.Scope) error {
-	out.ConfigMapRef = (*v1.ObjectReference)(unsafe.Pointer(in.ConfigMapRef))
+	out.ConfigMapRef = (*corev1.ObjectReference)(unsafe.Pointer(in.ConfigMapRef))
 	return nil
 }
 
@@ -1425,7 +1915,7 @@ func Convert_v1alpha1_AuditPolicy_To_garden_AuditPolicy(in *AuditPolicy, out *ga
 }
 
 func autoConvert_garden_AuditPolicy_To_v1alpha1_AuditPolicy(in *garden.AuditPolicy, out *AuditPolicy, s conversion.Scope) error {
-	out.ConfigMapRef = (*v1.ObjectReference)(unsafe.Pointer(in.ConfigMapRef))
+	out.ConfigMapRef = (*corev1.ObjectReference)(unsafe.Pointer(in.ConfigMapRef))
 	return nil
 }
 
@@ -1434,6 +1924,100 @@ func Convert_garden_AuditPolicy_To_v1alpha1_AuditPolicy(in *garden.AuditPolicy,
 	return autoConvert_garden_AuditPolicy_To_v1alpha1_AuditPolicy(in, out, s)
 }
 
+func autoConvert_v1alpha1_AuditWebhookBackend_To_garden_AuditWebhookBackend(in *AuditWebhookBackend, out *garden.AuditWebhookBackend, s conversion.Scope) error {
+	out.SecretRef = in.SecretRef
+	out.InitialBackoff = (*v1.Duration)(unsafe.Pointer(in.InitialBackoff))
+	out.Buffering = (*garden.AuditBuffering)(unsafe.Pointer(in.Buffering))
+	return nil
+}
+
+// Convert_v1alpha1_AuditWebhookBackend_To_garden_AuditWebhookBackend is an autogenerated conversion function.
+func Convert_v1alpha1_AuditWebhookBackend_To_garden_AuditWebhookBackend(in *AuditWebhookBackend, out *garden.AuditWebhookBackend, s conversion.Scope) error {
+	return autoConvert_v1alpha1_AuditWebhookBackend_To_garden_AuditWebhookBackend(in, out, s)
+}
+
+func autoConvert_garden_AuditWebhookBackend_To_v1alpha1_AuditWebhookBackend(in *garden.AuditWebhookBackend, out *AuditWebhookBackend, s conversion.Scope) error {
+	out.SecretRef = in.SecretRef
+	out.InitialBackoff = (*v1.Duration)(unsafe.Pointer(in.InitialBackoff))
+	out.Buffering = (*AuditBuffering)(unsafe.Pointer(in.Buffering))
+	return nil
+}
+
+// Convert_garden_AuditWebhookBackend_To_v1alpha1_AuditWebhookBackend is an autogenerated conversion function.
+func Convert_garden_AuditWebhookBackend_To_v1alpha1_AuditWebhookBackend(in *garden.AuditWebhookBackend, out *AuditWebhookBackend, s conversion.Scope) error {
+	return autoConvert_garden_AuditWebhookBackend_To_v1alpha1_AuditWebhookBackend(in, out, s)
+}
+
+func autoConvert_v1alpha1_AuthenticationWebhook_To_garden_AuthenticationWebhook(in *AuthenticationWebhook, out *garden.AuthenticationWebhook, s conversion.Scope) error {
+	out.SecretRef = in.SecretRef
+	out.CacheTTL = (*v1.Duration)(unsafe.Pointer(in.CacheTTL))
+	return nil
+}
+
+// Convert_v1alpha1_AuthenticationWebhook_To_garden_AuthenticationWebhook is an autogenerated conversion function.
+func Convert_v1alpha1_AuthenticationWebhook_To_garden_AuthenticationWebhook(in *AuthenticationWebhook, out *garden.AuthenticationWebhook, s conversion.Scope) error {
+	return autoConvert_v1alpha1_AuthenticationWebhook_To_garden_AuthenticationWebhook(in, out, s)
+}
+
+func autoConvert_garden_AuthenticationWebhook_To_v1alpha1_AuthenticationWebhook(in *garden.AuthenticationWebhook, out *AuthenticationWebhook, s conversion.Scope) error {
+	out.SecretRef = in.SecretRef
+	out.CacheTTL = (*v1.Duration)(unsafe.Pointer(in.CacheTTL))
+	return nil
+}
+
+// Convert_garden_AuthenticationWebhook_To_v1alpha1_AuthenticationWebhook is an autogenerated conversion function.
+func Convert_garden_AuthenticationWebhook_To_v1alpha1_AuthenticationWebhook(in *garden.AuthenticationWebhook, out *AuthenticationWebhook, s conversion.Scope) error {
+	return autoConvert_garden_AuthenticationWebhook_To_v1alpha1_AuthenticationWebhook(in, out, s)
+}
+
+func autoConvert_v1alpha1_AuthorizationWebhook_To_garden_AuthorizationWebhook(in *AuthorizationWebhook, out *garden.AuthorizationWebhook, s conversion.Scope) error {
+	out.SecretRef = in.SecretRef
+	out.CacheAuthorizedTTL = (*v1.Duration)(unsafe.Pointer(in.CacheAuthorizedTTL))
+	out.CacheUnauthorizedTTL = (*v1.Duration)(unsafe.Pointer(in.CacheUnauthorizedTTL))
+	return nil
+}
+
+// Convert_v1alpha1_AuthorizationWebhook_To_garden_AuthorizationWebhook is an autogenerated conversion function.
+func Convert_v1alpha1_AuthorizationWebhook_To_garden_AuthorizationWebhook(in *AuthorizationWebhook, out *garden.AuthorizationWebhook, s conversion.Scope) error {
+	return autoConvert_v1alpha1_AuthorizationWebhook_To_garden_AuthorizationWebhook(in, out, s)
+}
+
+func autoConvert_garden_AuthorizationWebhook_To_v1alpha1_AuthorizationWebhook(in *garden.AuthorizationWebhook, out *AuthorizationWebhook, s conversion.Scope) error {
+	out.SecretRef = in.SecretRef
+	out.CacheAuthorizedTTL = (*v1.Duration)(unsafe.Pointer(in.CacheAuthorizedTTL))
+	out.CacheUnauthorizedTTL = (*v1.Duration)(unsafe.Pointer(in.CacheUnauthorizedTTL))
+	return nil
+}
+
+// Convert_garden_AuthorizationWebhook_To_v1alpha1_AuthorizationWebhook is an autogenerated conversion function.
+func Convert_garden_AuthorizationWebhook_To_v1alpha1_AuthorizationWebhook(in *garden.AuthorizationWebhook, out *AuthorizationWebhook, s conversion.Scope) error {
+	return autoConvert_garden_AuthorizationWebhook_To_v1alpha1_AuthorizationWebhook(in, out, s)
+}
+
+func autoConvert_v1alpha1_AvailabilityRatio_To_garden_AvailabilityRatio(in *AvailabilityRatio, out *garden.AvailabilityRatio, s conversion.Scope) error {
+	out.Period = in.Period
+	out.Ratio = in.Ratio
+	out.Probes = in.Probes
+	return nil
+}
+
+// Convert_v1alpha1_AvailabilityRatio_To_garden_AvailabilityRatio is an autogenerated conversion function.
+func Convert_v1alpha1_AvailabilityRatio_To_garden_AvailabilityRatio(in *AvailabilityRatio, out *garden.AvailabilityRatio, s conversion.Scope) error {
+	return autoConvert_v1alpha1_AvailabilityRatio_To_garden_AvailabilityRatio(in, out, s)
+}
+
+func autoConvert_garden_AvailabilityRatio_To_v1alpha1_AvailabilityRatio(in *garden.AvailabilityRatio, out *AvailabilityRatio, s conversion.Scope) error {
+	out.Period = in.Period
+	out.Ratio = in.Ratio
+	out.Probes = in.Probes
+	return nil
+}
+
+// Convert_garden_AvailabilityRatio_To_v1alpha1_AvailabilityRatio is an autogenerated conversion function.
+func Convert_garden_AvailabilityRatio_To_v1alpha1_AvailabilityRatio(in *garden.AvailabilityRatio, out *AvailabilityRatio, s conversion.Scope) error {
+	return autoConvert_garden_AvailabilityRatio_To_v1alpha1_AvailabilityRatio(in, out, s)
+}
+
 func autoConvert_v1alpha1_AvailabilityZone_To_garden_AvailabilityZone(in *AvailabilityZone, out *garden.AvailabilityZone, s conversion.Scope) error {
 	out.Name = in.Name
 	out.UnavailableMachineTypes = *(*[]string)(unsafe.Pointer(&in.UnavailableMachineTypes))
@@ -1550,6 +2134,7 @@ func autoConvert_v1alpha1_BackupBucketSpec_To_core_BackupBucketSpec(in *BackupBu
 	}
 	out.SecretRef = in.SecretRef
 	// WARNING: in.Seed requires manual conversion: does not exist in peer-type
+	out.Secondary = (*core.SecondaryBackupLocation)(unsafe.Pointer(in.Secondary))
 	return nil
 }
 
@@ -1559,6 +2144,7 @@ func autoConvert_core_BackupBucketSpec_To_v1alpha1_BackupBucketSpec(in *core.Bac
 	}
 	out.SecretRef = in.SecretRef
 	// WARNING: in.SeedName requires manual conversion: does not exist in peer-type
+	out.Secondary = (*SecondaryBackupLocation)(unsafe.Pointer(in.Secondary))
 	return nil
 }
 
@@ -1566,7 +2152,7 @@ func autoConvert_v1alpha1_BackupBucketStatus_To_core_BackupBucketStatus(in *Back
 	out.LastOperation = (*core.LastOperation)(unsafe.Pointer(in.LastOperation))
 	out.LastError = (*core.LastError)(unsafe.Pointer(in.LastError))
 	out.ObservedGeneration = in.ObservedGeneration
-	out.GeneratedSecretRef = (*v1.SecretReference)(unsafe.Pointer(in.GeneratedSecretRef))
+	out.GeneratedSecretRef = (*corev1.SecretReference)(unsafe.Pointer(in.GeneratedSecretRef))
 	return nil
 }
 
@@ -1579,7 +2165,7 @@ func autoConvert_core_BackupBucketStatus_To_v1alpha1_BackupBucketStatus(in *core
 	out.LastOperation = (*LastOperation)(unsafe.Pointer(in.LastOperation))
 	out.LastError = (*LastError)(unsafe.Pointer(in.LastError))
 	out.ObservedGeneration = in.ObservedGeneration
-	out.GeneratedSecretRef = (*v1.SecretReference)(unsafe.Pointer(in.GeneratedSecretRef))
+	out.GeneratedSecretRef = (*corev1.SecretReference)(unsafe.Pointer(in.GeneratedSecretRef))
 	return nil
 }
 
@@ -1655,12 +2241,14 @@ func Convert_core_BackupEntryList_To_v1alpha1_BackupEntryList(in *core.BackupEnt
 func autoConvert_v1alpha1_BackupEntrySpec_To_core_BackupEntrySpec(in *BackupEntrySpec, out *core.BackupEntrySpec, s conversion.Scope) error {
 	out.BucketName = in.BucketName
 	// WARNING: in.Seed requires manual conversion: does not exist in peer-type
+	out.RetentionPolicy = (*core.BackupRetentionPolicy)(unsafe.Pointer(in.RetentionPolicy))
 	return nil
 }
 
 func autoConvert_core_BackupEntrySpec_To_v1alpha1_BackupEntrySpec(in *core.BackupEntrySpec, out *BackupEntrySpec, s conversion.Scope) error {
 	out.BucketName = in.BucketName
 	// WARNING: in.SeedName requires manual conversion: does not exist in peer-type
+	out.RetentionPolicy = (*BackupRetentionPolicy)(unsafe.Pointer(in.RetentionPolicy))
 	return nil
 }
 
@@ -1668,6 +2256,7 @@ func autoConvert_v1alpha1_BackupEntryStatus_To_core_BackupEntryStatus(in *Backup
 	out.LastOperation = (*core.LastOperation)(unsafe.Pointer(in.LastOperation))
 	out.LastError = (*core.LastError)(unsafe.Pointer(in.LastError))
 	out.ObservedGeneration = in.ObservedGeneration
+	out.ReplicaBucketName = (*string)(unsafe.Pointer(in.ReplicaBucketName))
 	return nil
 }
 
@@ -1680,6 +2269,7 @@ func autoConvert_core_BackupEntryStatus_To_v1alpha1_BackupEntryStatus(in *core.B
 	out.LastOperation = (*LastOperation)(unsafe.Pointer(in.LastOperation))
 	out.LastError = (*LastError)(unsafe.Pointer(in.LastError))
 	out.ObservedGeneration = in.ObservedGeneration
+	out.ReplicaBucketName = (*string)(unsafe.Pointer(in.ReplicaBucketName))
 	return nil
 }
 
@@ -1688,6 +2278,72 @@ func Convert_core_BackupEntryStatus_To_v1alpha1_BackupEntryStatus(in *core.Backu
 	return autoConvert_core_BackupEntryStatus_To_v1alpha1_BackupEntryStatus(in, out, s)
 }
 
+func autoConvert_v1alpha1_BackupRetentionPolicy_To_core_BackupRetentionPolicy(in *BackupRetentionPolicy, out *core.BackupRetentionPolicy, s conversion.Scope) error {
+	out.DailySnapshots = (*int32)(unsafe.Pointer(in.DailySnapshots))
+	out.WeeklySnapshots = (*int32)(unsafe.Pointer(in.WeeklySnapshots))
+	return nil
+}
+
+// Convert_v1alpha1_BackupRetentionPolicy_To_core_BackupRetentionPolicy is an autogenerated conversion function.
+func Convert_v1alpha1_BackupRetentionPolicy_To_core_BackupRetentionPolicy(in *BackupRetentionPolicy, out *core.BackupRetentionPolicy, s conversion.Scope) error {
+	return autoConvert_v1alpha1_BackupRetentionPolicy_To_core_BackupRetentionPolicy(in, out, s)
+}
+
+func autoConvert_core_BackupRetentionPolicy_To_v1alpha1_BackupRetentionPolicy(in *core.BackupRetentionPolicy, out *BackupRetentionPolicy, s conversion.Scope) error {
+	out.DailySnapshots = (*int32)(unsafe.Pointer(in.DailySnapshots))
+	out.WeeklySnapshots = (*int32)(unsafe.Pointer(in.WeeklySnapshots))
+	return nil
+}
+
+// Convert_core_BackupRetentionPolicy_To_v1alpha1_BackupRetentionPolicy is an autogenerated conversion function.
+func Convert_core_BackupRetentionPolicy_To_v1alpha1_BackupRetentionPolicy(in *core.BackupRetentionPolicy, out *BackupRetentionPolicy, s conversion.Scope) error {
+	return autoConvert_core_BackupRetentionPolicy_To_v1alpha1_BackupRetentionPolicy(in, out, s)
+}
+
+func autoConvert_v1alpha1_CARotation_To_garden_CARotation(in *CARotation, out *garden.CARotation, s conversion.Scope) error {
+	out.Phase = garden.CredentialsRotationPhase(in.Phase)
+	out.LastInitiationTime = (*v1.Time)(unsafe.Pointer(in.LastInitiationTime))
+	out.LastCompletionTime = (*v1.Time)(unsafe.Pointer(in.LastCompletionTime))
+	return nil
+}
+
+// Convert_v1alpha1_CARotation_To_garden_CARotation is an autogenerated conversion function.
+func Convert_v1alpha1_CARotation_To_garden_CARotation(in *CARotation, out *garden.CARotation, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CARotation_To_garden_CARotation(in, out, s)
+}
+
+func autoConvert_garden_CARotation_To_v1alpha1_CARotation(in *garden.CARotation, out *CARotation, s conversion.Scope) error {
+	out.Phase = CredentialsRotationPhase(in.Phase)
+	out.LastInitiationTime = (*v1.Time)(unsafe.Pointer(in.LastInitiationTime))
+	out.LastCompletionTime = (*v1.Time)(unsafe.Pointer(in.LastCompletionTime))
+	return nil
+}
+
+// Convert_garden_CARotation_To_v1alpha1_CARotation is an autogenerated conversion function.
+func Convert_garden_CARotation_To_v1alpha1_CARotation(in *garden.CARotation, out *CARotation, s conversion.Scope) error {
+	return autoConvert_garden_CARotation_To_v1alpha1_CARotation(in, out, s)
+}
+
+func autoConvert_v1alpha1_CRI_To_garden_CRI(in *CRI, out *garden.CRI, s conversion.Scope) error {
+	out.Name = garden.CRIName(in.Name)
+	return nil
+}
+
+// Convert_v1alpha1_CRI_To_garden_CRI is an autogenerated conversion function.
+func Convert_v1alpha1_CRI_To_garden_CRI(in *CRI, out *garden.CRI, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CRI_To_garden_CRI(in, out, s)
+}
+
+func autoConvert_garden_CRI_To_v1alpha1_CRI(in *garden.CRI, out *CRI, s conversion.Scope) error {
+	out.Name = CRIName(in.Name)
+	return nil
+}
+
+// Convert_garden_CRI_To_v1alpha1_CRI is an autogenerated conversion function.
+func Convert_garden_CRI_To_v1alpha1_CRI(in *garden.CRI, out *CRI, s conversion.Scope) error {
+	return autoConvert_garden_CRI_To_v1alpha1_CRI(in, out, s)
+}
+
 func autoConvert_v1alpha1_CloudInfo_To_core_CloudInfo(in *CloudInfo, out *core.CloudInfo, s conversion.Scope) error {
 	out.Type = in.Type
 	out.Region = in.Region
@@ -1787,7 +2443,7 @@ func autoConvert_v1alpha1_CloudProfileSpec_To_garden_CloudProfileSpec(in *CloudP
 	}
 	out.ProviderConfig = (*garden.ProviderConfig)(unsafe.Pointer(in.ProviderConfig))
 	out.Regions = *(*[]garden.Region)(unsafe.Pointer(&in.Regions))
-	out.SeedSelector = (*metav1.LabelSelector)(unsafe.Pointer(in.SeedSelector))
+	out.SeedSelector = (*v1.LabelSelector)(unsafe.Pointer(in.SeedSelector))
 	out.Type = in.Type
 	if in.VolumeTypes != nil {
 		in, out := &in.VolumeTypes, &out.VolumeTypes
@@ -1828,7 +2484,7 @@ func autoConvert_garden_CloudProfileSpec_To_v1alpha1_CloudProfileSpec(in *garden
 	}
 	out.ProviderConfig = (*ProviderConfig)(unsafe.Pointer(in.ProviderConfig))
 	out.Regions = *(*[]Region)(unsafe.Pointer(&in.Regions))
-	out.SeedSelector = (*metav1.LabelSelector)(unsafe.Pointer(in.SeedSelector))
+	out.SeedSelector = (*v1.LabelSelector)(unsafe.Pointer(in.SeedSelector))
 	out.Type = in.Type
 	if in.VolumeTypes != nil {
 		in, out := &in.VolumeTypes, &out.VolumeTypes
@@ -1845,12 +2501,12 @@ func autoConvert_garden_CloudProfileSpec_To_v1alpha1_CloudProfileSpec(in *garden
 }
 
 func autoConvert_v1alpha1_ClusterAutoscaler_To_garden_ClusterAutoscaler(in *ClusterAutoscaler, out *garden.ClusterAutoscaler, s conversion.Scope) error {
-	out.ScaleDownDelayAfterAdd = (*metav1.Duration)(unsafe.Pointer(in.ScaleDownDelayAfterAdd))
-	out.ScaleDownDelayAfterDelete = (*metav1.Duration)(unsafe.Pointer(in.ScaleDownDelayAfterDelete))
-	out.ScaleDownDelayAfterFailure = (*metav1.Duration)(unsafe.Pointer(in.ScaleDownDelayAfterFailure))
-	out.ScaleDownUnneededTime = (*metav1.Duration)(unsafe.Pointer(in.ScaleDownUnneededTime))
+	out.ScaleDownDelayAfterAdd = (*v1.Duration)(unsafe.Pointer(in.ScaleDownDelayAfterAdd))
+	out.ScaleDownDelayAfterDelete = (*v1.Duration)(unsafe.Pointer(in.ScaleDownDelayAfterDelete))
+	out.ScaleDownDelayAfterFailure = (*v1.Duration)(unsafe.Pointer(in.ScaleDownDelayAfterFailure))
+	out.ScaleDownUnneededTime = (*v1.Duration)(unsafe.Pointer(in.ScaleDownUnneededTime))
 	out.ScaleDownUtilizationThreshold = (*float64)(unsafe.Pointer(in.ScaleDownUtilizationThreshold))
-	out.ScanInterval = (*metav1.Duration)(unsafe.Pointer(in.ScanInterval))
+	out.ScanInterval = (*v1.Duration)(unsafe.Pointer(in.ScanInterval))
 	return nil
 }
 
@@ -1861,11 +2517,11 @@ func Convert_v1alpha1_ClusterAutoscaler_To_garden_ClusterAutoscaler(in *ClusterA
 
 func autoConvert_garden_ClusterAutoscaler_To_v1alpha1_ClusterAutoscaler(in *garden.ClusterAutoscaler, out *ClusterAutoscaler, s conversion.Scope) error {
 	out.ScaleDownUtilizationThreshold = (*float64)(unsafe.Pointer(in.ScaleDownUtilizationThreshold))
-	out.ScaleDownUnneededTime = (*metav1.Duration)(unsafe.Pointer(in.ScaleDownUnneededTime))
-	out.ScaleDownDelayAfterAdd = (*metav1.Duration)(unsafe.Pointer(in.ScaleDownDelayAfterAdd))
-	out.ScaleDownDelayAfterFailure = (*metav1.Duration)(unsafe.Pointer(in.ScaleDownDelayAfterFailure))
-	out.ScaleDownDelayAfterDelete = (*metav1.Duration)(unsafe.Pointer(in.ScaleDownDelayAfterDelete))
-	out.ScanInterval = (*metav1.Duration)(unsafe.Pointer(in.ScanInterval))
+	out.ScaleDownUnneededTime = (*v1.Duration)(unsafe.Pointer(in.ScaleDownUnneededTime))
+	out.ScaleDownDelayAfterAdd = (*v1.Duration)(unsafe.Pointer(in.ScaleDownDelayAfterAdd))
+	out.ScaleDownDelayAfterFailure = (*v1.Duration)(unsafe.Pointer(in.ScaleDownDelayAfterFailure))
+	out.ScaleDownDelayAfterDelete = (*v1.Duration)(unsafe.Pointer(in.ScaleDownDelayAfterDelete))
+	out.ScanInterval = (*v1.Duration)(unsafe.Pointer(in.ScanInterval))
 	return nil
 }
 
@@ -1881,6 +2537,9 @@ func autoConvert_v1alpha1_ClusterInfo_To_core_ClusterInfo(in *ClusterInfo, out *
 	if err := Convert_v1alpha1_KubernetesInfo_To_core_KubernetesInfo(&in.Kubernetes, &out.Kubernetes, s); err != nil {
 		return err
 	}
+	out.Nodes = (*core.NodesInfo)(unsafe.Pointer(in.Nodes))
+	out.Workloads = (*core.WorkloadsInfo)(unsafe.Pointer(in.Workloads))
+	out.Kubeconfig = (*core.KubeconfigInfo)(unsafe.Pointer(in.Kubeconfig))
 	return nil
 }
 
@@ -1896,6 +2555,9 @@ func autoConvert_core_ClusterInfo_To_v1alpha1_ClusterInfo(in *core.ClusterInfo,
 	if err := Convert_core_KubernetesInfo_To_v1alpha1_KubernetesInfo(&in.Kubernetes, &out.Kubernetes, s); err != nil {
 		return err
 	}
+	out.Nodes = (*NodesInfo)(unsafe.Pointer(in.Nodes))
+	out.Workloads = (*WorkloadsInfo)(unsafe.Pointer(in.Workloads))
+	out.Kubeconfig = (*KubeconfigInfo)(unsafe.Pointer(in.Kubeconfig))
 	return nil
 }
 
@@ -1934,6 +2596,72 @@ func Convert_core_Condition_To_v1alpha1_Condition(in *core.Condition, out *Condi
 	return autoConvert_core_Condition_To_v1alpha1_Condition(in, out, s)
 }
 
+func autoConvert_v1alpha1_ConditionHistory_To_garden_ConditionHistory(in *ConditionHistory, out *garden.ConditionHistory, s conversion.Scope) error {
+	out.Type = garden.ConditionType(in.Type)
+	out.Transitions = *(*[]garden.ConditionTransition)(unsafe.Pointer(&in.Transitions))
+	return nil
+}
+
+// Convert_v1alpha1_ConditionHistory_To_garden_ConditionHistory is an autogenerated conversion function.
+func Convert_v1alpha1_ConditionHistory_To_garden_ConditionHistory(in *ConditionHistory, out *garden.ConditionHistory, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ConditionHistory_To_garden_ConditionHistory(in, out, s)
+}
+
+func autoConvert_garden_ConditionHistory_To_v1alpha1_ConditionHistory(in *garden.ConditionHistory, out *ConditionHistory, s conversion.Scope) error {
+	out.Type = ConditionType(in.Type)
+	out.Transitions = *(*[]ConditionTransition)(unsafe.Pointer(&in.Transitions))
+	return nil
+}
+
+// Convert_garden_ConditionHistory_To_v1alpha1_ConditionHistory is an autogenerated conversion function.
+func Convert_garden_ConditionHistory_To_v1alpha1_ConditionHistory(in *garden.ConditionHistory, out *ConditionHistory, s conversion.Scope) error {
+	return autoConvert_garden_ConditionHistory_To_v1alpha1_ConditionHistory(in, out, s)
+}
+
+func autoConvert_v1alpha1_ConditionTransition_To_garden_ConditionTransition(in *ConditionTransition, out *garden.ConditionTransition, s conversion.Scope) error {
+	out.Status = garden.ConditionStatus(in.Status)
+	out.Time = in.Time
+	return nil
+}
+
+// Convert_v1alpha1_ConditionTransition_To_garden_ConditionTransition is an autogenerated conversion function.
+func Convert_v1alpha1_ConditionTransition_To_garden_ConditionTransition(in *ConditionTransition, out *garden.ConditionTransition, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ConditionTransition_To_garden_ConditionTransition(in, out, s)
+}
+
+func autoConvert_garden_ConditionTransition_To_v1alpha1_ConditionTransition(in *garden.ConditionTransition, out *ConditionTransition, s conversion.Scope) error {
+	out.Status = ConditionStatus(in.Status)
+	out.Time = in.Time
+	return nil
+}
+
+// Convert_garden_ConditionTransition_To_v1alpha1_ConditionTransition is an autogenerated conversion function.
+func Convert_garden_ConditionTransition_To_v1alpha1_ConditionTransition(in *garden.ConditionTransition, out *ConditionTransition, s conversion.Scope) error {
+	return autoConvert_garden_ConditionTransition_To_v1alpha1_ConditionTransition(in, out, s)
+}
+
+func autoConvert_v1alpha1_ControlPlane_To_garden_ControlPlane(in *ControlPlane, out *garden.ControlPlane, s conversion.Scope) error {
+	out.HighAvailability = (*garden.HighAvailability)(unsafe.Pointer(in.HighAvailability))
+	out.NetworkPolicyRules = *(*[]garden.NetworkPolicyRule)(unsafe.Pointer(&in.NetworkPolicyRules))
+	return nil
+}
+
+// Convert_v1alpha1_ControlPlane_To_garden_ControlPlane is an autogenerated conversion function.
+func Convert_v1alpha1_ControlPlane_To_garden_ControlPlane(in *ControlPlane, out *garden.ControlPlane, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ControlPlane_To_garden_ControlPlane(in, out, s)
+}
+
+func autoConvert_garden_ControlPlane_To_v1alpha1_ControlPlane(in *garden.ControlPlane, out *ControlPlane, s conversion.Scope) error {
+	out.HighAvailability = (*HighAvailability)(unsafe.Pointer(in.HighAvailability))
+	out.NetworkPolicyRules = *(*[]NetworkPolicyRule)(unsafe.Pointer(&in.NetworkPolicyRules))
+	return nil
+}
+
+// Convert_garden_ControlPlane_To_v1alpha1_ControlPlane is an autogenerated conversion function.
+func Convert_garden_ControlPlane_To_v1alpha1_ControlPlane(in *garden.ControlPlane, out *ControlPlane, s conversion.Scope) error {
+	return autoConvert_garden_ControlPlane_To_v1alpha1_ControlPlane(in, out, s)
+}
+
 func autoConvert_v1alpha1_ControllerDeployment_To_core_ControllerDeployment(in *ControllerDeployment, out *core.ControllerDeployment, s conversion.Scope) error {
 	out.Type = in.Type
 	out.ProviderConfig = (*core.ProviderConfig)(unsafe.Pointer(in.ProviderConfig))
@@ -2128,7 +2856,7 @@ func autoConvert_v1alpha1_ControllerResource_To_core_ControllerResource(in *Cont
 	out.Kind = in.Kind
 	out.Type = in.Type
 	out.GloballyEnabled = (*bool)(unsafe.Pointer(in.GloballyEnabled))
-	out.ReconcileTimeout = (*metav1.Duration)(unsafe.Pointer(in.ReconcileTimeout))
+	out.ReconcileTimeout = (*v1.Duration)(unsafe.Pointer(in.ReconcileTimeout))
 	return nil
 }
 
@@ -2141,7 +2869,7 @@ func autoConvert_core_ControllerResource_To_v1alpha1_ControllerResource(in *core
 	out.Kind = in.Kind
 	out.Type = in.Type
 	out.GloballyEnabled = (*bool)(unsafe.Pointer(in.GloballyEnabled))
-	out.ReconcileTimeout = (*metav1.Duration)(unsafe.Pointer(in.ReconcileTimeout))
+	out.ReconcileTimeout = (*v1.Duration)(unsafe.Pointer(in.ReconcileTimeout))
 	return nil
 }
 
@@ -2194,30 +2922,104 @@ func Convert_garden_DNSIncludeExclude_To_v1alpha1_DNSIncludeExclude(in *garden.D
 	return autoConvert_garden_DNSIncludeExclude_To_v1alpha1_DNSIncludeExclude(in, out, s)
 }
 
-func autoConvert_v1alpha1_DNSProvider_To_garden_DNSProvider(in *DNSProvider, out *garden.DNSProvider, s conversion.Scope) error {
-	out.Domains = (*garden.DNSIncludeExclude)(unsafe.Pointer(in.Domains))
-	out.SecretName = (*string)(unsafe.Pointer(in.SecretName))
-	out.Type = (*string)(unsafe.Pointer(in.Type))
-	out.Zones = (*garden.DNSIncludeExclude)(unsafe.Pointer(in.Zones))
+func autoConvert_v1alpha1_DNSProvider_To_garden_DNSProvider(in *DNSProvider, out *garden.DNSProvider, s conversion.Scope) error {
+	out.Domains = (*garden.DNSIncludeExclude)(unsafe.Pointer(in.Domains))
+	out.SecretName = (*string)(unsafe.Pointer(in.SecretName))
+	out.Type = (*string)(unsafe.Pointer(in.Type))
+	out.Zones = (*garden.DNSIncludeExclude)(unsafe.Pointer(in.Zones))
+	return nil
+}
+
+// Convert_v1alpha1_DNSProvider_To_garden_DNSProvider is an autogenerated conversion function.
+func Convert_v1alpha1_DNSProvider_To_garden_DNSProvider(in *DNSProvider, out *garden.DNSProvider, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DNSProvider_To_garden_DNSProvider(in, out, s)
+}
+
+func autoConvert_garden_DNSProvider_To_v1alpha1_DNSProvider(in *garden.DNSProvider, out *DNSProvider, s conversion.Scope) error {
+	out.Domains = (*DNSIncludeExclude)(unsafe.Pointer(in.Domains))
+	out.SecretName = (*string)(unsafe.Pointer(in.SecretName))
+	out.Type = (*string)(unsafe.Pointer(in.Type))
+	out.Zones = (*DNSIncludeExclude)(unsafe.Pointer(in.Zones))
+	return nil
+}
+
+// Convert_garden_DNSProvider_To_v1alpha1_DNSProvider is an autogenerated conversion function.
+func Convert_garden_DNSProvider_To_v1alpha1_DNSProvider(in *garden.DNSProvider, out *DNSProvider, s conversion.Scope) error {
+	return autoConvert_garden_DNSProvider_To_v1alpha1_DNSProvider(in, out, s)
+}
+
+func autoConvert_v1alpha1_ETCDBackupOperation_To_garden_ETCDBackupOperation(in *ETCDBackupOperation, out *garden.ETCDBackupOperation, s conversion.Scope) error {
+	out.State = garden.LastOperationState(in.State)
+	out.Description = in.Description
+	out.Progress = in.Progress
+	out.LastUpdateTime = in.LastUpdateTime
+	out.Snapshot = (*string)(unsafe.Pointer(in.Snapshot))
+	return nil
+}
+
+// Convert_v1alpha1_ETCDBackupOperation_To_garden_ETCDBackupOperation is an autogenerated conversion function.
+func Convert_v1alpha1_ETCDBackupOperation_To_garden_ETCDBackupOperation(in *ETCDBackupOperation, out *garden.ETCDBackupOperation, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ETCDBackupOperation_To_garden_ETCDBackupOperation(in, out, s)
+}
+
+func autoConvert_garden_ETCDBackupOperation_To_v1alpha1_ETCDBackupOperation(in *garden.ETCDBackupOperation, out *ETCDBackupOperation, s conversion.Scope) error {
+	out.State = LastOperationState(in.State)
+	out.Description = in.Description
+	out.Progress = in.Progress
+	out.LastUpdateTime = in.LastUpdateTime
+	out.Snapshot = (*string)(unsafe.Pointer(in.Snapshot))
+	return nil
+}
+
+// Convert_garden_ETCDBackupOperation_To_v1alpha1_ETCDBackupOperation is an autogenerated conversion function.
+func Convert_garden_ETCDBackupOperation_To_v1alpha1_ETCDBackupOperation(in *garden.ETCDBackupOperation, out *ETCDBackupOperation, s conversion.Scope) error {
+	return autoConvert_garden_ETCDBackupOperation_To_v1alpha1_ETCDBackupOperation(in, out, s)
+}
+
+func autoConvert_v1alpha1_ETCDEncryptionKeyRotation_To_garden_ETCDEncryptionKeyRotation(in *ETCDEncryptionKeyRotation, out *garden.ETCDEncryptionKeyRotation, s conversion.Scope) error {
+	out.Phase = garden.CredentialsRotationPhase(in.Phase)
+	out.LastInitiationTime = (*v1.Time)(unsafe.Pointer(in.LastInitiationTime))
+	out.LastCompletionTime = (*v1.Time)(unsafe.Pointer(in.LastCompletionTime))
+	return nil
+}
+
+// Convert_v1alpha1_ETCDEncryptionKeyRotation_To_garden_ETCDEncryptionKeyRotation is an autogenerated conversion function.
+func Convert_v1alpha1_ETCDEncryptionKeyRotation_To_garden_ETCDEncryptionKeyRotation(in *ETCDEncryptionKeyRotation, out *garden.ETCDEncryptionKeyRotation, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ETCDEncryptionKeyRotation_To_garden_ETCDEncryptionKeyRotation(in, out, s)
+}
+
+func autoConvert_garden_ETCDEncryptionKeyRotation_To_v1alpha1_ETCDEncryptionKeyRotation(in *garden.ETCDEncryptionKeyRotation, out *ETCDEncryptionKeyRotation, s conversion.Scope) error {
+	out.Phase = CredentialsRotationPhase(in.Phase)
+	out.LastInitiationTime = (*v1.Time)(unsafe.Pointer(in.LastInitiationTime))
+	out.LastCompletionTime = (*v1.Time)(unsafe.Pointer(in.LastCompletionTime))
+	return nil
+}
+
+// Convert_garden_ETCDEncryptionKeyRotation_To_v1alpha1_ETCDEncryptionKeyRotation is an autogenerated conversion function.
+func Convert_garden_ETCDEncryptionKeyRotation_To_v1alpha1_ETCDEncryptionKeyRotation(in *garden.ETCDEncryptionKeyRotation, out *ETCDEncryptionKeyRotation, s conversion.Scope) error {
+	return autoConvert_garden_ETCDEncryptionKeyRotation_To_v1alpha1_ETCDEncryptionKeyRotation(in, out, s)
+}
+
+func autoConvert_v1alpha1_EncryptionConfig_To_garden_EncryptionConfig(in *EncryptionConfig, out *garden.EncryptionConfig, s conversion.Scope) error {
+	out.Resources = *(*[]string)(unsafe.Pointer(&in.Resources))
+	out.KMS = (*garden.KMSProvider)(unsafe.Pointer(in.KMS))
 	return nil
 }
 
-// Convert_v1alpha1_DNSProvider_To_garden_DNSProvider is an autogenerated conversion function.
-func Convert_v1alpha1_DNSProvider_To_garden_DNSProvider(in *DNSProvider, out *garden.DNSProvider, s conversion.Scope) error {
-	return autoConvert_v1alpha1_DNSProvider_To_garden_DNSProvider(in, out, s)
+// Convert_v1alpha1_EncryptionConfig_To_garden_EncryptionConfig is an autogenerated conversion function.
+func Convert_v1alpha1_EncryptionConfig_To_garden_EncryptionConfig(in *EncryptionConfig, out *garden.EncryptionConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_EncryptionConfig_To_garden_EncryptionConfig(in, out, s)
 }
 
-func autoConvert_garden_DNSProvider_To_v1alpha1_DNSProvider(in *garden.DNSProvider, out *DNSProvider, s conversion.Scope) error {
-	out.Domains = (*DNSIncludeExclude)(unsafe.Pointer(in.Domains))
-	out.SecretName = (*string)(unsafe.Pointer(in.SecretName))
-	out.Type = (*string)(unsafe.Pointer(in.Type))
-	out.Zones = (*DNSIncludeExclude)(unsafe.Pointer(in.Zones))
+func autoConvert_garden_EncryptionConfig_To_v1alpha1_EncryptionConfig(in *garden.EncryptionConfig, out *EncryptionConfig, s conversion.Scope) error {
+	out.Resources = *(*[]string)(unsafe.Pointer(&in.Resources))
+	out.KMS = (*KMSProvider)(unsafe.Pointer(in.KMS))
 	return nil
 }
 
-// Convert_garden_DNSProvider_To_v1alpha1_DNSProvider is an autogenerated conversion function.
-func Convert_garden_DNSProvider_To_v1alpha1_DNSProvider(in *garden.DNSProvider, out *DNSProvider, s conversion.Scope) error {
-	return autoConvert_garden_DNSProvider_To_v1alpha1_DNSProvider(in, out, s)
+// Convert_garden_EncryptionConfig_To_v1alpha1_EncryptionConfig is an autogenerated conversion function.
+func Convert_garden_EncryptionConfig_To_v1alpha1_EncryptionConfig(in *garden.EncryptionConfig, out *EncryptionConfig, s conversion.Scope) error {
+	return autoConvert_garden_EncryptionConfig_To_v1alpha1_EncryptionConfig(in, out, s)
 }
 
 func autoConvert_v1alpha1_Endpoint_To_core_Endpoint(in *Endpoint, out *core.Endpoint, s conversion.Scope) error {
@@ -2246,7 +3048,7 @@ func Convert_core_Endpoint_To_v1alpha1_Endpoint(in *core.Endpoint, out *Endpoint
 
 func autoConvert_v1alpha1_ExpirableVersion_To_garden_ExpirableVersion(in *ExpirableVersion, out *garden.ExpirableVersion, s conversion.Scope) error {
 	out.Version = in.Version
-	out.ExpirationDate = (*metav1.Time)(unsafe.Pointer(in.ExpirationDate))
+	out.ExpirationDate = (*v1.Time)(unsafe.Pointer(in.ExpirationDate))
 	return nil
 }
 
@@ -2257,7 +3059,7 @@ func Convert_v1alpha1_ExpirableVersion_To_garden_ExpirableVersion(in *ExpirableV
 
 func autoConvert_garden_ExpirableVersion_To_v1alpha1_ExpirableVersion(in *garden.ExpirableVersion, out *ExpirableVersion, s conversion.Scope) error {
 	out.Version = in.Version
-	out.ExpirationDate = (*metav1.Time)(unsafe.Pointer(in.ExpirationDate))
+	out.ExpirationDate = (*v1.Time)(unsafe.Pointer(in.ExpirationDate))
 	return nil
 }
 
@@ -2316,6 +3118,26 @@ func Convert_core_ExtensionResourceState_To_v1alpha1_ExtensionResourceState(in *
 	return autoConvert_core_ExtensionResourceState_To_v1alpha1_ExtensionResourceState(in, out, s)
 }
 
+func autoConvert_v1alpha1_FailureTolerance_To_garden_FailureTolerance(in *FailureTolerance, out *garden.FailureTolerance, s conversion.Scope) error {
+	out.Type = garden.FailureToleranceType(in.Type)
+	return nil
+}
+
+// Convert_v1alpha1_FailureTolerance_To_garden_FailureTolerance is an autogenerated conversion function.
+func Convert_v1alpha1_FailureTolerance_To_garden_FailureTolerance(in *FailureTolerance, out *garden.FailureTolerance, s conversion.Scope) error {
+	return autoConvert_v1alpha1_FailureTolerance_To_garden_FailureTolerance(in, out, s)
+}
+
+func autoConvert_garden_FailureTolerance_To_v1alpha1_FailureTolerance(in *garden.FailureTolerance, out *FailureTolerance, s conversion.Scope) error {
+	out.Type = FailureToleranceType(in.Type)
+	return nil
+}
+
+// Convert_garden_FailureTolerance_To_v1alpha1_FailureTolerance is an autogenerated conversion function.
+func Convert_garden_FailureTolerance_To_v1alpha1_FailureTolerance(in *garden.FailureTolerance, out *FailureTolerance, s conversion.Scope) error {
+	return autoConvert_garden_FailureTolerance_To_v1alpha1_FailureTolerance(in, out, s)
+}
+
 func autoConvert_v1alpha1_Gardener_To_garden_Gardener(in *Gardener, out *garden.Gardener, s conversion.Scope) error {
 	out.ID = in.ID
 	out.Name = in.Name
@@ -2408,14 +3230,38 @@ func Convert_garden_HibernationSchedule_To_v1alpha1_HibernationSchedule(in *gard
 	return autoConvert_garden_HibernationSchedule_To_v1alpha1_HibernationSchedule(in, out, s)
 }
 
+func autoConvert_v1alpha1_HighAvailability_To_garden_HighAvailability(in *HighAvailability, out *garden.HighAvailability, s conversion.Scope) error {
+	if err := Convert_v1alpha1_FailureTolerance_To_garden_FailureTolerance(&in.FailureTolerance, &out.FailureTolerance, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_HighAvailability_To_garden_HighAvailability is an autogenerated conversion function.
+func Convert_v1alpha1_HighAvailability_To_garden_HighAvailability(in *HighAvailability, out *garden.HighAvailability, s conversion.Scope) error {
+	return autoConvert_v1alpha1_HighAvailability_To_garden_HighAvailability(in, out, s)
+}
+
+func autoConvert_garden_HighAvailability_To_v1alpha1_HighAvailability(in *garden.HighAvailability, out *HighAvailability, s conversion.Scope) error {
+	if err := Convert_garden_FailureTolerance_To_v1alpha1_FailureTolerance(&in.FailureTolerance, &out.FailureTolerance, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_garden_HighAvailability_To_v1alpha1_HighAvailability is an autogenerated conversion function.
+func Convert_garden_HighAvailability_To_v1alpha1_HighAvailability(in *garden.HighAvailability, out *HighAvailability, s conversion.Scope) error {
+	return autoConvert_garden_HighAvailability_To_v1alpha1_HighAvailability(in, out, s)
+}
+
 func autoConvert_v1alpha1_HorizontalPodAutoscalerConfig_To_garden_HorizontalPodAutoscalerConfig(in *HorizontalPodAutoscalerConfig, out *garden.HorizontalPodAutoscalerConfig, s conversion.Scope) error {
-	out.CPUInitializationPeriod = (*metav1.Duration)(unsafe.Pointer(in.CPUInitializationPeriod))
-	out.DownscaleDelay = (*metav1.Duration)(unsafe.Pointer(in.DownscaleDelay))
-	out.DownscaleStabilization = (*metav1.Duration)(unsafe.Pointer(in.DownscaleStabilization))
-	out.InitialReadinessDelay = (*metav1.Duration)(unsafe.Pointer(in.InitialReadinessDelay))
-	out.SyncPeriod = (*metav1.Duration)(unsafe.Pointer(in.SyncPeriod))
+	out.CPUInitializationPeriod = (*v1.Duration)(unsafe.Pointer(in.CPUInitializationPeriod))
+	out.DownscaleDelay = (*v1.Duration)(unsafe.Pointer(in.DownscaleDelay))
+	out.DownscaleStabilization = (*v1.Duration)(unsafe.Pointer(in.DownscaleStabilization))
+	out.InitialReadinessDelay = (*v1.Duration)(unsafe.Pointer(in.InitialReadinessDelay))
+	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
 	out.Tolerance = (*float64)(unsafe.Pointer(in.Tolerance))
-	out.UpscaleDelay = (*metav1.Duration)(unsafe.Pointer(in.UpscaleDelay))
+	out.UpscaleDelay = (*v1.Duration)(unsafe.Pointer(in.UpscaleDelay))
 	return nil
 }
 
@@ -2440,6 +3286,30 @@ func Convert_garden_HorizontalPodAutoscalerConfig_To_v1alpha1_HorizontalPodAutos
 	return autoConvert_garden_HorizontalPodAutoscalerConfig_To_v1alpha1_HorizontalPodAutoscalerConfig(in, out, s)
 }
 
+func autoConvert_v1alpha1_KMSProvider_To_garden_KMSProvider(in *KMSProvider, out *garden.KMSProvider, s conversion.Scope) error {
+	out.Type = in.Type
+	out.CacheSize = (*int32)(unsafe.Pointer(in.CacheSize))
+	out.Timeout = (*v1.Duration)(unsafe.Pointer(in.Timeout))
+	return nil
+}
+
+// Convert_v1alpha1_KMSProvider_To_garden_KMSProvider is an autogenerated conversion function.
+func Convert_v1alpha1_KMSProvider_To_garden_KMSProvider(in *KMSProvider, out *garden.KMSProvider, s conversion.Scope) error {
+	return autoConvert_v1alpha1_KMSProvider_To_garden_KMSProvider(in, out, s)
+}
+
+func autoConvert_garden_KMSProvider_To_v1alpha1_KMSProvider(in *garden.KMSProvider, out *KMSProvider, s conversion.Scope) error {
+	out.Type = in.Type
+	out.CacheSize = (*int32)(unsafe.Pointer(in.CacheSize))
+	out.Timeout = (*v1.Duration)(unsafe.Pointer(in.Timeout))
+	return nil
+}
+
+// Convert_garden_KMSProvider_To_v1alpha1_KMSProvider is an autogenerated conversion function.
+func Convert_garden_KMSProvider_To_v1alpha1_KMSProvider(in *garden.KMSProvider, out *KMSProvider, s conversion.Scope) error {
+	return autoConvert_garden_KMSProvider_To_v1alpha1_KMSProvider(in, out, s)
+}
+
 func autoConvert_v1alpha1_KubeAPIServerConfig_To_garden_KubeAPIServerConfig(in *KubeAPIServerConfig, out *garden.KubeAPIServerConfig, s conversion.Scope) error {
 	if err := Convert_v1alpha1_KubernetesConfig_To_garden_KubernetesConfig(&in.KubernetesConfig, &out.KubernetesConfig, s); err != nil {
 		return err
@@ -2448,6 +3318,7 @@ func autoConvert_v1alpha1_KubeAPIServerConfig_To_garden_KubeAPIServerConfig(in *
 	out.APIAudiences = *(*[]string)(unsafe.Pointer(&in.APIAudiences))
 	out.AuditConfig = (*garden.AuditConfig)(unsafe.Pointer(in.AuditConfig))
 	out.EnableBasicAuthentication = (*bool)(unsafe.Pointer(in.EnableBasicAuthentication))
+	out.EncryptionConfig = (*garden.EncryptionConfig)(unsafe.Pointer(in.EncryptionConfig))
 	if in.OIDCConfig != nil {
 		in, out := &in.OIDCConfig, &out.OIDCConfig
 		*out = new(garden.OIDCConfig)
@@ -2459,6 +3330,10 @@ func autoConvert_v1alpha1_KubeAPIServerConfig_To_garden_KubeAPIServerConfig(in *
 	}
 	out.RuntimeConfig = *(*map[string]bool)(unsafe.Pointer(&in.RuntimeConfig))
 	out.ServiceAccountConfig = (*garden.ServiceAccountConfig)(unsafe.Pointer(in.ServiceAccountConfig))
+	out.StaticCredentialsRotation = (*garden.StaticCredentialsRotationConfig)(unsafe.Pointer(in.StaticCredentialsRotation))
+	out.AuthenticationWebhook = (*garden.AuthenticationWebhook)(unsafe.Pointer(in.AuthenticationWebhook))
+	out.AuthorizationWebhook = (*garden.AuthorizationWebhook)(unsafe.Pointer(in.AuthorizationWebhook))
+	out.AdmissionWebhookConfig = (*garden.AdmissionWebhookConfig)(unsafe.Pointer(in.AdmissionWebhookConfig))
 	return nil
 }
 
@@ -2475,6 +3350,7 @@ func autoConvert_garden_KubeAPIServerConfig_To_v1alpha1_KubeAPIServerConfig(in *
 	out.APIAudiences = *(*[]string)(unsafe.Pointer(&in.APIAudiences))
 	out.AuditConfig = (*AuditConfig)(unsafe.Pointer(in.AuditConfig))
 	out.EnableBasicAuthentication = (*bool)(unsafe.Pointer(in.EnableBasicAuthentication))
+	out.EncryptionConfig = (*EncryptionConfig)(unsafe.Pointer(in.EncryptionConfig))
 	if in.OIDCConfig != nil {
 		in, out := &in.OIDCConfig, &out.OIDCConfig
 		*out = new(OIDCConfig)
@@ -2486,6 +3362,10 @@ func autoConvert_garden_KubeAPIServerConfig_To_v1alpha1_KubeAPIServerConfig(in *
 	}
 	out.RuntimeConfig = *(*map[string]bool)(unsafe.Pointer(&in.RuntimeConfig))
 	out.ServiceAccountConfig = (*ServiceAccountConfig)(unsafe.Pointer(in.ServiceAccountConfig))
+	out.StaticCredentialsRotation = (*StaticCredentialsRotationConfig)(unsafe.Pointer(in.StaticCredentialsRotation))
+	out.AuthenticationWebhook = (*AuthenticationWebhook)(unsafe.Pointer(in.AuthenticationWebhook))
+	out.AuthorizationWebhook = (*AuthorizationWebhook)(unsafe.Pointer(in.AuthorizationWebhook))
+	out.AdmissionWebhookConfig = (*AdmissionWebhookConfig)(unsafe.Pointer(in.AdmissionWebhookConfig))
 	return nil
 }
 
@@ -2494,6 +3374,38 @@ func Convert_garden_KubeAPIServerConfig_To_v1alpha1_KubeAPIServerConfig(in *gard
 	return autoConvert_garden_KubeAPIServerConfig_To_v1alpha1_KubeAPIServerConfig(in, out, s)
 }
 
+func autoConvert_v1alpha1_KubeControllerManagerConcurrentSyncs_To_garden_KubeControllerManagerConcurrentSyncs(in *KubeControllerManagerConcurrentSyncs, out *garden.KubeControllerManagerConcurrentSyncs, s conversion.Scope) error {
+	out.Deployments = (*int32)(unsafe.Pointer(in.Deployments))
+	out.Endpoints = (*int32)(unsafe.Pointer(in.Endpoints))
+	out.GarbageCollector = (*int32)(unsafe.Pointer(in.GarbageCollector))
+	out.Namespaces = (*int32)(unsafe.Pointer(in.Namespaces))
+	out.ReplicaSets = (*int32)(unsafe.Pointer(in.ReplicaSets))
+	out.ResourceQuotas = (*int32)(unsafe.Pointer(in.ResourceQuotas))
+	out.ServiceAccountTokens = (*int32)(unsafe.Pointer(in.ServiceAccountTokens))
+	return nil
+}
+
+// Convert_v1alpha1_KubeControllerManagerConcurrentSyncs_To_garden_KubeControllerManagerConcurrentSyncs is an autogenerated conversion function.
+func Convert_v1alpha1_KubeControllerManagerConcurrentSyncs_To_garden_KubeControllerManagerConcurrentSyncs(in *KubeControllerManagerConcurrentSyncs, out *garden.KubeControllerManagerConcurrentSyncs, s conversion.Scope) error {
+	return autoConvert_v1alpha1_KubeControllerManagerConcurrentSyncs_To_garden_KubeControllerManagerConcurrentSyncs(in, out, s)
+}
+
+func autoConvert_garden_KubeControllerManagerConcurrentSyncs_To_v1alpha1_KubeControllerManagerConcurrentSyncs(in *garden.KubeControllerManagerConcurrentSyncs, out *KubeControllerManagerConcurrentSyncs, s conversion.Scope) error {
+	out.Deployments = (*int32)(unsafe.Pointer(in.Deployments))
+	out.Endpoints = (*int32)(unsafe.Pointer(in.Endpoints))
+	out.GarbageCollector = (*int32)(unsafe.Pointer(in.GarbageCollector))
+	out.Namespaces = (*int32)(unsafe.Pointer(in.Namespaces))
+	out.ReplicaSets = (*int32)(unsafe.Pointer(in.ReplicaSets))
+	out.ResourceQuotas = (*int32)(unsafe.Pointer(in.ResourceQuotas))
+	out.ServiceAccountTokens = (*int32)(unsafe.Pointer(in.ServiceAccountTokens))
+	return nil
+}
+
+// Convert_garden_KubeControllerManagerConcurrentSyncs_To_v1alpha1_KubeControllerManagerConcurrentSyncs is an autogenerated conversion function.
+func Convert_garden_KubeControllerManagerConcurrentSyncs_To_v1alpha1_KubeControllerManagerConcurrentSyncs(in *garden.KubeControllerManagerConcurrentSyncs, out *KubeControllerManagerConcurrentSyncs, s conversion.Scope) error {
+	return autoConvert_garden_KubeControllerManagerConcurrentSyncs_To_v1alpha1_KubeControllerManagerConcurrentSyncs(in, out, s)
+}
+
 func autoConvert_v1alpha1_KubeControllerManagerConfig_To_garden_KubeControllerManagerConfig(in *KubeControllerManagerConfig, out *garden.KubeControllerManagerConfig, s conversion.Scope) error {
 	if err := Convert_v1alpha1_KubernetesConfig_To_garden_KubernetesConfig(&in.KubernetesConfig, &out.KubernetesConfig, s); err != nil {
 		return err
@@ -2514,6 +3426,9 @@ func autoConvert_v1alpha1_KubeControllerManagerConfig_To_garden_KubeControllerMa
 	} else {
 		out.NodeCIDRMaskSize = nil
 	}
+	out.NodeMonitorGracePeriod = (*v1.Duration)(unsafe.Pointer(in.NodeMonitorGracePeriod))
+	out.PodEvictionTimeout = (*v1.Duration)(unsafe.Pointer(in.PodEvictionTimeout))
+	out.ConcurrentSyncs = (*garden.KubeControllerManagerConcurrentSyncs)(unsafe.Pointer(in.ConcurrentSyncs))
 	return nil
 }
 
@@ -2542,6 +3457,9 @@ func autoConvert_garden_KubeControllerManagerConfig_To_v1alpha1_KubeControllerMa
 	} else {
 		out.NodeCIDRMaskSize = nil
 	}
+	out.NodeMonitorGracePeriod = (*v1.Duration)(unsafe.Pointer(in.NodeMonitorGracePeriod))
+	out.PodEvictionTimeout = (*v1.Duration)(unsafe.Pointer(in.PodEvictionTimeout))
+	out.ConcurrentSyncs = (*KubeControllerManagerConcurrentSyncs)(unsafe.Pointer(in.ConcurrentSyncs))
 	return nil
 }
 
@@ -2580,6 +3498,7 @@ func autoConvert_v1alpha1_KubeSchedulerConfig_To_garden_KubeSchedulerConfig(in *
 	if err := Convert_v1alpha1_KubernetesConfig_To_garden_KubernetesConfig(&in.KubernetesConfig, &out.KubernetesConfig, s); err != nil {
 		return err
 	}
+	out.Profile = (*garden.SchedulingProfile)(unsafe.Pointer(in.Profile))
 	return nil
 }
 
@@ -2592,6 +3511,7 @@ func autoConvert_garden_KubeSchedulerConfig_To_v1alpha1_KubeSchedulerConfig(in *
 	if err := Convert_garden_KubernetesConfig_To_v1alpha1_KubernetesConfig(&in.KubernetesConfig, &out.KubernetesConfig, s); err != nil {
 		return err
 	}
+	out.Profile = (*SchedulingProfile)(unsafe.Pointer(in.Profile))
 	return nil
 }
 
@@ -2600,6 +3520,28 @@ func Convert_garden_KubeSchedulerConfig_To_v1alpha1_KubeSchedulerConfig(in *gard
 	return autoConvert_garden_KubeSchedulerConfig_To_v1alpha1_KubeSchedulerConfig(in, out, s)
 }
 
+func autoConvert_v1alpha1_KubeconfigInfo_To_core_KubeconfigInfo(in *KubeconfigInfo, out *core.KubeconfigInfo, s conversion.Scope) error {
+	out.CertificateExpirationTime = (*v1.Time)(unsafe.Pointer(in.CertificateExpirationTime))
+	out.TokenExpirationTime = (*v1.Time)(unsafe.Pointer(in.TokenExpirationTime))
+	return nil
+}
+
+// Convert_v1alpha1_KubeconfigInfo_To_core_KubeconfigInfo is an autogenerated conversion function.
+func Convert_v1alpha1_KubeconfigInfo_To_core_KubeconfigInfo(in *KubeconfigInfo, out *core.KubeconfigInfo, s conversion.Scope) error {
+	return autoConvert_v1alpha1_KubeconfigInfo_To_core_KubeconfigInfo(in, out, s)
+}
+
+func autoConvert_core_KubeconfigInfo_To_v1alpha1_KubeconfigInfo(in *core.KubeconfigInfo, out *KubeconfigInfo, s conversion.Scope) error {
+	out.CertificateExpirationTime = (*v1.Time)(unsafe.Pointer(in.CertificateExpirationTime))
+	out.TokenExpirationTime = (*v1.Time)(unsafe.Pointer(in.TokenExpirationTime))
+	return nil
+}
+
+// Convert_core_KubeconfigInfo_To_v1alpha1_KubeconfigInfo is an autogenerated conversion function.
+func Convert_core_KubeconfigInfo_To_v1alpha1_KubeconfigInfo(in *core.KubeconfigInfo, out *KubeconfigInfo, s conversion.Scope) error {
+	return autoConvert_core_KubeconfigInfo_To_v1alpha1_KubeconfigInfo(in, out, s)
+}
+
 func autoConvert_v1alpha1_KubeletConfig_To_garden_KubeletConfig(in *KubeletConfig, out *garden.KubeletConfig, s conversion.Scope) error {
 	if err := Convert_v1alpha1_KubernetesConfig_To_garden_KubernetesConfig(&in.KubernetesConfig, &out.KubernetesConfig, s); err != nil {
 		return err
@@ -2609,7 +3551,7 @@ func autoConvert_v1alpha1_KubeletConfig_To_garden_KubeletConfig(in *KubeletConfi
 	out.EvictionHard = (*garden.KubeletConfigEviction)(unsafe.Pointer(in.EvictionHard))
 	out.EvictionMaxPodGracePeriod = (*int32)(unsafe.Pointer(in.EvictionMaxPodGracePeriod))
 	out.EvictionMinimumReclaim = (*garden.KubeletConfigEvictionMinimumReclaim)(unsafe.Pointer(in.EvictionMinimumReclaim))
-	out.EvictionPressureTransitionPeriod = (*metav1.Duration)(unsafe.Pointer(in.EvictionPressureTransitionPeriod))
+	out.EvictionPressureTransitionPeriod = (*v1.Duration)(unsafe.Pointer(in.EvictionPressureTransitionPeriod))
 	out.EvictionSoft = (*garden.KubeletConfigEviction)(unsafe.Pointer(in.EvictionSoft))
 	out.EvictionSoftGracePeriod = (*garden.KubeletConfigEvictionSoftGracePeriod)(unsafe.Pointer(in.EvictionSoftGracePeriod))
 	out.MaxPods = (*int32)(unsafe.Pointer(in.MaxPods))
@@ -2634,7 +3576,7 @@ func autoConvert_garden_KubeletConfig_To_v1alpha1_KubeletConfig(in *garden.Kubel
 	out.EvictionSoft = (*KubeletConfigEviction)(unsafe.Pointer(in.EvictionSoft))
 	out.EvictionSoftGracePeriod = (*KubeletConfigEvictionSoftGracePeriod)(unsafe.Pointer(in.EvictionSoftGracePeriod))
 	out.EvictionMinimumReclaim = (*KubeletConfigEvictionMinimumReclaim)(unsafe.Pointer(in.EvictionMinimumReclaim))
-	out.EvictionPressureTransitionPeriod = (*metav1.Duration)(unsafe.Pointer(in.EvictionPressureTransitionPeriod))
+	out.EvictionPressureTransitionPeriod = (*v1.Duration)(unsafe.Pointer(in.EvictionPressureTransitionPeriod))
 	out.EvictionMaxPodGracePeriod = (*int32)(unsafe.Pointer(in.EvictionMaxPodGracePeriod))
 	return nil
 }
@@ -2701,11 +3643,11 @@ func Convert_garden_KubeletConfigEvictionMinimumReclaim_To_v1alpha1_KubeletConfi
 }
 
 func autoConvert_v1alpha1_KubeletConfigEvictionSoftGracePeriod_To_garden_KubeletConfigEvictionSoftGracePeriod(in *KubeletConfigEvictionSoftGracePeriod, out *garden.KubeletConfigEvictionSoftGracePeriod, s conversion.Scope) error {
-	out.MemoryAvailable = (*metav1.Duration)(unsafe.Pointer(in.MemoryAvailable))
-	out.ImageFSAvailable = (*metav1.Duration)(unsafe.Pointer(in.ImageFSAvailable))
-	out.ImageFSInodesFree = (*metav1.Duration)(unsafe.Pointer(in.ImageFSInodesFree))
-	out.NodeFSAvailable = (*metav1.Duration)(unsafe.Pointer(in.NodeFSAvailable))
-	out.NodeFSInodesFree = (*metav1.Duration)(unsafe.Pointer(in.NodeFSInodesFree))
+	out.MemoryAvailable = (*v1.Duration)(unsafe.Pointer(in.MemoryAvailable))
+	out.ImageFSAvailable = (*v1.Duration)(unsafe.Pointer(in.ImageFSAvailable))
+	out.ImageFSInodesFree = (*v1.Duration)(unsafe.Pointer(in.ImageFSInodesFree))
+	out.NodeFSAvailable = (*v1.Duration)(unsafe.Pointer(in.NodeFSAvailable))
+	out.NodeFSInodesFree = (*v1.Duration)(unsafe.Pointer(in.NodeFSInodesFree))
 	return nil
 }
 
@@ -2715,11 +3657,11 @@ func Convert_v1alpha1_KubeletConfigEvictionSoftGracePeriod_To_garden_KubeletConf
 }
 
 func autoConvert_garden_KubeletConfigEvictionSoftGracePeriod_To_v1alpha1_KubeletConfigEvictionSoftGracePeriod(in *garden.KubeletConfigEvictionSoftGracePeriod, out *KubeletConfigEvictionSoftGracePeriod, s conversion.Scope) error {
-	out.MemoryAvailable = (*metav1.Duration)(unsafe.Pointer(in.MemoryAvailable))
-	out.ImageFSAvailable = (*metav1.Duration)(unsafe.Pointer(in.ImageFSAvailable))
-	out.ImageFSInodesFree = (*metav1.Duration)(unsafe.Pointer(in.ImageFSInodesFree))
-	out.NodeFSAvailable = (*metav1.Duration)(unsafe.Pointer(in.NodeFSAvailable))
-	out.NodeFSInodesFree = (*metav1.Duration)(unsafe.Pointer(in.NodeFSInodesFree))
+	out.MemoryAvailable = (*v1.Duration)(unsafe.Pointer(in.MemoryAvailable))
+	out.ImageFSAvailable = (*v1.Duration)(unsafe.Pointer(in.ImageFSAvailable))
+	out.ImageFSInodesFree = (*v1.Duration)(unsafe.Pointer(in.ImageFSInodesFree))
+	out.NodeFSAvailable = (*v1.Duration)(unsafe.Pointer(in.NodeFSAvailable))
+	out.NodeFSInodesFree = (*v1.Duration)(unsafe.Pointer(in.NodeFSInodesFree))
 	return nil
 }
 
@@ -2728,6 +3670,30 @@ func Convert_garden_KubeletConfigEvictionSoftGracePeriod_To_v1alpha1_KubeletConf
 	return autoConvert_garden_KubeletConfigEvictionSoftGracePeriod_To_v1alpha1_KubeletConfigEvictionSoftGracePeriod(in, out, s)
 }
 
+func autoConvert_v1alpha1_KubeletConfigFieldDrift_To_garden_KubeletConfigFieldDrift(in *KubeletConfigFieldDrift, out *garden.KubeletConfigFieldDrift, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Desired = in.Desired
+	out.Actual = in.Actual
+	return nil
+}
+
+// Convert_v1alpha1_KubeletConfigFieldDrift_To_garden_KubeletConfigFieldDrift is an autogenerated conversion function.
+func Convert_v1alpha1_KubeletConfigFieldDrift_To_garden_KubeletConfigFieldDrift(in *KubeletConfigFieldDrift, out *garden.KubeletConfigFieldDrift, s conversion.Scope) error {
+	return autoConvert_v1alpha1_KubeletConfigFieldDrift_To_garden_KubeletConfigFieldDrift(in, out, s)
+}
+
+func autoConvert_garden_KubeletConfigFieldDrift_To_v1alpha1_KubeletConfigFieldDrift(in *garden.KubeletConfigFieldDrift, out *KubeletConfigFieldDrift, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Desired = in.Desired
+	out.Actual = in.Actual
+	return nil
+}
+
+// Convert_garden_KubeletConfigFieldDrift_To_v1alpha1_KubeletConfigFieldDrift is an autogenerated conversion function.
+func Convert_garden_KubeletConfigFieldDrift_To_v1alpha1_KubeletConfigFieldDrift(in *garden.KubeletConfigFieldDrift, out *KubeletConfigFieldDrift, s conversion.Scope) error {
+	return autoConvert_garden_KubeletConfigFieldDrift_To_v1alpha1_KubeletConfigFieldDrift(in, out, s)
+}
+
 func autoConvert_v1alpha1_Kubernetes_To_garden_Kubernetes(in *Kubernetes, out *garden.Kubernetes, s conversion.Scope) error {
 	out.AllowPrivilegedContainers = (*bool)(unsafe.Pointer(in.AllowPrivilegedContainers))
 	if in.ClusterAutoscaler != nil {
@@ -2865,6 +3831,8 @@ func Convert_garden_KubernetesDashboard_To_v1alpha1_KubernetesDashboard(in *gard
 
 func autoConvert_v1alpha1_KubernetesInfo_To_core_KubernetesInfo(in *KubernetesInfo, out *core.KubernetesInfo, s conversion.Scope) error {
 	out.Version = in.Version
+	out.NodeVersions = *(*[]string)(unsafe.Pointer(&in.NodeVersions))
+	out.NodeVersionSkew = (*int32)(unsafe.Pointer(in.NodeVersionSkew))
 	return nil
 }
 
@@ -2875,6 +3843,8 @@ func Convert_v1alpha1_KubernetesInfo_To_core_KubernetesInfo(in *KubernetesInfo,
 
 func autoConvert_core_KubernetesInfo_To_v1alpha1_KubernetesInfo(in *core.KubernetesInfo, out *KubernetesInfo, s conversion.Scope) error {
 	out.Version = in.Version
+	out.NodeVersions = *(*[]string)(unsafe.Pointer(&in.NodeVersions))
+	out.NodeVersionSkew = (*int32)(unsafe.Pointer(in.NodeVersionSkew))
 	return nil
 }
 
@@ -2907,7 +3877,7 @@ func autoConvert_v1alpha1_LastError_To_core_LastError(in *LastError, out *core.L
 	out.Description = in.Description
 	out.TaskID = (*string)(unsafe.Pointer(in.TaskID))
 	out.Codes = *(*[]core.ErrorCode)(unsafe.Pointer(&in.Codes))
-	out.LastUpdateTime = (*metav1.Time)(unsafe.Pointer(in.LastUpdateTime))
+	out.LastUpdateTime = (*v1.Time)(unsafe.Pointer(in.LastUpdateTime))
 	return nil
 }
 
@@ -2920,7 +3890,7 @@ func autoConvert_core_LastError_To_v1alpha1_LastError(in *core.LastError, out *L
 	out.Description = in.Description
 	out.TaskID = (*string)(unsafe.Pointer(in.TaskID))
 	out.Codes = *(*[]ErrorCode)(unsafe.Pointer(&in.Codes))
-	out.LastUpdateTime = (*metav1.Time)(unsafe.Pointer(in.LastUpdateTime))
+	out.LastUpdateTime = (*v1.Time)(unsafe.Pointer(in.LastUpdateTime))
 	return nil
 }
 
@@ -3095,7 +4065,7 @@ func Convert_garden_Maintenance_To_v1alpha1_Maintenance(in *garden.Maintenance,
 
 func autoConvert_v1alpha1_MaintenanceAutoUpdate_To_garden_MaintenanceAutoUpdate(in *MaintenanceAutoUpdate, out *garden.MaintenanceAutoUpdate, s conversion.Scope) error {
 	out.KubernetesVersion = in.KubernetesVersion
-	if err := metav1.Convert_bool_To_Pointer_bool(&in.MachineImageVersion, &out.MachineImageVersion, s); err != nil {
+	if err := v1.Convert_bool_To_Pointer_bool(&in.MachineImageVersion, &out.MachineImageVersion, s); err != nil {
 		return err
 	}
 	return nil
@@ -3108,7 +4078,7 @@ func Convert_v1alpha1_MaintenanceAutoUpdate_To_garden_MaintenanceAutoUpdate(in *
 
 func autoConvert_garden_MaintenanceAutoUpdate_To_v1alpha1_MaintenanceAutoUpdate(in *garden.MaintenanceAutoUpdate, out *MaintenanceAutoUpdate, s conversion.Scope) error {
 	out.KubernetesVersion = in.KubernetesVersion
-	if err := metav1.Convert_Pointer_bool_To_bool(&in.MachineImageVersion, &out.MachineImageVersion, s); err != nil {
+	if err := v1.Convert_Pointer_bool_To_bool(&in.MachineImageVersion, &out.MachineImageVersion, s); err != nil {
 		return err
 	}
 	return nil
@@ -3161,12 +4131,83 @@ func Convert_garden_Monitoring_To_v1alpha1_Monitoring(in *garden.Monitoring, out
 	return autoConvert_garden_Monitoring_To_v1alpha1_Monitoring(in, out, s)
 }
 
+func autoConvert_v1alpha1_NetworkPolicyRule_To_garden_NetworkPolicyRule(in *NetworkPolicyRule, out *garden.NetworkPolicyRule, s conversion.Scope) error {
+	out.Name = in.Name
+	out.PodSelector = in.PodSelector
+	out.CIDRs = *(*[]string)(unsafe.Pointer(&in.CIDRs))
+	out.Ports = *(*[]garden.NetworkPolicyRulePort)(unsafe.Pointer(&in.Ports))
+	return nil
+}
+
+// Convert_v1alpha1_NetworkPolicyRule_To_garden_NetworkPolicyRule is an autogenerated conversion function.
+func Convert_v1alpha1_NetworkPolicyRule_To_garden_NetworkPolicyRule(in *NetworkPolicyRule, out *garden.NetworkPolicyRule, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NetworkPolicyRule_To_garden_NetworkPolicyRule(in, out, s)
+}
+
+func autoConvert_garden_NetworkPolicyRule_To_v1alpha1_NetworkPolicyRule(in *garden.NetworkPolicyRule, out *NetworkPolicyRule, s conversion.Scope) error {
+	out.Name = in.Name
+	out.PodSelector = in.PodSelector
+	out.CIDRs = *(*[]string)(unsafe.Pointer(&in.CIDRs))
+	out.Ports = *(*[]NetworkPolicyRulePort)(unsafe.Pointer(&in.Ports))
+	return nil
+}
+
+// Convert_garden_NetworkPolicyRule_To_v1alpha1_NetworkPolicyRule is an autogenerated conversion function.
+func Convert_garden_NetworkPolicyRule_To_v1alpha1_NetworkPolicyRule(in *garden.NetworkPolicyRule, out *NetworkPolicyRule, s conversion.Scope) error {
+	return autoConvert_garden_NetworkPolicyRule_To_v1alpha1_NetworkPolicyRule(in, out, s)
+}
+
+func autoConvert_v1alpha1_NetworkPolicyRulePort_To_garden_NetworkPolicyRulePort(in *NetworkPolicyRulePort, out *garden.NetworkPolicyRulePort, s conversion.Scope) error {
+	out.Protocol = (*corev1.Protocol)(unsafe.Pointer(in.Protocol))
+	out.Port = in.Port
+	return nil
+}
+
+// Convert_v1alpha1_NetworkPolicyRulePort_To_garden_NetworkPolicyRulePort is an autogenerated conversion function.
+func Convert_v1alpha1_NetworkPolicyRulePort_To_garden_NetworkPolicyRulePort(in *NetworkPolicyRulePort, out *garden.NetworkPolicyRulePort, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NetworkPolicyRulePort_To_garden_NetworkPolicyRulePort(in, out, s)
+}
+
+func autoConvert_garden_NetworkPolicyRulePort_To_v1alpha1_NetworkPolicyRulePort(in *garden.NetworkPolicyRulePort, out *NetworkPolicyRulePort, s conversion.Scope) error {
+	out.Protocol = (*corev1.Protocol)(unsafe.Pointer(in.Protocol))
+	out.Port = in.Port
+	return nil
+}
+
+// Convert_garden_NetworkPolicyRulePort_To_v1alpha1_NetworkPolicyRulePort is an autogenerated conversion function.
+func Convert_garden_NetworkPolicyRulePort_To_v1alpha1_NetworkPolicyRulePort(in *garden.NetworkPolicyRulePort, out *NetworkPolicyRulePort, s conversion.Scope) error {
+	return autoConvert_garden_NetworkPolicyRulePort_To_v1alpha1_NetworkPolicyRulePort(in, out, s)
+}
+
+func autoConvert_v1alpha1_NetworkPool_To_garden_NetworkPool(in *NetworkPool, out *garden.NetworkPool, s conversion.Scope) error {
+	out.CIDR = in.CIDR
+	out.PrefixLength = in.PrefixLength
+	return nil
+}
+
+// Convert_v1alpha1_NetworkPool_To_garden_NetworkPool is an autogenerated conversion function.
+func Convert_v1alpha1_NetworkPool_To_garden_NetworkPool(in *NetworkPool, out *garden.NetworkPool, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NetworkPool_To_garden_NetworkPool(in, out, s)
+}
+
+func autoConvert_garden_NetworkPool_To_v1alpha1_NetworkPool(in *garden.NetworkPool, out *NetworkPool, s conversion.Scope) error {
+	out.CIDR = in.CIDR
+	out.PrefixLength = in.PrefixLength
+	return nil
+}
+
+// Convert_garden_NetworkPool_To_v1alpha1_NetworkPool is an autogenerated conversion function.
+func Convert_garden_NetworkPool_To_v1alpha1_NetworkPool(in *garden.NetworkPool, out *NetworkPool, s conversion.Scope) error {
+	return autoConvert_garden_NetworkPool_To_v1alpha1_NetworkPool(in, out, s)
+}
+
 func autoConvert_v1alpha1_Networking_To_garden_Networking(in *Networking, out *garden.Networking, s conversion.Scope) error {
 	out.Type = in.Type
 	out.ProviderConfig = (*garden.ProviderConfig)(unsafe.Pointer(in.ProviderConfig))
 	out.Pods = (*string)(unsafe.Pointer(in.Pods))
 	out.Nodes = in.Nodes
 	out.Services = (*string)(unsafe.Pointer(in.Services))
+	out.IPFamilies = *(*[]garden.IPFamily)(unsafe.Pointer(&in.IPFamilies))
 	return nil
 }
 
@@ -3181,6 +4222,7 @@ func autoConvert_garden_Networking_To_v1alpha1_Networking(in *garden.Networking,
 	out.Pods = (*string)(unsafe.Pointer(in.Pods))
 	out.Nodes = in.Nodes
 	out.Services = (*string)(unsafe.Pointer(in.Services))
+	out.IPFamilies = *(*[]IPFamily)(unsafe.Pointer(&in.IPFamilies))
 	return nil
 }
 
@@ -3195,7 +4237,7 @@ func autoConvert_v1alpha1_NginxIngress_To_garden_NginxIngress(in *NginxIngress,
 	}
 	out.LoadBalancerSourceRanges = *(*[]string)(unsafe.Pointer(&in.LoadBalancerSourceRanges))
 	out.Config = *(*map[string]string)(unsafe.Pointer(&in.Config))
-	out.ExternalTrafficPolicy = (*v1.ServiceExternalTrafficPolicyType)(unsafe.Pointer(in.ExternalTrafficPolicy))
+	out.ExternalTrafficPolicy = (*corev1.ServiceExternalTrafficPolicyType)(unsafe.Pointer(in.ExternalTrafficPolicy))
 	return nil
 }
 
@@ -3210,7 +4252,7 @@ func autoConvert_garden_NginxIngress_To_v1alpha1_NginxIngress(in *garden.NginxIn
 	}
 	out.LoadBalancerSourceRanges = *(*[]string)(unsafe.Pointer(&in.LoadBalancerSourceRanges))
 	out.Config = *(*map[string]string)(unsafe.Pointer(&in.Config))
-	out.ExternalTrafficPolicy = (*v1.ServiceExternalTrafficPolicyType)(unsafe.Pointer(in.ExternalTrafficPolicy))
+	out.ExternalTrafficPolicy = (*corev1.ServiceExternalTrafficPolicyType)(unsafe.Pointer(in.ExternalTrafficPolicy))
 	return nil
 }
 
@@ -3219,6 +4261,52 @@ func Convert_garden_NginxIngress_To_v1alpha1_NginxIngress(in *garden.NginxIngres
 	return autoConvert_garden_NginxIngress_To_v1alpha1_NginxIngress(in, out, s)
 }
 
+func autoConvert_v1alpha1_NodeKubeletConfigDrift_To_garden_NodeKubeletConfigDrift(in *NodeKubeletConfigDrift, out *garden.NodeKubeletConfigDrift, s conversion.Scope) error {
+	out.Node = in.Node
+	out.WorkerPool = (*string)(unsafe.Pointer(in.WorkerPool))
+	out.Fields = *(*[]garden.KubeletConfigFieldDrift)(unsafe.Pointer(&in.Fields))
+	return nil
+}
+
+// Convert_v1alpha1_NodeKubeletConfigDrift_To_garden_NodeKubeletConfigDrift is an autogenerated conversion function.
+func Convert_v1alpha1_NodeKubeletConfigDrift_To_garden_NodeKubeletConfigDrift(in *NodeKubeletConfigDrift, out *garden.NodeKubeletConfigDrift, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NodeKubeletConfigDrift_To_garden_NodeKubeletConfigDrift(in, out, s)
+}
+
+func autoConvert_garden_NodeKubeletConfigDrift_To_v1alpha1_NodeKubeletConfigDrift(in *garden.NodeKubeletConfigDrift, out *NodeKubeletConfigDrift, s conversion.Scope) error {
+	out.Node = in.Node
+	out.WorkerPool = (*string)(unsafe.Pointer(in.WorkerPool))
+	out.Fields = *(*[]KubeletConfigFieldDrift)(unsafe.Pointer(&in.Fields))
+	return nil
+}
+
+// Convert_garden_NodeKubeletConfigDrift_To_v1alpha1_NodeKubeletConfigDrift is an autogenerated conversion function.
+func Convert_garden_NodeKubeletConfigDrift_To_v1alpha1_NodeKubeletConfigDrift(in *garden.NodeKubeletConfigDrift, out *NodeKubeletConfigDrift, s conversion.Scope) error {
+	return autoConvert_garden_NodeKubeletConfigDrift_To_v1alpha1_NodeKubeletConfigDrift(in, out, s)
+}
+
+func autoConvert_v1alpha1_NodesInfo_To_core_NodesInfo(in *NodesInfo, out *core.NodesInfo, s conversion.Scope) error {
+	out.Count = in.Count
+	out.Capacity = *(*corev1.ResourceList)(unsafe.Pointer(&in.Capacity))
+	return nil
+}
+
+// Convert_v1alpha1_NodesInfo_To_core_NodesInfo is an autogenerated conversion function.
+func Convert_v1alpha1_NodesInfo_To_core_NodesInfo(in *NodesInfo, out *core.NodesInfo, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NodesInfo_To_core_NodesInfo(in, out, s)
+}
+
+func autoConvert_core_NodesInfo_To_v1alpha1_NodesInfo(in *core.NodesInfo, out *NodesInfo, s conversion.Scope) error {
+	out.Count = in.Count
+	out.Capacity = *(*corev1.ResourceList)(unsafe.Pointer(&in.Capacity))
+	return nil
+}
+
+// Convert_core_NodesInfo_To_v1alpha1_NodesInfo is an autogenerated conversion function.
+func Convert_core_NodesInfo_To_v1alpha1_NodesInfo(in *core.NodesInfo, out *NodesInfo, s conversion.Scope) error {
+	return autoConvert_core_NodesInfo_To_v1alpha1_NodesInfo(in, out, s)
+}
+
 func autoConvert_v1alpha1_OIDCConfig_To_garden_OIDCConfig(in *OIDCConfig, out *garden.OIDCConfig, s conversion.Scope) error {
 	out.CABundle = (*string)(unsafe.Pointer(in.CABundle))
 	if in.ClientAuthentication != nil {
@@ -3472,6 +4560,7 @@ func Convert_garden_ProjectList_To_v1alpha1_ProjectList(in *garden.ProjectList,
 func autoConvert_v1alpha1_ProjectMember_To_garden_ProjectMember(in *ProjectMember, out *garden.ProjectMember, s conversion.Scope) error {
 	out.Subject = in.Subject
 	out.Role = in.Role
+	out.Roles = *(*[]string)(unsafe.Pointer(&in.Roles))
 	return nil
 }
 
@@ -3483,6 +4572,7 @@ func Convert_v1alpha1_ProjectMember_To_garden_ProjectMember(in *ProjectMember, o
 func autoConvert_garden_ProjectMember_To_v1alpha1_ProjectMember(in *garden.ProjectMember, out *ProjectMember, s conversion.Scope) error {
 	out.Subject = in.Subject
 	out.Role = in.Role
+	out.Roles = *(*[]string)(unsafe.Pointer(&in.Roles))
 	return nil
 }
 
@@ -3498,6 +4588,7 @@ func autoConvert_v1alpha1_ProjectSpec_To_garden_ProjectSpec(in *ProjectSpec, out
 	out.Purpose = (*string)(unsafe.Pointer(in.Purpose))
 	// WARNING: in.Members requires manual conversion: does not exist in peer-type
 	out.Namespace = (*string)(unsafe.Pointer(in.Namespace))
+	out.BackupRetentionPolicy = (*garden.BackupRetentionPolicy)(unsafe.Pointer(in.BackupRetentionPolicy))
 	return nil
 }
 
@@ -3508,6 +4599,7 @@ func autoConvert_garden_ProjectSpec_To_v1alpha1_ProjectSpec(in *garden.ProjectSp
 	out.Purpose = (*string)(unsafe.Pointer(in.Purpose))
 	// WARNING: in.ProjectMembers requires manual conversion: does not exist in peer-type
 	out.Namespace = (*string)(unsafe.Pointer(in.Namespace))
+	out.BackupRetentionPolicy = (*BackupRetentionPolicy)(unsafe.Pointer(in.BackupRetentionPolicy))
 	return nil
 }
 
@@ -3649,7 +4741,7 @@ func Convert_garden_QuotaList_To_v1alpha1_QuotaList(in *garden.QuotaList, out *Q
 
 func autoConvert_v1alpha1_QuotaSpec_To_garden_QuotaSpec(in *QuotaSpec, out *garden.QuotaSpec, s conversion.Scope) error {
 	out.ClusterLifetimeDays = (*int)(unsafe.Pointer(in.ClusterLifetimeDays))
-	out.Metrics = *(*v1.ResourceList)(unsafe.Pointer(&in.Metrics))
+	out.Metrics = *(*corev1.ResourceList)(unsafe.Pointer(&in.Metrics))
 	out.Scope = in.Scope
 	return nil
 }
@@ -3661,7 +4753,7 @@ func Convert_v1alpha1_QuotaSpec_To_garden_QuotaSpec(in *QuotaSpec, out *garden.Q
 
 func autoConvert_garden_QuotaSpec_To_v1alpha1_QuotaSpec(in *garden.QuotaSpec, out *QuotaSpec, s conversion.Scope) error {
 	out.ClusterLifetimeDays = (*int)(unsafe.Pointer(in.ClusterLifetimeDays))
-	out.Metrics = *(*v1.ResourceList)(unsafe.Pointer(&in.Metrics))
+	out.Metrics = *(*corev1.ResourceList)(unsafe.Pointer(&in.Metrics))
 	out.Scope = in.Scope
 	return nil
 }
@@ -3693,10 +4785,30 @@ func Convert_garden_Region_To_v1alpha1_Region(in *garden.Region, out *Region, s
 	return autoConvert_garden_Region_To_v1alpha1_Region(in, out, s)
 }
 
+func autoConvert_v1alpha1_SecondaryBackupLocation_To_core_SecondaryBackupLocation(in *SecondaryBackupLocation, out *core.SecondaryBackupLocation, s conversion.Scope) error {
+	out.Region = in.Region
+	return nil
+}
+
+// Convert_v1alpha1_SecondaryBackupLocation_To_core_SecondaryBackupLocation is an autogenerated conversion function.
+func Convert_v1alpha1_SecondaryBackupLocation_To_core_SecondaryBackupLocation(in *SecondaryBackupLocation, out *core.SecondaryBackupLocation, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SecondaryBackupLocation_To_core_SecondaryBackupLocation(in, out, s)
+}
+
+func autoConvert_core_SecondaryBackupLocation_To_v1alpha1_SecondaryBackupLocation(in *core.SecondaryBackupLocation, out *SecondaryBackupLocation, s conversion.Scope) error {
+	out.Region = in.Region
+	return nil
+}
+
+// Convert_core_SecondaryBackupLocation_To_v1alpha1_SecondaryBackupLocation is an autogenerated conversion function.
+func Convert_core_SecondaryBackupLocation_To_v1alpha1_SecondaryBackupLocation(in *core.SecondaryBackupLocation, out *SecondaryBackupLocation, s conversion.Scope) error {
+	return autoConvert_core_SecondaryBackupLocation_To_v1alpha1_SecondaryBackupLocation(in, out, s)
+}
+
 func autoConvert_v1alpha1_SecretBinding_To_garden_SecretBinding(in *SecretBinding, out *garden.SecretBinding, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	out.SecretRef = in.SecretRef
-	out.Quotas = *(*[]v1.ObjectReference)(unsafe.Pointer(&in.Quotas))
+	out.Quotas = *(*[]corev1.ObjectReference)(unsafe.Pointer(&in.Quotas))
 	return nil
 }
 
@@ -3708,7 +4820,7 @@ func Convert_v1alpha1_SecretBinding_To_garden_SecretBinding(in *SecretBinding, o
 func autoConvert_garden_SecretBinding_To_v1alpha1_SecretBinding(in *garden.SecretBinding, out *SecretBinding, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	out.SecretRef = in.SecretRef
-	out.Quotas = *(*[]v1.ObjectReference)(unsafe.Pointer(&in.Quotas))
+	out.Quotas = *(*[]corev1.ObjectReference)(unsafe.Pointer(&in.Quotas))
 	return nil
 }
 
@@ -3765,6 +4877,7 @@ func autoConvert_v1alpha1_SeedBackup_To_garden_SeedBackup(in *SeedBackup, out *g
 	out.Provider = garden.CloudProvider(in.Provider)
 	out.Region = (*string)(unsafe.Pointer(in.Region))
 	out.SecretRef = in.SecretRef
+	out.Secondary = (*garden.SecondaryBackupLocation)(unsafe.Pointer(in.Secondary))
 	return nil
 }
 
@@ -3777,6 +4890,7 @@ func autoConvert_garden_SeedBackup_To_v1alpha1_SeedBackup(in *garden.SeedBackup,
 	out.Provider = string(in.Provider)
 	out.Region = (*string)(unsafe.Pointer(in.Region))
 	out.SecretRef = in.SecretRef
+	out.Secondary = (*SecondaryBackupLocation)(unsafe.Pointer(in.Secondary))
 	return nil
 }
 
@@ -3832,6 +4946,8 @@ func autoConvert_v1alpha1_SeedNetworks_To_garden_SeedNetworks(in *SeedNetworks,
 	out.Pods = in.Pods
 	out.Services = in.Services
 	out.ShootDefaults = (*garden.ShootNetworks)(unsafe.Pointer(in.ShootDefaults))
+	out.ShootPools = (*garden.ShootNetworkPools)(unsafe.Pointer(in.ShootPools))
+	out.IPFamilies = *(*[]garden.IPFamily)(unsafe.Pointer(&in.IPFamilies))
 	return nil
 }
 
@@ -3840,6 +4956,8 @@ func autoConvert_garden_SeedNetworks_To_v1alpha1_SeedNetworks(in *garden.SeedNet
 	out.Pods = in.Pods
 	out.Services = in.Services
 	out.ShootDefaults = (*ShootNetworks)(unsafe.Pointer(in.ShootDefaults))
+	out.ShootPools = (*ShootNetworkPools)(unsafe.Pointer(in.ShootPools))
+	out.IPFamilies = *(*[]IPFamily)(unsafe.Pointer(&in.IPFamilies))
 	// WARNING: in.BlockCIDRs requires manual conversion: does not exist in peer-type
 	return nil
 }
@@ -3876,7 +4994,7 @@ func autoConvert_v1alpha1_SeedSpec_To_garden_SeedSpec(in *SeedSpec, out *garden.
 	if err := Convert_v1alpha1_SeedProvider_To_garden_SeedProvider(&in.Provider, &out.Provider, s); err != nil {
 		return err
 	}
-	out.SecretRef = (*v1.SecretReference)(unsafe.Pointer(in.SecretRef))
+	out.SecretRef = (*corev1.SecretReference)(unsafe.Pointer(in.SecretRef))
 	out.Taints = *(*[]garden.SeedTaint)(unsafe.Pointer(&in.Taints))
 	out.Volume = (*garden.SeedVolume)(unsafe.Pointer(in.Volume))
 	return nil
@@ -3888,7 +5006,7 @@ func autoConvert_garden_SeedSpec_To_v1alpha1_SeedSpec(in *garden.SeedSpec, out *
 		return err
 	}
 	// WARNING: in.IngressDomain requires manual conversion: does not exist in peer-type
-	out.SecretRef = (*v1.SecretReference)(unsafe.Pointer(in.SecretRef))
+	out.SecretRef = (*corev1.SecretReference)(unsafe.Pointer(in.SecretRef))
 	if err := Convert_garden_SeedNetworks_To_v1alpha1_SeedNetworks(&in.Networks, &out.Networks, s); err != nil {
 		return err
 	}
@@ -3992,7 +5110,7 @@ func Convert_garden_SeedVolumeProvider_To_v1alpha1_SeedVolumeProvider(in *garden
 
 func autoConvert_v1alpha1_ServiceAccountConfig_To_garden_ServiceAccountConfig(in *ServiceAccountConfig, out *garden.ServiceAccountConfig, s conversion.Scope) error {
 	out.Issuer = (*string)(unsafe.Pointer(in.Issuer))
-	out.SigningKeySecret = (*v1.LocalObjectReference)(unsafe.Pointer(in.SigningKeySecret))
+	out.SigningKeySecret = (*corev1.LocalObjectReference)(unsafe.Pointer(in.SigningKeySecret))
 	return nil
 }
 
@@ -4003,7 +5121,7 @@ func Convert_v1alpha1_ServiceAccountConfig_To_garden_ServiceAccountConfig(in *Se
 
 func autoConvert_garden_ServiceAccountConfig_To_v1alpha1_ServiceAccountConfig(in *garden.ServiceAccountConfig, out *ServiceAccountConfig, s conversion.Scope) error {
 	out.Issuer = (*string)(unsafe.Pointer(in.Issuer))
-	out.SigningKeySecret = (*v1.LocalObjectReference)(unsafe.Pointer(in.SigningKeySecret))
+	out.SigningKeySecret = (*corev1.LocalObjectReference)(unsafe.Pointer(in.SigningKeySecret))
 	return nil
 }
 
@@ -4034,6 +5152,112 @@ func autoConvert_garden_Shoot_To_v1alpha1_Shoot(in *garden.Shoot, out *Shoot, s
 	return nil
 }
 
+func autoConvert_v1alpha1_ShootAvailability_To_garden_ShootAvailability(in *ShootAvailability, out *garden.ShootAvailability, s conversion.Scope) error {
+	out.APIServer = *(*[]garden.AvailabilityRatio)(unsafe.Pointer(&in.APIServer))
+	return nil
+}
+
+// Convert_v1alpha1_ShootAvailability_To_garden_ShootAvailability is an autogenerated conversion function.
+func Convert_v1alpha1_ShootAvailability_To_garden_ShootAvailability(in *ShootAvailability, out *garden.ShootAvailability, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ShootAvailability_To_garden_ShootAvailability(in, out, s)
+}
+
+func autoConvert_garden_ShootAvailability_To_v1alpha1_ShootAvailability(in *garden.ShootAvailability, out *ShootAvailability, s conversion.Scope) error {
+	out.APIServer = *(*[]AvailabilityRatio)(unsafe.Pointer(&in.APIServer))
+	return nil
+}
+
+// Convert_garden_ShootAvailability_To_v1alpha1_ShootAvailability is an autogenerated conversion function.
+func Convert_garden_ShootAvailability_To_v1alpha1_ShootAvailability(in *garden.ShootAvailability, out *ShootAvailability, s conversion.Scope) error {
+	return autoConvert_garden_ShootAvailability_To_v1alpha1_ShootAvailability(in, out, s)
+}
+
+func autoConvert_v1alpha1_ShootBackup_To_garden_ShootBackup(in *ShootBackup, out *garden.ShootBackup, s conversion.Scope) error {
+	out.RetentionPolicy = (*garden.BackupRetentionPolicy)(unsafe.Pointer(in.RetentionPolicy))
+	return nil
+}
+
+// Convert_v1alpha1_ShootBackup_To_garden_ShootBackup is an autogenerated conversion function.
+func Convert_v1alpha1_ShootBackup_To_garden_ShootBackup(in *ShootBackup, out *garden.ShootBackup, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ShootBackup_To_garden_ShootBackup(in, out, s)
+}
+
+func autoConvert_garden_ShootBackup_To_v1alpha1_ShootBackup(in *garden.ShootBackup, out *ShootBackup, s conversion.Scope) error {
+	out.RetentionPolicy = (*BackupRetentionPolicy)(unsafe.Pointer(in.RetentionPolicy))
+	return nil
+}
+
+// Convert_garden_ShootBackup_To_v1alpha1_ShootBackup is an autogenerated conversion function.
+func Convert_garden_ShootBackup_To_v1alpha1_ShootBackup(in *garden.ShootBackup, out *ShootBackup, s conversion.Scope) error {
+	return autoConvert_garden_ShootBackup_To_v1alpha1_ShootBackup(in, out, s)
+}
+
+func autoConvert_v1alpha1_ShootCredentials_To_garden_ShootCredentials(in *ShootCredentials, out *garden.ShootCredentials, s conversion.Scope) error {
+	out.Rotation = (*garden.ShootCredentialsRotation)(unsafe.Pointer(in.Rotation))
+	return nil
+}
+
+// Convert_v1alpha1_ShootCredentials_To_garden_ShootCredentials is an autogenerated conversion function.
+func Convert_v1alpha1_ShootCredentials_To_garden_ShootCredentials(in *ShootCredentials, out *garden.ShootCredentials, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ShootCredentials_To_garden_ShootCredentials(in, out, s)
+}
+
+func autoConvert_garden_ShootCredentials_To_v1alpha1_ShootCredentials(in *garden.ShootCredentials, out *ShootCredentials, s conversion.Scope) error {
+	out.Rotation = (*ShootCredentialsRotation)(unsafe.Pointer(in.Rotation))
+	return nil
+}
+
+// Convert_garden_ShootCredentials_To_v1alpha1_ShootCredentials is an autogenerated conversion function.
+func Convert_garden_ShootCredentials_To_v1alpha1_ShootCredentials(in *garden.ShootCredentials, out *ShootCredentials, s conversion.Scope) error {
+	return autoConvert_garden_ShootCredentials_To_v1alpha1_ShootCredentials(in, out, s)
+}
+
+func autoConvert_v1alpha1_ShootCredentialsRotation_To_garden_ShootCredentialsRotation(in *ShootCredentialsRotation, out *garden.ShootCredentialsRotation, s conversion.Scope) error {
+	out.CertificateAuthorities = (*garden.CARotation)(unsafe.Pointer(in.CertificateAuthorities))
+	out.StaticCredentials = (*garden.StaticCredentialsRotation)(unsafe.Pointer(in.StaticCredentials))
+	out.ETCDEncryptionKey = (*garden.ETCDEncryptionKeyRotation)(unsafe.Pointer(in.ETCDEncryptionKey))
+	return nil
+}
+
+// Convert_v1alpha1_ShootCredentialsRotation_To_garden_ShootCredentialsRotation is an autogenerated conversion function.
+func Convert_v1alpha1_ShootCredentialsRotation_To_garden_ShootCredentialsRotation(in *ShootCredentialsRotation, out *garden.ShootCredentialsRotation, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ShootCredentialsRotation_To_garden_ShootCredentialsRotation(in, out, s)
+}
+
+func autoConvert_garden_ShootCredentialsRotation_To_v1alpha1_ShootCredentialsRotation(in *garden.ShootCredentialsRotation, out *ShootCredentialsRotation, s conversion.Scope) error {
+	out.CertificateAuthorities = (*CARotation)(unsafe.Pointer(in.CertificateAuthorities))
+	out.StaticCredentials = (*StaticCredentialsRotation)(unsafe.Pointer(in.StaticCredentials))
+	out.ETCDEncryptionKey = (*ETCDEncryptionKeyRotation)(unsafe.Pointer(in.ETCDEncryptionKey))
+	return nil
+}
+
+// Convert_garden_ShootCredentialsRotation_To_v1alpha1_ShootCredentialsRotation is an autogenerated conversion function.
+func Convert_garden_ShootCredentialsRotation_To_v1alpha1_ShootCredentialsRotation(in *garden.ShootCredentialsRotation, out *ShootCredentialsRotation, s conversion.Scope) error {
+	return autoConvert_garden_ShootCredentialsRotation_To_v1alpha1_ShootCredentialsRotation(in, out, s)
+}
+
+func autoConvert_v1alpha1_ShootETCDBackup_To_garden_ShootETCDBackup(in *ShootETCDBackup, out *garden.ShootETCDBackup, s conversion.Scope) error {
+	out.LastSnapshot = (*garden.ETCDBackupOperation)(unsafe.Pointer(in.LastSnapshot))
+	out.LastRestore = (*garden.ETCDBackupOperation)(unsafe.Pointer(in.LastRestore))
+	return nil
+}
+
+// Convert_v1alpha1_ShootETCDBackup_To_garden_ShootETCDBackup is an autogenerated conversion function.
+func Convert_v1alpha1_ShootETCDBackup_To_garden_ShootETCDBackup(in *ShootETCDBackup, out *garden.ShootETCDBackup, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ShootETCDBackup_To_garden_ShootETCDBackup(in, out, s)
+}
+
+func autoConvert_garden_ShootETCDBackup_To_v1alpha1_ShootETCDBackup(in *garden.ShootETCDBackup, out *ShootETCDBackup, s conversion.Scope) error {
+	out.LastSnapshot = (*ETCDBackupOperation)(unsafe.Pointer(in.LastSnapshot))
+	out.LastRestore = (*ETCDBackupOperation)(unsafe.Pointer(in.LastRestore))
+	return nil
+}
+
+// Convert_garden_ShootETCDBackup_To_v1alpha1_ShootETCDBackup is an autogenerated conversion function.
+func Convert_garden_ShootETCDBackup_To_v1alpha1_ShootETCDBackup(in *garden.ShootETCDBackup, out *ShootETCDBackup, s conversion.Scope) error {
+	return autoConvert_garden_ShootETCDBackup_To_v1alpha1_ShootETCDBackup(in, out, s)
+}
+
 func autoConvert_v1alpha1_ShootList_To_garden_ShootList(in *ShootList, out *garden.ShootList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
 	if in.Items != nil {
@@ -4100,6 +5324,28 @@ func Convert_garden_ShootMachineImage_To_v1alpha1_ShootMachineImage(in *garden.S
 	return autoConvert_garden_ShootMachineImage_To_v1alpha1_ShootMachineImage(in, out, s)
 }
 
+func autoConvert_v1alpha1_ShootNetworkPools_To_garden_ShootNetworkPools(in *ShootNetworkPools, out *garden.ShootNetworkPools, s conversion.Scope) error {
+	out.Nodes = (*garden.NetworkPool)(unsafe.Pointer(in.Nodes))
+	out.Pods = (*garden.NetworkPool)(unsafe.Pointer(in.Pods))
+	return nil
+}
+
+// Convert_v1alpha1_ShootNetworkPools_To_garden_ShootNetworkPools is an autogenerated conversion function.
+func Convert_v1alpha1_ShootNetworkPools_To_garden_ShootNetworkPools(in *ShootNetworkPools, out *garden.ShootNetworkPools, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ShootNetworkPools_To_garden_ShootNetworkPools(in, out, s)
+}
+
+func autoConvert_garden_ShootNetworkPools_To_v1alpha1_ShootNetworkPools(in *garden.ShootNetworkPools, out *ShootNetworkPools, s conversion.Scope) error {
+	out.Nodes = (*NetworkPool)(unsafe.Pointer(in.Nodes))
+	out.Pods = (*NetworkPool)(unsafe.Pointer(in.Pods))
+	return nil
+}
+
+// Convert_garden_ShootNetworkPools_To_v1alpha1_ShootNetworkPools is an autogenerated conversion function.
+func Convert_garden_ShootNetworkPools_To_v1alpha1_ShootNetworkPools(in *garden.ShootNetworkPools, out *ShootNetworkPools, s conversion.Scope) error {
+	return autoConvert_garden_ShootNetworkPools_To_v1alpha1_ShootNetworkPools(in, out, s)
+}
+
 func autoConvert_v1alpha1_ShootNetworks_To_garden_ShootNetworks(in *ShootNetworks, out *garden.ShootNetworks, s conversion.Scope) error {
 	out.Pods = (*string)(unsafe.Pointer(in.Pods))
 	out.Services = (*string)(unsafe.Pointer(in.Services))
@@ -4132,7 +5378,9 @@ func autoConvert_v1alpha1_ShootSpec_To_garden_ShootSpec(in *ShootSpec, out *gard
 	} else {
 		out.Addons = nil
 	}
+	out.Backup = (*garden.ShootBackup)(unsafe.Pointer(in.Backup))
 	out.CloudProfileName = in.CloudProfileName
+	out.ControlPlane = (*garden.ControlPlane)(unsafe.Pointer(in.ControlPlane))
 	out.DNS = (*garden.DNS)(unsafe.Pointer(in.DNS))
 	out.Extensions = *(*[]garden.Extension)(unsafe.Pointer(&in.Extensions))
 	out.Hibernation = (*garden.Hibernation)(unsafe.Pointer(in.Hibernation))
@@ -4171,8 +5419,10 @@ func autoConvert_garden_ShootSpec_To_v1alpha1_ShootSpec(in *garden.ShootSpec, ou
 	} else {
 		out.Addons = nil
 	}
+	out.Backup = (*ShootBackup)(unsafe.Pointer(in.Backup))
 	// WARNING: in.Cloud requires manual conversion: does not exist in peer-type
 	out.CloudProfileName = in.CloudProfileName
+	out.ControlPlane = (*ControlPlane)(unsafe.Pointer(in.ControlPlane))
 	out.DNS = (*DNS)(unsafe.Pointer(in.DNS))
 	out.Extensions = *(*[]Extension)(unsafe.Pointer(&in.Extensions))
 	out.Hibernation = (*Hibernation)(unsafe.Pointer(in.Hibernation))
@@ -4272,19 +5522,24 @@ func Convert_core_ShootStateSpec_To_v1alpha1_ShootStateSpec(in *core.ShootStateS
 }
 
 func autoConvert_v1alpha1_ShootStatus_To_garden_ShootStatus(in *ShootStatus, out *garden.ShootStatus, s conversion.Scope) error {
+	out.Availability = (*garden.ShootAvailability)(unsafe.Pointer(in.Availability))
 	out.Conditions = *(*[]garden.Condition)(unsafe.Pointer(&in.Conditions))
 	out.Constraints = *(*[]garden.Condition)(unsafe.Pointer(&in.Constraints))
+	out.ConditionHistories = *(*[]garden.ConditionHistory)(unsafe.Pointer(&in.ConditionHistories))
+	out.Credentials = (*garden.ShootCredentials)(unsafe.Pointer(in.Credentials))
+	out.ETCDBackup = (*garden.ShootETCDBackup)(unsafe.Pointer(in.ETCDBackup))
 	if err := Convert_v1alpha1_Gardener_To_garden_Gardener(&in.Gardener, &out.Gardener, s); err != nil {
 		return err
 	}
-	if err := metav1.Convert_bool_To_Pointer_bool(&in.IsHibernated, &out.IsHibernated, s); err != nil {
+	if err := v1.Convert_bool_To_Pointer_bool(&in.IsHibernated, &out.IsHibernated, s); err != nil {
 		return err
 	}
+	out.KubeletConfigDrifts = *(*[]garden.NodeKubeletConfigDrift)(unsafe.Pointer(&in.KubeletConfigDrifts))
 	out.LastOperation = (*garden.LastOperation)(unsafe.Pointer(in.LastOperation))
 	// WARNING: in.LastError requires manual conversion: does not exist in peer-type
 	out.LastErrors = *(*[]garden.LastError)(unsafe.Pointer(&in.LastErrors))
 	out.ObservedGeneration = in.ObservedGeneration
-	out.RetryCycleStartTime = (*metav1.Time)(unsafe.Pointer(in.RetryCycleStartTime))
+	out.RetryCycleStartTime = (*v1.Time)(unsafe.Pointer(in.RetryCycleStartTime))
 	// WARNING: in.Seed requires manual conversion: does not exist in peer-type
 	out.TechnicalID = in.TechnicalID
 	out.UID = types.UID(in.UID)
@@ -4292,17 +5547,22 @@ func autoConvert_v1alpha1_ShootStatus_To_garden_ShootStatus(in *ShootStatus, out
 }
 
 func autoConvert_garden_ShootStatus_To_v1alpha1_ShootStatus(in *garden.ShootStatus, out *ShootStatus, s conversion.Scope) error {
+	out.Availability = (*ShootAvailability)(unsafe.Pointer(in.Availability))
 	out.Conditions = *(*[]Condition)(unsafe.Pointer(&in.Conditions))
 	out.Constraints = *(*[]Condition)(unsafe.Pointer(&in.Constraints))
+	out.ConditionHistories = *(*[]ConditionHistory)(unsafe.Pointer(&in.ConditionHistories))
+	out.Credentials = (*ShootCredentials)(unsafe.Pointer(in.Credentials))
+	out.ETCDBackup = (*ShootETCDBackup)(unsafe.Pointer(in.ETCDBackup))
 	if err := Convert_garden_Gardener_To_v1alpha1_Gardener(&in.Gardener, &out.Gardener, s); err != nil {
 		return err
 	}
+	out.KubeletConfigDrifts = *(*[]NodeKubeletConfigDrift)(unsafe.Pointer(&in.KubeletConfigDrifts))
 	out.LastOperation = (*LastOperation)(unsafe.Pointer(in.LastOperation))
 	out.LastErrors = *(*[]LastError)(unsafe.Pointer(&in.LastErrors))
 	out.ObservedGeneration = in.ObservedGeneration
-	out.RetryCycleStartTime = (*metav1.Time)(unsafe.Pointer(in.RetryCycleStartTime))
+	out.RetryCycleStartTime = (*v1.Time)(unsafe.Pointer(in.RetryCycleStartTime))
 	// WARNING: in.SeedName requires manual conversion: does not exist in peer-type
-	if err := metav1.Convert_Pointer_bool_To_bool(&in.IsHibernated, &out.IsHibernated, s); err != nil {
+	if err := v1.Convert_Pointer_bool_To_bool(&in.IsHibernated, &out.IsHibernated, s); err != nil {
 		return err
 	}
 	out.TechnicalID = in.TechnicalID
@@ -4310,6 +5570,48 @@ func autoConvert_garden_ShootStatus_To_v1alpha1_ShootStatus(in *garden.ShootStat
 	return nil
 }
 
+func autoConvert_v1alpha1_StaticCredentialsRotation_To_garden_StaticCredentialsRotation(in *StaticCredentialsRotation, out *garden.StaticCredentialsRotation, s conversion.Scope) error {
+	out.LastRotationTime = (*v1.Time)(unsafe.Pointer(in.LastRotationTime))
+	return nil
+}
+
+// Convert_v1alpha1_StaticCredentialsRotation_To_garden_StaticCredentialsRotation is an autogenerated conversion function.
+func Convert_v1alpha1_StaticCredentialsRotation_To_garden_StaticCredentialsRotation(in *StaticCredentialsRotation, out *garden.StaticCredentialsRotation, s conversion.Scope) error {
+	return autoConvert_v1alpha1_StaticCredentialsRotation_To_garden_StaticCredentialsRotation(in, out, s)
+}
+
+func autoConvert_garden_StaticCredentialsRotation_To_v1alpha1_StaticCredentialsRotation(in *garden.StaticCredentialsRotation, out *StaticCredentialsRotation, s conversion.Scope) error {
+	out.LastRotationTime = (*v1.Time)(unsafe.Pointer(in.LastRotationTime))
+	return nil
+}
+
+// Convert_garden_StaticCredentialsRotation_To_v1alpha1_StaticCredentialsRotation is an autogenerated conversion function.
+func Convert_garden_StaticCredentialsRotation_To_v1alpha1_StaticCredentialsRotation(in *garden.StaticCredentialsRotation, out *StaticCredentialsRotation, s conversion.Scope) error {
+	return autoConvert_garden_StaticCredentialsRotation_To_v1alpha1_StaticCredentialsRotation(in, out, s)
+}
+
+func autoConvert_v1alpha1_StaticCredentialsRotationConfig_To_garden_StaticCredentialsRotationConfig(in *StaticCredentialsRotationConfig, out *garden.StaticCredentialsRotationConfig, s conversion.Scope) error {
+	out.Period = in.Period
+	out.TransitionPeriod = (*v1.Duration)(unsafe.Pointer(in.TransitionPeriod))
+	return nil
+}
+
+// Convert_v1alpha1_StaticCredentialsRotationConfig_To_garden_StaticCredentialsRotationConfig is an autogenerated conversion function.
+func Convert_v1alpha1_StaticCredentialsRotationConfig_To_garden_StaticCredentialsRotationConfig(in *StaticCredentialsRotationConfig, out *garden.StaticCredentialsRotationConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_StaticCredentialsRotationConfig_To_garden_StaticCredentialsRotationConfig(in, out, s)
+}
+
+func autoConvert_garden_StaticCredentialsRotationConfig_To_v1alpha1_StaticCredentialsRotationConfig(in *garden.StaticCredentialsRotationConfig, out *StaticCredentialsRotationConfig, s conversion.Scope) error {
+	out.Period = in.Period
+	out.TransitionPeriod = (*v1.Duration)(unsafe.Pointer(in.TransitionPeriod))
+	return nil
+}
+
+// Convert_garden_StaticCredentialsRotationConfig_To_v1alpha1_StaticCredentialsRotationConfig is an autogenerated conversion function.
+func Convert_garden_StaticCredentialsRotationConfig_To_v1alpha1_StaticCredentialsRotationConfig(in *garden.StaticCredentialsRotationConfig, out *StaticCredentialsRotationConfig, s conversion.Scope) error {
+	return autoConvert_garden_StaticCredentialsRotationConfig_To_v1alpha1_StaticCredentialsRotationConfig(in, out, s)
+}
+
 func autoConvert_v1alpha1_Volume_To_garden_Volume(in *Volume, out *garden.Volume, s conversion.Scope) error {
 	out.Type = (*string)(unsafe.Pointer(in.Type))
 	out.Size = in.Size
@@ -4359,6 +5661,7 @@ func Convert_garden_VolumeType_To_v1alpha1_VolumeType(in *garden.VolumeType, out
 func autoConvert_v1alpha1_Worker_To_garden_Worker(in *Worker, out *garden.Worker, s conversion.Scope) error {
 	out.Annotations = *(*map[string]string)(unsafe.Pointer(&in.Annotations))
 	out.CABundle = (*string)(unsafe.Pointer(in.CABundle))
+	out.CRI = (*garden.CRI)(unsafe.Pointer(in.CRI))
 	if in.Kubernetes != nil {
 		in, out := &in.Kubernetes, &out.Kubernetes
 		*out = new(garden.WorkerKubernetes)
@@ -4378,7 +5681,7 @@ func autoConvert_v1alpha1_Worker_To_garden_Worker(in *Worker, out *garden.Worker
 	out.MaxSurge = (*intstr.IntOrString)(unsafe.Pointer(in.MaxSurge))
 	out.MaxUnavailable = (*intstr.IntOrString)(unsafe.Pointer(in.MaxUnavailable))
 	out.ProviderConfig = (*garden.ProviderConfig)(unsafe.Pointer(in.ProviderConfig))
-	out.Taints = *(*[]v1.Taint)(unsafe.Pointer(&in.Taints))
+	out.Taints = *(*[]corev1.Taint)(unsafe.Pointer(&in.Taints))
 	out.Volume = (*garden.Volume)(unsafe.Pointer(in.Volume))
 	out.Zones = *(*[]string)(unsafe.Pointer(&in.Zones))
 	return nil
@@ -4392,6 +5695,7 @@ func Convert_v1alpha1_Worker_To_garden_Worker(in *Worker, out *garden.Worker, s
 func autoConvert_garden_Worker_To_v1alpha1_Worker(in *garden.Worker, out *Worker, s conversion.Scope) error {
 	out.Annotations = *(*map[string]string)(unsafe.Pointer(&in.Annotations))
 	out.CABundle = (*string)(unsafe.Pointer(in.CABundle))
+	out.CRI = (*CRI)(unsafe.Pointer(in.CRI))
 	if in.Kubernetes != nil {
 		in, out := &in.Kubernetes, &out.Kubernetes
 		*out = new(WorkerKubernetes)
@@ -4411,7 +5715,7 @@ func autoConvert_garden_Worker_To_v1alpha1_Worker(in *garden.Worker, out *Worker
 	out.MaxSurge = (*intstr.IntOrString)(unsafe.Pointer(in.MaxSurge))
 	out.MaxUnavailable = (*intstr.IntOrString)(unsafe.Pointer(in.MaxUnavailable))
 	out.ProviderConfig = (*ProviderConfig)(unsafe.Pointer(in.ProviderConfig))
-	out.Taints = *(*[]v1.Taint)(unsafe.Pointer(&in.Taints))
+	out.Taints = *(*[]corev1.Taint)(unsafe.Pointer(&in.Taints))
 	out.Volume = (*Volume)(unsafe.Pointer(in.Volume))
 	out.Zones = *(*[]string)(unsafe.Pointer(&in.Zones))
 	return nil
@@ -4457,3 +5761,55 @@ func autoConvert_garden_WorkerKubernetes_To_v1alpha1_WorkerKubernetes(in *garden
 func Convert_garden_WorkerKubernetes_To_v1alpha1_WorkerKubernetes(in *garden.WorkerKubernetes, out *WorkerKubernetes, s conversion.Scope) error {
 	return autoConvert_garden_WorkerKubernetes_To_v1alpha1_WorkerKubernetes(in, out, s)
 }
+
+func autoConvert_v1alpha1_WorkloadHealthInfo_To_core_WorkloadHealthInfo(in *WorkloadHealthInfo, out *core.WorkloadHealthInfo, s conversion.Scope) error {
+	out.Total = in.Total
+	out.Healthy = in.Healthy
+	return nil
+}
+
+// Convert_v1alpha1_WorkloadHealthInfo_To_core_WorkloadHealthInfo is an autogenerated conversion function.
+func Convert_v1alpha1_WorkloadHealthInfo_To_core_WorkloadHealthInfo(in *WorkloadHealthInfo, out *core.WorkloadHealthInfo, s conversion.Scope) error {
+	return autoConvert_v1alpha1_WorkloadHealthInfo_To_core_WorkloadHealthInfo(in, out, s)
+}
+
+func autoConvert_core_WorkloadHealthInfo_To_v1alpha1_WorkloadHealthInfo(in *core.WorkloadHealthInfo, out *WorkloadHealthInfo, s conversion.Scope) error {
+	out.Total = in.Total
+	out.Healthy = in.Healthy
+	return nil
+}
+
+// Convert_core_WorkloadHealthInfo_To_v1alpha1_WorkloadHealthInfo is an autogenerated conversion function.
+func Convert_core_WorkloadHealthInfo_To_v1alpha1_WorkloadHealthInfo(in *core.WorkloadHealthInfo, out *WorkloadHealthInfo, s conversion.Scope) error {
+	return autoConvert_core_WorkloadHealthInfo_To_v1alpha1_WorkloadHealthInfo(in, out, s)
+}
+
+func autoConvert_v1alpha1_WorkloadsInfo_To_core_WorkloadsInfo(in *WorkloadsInfo, out *core.WorkloadsInfo, s conversion.Scope) error {
+	if err := Convert_v1alpha1_WorkloadHealthInfo_To_core_WorkloadHealthInfo(&in.Deployments, &out.Deployments, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_WorkloadHealthInfo_To_core_WorkloadHealthInfo(&in.StatefulSets, &out.StatefulSets, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_WorkloadsInfo_To_core_WorkloadsInfo is an autogenerated conversion function.
+func Convert_v1alpha1_WorkloadsInfo_To_core_WorkloadsInfo(in *WorkloadsInfo, out *core.WorkloadsInfo, s conversion.Scope) error {
+	return autoConvert_v1alpha1_WorkloadsInfo_To_core_WorkloadsInfo(in, out, s)
+}
+
+func autoConvert_core_WorkloadsInfo_To_v1alpha1_WorkloadsInfo(in *core.WorkloadsInfo, out *WorkloadsInfo, s conversion.Scope) error {
+	if err := Convert_core_WorkloadHealthInfo_To_v1alpha1_WorkloadHealthInfo(&in.Deployments, &out.Deployments, s); err != nil {
+		return err
+	}
+	if err := Convert_core_WorkloadHealthInfo_To_v1alpha1_WorkloadHealthInfo(&in.StatefulSets, &out.StatefulSets, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_core_WorkloadsInfo_To_v1alpha1_WorkloadsInfo is an autogenerated conversion function.
+func Convert_core_WorkloadsInfo_To_v1alpha1_WorkloadsInfo(in *core.WorkloadsInfo, out *WorkloadsInfo, s conversion.Scope) error {
+	return autoConvert_core_WorkloadsInfo_To_v1alpha1_WorkloadsInfo(in, out, s)
+}
@@ -21,9 +21,9 @@ limitations under the License.
 package v1alpha1
 
 import (
-	v1 "k8s.io/api/core/v1"
+	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 	intstr "k8s.io/apimachinery/pkg/util/intstr"
 )
@@ -91,6 +91,23 @@ func (in *AdmissionPlugin) DeepCopy() *AdmissionPlugin {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AdmissionWebhookConfig) DeepCopyInto(out *AdmissionWebhookConfig) {
+	*out = *in
+	out.SecretRef = in.SecretRef
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdmissionWebhookConfig.
+func (in *AdmissionWebhookConfig) DeepCopy() *AdmissionWebhookConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(AdmissionWebhookConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Alerting) DeepCopyInto(out *Alerting) {
 	*out = *in
@@ -112,6 +129,73 @@ func (in *Alerting) DeepCopy() *Alerting {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuditBackend) DeepCopyInto(out *AuditBackend) {
+	*out = *in
+	if in.Log != nil {
+		in, out := &in.Log, &out.Log
+		*out = new(AuditLogBackend)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Webhook != nil {
+		in, out := &in.Webhook, &out.Webhook
+		*out = new(AuditWebhookBackend)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuditBackend.
+func (in *AuditBackend) DeepCopy() *AuditBackend {
+	if in == nil {
+		return nil
+	}
+	out := new(AuditBackend)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuditBuffering) DeepCopyInto(out *AuditBuffering) {
+	*out = *in
+	if in.BufferSize != nil {
+		in, out := &in.BufferSize, &out.BufferSize
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxBatchSize != nil {
+		in, out := &in.MaxBatchSize, &out.MaxBatchSize
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxBatchWait != nil {
+		in, out := &in.MaxBatchWait, &out.MaxBatchWait
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.ThrottleQPS != nil {
+		in, out := &in.ThrottleQPS, &out.ThrottleQPS
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ThrottleBurst != nil {
+		in, out := &in.ThrottleBurst, &out.ThrottleBurst
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuditBuffering.
+func (in *AuditBuffering) DeepCopy() *AuditBuffering {
+	if in == nil {
+		return nil
+	}
+	out := new(AuditBuffering)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AuditConfig) DeepCopyInto(out *AuditConfig) {
 	*out = *in
@@ -120,6 +204,11 @@ func (in *AuditConfig) DeepCopyInto(out *AuditConfig) {
 		*out = new(AuditPolicy)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Backend != nil {
+		in, out := &in.Backend, &out.Backend
+		*out = new(AuditBackend)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -133,12 +222,48 @@ func (in *AuditConfig) DeepCopy() *AuditConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuditLogBackend) DeepCopyInto(out *AuditLogBackend) {
+	*out = *in
+	if in.MaxAge != nil {
+		in, out := &in.MaxAge, &out.MaxAge
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxBackups != nil {
+		in, out := &in.MaxBackups, &out.MaxBackups
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxSize != nil {
+		in, out := &in.MaxSize, &out.MaxSize
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Buffering != nil {
+		in, out := &in.Buffering, &out.Buffering
+		*out = new(AuditBuffering)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuditLogBackend.
+func (in *AuditLogBackend) DeepCopy() *AuditLogBackend {
+	if in == nil {
+		return nil
+	}
+	out := new(AuditLogBackend)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AuditPolicy) DeepCopyInto(out *AuditPolicy) {
 	*out = *in
 	if in.ConfigMapRef != nil {
 		in, out := &in.ConfigMapRef, &out.ConfigMapRef
-		*out = new(v1.ObjectReference)
+		*out = new(corev1.ObjectReference)
 		**out = **in
 	}
 	return
@@ -154,6 +279,99 @@ func (in *AuditPolicy) DeepCopy() *AuditPolicy {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuditWebhookBackend) DeepCopyInto(out *AuditWebhookBackend) {
+	*out = *in
+	out.SecretRef = in.SecretRef
+	if in.InitialBackoff != nil {
+		in, out := &in.InitialBackoff, &out.InitialBackoff
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Buffering != nil {
+		in, out := &in.Buffering, &out.Buffering
+		*out = new(AuditBuffering)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuditWebhookBackend.
+func (in *AuditWebhookBackend) DeepCopy() *AuditWebhookBackend {
+	if in == nil {
+		return nil
+	}
+	out := new(AuditWebhookBackend)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthenticationWebhook) DeepCopyInto(out *AuthenticationWebhook) {
+	*out = *in
+	out.SecretRef = in.SecretRef
+	if in.CacheTTL != nil {
+		in, out := &in.CacheTTL, &out.CacheTTL
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthenticationWebhook.
+func (in *AuthenticationWebhook) DeepCopy() *AuthenticationWebhook {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthenticationWebhook)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthorizationWebhook) DeepCopyInto(out *AuthorizationWebhook) {
+	*out = *in
+	out.SecretRef = in.SecretRef
+	if in.CacheAuthorizedTTL != nil {
+		in, out := &in.CacheAuthorizedTTL, &out.CacheAuthorizedTTL
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.CacheUnauthorizedTTL != nil {
+		in, out := &in.CacheUnauthorizedTTL, &out.CacheUnauthorizedTTL
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthorizationWebhook.
+func (in *AuthorizationWebhook) DeepCopy() *AuthorizationWebhook {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthorizationWebhook)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AvailabilityRatio) DeepCopyInto(out *AvailabilityRatio) {
+	*out = *in
+	out.Period = in.Period
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AvailabilityRatio.
+func (in *AvailabilityRatio) DeepCopy() *AvailabilityRatio {
+	if in == nil {
+		return nil
+	}
+	out := new(AvailabilityRatio)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AvailabilityZone) DeepCopyInto(out *AvailabilityZone) {
 	*out = *in
@@ -267,6 +485,11 @@ func (in *BackupBucketSpec) DeepCopyInto(out *BackupBucketSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Secondary != nil {
+		in, out := &in.Secondary, &out.Secondary
+		*out = new(SecondaryBackupLocation)
+		**out = **in
+	}
 	return
 }
 
@@ -295,7 +518,7 @@ func (in *BackupBucketStatus) DeepCopyInto(out *BackupBucketStatus) {
 	}
 	if in.GeneratedSecretRef != nil {
 		in, out := &in.GeneratedSecretRef, &out.GeneratedSecretRef
-		*out = new(v1.SecretReference)
+		*out = new(corev1.SecretReference)
 		**out = **in
 	}
 	return
@@ -380,6 +603,11 @@ func (in *BackupEntrySpec) DeepCopyInto(out *BackupEntrySpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.RetentionPolicy != nil {
+		in, out := &in.RetentionPolicy, &out.RetentionPolicy
+		*out = new(BackupRetentionPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -406,6 +634,11 @@ func (in *BackupEntryStatus) DeepCopyInto(out *BackupEntryStatus) {
 		*out = new(LastError)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ReplicaBucketName != nil {
+		in, out := &in.ReplicaBucketName, &out.ReplicaBucketName
+		*out = new(string)
+		**out = **in
+	}
 	return
 }
 
@@ -419,6 +652,72 @@ func (in *BackupEntryStatus) DeepCopy() *BackupEntryStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupRetentionPolicy) DeepCopyInto(out *BackupRetentionPolicy) {
+	*out = *in
+	if in.DailySnapshots != nil {
+		in, out := &in.DailySnapshots, &out.DailySnapshots
+		*out = new(int32)
+		**out = **in
+	}
+	if in.WeeklySnapshots != nil {
+		in, out := &in.WeeklySnapshots, &out.WeeklySnapshots
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupRetentionPolicy.
+func (in *BackupRetentionPolicy) DeepCopy() *BackupRetentionPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupRetentionPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CARotation) DeepCopyInto(out *CARotation) {
+	*out = *in
+	if in.LastInitiationTime != nil {
+		in, out := &in.LastInitiationTime, &out.LastInitiationTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastCompletionTime != nil {
+		in, out := &in.LastCompletionTime, &out.LastCompletionTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CARotation.
+func (in *CARotation) DeepCopy() *CARotation {
+	if in == nil {
+		return nil
+	}
+	out := new(CARotation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CRI) DeepCopyInto(out *CRI) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CRI.
+func (in *CRI) DeepCopy() *CRI {
+	if in == nil {
+		return nil
+	}
+	out := new(CRI)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CloudInfo) DeepCopyInto(out *CloudInfo) {
 	*out = *in
@@ -532,7 +831,7 @@ func (in *CloudProfileSpec) DeepCopyInto(out *CloudProfileSpec) {
 	}
 	if in.SeedSelector != nil {
 		in, out := &in.SeedSelector, &out.SeedSelector
-		*out = new(metav1.LabelSelector)
+		*out = new(v1.LabelSelector)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.VolumeTypes != nil {
@@ -560,22 +859,22 @@ func (in *ClusterAutoscaler) DeepCopyInto(out *ClusterAutoscaler) {
 	*out = *in
 	if in.ScaleDownDelayAfterAdd != nil {
 		in, out := &in.ScaleDownDelayAfterAdd, &out.ScaleDownDelayAfterAdd
-		*out = new(metav1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 	if in.ScaleDownDelayAfterDelete != nil {
 		in, out := &in.ScaleDownDelayAfterDelete, &out.ScaleDownDelayAfterDelete
-		*out = new(metav1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 	if in.ScaleDownDelayAfterFailure != nil {
 		in, out := &in.ScaleDownDelayAfterFailure, &out.ScaleDownDelayAfterFailure
-		*out = new(metav1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 	if in.ScaleDownUnneededTime != nil {
 		in, out := &in.ScaleDownUnneededTime, &out.ScaleDownUnneededTime
-		*out = new(metav1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 	if in.ScaleDownUtilizationThreshold != nil {
@@ -585,7 +884,7 @@ func (in *ClusterAutoscaler) DeepCopyInto(out *ClusterAutoscaler) {
 	}
 	if in.ScanInterval != nil {
 		in, out := &in.ScanInterval, &out.ScanInterval
-		*out = new(metav1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 	return
@@ -605,7 +904,22 @@ func (in *ClusterAutoscaler) DeepCopy() *ClusterAutoscaler {
 func (in *ClusterInfo) DeepCopyInto(out *ClusterInfo) {
 	*out = *in
 	out.Cloud = in.Cloud
-	out.Kubernetes = in.Kubernetes
+	in.Kubernetes.DeepCopyInto(&out.Kubernetes)
+	if in.Nodes != nil {
+		in, out := &in.Nodes, &out.Nodes
+		*out = new(NodesInfo)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Workloads != nil {
+		in, out := &in.Workloads, &out.Workloads
+		*out = new(WorkloadsInfo)
+		**out = **in
+	}
+	if in.Kubeconfig != nil {
+		in, out := &in.Kubeconfig, &out.Kubeconfig
+		*out = new(KubeconfigInfo)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -637,6 +951,74 @@ func (in *Condition) DeepCopy() *Condition {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConditionHistory) DeepCopyInto(out *ConditionHistory) {
+	*out = *in
+	if in.Transitions != nil {
+		in, out := &in.Transitions, &out.Transitions
+		*out = make([]ConditionTransition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConditionHistory.
+func (in *ConditionHistory) DeepCopy() *ConditionHistory {
+	if in == nil {
+		return nil
+	}
+	out := new(ConditionHistory)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConditionTransition) DeepCopyInto(out *ConditionTransition) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConditionTransition.
+func (in *ConditionTransition) DeepCopy() *ConditionTransition {
+	if in == nil {
+		return nil
+	}
+	out := new(ConditionTransition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ControlPlane) DeepCopyInto(out *ControlPlane) {
+	*out = *in
+	if in.HighAvailability != nil {
+		in, out := &in.HighAvailability, &out.HighAvailability
+		*out = new(HighAvailability)
+		**out = **in
+	}
+	if in.NetworkPolicyRules != nil {
+		in, out := &in.NetworkPolicyRules, &out.NetworkPolicyRules
+		*out = make([]NetworkPolicyRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControlPlane.
+func (in *ControlPlane) DeepCopy() *ControlPlane {
+	if in == nil {
+		return nil
+	}
+	out := new(ControlPlane)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ControllerDeployment) DeepCopyInto(out *ControllerDeployment) {
 	*out = *in
@@ -863,7 +1245,7 @@ func (in *ControllerResource) DeepCopyInto(out *ControllerResource) {
 	}
 	if in.ReconcileTimeout != nil {
 		in, out := &in.ReconcileTimeout, &out.ReconcileTimeout
-		*out = new(metav1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 	return
@@ -969,6 +1351,78 @@ func (in *DNSProvider) DeepCopy() *DNSProvider {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ETCDBackupOperation) DeepCopyInto(out *ETCDBackupOperation) {
+	*out = *in
+	in.LastUpdateTime.DeepCopyInto(&out.LastUpdateTime)
+	if in.Snapshot != nil {
+		in, out := &in.Snapshot, &out.Snapshot
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ETCDBackupOperation.
+func (in *ETCDBackupOperation) DeepCopy() *ETCDBackupOperation {
+	if in == nil {
+		return nil
+	}
+	out := new(ETCDBackupOperation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ETCDEncryptionKeyRotation) DeepCopyInto(out *ETCDEncryptionKeyRotation) {
+	*out = *in
+	if in.LastInitiationTime != nil {
+		in, out := &in.LastInitiationTime, &out.LastInitiationTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastCompletionTime != nil {
+		in, out := &in.LastCompletionTime, &out.LastCompletionTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ETCDEncryptionKeyRotation.
+func (in *ETCDEncryptionKeyRotation) DeepCopy() *ETCDEncryptionKeyRotation {
+	if in == nil {
+		return nil
+	}
+	out := new(ETCDEncryptionKeyRotation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EncryptionConfig) DeepCopyInto(out *EncryptionConfig) {
+	*out = *in
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.KMS != nil {
+		in, out := &in.KMS, &out.KMS
+		*out = new(KMSProvider)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EncryptionConfig.
+func (in *EncryptionConfig) DeepCopy() *EncryptionConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(EncryptionConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Endpoint) DeepCopyInto(out *Endpoint) {
 	*out = *in
@@ -1048,6 +1502,22 @@ func (in *ExtensionResourceState) DeepCopy() *ExtensionResourceState {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FailureTolerance) DeepCopyInto(out *FailureTolerance) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FailureTolerance.
+func (in *FailureTolerance) DeepCopy() *FailureTolerance {
+	if in == nil {
+		return nil
+	}
+	out := new(FailureTolerance)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Gardener) DeepCopyInto(out *Gardener) {
 	*out = *in
@@ -1162,6 +1632,23 @@ func (in *HibernationSchedule) DeepCopy() *HibernationSchedule {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HighAvailability) DeepCopyInto(out *HighAvailability) {
+	*out = *in
+	out.FailureTolerance = in.FailureTolerance
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HighAvailability.
+func (in *HighAvailability) DeepCopy() *HighAvailability {
+	if in == nil {
+		return nil
+	}
+	out := new(HighAvailability)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HorizontalPodAutoscalerConfig) DeepCopyInto(out *HorizontalPodAutoscalerConfig) {
 	*out = *in
@@ -1213,6 +1700,32 @@ func (in *HorizontalPodAutoscalerConfig) DeepCopy() *HorizontalPodAutoscalerConf
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KMSProvider) DeepCopyInto(out *KMSProvider) {
+	*out = *in
+	if in.CacheSize != nil {
+		in, out := &in.CacheSize, &out.CacheSize
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KMSProvider.
+func (in *KMSProvider) DeepCopy() *KMSProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(KMSProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KubeAPIServerConfig) DeepCopyInto(out *KubeAPIServerConfig) {
 	*out = *in
@@ -1239,6 +1752,11 @@ func (in *KubeAPIServerConfig) DeepCopyInto(out *KubeAPIServerConfig) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.EncryptionConfig != nil {
+		in, out := &in.EncryptionConfig, &out.EncryptionConfig
+		*out = new(EncryptionConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.OIDCConfig != nil {
 		in, out := &in.OIDCConfig, &out.OIDCConfig
 		*out = new(OIDCConfig)
@@ -1256,6 +1774,26 @@ func (in *KubeAPIServerConfig) DeepCopyInto(out *KubeAPIServerConfig) {
 		*out = new(ServiceAccountConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.StaticCredentialsRotation != nil {
+		in, out := &in.StaticCredentialsRotation, &out.StaticCredentialsRotation
+		*out = new(StaticCredentialsRotationConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AuthenticationWebhook != nil {
+		in, out := &in.AuthenticationWebhook, &out.AuthenticationWebhook
+		*out = new(AuthenticationWebhook)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AuthorizationWebhook != nil {
+		in, out := &in.AuthorizationWebhook, &out.AuthorizationWebhook
+		*out = new(AuthorizationWebhook)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AdmissionWebhookConfig != nil {
+		in, out := &in.AdmissionWebhookConfig, &out.AdmissionWebhookConfig
+		*out = new(AdmissionWebhookConfig)
+		**out = **in
+	}
 	return
 }
 
@@ -1269,6 +1807,57 @@ func (in *KubeAPIServerConfig) DeepCopy() *KubeAPIServerConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeControllerManagerConcurrentSyncs) DeepCopyInto(out *KubeControllerManagerConcurrentSyncs) {
+	*out = *in
+	if in.Deployments != nil {
+		in, out := &in.Deployments, &out.Deployments
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Endpoints != nil {
+		in, out := &in.Endpoints, &out.Endpoints
+		*out = new(int32)
+		**out = **in
+	}
+	if in.GarbageCollector != nil {
+		in, out := &in.GarbageCollector, &out.GarbageCollector
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ReplicaSets != nil {
+		in, out := &in.ReplicaSets, &out.ReplicaSets
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ResourceQuotas != nil {
+		in, out := &in.ResourceQuotas, &out.ResourceQuotas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ServiceAccountTokens != nil {
+		in, out := &in.ServiceAccountTokens, &out.ServiceAccountTokens
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeControllerManagerConcurrentSyncs.
+func (in *KubeControllerManagerConcurrentSyncs) DeepCopy() *KubeControllerManagerConcurrentSyncs {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeControllerManagerConcurrentSyncs)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KubeControllerManagerConfig) DeepCopyInto(out *KubeControllerManagerConfig) {
 	*out = *in
@@ -1283,6 +1872,21 @@ func (in *KubeControllerManagerConfig) DeepCopyInto(out *KubeControllerManagerCo
 		*out = new(int32)
 		**out = **in
 	}
+	if in.NodeMonitorGracePeriod != nil {
+		in, out := &in.NodeMonitorGracePeriod, &out.NodeMonitorGracePeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.PodEvictionTimeout != nil {
+		in, out := &in.PodEvictionTimeout, &out.PodEvictionTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.ConcurrentSyncs != nil {
+		in, out := &in.ConcurrentSyncs, &out.ConcurrentSyncs
+		*out = new(KubeControllerManagerConcurrentSyncs)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1322,6 +1926,11 @@ func (in *KubeProxyConfig) DeepCopy() *KubeProxyConfig {
 func (in *KubeSchedulerConfig) DeepCopyInto(out *KubeSchedulerConfig) {
 	*out = *in
 	in.KubernetesConfig.DeepCopyInto(&out.KubernetesConfig)
+	if in.Profile != nil {
+		in, out := &in.Profile, &out.Profile
+		*out = new(SchedulingProfile)
+		**out = **in
+	}
 	return
 }
 
@@ -1335,6 +1944,30 @@ func (in *KubeSchedulerConfig) DeepCopy() *KubeSchedulerConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeconfigInfo) DeepCopyInto(out *KubeconfigInfo) {
+	*out = *in
+	if in.CertificateExpirationTime != nil {
+		in, out := &in.CertificateExpirationTime, &out.CertificateExpirationTime
+		*out = (*in).DeepCopy()
+	}
+	if in.TokenExpirationTime != nil {
+		in, out := &in.TokenExpirationTime, &out.TokenExpirationTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeconfigInfo.
+func (in *KubeconfigInfo) DeepCopy() *KubeconfigInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeconfigInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KubeletConfig) DeepCopyInto(out *KubeletConfig) {
 	*out = *in
@@ -1366,7 +1999,7 @@ func (in *KubeletConfig) DeepCopyInto(out *KubeletConfig) {
 	}
 	if in.EvictionPressureTransitionPeriod != nil {
 		in, out := &in.EvictionPressureTransitionPeriod, &out.EvictionPressureTransitionPeriod
-		*out = new(metav1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 	if in.EvictionSoft != nil {
@@ -1489,27 +2122,27 @@ func (in *KubeletConfigEvictionSoftGracePeriod) DeepCopyInto(out *KubeletConfigE
 	*out = *in
 	if in.MemoryAvailable != nil {
 		in, out := &in.MemoryAvailable, &out.MemoryAvailable
-		*out = new(metav1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 	if in.ImageFSAvailable != nil {
 		in, out := &in.ImageFSAvailable, &out.ImageFSAvailable
-		*out = new(metav1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 	if in.ImageFSInodesFree != nil {
 		in, out := &in.ImageFSInodesFree, &out.ImageFSInodesFree
-		*out = new(metav1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 	if in.NodeFSAvailable != nil {
 		in, out := &in.NodeFSAvailable, &out.NodeFSAvailable
-		*out = new(metav1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 	if in.NodeFSInodesFree != nil {
 		in, out := &in.NodeFSInodesFree, &out.NodeFSInodesFree
-		*out = new(metav1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 	return
@@ -1525,6 +2158,22 @@ func (in *KubeletConfigEvictionSoftGracePeriod) DeepCopy() *KubeletConfigEvictio
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeletConfigFieldDrift) DeepCopyInto(out *KubeletConfigFieldDrift) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeletConfigFieldDrift.
+func (in *KubeletConfigFieldDrift) DeepCopy() *KubeletConfigFieldDrift {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeletConfigFieldDrift)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Kubernetes) DeepCopyInto(out *Kubernetes) {
 	*out = *in
@@ -1624,6 +2273,16 @@ func (in *KubernetesDashboard) DeepCopy() *KubernetesDashboard {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KubernetesInfo) DeepCopyInto(out *KubernetesInfo) {
 	*out = *in
+	if in.NodeVersions != nil {
+		in, out := &in.NodeVersions, &out.NodeVersions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NodeVersionSkew != nil {
+		in, out := &in.NodeVersionSkew, &out.NodeVersionSkew
+		*out = new(int32)
+		**out = **in
+	}
 	return
 }
 
@@ -1829,49 +2488,115 @@ func (in *MaintenanceAutoUpdate) DeepCopyInto(out *MaintenanceAutoUpdate) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceAutoUpdate.
-func (in *MaintenanceAutoUpdate) DeepCopy() *MaintenanceAutoUpdate {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceAutoUpdate.
+func (in *MaintenanceAutoUpdate) DeepCopy() *MaintenanceAutoUpdate {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceAutoUpdate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceTimeWindow) DeepCopyInto(out *MaintenanceTimeWindow) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceTimeWindow.
+func (in *MaintenanceTimeWindow) DeepCopy() *MaintenanceTimeWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceTimeWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Monitoring) DeepCopyInto(out *Monitoring) {
+	*out = *in
+	if in.Alerting != nil {
+		in, out := &in.Alerting, &out.Alerting
+		*out = new(Alerting)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Monitoring.
+func (in *Monitoring) DeepCopy() *Monitoring {
+	if in == nil {
+		return nil
+	}
+	out := new(Monitoring)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkPolicyRule) DeepCopyInto(out *NetworkPolicyRule) {
+	*out = *in
+	in.PodSelector.DeepCopyInto(&out.PodSelector)
+	if in.CIDRs != nil {
+		in, out := &in.CIDRs, &out.CIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Ports != nil {
+		in, out := &in.Ports, &out.Ports
+		*out = make([]NetworkPolicyRulePort, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkPolicyRule.
+func (in *NetworkPolicyRule) DeepCopy() *NetworkPolicyRule {
 	if in == nil {
 		return nil
 	}
-	out := new(MaintenanceAutoUpdate)
+	out := new(NetworkPolicyRule)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MaintenanceTimeWindow) DeepCopyInto(out *MaintenanceTimeWindow) {
+func (in *NetworkPolicyRulePort) DeepCopyInto(out *NetworkPolicyRulePort) {
 	*out = *in
+	if in.Protocol != nil {
+		in, out := &in.Protocol, &out.Protocol
+		*out = new(corev1.Protocol)
+		**out = **in
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceTimeWindow.
-func (in *MaintenanceTimeWindow) DeepCopy() *MaintenanceTimeWindow {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkPolicyRulePort.
+func (in *NetworkPolicyRulePort) DeepCopy() *NetworkPolicyRulePort {
 	if in == nil {
 		return nil
 	}
-	out := new(MaintenanceTimeWindow)
+	out := new(NetworkPolicyRulePort)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Monitoring) DeepCopyInto(out *Monitoring) {
+func (in *NetworkPool) DeepCopyInto(out *NetworkPool) {
 	*out = *in
-	if in.Alerting != nil {
-		in, out := &in.Alerting, &out.Alerting
-		*out = new(Alerting)
-		(*in).DeepCopyInto(*out)
-	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Monitoring.
-func (in *Monitoring) DeepCopy() *Monitoring {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkPool.
+func (in *NetworkPool) DeepCopy() *NetworkPool {
 	if in == nil {
 		return nil
 	}
-	out := new(Monitoring)
+	out := new(NetworkPool)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -1894,6 +2619,11 @@ func (in *Networking) DeepCopyInto(out *Networking) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.IPFamilies != nil {
+		in, out := &in.IPFamilies, &out.IPFamilies
+		*out = make([]IPFamily, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -1925,7 +2655,7 @@ func (in *NginxIngress) DeepCopyInto(out *NginxIngress) {
 	}
 	if in.ExternalTrafficPolicy != nil {
 		in, out := &in.ExternalTrafficPolicy, &out.ExternalTrafficPolicy
-		*out = new(v1.ServiceExternalTrafficPolicyType)
+		*out = new(corev1.ServiceExternalTrafficPolicyType)
 		**out = **in
 	}
 	return
@@ -1941,6 +2671,55 @@ func (in *NginxIngress) DeepCopy() *NginxIngress {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeKubeletConfigDrift) DeepCopyInto(out *NodeKubeletConfigDrift) {
+	*out = *in
+	if in.WorkerPool != nil {
+		in, out := &in.WorkerPool, &out.WorkerPool
+		*out = new(string)
+		**out = **in
+	}
+	if in.Fields != nil {
+		in, out := &in.Fields, &out.Fields
+		*out = make([]KubeletConfigFieldDrift, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeKubeletConfigDrift.
+func (in *NodeKubeletConfigDrift) DeepCopy() *NodeKubeletConfigDrift {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeKubeletConfigDrift)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodesInfo) DeepCopyInto(out *NodesInfo) {
+	*out = *in
+	if in.Capacity != nil {
+		in, out := &in.Capacity, &out.Capacity
+		*out = make(corev1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodesInfo.
+func (in *NodesInfo) DeepCopy() *NodesInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(NodesInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OIDCConfig) DeepCopyInto(out *OIDCConfig) {
 	*out = *in
@@ -2138,7 +2917,7 @@ func (in *PlantStatus) DeepCopyInto(out *PlantStatus) {
 	if in.ClusterInfo != nil {
 		in, out := &in.ClusterInfo, &out.ClusterInfo
 		*out = new(ClusterInfo)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	return
 }
@@ -2218,6 +2997,11 @@ func (in *ProjectList) DeepCopyObject() runtime.Object {
 func (in *ProjectMember) DeepCopyInto(out *ProjectMember) {
 	*out = *in
 	out.Subject = in.Subject
+	if in.Roles != nil {
+		in, out := &in.Roles, &out.Roles
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -2257,13 +3041,20 @@ func (in *ProjectSpec) DeepCopyInto(out *ProjectSpec) {
 	if in.Members != nil {
 		in, out := &in.Members, &out.Members
 		*out = make([]ProjectMember, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	if in.Namespace != nil {
 		in, out := &in.Namespace, &out.Namespace
 		*out = new(string)
 		**out = **in
 	}
+	if in.BackupRetentionPolicy != nil {
+		in, out := &in.BackupRetentionPolicy, &out.BackupRetentionPolicy
+		*out = new(BackupRetentionPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2413,7 +3204,7 @@ func (in *QuotaSpec) DeepCopyInto(out *QuotaSpec) {
 	}
 	if in.Metrics != nil {
 		in, out := &in.Metrics, &out.Metrics
-		*out = make(v1.ResourceList, len(*in))
+		*out = make(corev1.ResourceList, len(*in))
 		for key, val := range *in {
 			(*out)[key] = val.DeepCopy()
 		}
@@ -2455,6 +3246,22 @@ func (in *Region) DeepCopy() *Region {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecondaryBackupLocation) DeepCopyInto(out *SecondaryBackupLocation) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecondaryBackupLocation.
+func (in *SecondaryBackupLocation) DeepCopy() *SecondaryBackupLocation {
+	if in == nil {
+		return nil
+	}
+	out := new(SecondaryBackupLocation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SecretBinding) DeepCopyInto(out *SecretBinding) {
 	*out = *in
@@ -2463,7 +3270,7 @@ func (in *SecretBinding) DeepCopyInto(out *SecretBinding) {
 	out.SecretRef = in.SecretRef
 	if in.Quotas != nil {
 		in, out := &in.Quotas, &out.Quotas
-		*out = make([]v1.ObjectReference, len(*in))
+		*out = make([]corev1.ObjectReference, len(*in))
 		copy(*out, *in)
 	}
 	return
@@ -2557,6 +3364,11 @@ func (in *SeedBackup) DeepCopyInto(out *SeedBackup) {
 		**out = **in
 	}
 	out.SecretRef = in.SecretRef
+	if in.Secondary != nil {
+		in, out := &in.Secondary, &out.Secondary
+		*out = new(SecondaryBackupLocation)
+		**out = **in
+	}
 	return
 }
 
@@ -2627,6 +3439,16 @@ func (in *SeedNetworks) DeepCopyInto(out *SeedNetworks) {
 		*out = new(ShootNetworks)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ShootPools != nil {
+		in, out := &in.ShootPools, &out.ShootPools
+		*out = new(ShootNetworkPools)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IPFamilies != nil {
+		in, out := &in.IPFamilies, &out.IPFamilies
+		*out = make([]IPFamily, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -2674,7 +3496,7 @@ func (in *SeedSpec) DeepCopyInto(out *SeedSpec) {
 	out.Provider = in.Provider
 	if in.SecretRef != nil {
 		in, out := &in.SecretRef, &out.SecretRef
-		*out = new(v1.SecretReference)
+		*out = new(corev1.SecretReference)
 		**out = **in
 	}
 	if in.Taints != nil {
@@ -2808,7 +3630,7 @@ func (in *ServiceAccountConfig) DeepCopyInto(out *ServiceAccountConfig) {
 	}
 	if in.SigningKeySecret != nil {
 		in, out := &in.SigningKeySecret, &out.SigningKeySecret
-		*out = new(v1.LocalObjectReference)
+		*out = new(corev1.LocalObjectReference)
 		**out = **in
 	}
 	return
@@ -2852,6 +3674,126 @@ func (in *Shoot) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootAvailability) DeepCopyInto(out *ShootAvailability) {
+	*out = *in
+	if in.APIServer != nil {
+		in, out := &in.APIServer, &out.APIServer
+		*out = make([]AvailabilityRatio, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootAvailability.
+func (in *ShootAvailability) DeepCopy() *ShootAvailability {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootAvailability)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootBackup) DeepCopyInto(out *ShootBackup) {
+	*out = *in
+	if in.RetentionPolicy != nil {
+		in, out := &in.RetentionPolicy, &out.RetentionPolicy
+		*out = new(BackupRetentionPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootBackup.
+func (in *ShootBackup) DeepCopy() *ShootBackup {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootBackup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootCredentials) DeepCopyInto(out *ShootCredentials) {
+	*out = *in
+	if in.Rotation != nil {
+		in, out := &in.Rotation, &out.Rotation
+		*out = new(ShootCredentialsRotation)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootCredentials.
+func (in *ShootCredentials) DeepCopy() *ShootCredentials {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootCredentials)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootCredentialsRotation) DeepCopyInto(out *ShootCredentialsRotation) {
+	*out = *in
+	if in.CertificateAuthorities != nil {
+		in, out := &in.CertificateAuthorities, &out.CertificateAuthorities
+		*out = new(CARotation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StaticCredentials != nil {
+		in, out := &in.StaticCredentials, &out.StaticCredentials
+		*out = new(StaticCredentialsRotation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ETCDEncryptionKey != nil {
+		in, out := &in.ETCDEncryptionKey, &out.ETCDEncryptionKey
+		*out = new(ETCDEncryptionKeyRotation)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootCredentialsRotation.
+func (in *ShootCredentialsRotation) DeepCopy() *ShootCredentialsRotation {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootCredentialsRotation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootETCDBackup) DeepCopyInto(out *ShootETCDBackup) {
+	*out = *in
+	if in.LastSnapshot != nil {
+		in, out := &in.LastSnapshot, &out.LastSnapshot
+		*out = new(ETCDBackupOperation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LastRestore != nil {
+		in, out := &in.LastRestore, &out.LastRestore
+		*out = new(ETCDBackupOperation)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootETCDBackup.
+func (in *ShootETCDBackup) DeepCopy() *ShootETCDBackup {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootETCDBackup)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ShootList) DeepCopyInto(out *ShootList) {
 	*out = *in
@@ -2906,6 +3848,32 @@ func (in *ShootMachineImage) DeepCopy() *ShootMachineImage {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootNetworkPools) DeepCopyInto(out *ShootNetworkPools) {
+	*out = *in
+	if in.Nodes != nil {
+		in, out := &in.Nodes, &out.Nodes
+		*out = new(NetworkPool)
+		**out = **in
+	}
+	if in.Pods != nil {
+		in, out := &in.Pods, &out.Pods
+		*out = new(NetworkPool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootNetworkPools.
+func (in *ShootNetworkPools) DeepCopy() *ShootNetworkPools {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootNetworkPools)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ShootNetworks) DeepCopyInto(out *ShootNetworks) {
 	*out = *in
@@ -2940,6 +3908,16 @@ func (in *ShootSpec) DeepCopyInto(out *ShootSpec) {
 		*out = new(Addons)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Backup != nil {
+		in, out := &in.Backup, &out.Backup
+		*out = new(ShootBackup)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ControlPlane != nil {
+		in, out := &in.ControlPlane, &out.ControlPlane
+		*out = new(ControlPlane)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.DNS != nil {
 		in, out := &in.DNS, &out.DNS
 		*out = new(DNS)
@@ -3081,6 +4059,11 @@ func (in *ShootStateSpec) DeepCopy() *ShootStateSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ShootStatus) DeepCopyInto(out *ShootStatus) {
 	*out = *in
+	if in.Availability != nil {
+		in, out := &in.Availability, &out.Availability
+		*out = new(ShootAvailability)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make([]Condition, len(*in))
@@ -3095,7 +4078,31 @@ func (in *ShootStatus) DeepCopyInto(out *ShootStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.ConditionHistories != nil {
+		in, out := &in.ConditionHistories, &out.ConditionHistories
+		*out = make([]ConditionHistory, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Credentials != nil {
+		in, out := &in.Credentials, &out.Credentials
+		*out = new(ShootCredentials)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ETCDBackup != nil {
+		in, out := &in.ETCDBackup, &out.ETCDBackup
+		*out = new(ShootETCDBackup)
+		(*in).DeepCopyInto(*out)
+	}
 	out.Gardener = in.Gardener
+	if in.KubeletConfigDrifts != nil {
+		in, out := &in.KubeletConfigDrifts, &out.KubeletConfigDrifts
+		*out = make([]NodeKubeletConfigDrift, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.LastOperation != nil {
 		in, out := &in.LastOperation, &out.LastOperation
 		*out = new(LastOperation)
@@ -3135,6 +4142,48 @@ func (in *ShootStatus) DeepCopy() *ShootStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StaticCredentialsRotation) DeepCopyInto(out *StaticCredentialsRotation) {
+	*out = *in
+	if in.LastRotationTime != nil {
+		in, out := &in.LastRotationTime, &out.LastRotationTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StaticCredentialsRotation.
+func (in *StaticCredentialsRotation) DeepCopy() *StaticCredentialsRotation {
+	if in == nil {
+		return nil
+	}
+	out := new(StaticCredentialsRotation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StaticCredentialsRotationConfig) DeepCopyInto(out *StaticCredentialsRotationConfig) {
+	*out = *in
+	out.Period = in.Period
+	if in.TransitionPeriod != nil {
+		in, out := &in.TransitionPeriod, &out.TransitionPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StaticCredentialsRotationConfig.
+func (in *StaticCredentialsRotationConfig) DeepCopy() *StaticCredentialsRotationConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(StaticCredentialsRotationConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Volume) DeepCopyInto(out *Volume) {
 	*out = *in
@@ -3192,6 +4241,11 @@ func (in *Worker) DeepCopyInto(out *Worker) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.CRI != nil {
+		in, out := &in.CRI, &out.CRI
+		*out = new(CRI)
+		**out = **in
+	}
 	if in.Kubernetes != nil {
 		in, out := &in.Kubernetes, &out.Kubernetes
 		*out = new(WorkerKubernetes)
@@ -3222,7 +4276,7 @@ func (in *Worker) DeepCopyInto(out *Worker) {
 	}
 	if in.Taints != nil {
 		in, out := &in.Taints, &out.Taints
-		*out = make([]v1.Taint, len(*in))
+		*out = make([]corev1.Taint, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -3270,3 +4324,37 @@ func (in *WorkerKubernetes) DeepCopy() *WorkerKubernetes {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkloadHealthInfo) DeepCopyInto(out *WorkloadHealthInfo) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadHealthInfo.
+func (in *WorkloadHealthInfo) DeepCopy() *WorkloadHealthInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkloadHealthInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkloadsInfo) DeepCopyInto(out *WorkloadsInfo) {
+	*out = *in
+	out.Deployments = in.Deployments
+	out.StatefulSets = in.StatefulSets
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadsInfo.
+func (in *WorkloadsInfo) DeepCopy() *WorkloadsInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkloadsInfo)
+	in.DeepCopyInto(out)
+	return out
+}
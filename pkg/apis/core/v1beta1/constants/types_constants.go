@@ -148,6 +148,9 @@ const (
 	// SeedResourceManagerClass is the resource-class managed by the Gardener-Resource-Manager
 	// instance in the garden namespace on the seeds.
 	SeedResourceManagerClass = "seed"
+	// AnnotationETCDBackupMember is the annotation on the etcd statefulsets of highly available control planes which
+	// contains the name of the only member pod whose backup-restore sidecar shall take snapshots.
+	AnnotationETCDBackupMember = "etcd.gardener.cloud/backup-member"
 	// LabelBackupProvider is used to identify the backup provider.
 	LabelBackupProvider = "backup.gardener.cloud/provider"
 	// LabelSeedProvider is used to identify the seed provider.
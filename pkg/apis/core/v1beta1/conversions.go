@@ -134,6 +134,7 @@ func Convert_v1beta1_ProjectSpec_To_garden_ProjectSpec(in *ProjectSpec, out *gar
 		out.ProjectMembers = append(out.ProjectMembers, garden.ProjectMember{
 			Subject: member.Subject,
 			Role:    member.Role,
+			Roles:   member.Roles,
 		})
 	}
 
@@ -149,6 +150,7 @@ func Convert_garden_ProjectSpec_To_v1beta1_ProjectSpec(in *garden.ProjectSpec, o
 		out.Members = append(out.Members, ProjectMember{
 			Subject: member.Subject,
 			Role:    member.Role,
+			Roles:   member.Roles,
 		})
 	}
 
@@ -55,6 +55,9 @@ type BackupBucketSpec struct {
 	// SeedName holds the name of the seed allocated to BackupBucket for running controller.
 	// +optional
 	SeedName *string `json:"seedName,omitempty"`
+	// Secondary is a secondary location into which the objects of the bucket are replicated.
+	// +optional
+	Secondary *SecondaryBackupLocation `json:"secondary,omitempty"`
 }
 
 // BackupBucketStatus holds the most recently observed status of the Backup Bucket.
@@ -58,6 +58,19 @@ type BackupEntrySpec struct {
 	// SeedName holds the name of the seed allocated to BackupEntry for running controller.
 	// +optional
 	SeedName *string `json:"seedName,omitempty"`
+	// RetentionPolicy defines how long the snapshots in this Backup Entry are kept.
+	// +optional
+	RetentionPolicy *BackupRetentionPolicy `json:"retentionPolicy,omitempty"`
+}
+
+// BackupRetentionPolicy defines how long the snapshots of a backup are kept.
+type BackupRetentionPolicy struct {
+	// DailySnapshots is the number of days for which the latest snapshot of each day is kept.
+	// +optional
+	DailySnapshots *int32 `json:"dailySnapshots,omitempty"`
+	// WeeklySnapshots is the number of weeks for which the latest snapshot of each week is kept.
+	// +optional
+	WeeklySnapshots *int32 `json:"weeklySnapshots,omitempty"`
 }
 
 // BackupEntryStatus holds the most recently observed status of the Backup Entry.
@@ -72,4 +85,8 @@ type BackupEntryStatus struct {
 	// BackupEntry's generation, which is updated on mutation by the API Server.
 	// +optional
 	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// ReplicaBucketName is the name of the bucket into which the objects of this Backup Entry are replicated. It is
+	// only set if the Backup Bucket has a secondary location.
+	// +optional
+	ReplicaBucketName *string `json:"replicaBucketName,omitempty"`
 }
@@ -50,6 +50,21 @@ const (
 	PlantEveryNodeReady ConditionType = "EveryNodeReady"
 	// PlantAPIServerAvailable is a constant for a condition type indicating that the Plant cluster API server is available.
 	PlantAPIServerAvailable ConditionType = "APIServerAvailable"
+	// PlantKubeconfigValid is a constant for a condition type indicating that the credentials of the kubeconfig used to
+	// access the Plant cluster are valid and do not expire soon.
+	PlantKubeconfigValid ConditionType = "KubeconfigValid"
+	// PlantInventoryCollected is a constant for a condition type indicating that the inventory of the Plant cluster
+	// (nodes and workloads) could be collected.
+	PlantInventoryCollected ConditionType = "InventoryCollected"
+
+	// PlantEventKubeconfigExpiring indicates that the credentials of the Plant kubeconfig expire soon.
+	PlantEventKubeconfigExpiring = "KubeconfigExpiring"
+	// PlantEventKubeconfigExpired indicates that the credentials of the Plant kubeconfig have expired.
+	PlantEventKubeconfigExpired = "KubeconfigExpired"
+	// PlantEventKubeconfigRenewed indicates that the credentials of the Plant kubeconfig have been renewed.
+	PlantEventKubeconfigRenewed = "KubeconfigRenewed"
+	// PlantEventKubeconfigRenewalFailed indicates that the renewal of the credentials of the Plant kubeconfig has failed.
+	PlantEventKubeconfigRenewalFailed = "KubeconfigRenewalFailed"
 )
 
 // PlantSpec is the specification of a Plant.
@@ -95,6 +110,15 @@ type ClusterInfo struct {
 	Cloud CloudInfo `json:"cloud"`
 	// Kubernetes describes kubernetes meta information (e.g., version)
 	Kubernetes KubernetesInfo `json:"kubernetes"`
+	// Nodes describes the nodes of the Plant cluster
+	// +optional
+	Nodes *NodesInfo `json:"nodes,omitempty"`
+	// Workloads describes the health of the deployments and statefulsets of the Plant cluster
+	// +optional
+	Workloads *WorkloadsInfo `json:"workloads,omitempty"`
+	// Kubeconfig describes the kubeconfig used to access the Plant cluster
+	// +optional
+	Kubeconfig *KubeconfigInfo `json:"kubeconfig,omitempty"`
 }
 
 // CloudInfo contains information about the cloud
@@ -109,4 +133,45 @@ type CloudInfo struct {
 type KubernetesInfo struct {
 	// Version is the semantic Kubernetes version to use for the Plant cluster.
 	Version string `json:"version"`
+	// NodeVersions are the distinct kubelet versions of the nodes of the Plant cluster.
+	// +optional
+	NodeVersions []string `json:"nodeVersions,omitempty"`
+	// NodeVersionSkew is the maximum number of minor versions the kubelets of the Plant cluster are behind its control plane.
+	// +optional
+	NodeVersionSkew *int32 `json:"nodeVersionSkew,omitempty"`
+}
+
+// NodesInfo contains information about the nodes of the Plant cluster
+type NodesInfo struct {
+	// Count is the number of nodes registered to the cluster
+	Count int32 `json:"count"`
+	// Capacity is the total capacity of all nodes registered to the cluster
+	// +optional
+	Capacity corev1.ResourceList `json:"capacity,omitempty"`
+}
+
+// WorkloadsInfo contains a health summary of the workloads of the Plant cluster
+type WorkloadsInfo struct {
+	// Deployments is the health summary of the deployments
+	Deployments WorkloadHealthInfo `json:"deployments"`
+	// StatefulSets is the health summary of the statefulsets
+	StatefulSets WorkloadHealthInfo `json:"statefulSets"`
+}
+
+// WorkloadHealthInfo contains the number of total and healthy workloads of a kind
+type WorkloadHealthInfo struct {
+	// Total is the total number of workloads
+	Total int32 `json:"total"`
+	// Healthy is the number of healthy workloads
+	Healthy int32 `json:"healthy"`
+}
+
+// KubeconfigInfo contains information about the kubeconfig used to access the Plant cluster
+type KubeconfigInfo struct {
+	// CertificateExpirationTime is the time when the client certificate of the kubeconfig expires
+	// +optional
+	CertificateExpirationTime *metav1.Time `json:"certificateExpirationTime,omitempty"`
+	// TokenExpirationTime is the time when the bearer token of the kubeconfig expires
+	// +optional
+	TokenExpirationTime *metav1.Time `json:"tokenExpirationTime,omitempty"`
 }
@@ -73,6 +73,9 @@ type ProjectSpec struct {
 	// A nil value means that Gardener will determine the name of the namespace.
 	// +optional
 	Namespace *string `json:"namespace,omitempty"`
+	// BackupRetentionPolicy is the default retention policy for the backups of the Shoots in the project.
+	// +optional
+	BackupRetentionPolicy *BackupRetentionPolicy `json:"backupRetentionPolicy,omitempty"`
 }
 
 // ProjectStatus holds the most recently observed status of the project.
@@ -91,6 +94,9 @@ type ProjectMember struct {
 	rbacv1.Subject `json:",inline"`
 	// Role represents the role of this member.
 	Role string `json:"role"`
+	// Roles represents the list of additional roles of this member.
+	// +optional
+	Roles []string `json:"roles,omitempty"`
 }
 
 const (
@@ -98,6 +104,12 @@ const (
 	ProjectMemberAdmin = "admin"
 	// ProjectMemberViewer is a const for a role that provides limited permissions to only view some resources.
 	ProjectMemberViewer = "viewer"
+	// ProjectMemberUserAccessManager is a const for a role that provides permissions to manage the members of the
+	// project.
+	ProjectMemberUserAccessManager = "uam"
+	// ProjectMemberExtensionPrefix is a prefix for custom roles whose permissions are defined by ClusterRoles carrying
+	// the label `rbac.gardener.cloud/aggregate-to-extension-role=<name>`.
+	ProjectMemberExtensionPrefix = "extension:"
 )
 
 // ProjectPhase is a label for the condition of a project at the current time.
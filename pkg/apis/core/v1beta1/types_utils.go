@@ -64,6 +64,23 @@ type Condition struct {
 	Message string `json:"message"`
 }
 
+// ConditionHistory contains the most recent status transitions of a condition.
+type ConditionHistory struct {
+	// Type is the type of the condition.
+	Type ConditionType `json:"type"`
+	// Transitions are the most recent status transitions of the condition, ordered from oldest to newest.
+	// +optional
+	Transitions []ConditionTransition `json:"transitions,omitempty"`
+}
+
+// ConditionTransition is a single status transition of a condition.
+type ConditionTransition struct {
+	// Status is the status the condition transitioned to.
+	Status ConditionStatus `json:"status"`
+	// Time is the time of the transition.
+	Time metav1.Time `json:"time"`
+}
+
 const (
 	// ConditionTrue means a resource is in the condition.
 	ConditionTrue ConditionStatus = "True"
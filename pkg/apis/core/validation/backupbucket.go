@@ -55,6 +55,13 @@ func ValidateBackupBucketSpec(spec *core.BackupBucketSpec, fldPath *field.Path)
 	if spec.SeedName == nil || len(*spec.SeedName) == 0 {
 		allErrs = append(allErrs, field.Invalid(fldPath.Child("seedName"), spec.SeedName, "seed must not be empty"))
 	}
+	if spec.Secondary != nil {
+		if len(spec.Secondary.Region) == 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("secondary.region"), spec.Secondary.Region, "region must not be empty"))
+		} else if spec.Secondary.Region == spec.Provider.Region {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("secondary.region"), spec.Secondary.Region, "region must differ from the region of the provider"))
+		}
+	}
 
 	allErrs = append(allErrs, validateSecretReference(spec.SecretRef, fldPath.Child("secretRef"))...)
 
@@ -67,6 +74,9 @@ func ValidateBackupBucketSpecUpdate(newSpec, oldSpec *core.BackupBucketSpec, fld
 
 	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newSpec.Provider, oldSpec.Provider, fldPath.Child("provider"))...)
 	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newSpec.SeedName, oldSpec.SeedName, fldPath.Child("seedName"))...)
+	if oldSpec.Secondary != nil {
+		allErrs = append(allErrs, apivalidation.ValidateImmutableField(newSpec.Secondary, oldSpec.Secondary, fldPath.Child("secondary"))...)
+	}
 
 	return allErrs
 }
@@ -97,6 +97,30 @@ var _ = Describe("validation", func() {
 				}))))
 		})
 
+		It("should forbid a secondary location in the region of the provider", func() {
+			backupBucket.Spec.Secondary = &core.SecondaryBackupLocation{Region: backupBucket.Spec.Provider.Region}
+
+			errorList := ValidateBackupBucket(backupBucket)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.secondary.region"),
+			}))))
+		})
+
+		It("should forbid changing the secondary location", func() {
+			backupBucket.Spec.Secondary = &core.SecondaryBackupLocation{Region: "other-region"}
+			newBackupBucket := prepareBackupBucketForUpdate(backupBucket)
+			newBackupBucket.Spec.Secondary.Region = "another-region"
+
+			errorList := ValidateBackupBucketUpdate(newBackupBucket, backupBucket)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.secondary"),
+			}))))
+		})
+
 		It("should forbid updating some keys", func() {
 			newBackupBucket := prepareBackupBucketForUpdate(backupBucket)
 			newBackupBucket.Spec.Provider.Type = "another-type"
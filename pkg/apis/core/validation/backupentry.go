@@ -48,6 +48,23 @@ func ValidateBackupEntrySpec(spec *core.BackupEntrySpec, fldPath *field.Path) fi
 	if len(spec.BucketName) == 0 {
 		allErrs = append(allErrs, field.Invalid(fldPath.Child("bucketName"), spec.BucketName, "bucketName must not be empty"))
 	}
+	allErrs = append(allErrs, validateBackupRetentionPolicy(spec.RetentionPolicy, fldPath.Child("retentionPolicy"))...)
+
+	return allErrs
+}
+
+func validateBackupRetentionPolicy(policy *core.BackupRetentionPolicy, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if policy == nil {
+		return allErrs
+	}
+
+	if policy.DailySnapshots != nil && *policy.DailySnapshots <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("dailySnapshots"), *policy.DailySnapshots, "must be greater than 0"))
+	}
+	if policy.WeeklySnapshots != nil && *policy.WeeklySnapshots <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("weeklySnapshots"), *policy.WeeklySnapshots, "must be greater than 0"))
+	}
 
 	return allErrs
 }
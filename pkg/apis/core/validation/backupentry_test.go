@@ -76,6 +76,21 @@ var _ = Describe("validation", func() {
 			}))))
 		})
 
+		It("should forbid a retention policy which does not keep any snapshots", func() {
+			daily, weekly := int32(0), int32(4)
+			backupEntry.Spec.RetentionPolicy = &core.BackupRetentionPolicy{
+				DailySnapshots:  &daily,
+				WeeklySnapshots: &weekly,
+			}
+
+			errorList := ValidateBackupEntry(backupEntry)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.retentionPolicy.dailySnapshots"),
+			}))))
+		})
+
 		It("should forbid updating some keys", func() {
 			newBackupEntry := prepareBackupEntryForUpdate(backupEntry)
 			newBackupEntry.Spec.BucketName = "another-bucketName"
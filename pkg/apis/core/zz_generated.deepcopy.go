@@ -113,6 +113,11 @@ func (in *BackupBucketSpec) DeepCopyInto(out *BackupBucketSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Secondary != nil {
+		in, out := &in.Secondary, &out.Secondary
+		*out = new(SecondaryBackupLocation)
+		**out = **in
+	}
 	return
 }
 
@@ -226,6 +231,11 @@ func (in *BackupEntrySpec) DeepCopyInto(out *BackupEntrySpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.RetentionPolicy != nil {
+		in, out := &in.RetentionPolicy, &out.RetentionPolicy
+		*out = new(BackupRetentionPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -252,6 +262,11 @@ func (in *BackupEntryStatus) DeepCopyInto(out *BackupEntryStatus) {
 		*out = new(LastError)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ReplicaBucketName != nil {
+		in, out := &in.ReplicaBucketName, &out.ReplicaBucketName
+		*out = new(string)
+		**out = **in
+	}
 	return
 }
 
@@ -265,6 +280,32 @@ func (in *BackupEntryStatus) DeepCopy() *BackupEntryStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupRetentionPolicy) DeepCopyInto(out *BackupRetentionPolicy) {
+	*out = *in
+	if in.DailySnapshots != nil {
+		in, out := &in.DailySnapshots, &out.DailySnapshots
+		*out = new(int32)
+		**out = **in
+	}
+	if in.WeeklySnapshots != nil {
+		in, out := &in.WeeklySnapshots, &out.WeeklySnapshots
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupRetentionPolicy.
+func (in *BackupRetentionPolicy) DeepCopy() *BackupRetentionPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupRetentionPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CloudInfo) DeepCopyInto(out *CloudInfo) {
 	*out = *in
@@ -285,7 +326,22 @@ func (in *CloudInfo) DeepCopy() *CloudInfo {
 func (in *ClusterInfo) DeepCopyInto(out *ClusterInfo) {
 	*out = *in
 	out.Cloud = in.Cloud
-	out.Kubernetes = in.Kubernetes
+	in.Kubernetes.DeepCopyInto(&out.Kubernetes)
+	if in.Nodes != nil {
+		in, out := &in.Nodes, &out.Nodes
+		*out = new(NodesInfo)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Workloads != nil {
+		in, out := &in.Workloads, &out.Workloads
+		*out = new(WorkloadsInfo)
+		**out = **in
+	}
+	if in.Kubeconfig != nil {
+		in, out := &in.Kubeconfig, &out.Kubeconfig
+		*out = new(KubeconfigInfo)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -620,9 +676,43 @@ func (in *GardenerResourceData) DeepCopy() *GardenerResourceData {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeconfigInfo) DeepCopyInto(out *KubeconfigInfo) {
+	*out = *in
+	if in.CertificateExpirationTime != nil {
+		in, out := &in.CertificateExpirationTime, &out.CertificateExpirationTime
+		*out = (*in).DeepCopy()
+	}
+	if in.TokenExpirationTime != nil {
+		in, out := &in.TokenExpirationTime, &out.TokenExpirationTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeconfigInfo.
+func (in *KubeconfigInfo) DeepCopy() *KubeconfigInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeconfigInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KubernetesInfo) DeepCopyInto(out *KubernetesInfo) {
 	*out = *in
+	if in.NodeVersions != nil {
+		in, out := &in.NodeVersions, &out.NodeVersions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NodeVersionSkew != nil {
+		in, out := &in.NodeVersionSkew, &out.NodeVersionSkew
+		*out = new(int32)
+		**out = **in
+	}
 	return
 }
 
@@ -683,6 +773,29 @@ func (in *LastOperation) DeepCopy() *LastOperation {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodesInfo) DeepCopyInto(out *NodesInfo) {
+	*out = *in
+	if in.Capacity != nil {
+		in, out := &in.Capacity, &out.Capacity
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodesInfo.
+func (in *NodesInfo) DeepCopy() *NodesInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(NodesInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Plant) DeepCopyInto(out *Plant) {
 	*out = *in
@@ -784,7 +897,7 @@ func (in *PlantStatus) DeepCopyInto(out *PlantStatus) {
 	if in.ClusterInfo != nil {
 		in, out := &in.ClusterInfo, &out.ClusterInfo
 		*out = new(ClusterInfo)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	return
 }
@@ -816,6 +929,22 @@ func (in *ProviderConfig) DeepCopy() *ProviderConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecondaryBackupLocation) DeepCopyInto(out *SecondaryBackupLocation) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecondaryBackupLocation.
+func (in *SecondaryBackupLocation) DeepCopy() *SecondaryBackupLocation {
+	if in == nil {
+		return nil
+	}
+	out := new(SecondaryBackupLocation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ShootState) DeepCopyInto(out *ShootState) {
 	*out = *in
@@ -905,3 +1034,37 @@ func (in *ShootStateSpec) DeepCopy() *ShootStateSpec {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkloadHealthInfo) DeepCopyInto(out *WorkloadHealthInfo) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadHealthInfo.
+func (in *WorkloadHealthInfo) DeepCopy() *WorkloadHealthInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkloadHealthInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkloadsInfo) DeepCopyInto(out *WorkloadsInfo) {
+	*out = *in
+	out.Deployments = in.Deployments
+	out.StatefulSets = in.StatefulSets
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadsInfo.
+func (in *WorkloadsInfo) DeepCopy() *WorkloadsInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkloadsInfo)
+	in.DeepCopyInto(out)
+	return out
+}
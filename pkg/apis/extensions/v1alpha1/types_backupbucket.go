@@ -68,6 +68,18 @@ type BackupBucketSpec struct {
 	Region string `json:"region"`
 	// SecretRef is a reference to a secret that contains the credentials to access object store.
 	SecretRef corev1.SecretReference `json:"secretRef"`
+	// ReplicaBucket is the bucket into which the objects of this bucket shall be replicated. It is created by a
+	// separate BackupBucket resource before.
+	// +optional
+	ReplicaBucket *BackupReplicaBucket `json:"replicaBucket,omitempty"`
+}
+
+// BackupReplicaBucket contains information about a bucket into which the objects of another bucket are replicated.
+type BackupReplicaBucket struct {
+	// Name is the name of the replica bucket.
+	Name string `json:"name"`
+	// Region is the region of the replica bucket.
+	Region string `json:"region"`
 }
 
 // BackupBucketStatus is the status for an BackupBucket resource.
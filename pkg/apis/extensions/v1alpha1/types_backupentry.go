@@ -70,6 +70,24 @@ type BackupEntrySpec struct {
 	BucketName string `json:"bucketName"`
 	// SecretRef is a reference to a secret that contains the credentials to access object store.
 	SecretRef corev1.SecretReference `json:"secretRef"`
+	// RetentionPolicy defines how long the snapshots in this Backup Entry are kept. If it is not set then the
+	// snapshots are kept according to the default of the provider extension.
+	// +optional
+	RetentionPolicy *BackupRetentionPolicy `json:"retentionPolicy,omitempty"`
+	// ReplicaBucket is the bucket into which the objects of this entry are replicated. The entry has to be cleaned
+	// up in the replica bucket as well.
+	// +optional
+	ReplicaBucket *BackupReplicaBucket `json:"replicaBucket,omitempty"`
+}
+
+// BackupRetentionPolicy defines how long the snapshots of a backup are kept.
+type BackupRetentionPolicy struct {
+	// DailySnapshots is the number of days for which the latest snapshot of each day is kept.
+	// +optional
+	DailySnapshots *int32 `json:"dailySnapshots,omitempty"`
+	// WeeklySnapshots is the number of weeks for which the latest snapshot of each week is kept.
+	// +optional
+	WeeklySnapshots *int32 `json:"weeklySnapshots,omitempty"`
 }
 
 // BackupEntryStatus is the status for an BackupEntry resource.
@@ -67,11 +67,26 @@ type NetworkSpec struct {
 	PodCIDR string `json:"podCIDR"`
 	// ServiceCIDR defines the CIDR that will be used for services.
 	ServiceCIDR string `json:"serviceCIDR"`
+	// IPFamilies specifies the IP protocol versions of the pod and service networks. The first family is the primary
+	// one. For dual-stack networking, PodCIDR and ServiceCIDR contain one CIDR per IP family, separated by a comma and in
+	// the same order. Defaults to IPv4 if empty.
+	// +optional
+	IPFamilies []IPFamily `json:"ipFamilies,omitempty"`
 	// ProviderConfig contains plugin-specific configuration.
 	// +optional
 	ProviderConfig *runtime.RawExtension `json:"providerConfig,omitempty"`
 }
 
+// IPFamily is a type for specifying an IP protocol version.
+type IPFamily string
+
+const (
+	// IPFamilyIPv4 is the IPv4 IP family.
+	IPFamilyIPv4 IPFamily = "IPv4"
+	// IPFamilyIPv6 is the IPv6 IP family.
+	IPFamilyIPv6 IPFamily = "IPv6"
+)
+
 // NetworkStatus is the status for an Network resource.
 type NetworkStatus struct {
 	// DefaultStatus is a structure containing common fields used by all extension resources.
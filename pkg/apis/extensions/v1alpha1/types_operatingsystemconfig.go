@@ -65,6 +65,10 @@ type OperatingSystemConfigSpec struct {
 	// DefaultSpec is a structure containing common fields used by all extension resources.
 	DefaultSpec `json:",inline"`
 
+	// CRIConfig contains configurations of the CRI library. If it is not set, Docker is expected to be used as
+	// container runtime.
+	// +optional
+	CRIConfig *CRIConfig `json:"criConfig,omitempty"`
 	// Purpose describes how the result of this OperatingSystemConfig is used by Gardener. Either it
 	// gets sent to the machine-controller-manager to bootstrap a VM, or it is downloaded by the
 	// cloud-config-downloader script already running on a bootstrapped VM.
@@ -90,6 +94,22 @@ type OperatingSystemConfigSpec struct {
 	ProviderConfig *runtime.RawExtension `json:"providerConfig,omitempty"`
 }
 
+// CRIConfig contains configurations of the CRI library.
+type CRIConfig struct {
+	// Name is a mandatory string containing the name of the CRI library.
+	Name CRIName `json:"name"`
+}
+
+// CRIName is a type for specifying the name of a container runtime interface implementation.
+type CRIName string
+
+const (
+	// CRINameContainerD is a constant for the ContainerD CRI name.
+	CRINameContainerD CRIName = "containerd"
+	// CRINameDocker is a constant for the Docker CRI name.
+	CRINameDocker CRIName = "docker"
+)
+
 // Unit is a unit for the operating system configuration (usually, a systemd unit).
 type Unit struct {
 	// Name is the name of a unit.
@@ -85,6 +85,10 @@ type WorkerSpec struct {
 
 // WorkerPool is the definition of a specific worker pool.
 type WorkerPool struct {
+	// CRIConfig contains configurations of the CRI library used by the machines of this worker pool. If it is
+	// not set, Docker is expected to be used as container runtime.
+	// +optional
+	CRIConfig *CRIConfig `json:"criConfig,omitempty"`
 	// MachineType contains information about the machine type that should be used for this worker pool.
 	MachineType string `json:"machineType"`
 	// Maximum is the maximum size of the worker pool.
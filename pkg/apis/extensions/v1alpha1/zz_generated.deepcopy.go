@@ -31,7 +31,7 @@ func (in *BackupBucket) DeepCopyInto(out *BackupBucket) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 	return
 }
@@ -92,6 +92,11 @@ func (in *BackupBucketSpec) DeepCopyInto(out *BackupBucketSpec) {
 	*out = *in
 	out.DefaultSpec = in.DefaultSpec
 	out.SecretRef = in.SecretRef
+	if in.ReplicaBucket != nil {
+		in, out := &in.ReplicaBucket, &out.ReplicaBucket
+		*out = new(BackupReplicaBucket)
+		**out = **in
+	}
 	return
 }
 
@@ -132,7 +137,7 @@ func (in *BackupEntry) DeepCopyInto(out *BackupEntry) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 	return
 }
@@ -193,6 +198,16 @@ func (in *BackupEntrySpec) DeepCopyInto(out *BackupEntrySpec) {
 	*out = *in
 	out.DefaultSpec = in.DefaultSpec
 	out.SecretRef = in.SecretRef
+	if in.RetentionPolicy != nil {
+		in, out := &in.RetentionPolicy, &out.RetentionPolicy
+		*out = new(BackupRetentionPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ReplicaBucket != nil {
+		in, out := &in.ReplicaBucket, &out.ReplicaBucket
+		*out = new(BackupReplicaBucket)
+		**out = **in
+	}
 	return
 }
 
@@ -223,6 +238,64 @@ func (in *BackupEntryStatus) DeepCopy() *BackupEntryStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupReplicaBucket) DeepCopyInto(out *BackupReplicaBucket) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupReplicaBucket.
+func (in *BackupReplicaBucket) DeepCopy() *BackupReplicaBucket {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupReplicaBucket)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupRetentionPolicy) DeepCopyInto(out *BackupRetentionPolicy) {
+	*out = *in
+	if in.DailySnapshots != nil {
+		in, out := &in.DailySnapshots, &out.DailySnapshots
+		*out = new(int32)
+		**out = **in
+	}
+	if in.WeeklySnapshots != nil {
+		in, out := &in.WeeklySnapshots, &out.WeeklySnapshots
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupRetentionPolicy.
+func (in *BackupRetentionPolicy) DeepCopy() *BackupRetentionPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupRetentionPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CRIConfig) DeepCopyInto(out *CRIConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CRIConfig.
+func (in *CRIConfig) DeepCopy() *CRIConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(CRIConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CloudConfig) DeepCopyInto(out *CloudConfig) {
 	*out = *in
@@ -898,6 +971,11 @@ func (in *NetworkList) DeepCopyObject() runtime.Object {
 func (in *NetworkSpec) DeepCopyInto(out *NetworkSpec) {
 	*out = *in
 	out.DefaultSpec = in.DefaultSpec
+	if in.IPFamilies != nil {
+		in, out := &in.IPFamilies, &out.IPFamilies
+		*out = make([]IPFamily, len(*in))
+		copy(*out, *in)
+	}
 	if in.ProviderConfig != nil {
 		in, out := &in.ProviderConfig, &out.ProviderConfig
 		*out = new(runtime.RawExtension)
@@ -1003,6 +1081,11 @@ func (in *OperatingSystemConfigList) DeepCopyObject() runtime.Object {
 func (in *OperatingSystemConfigSpec) DeepCopyInto(out *OperatingSystemConfigSpec) {
 	*out = *in
 	out.DefaultSpec = in.DefaultSpec
+	if in.CRIConfig != nil {
+		in, out := &in.CRIConfig, &out.CRIConfig
+		*out = new(CRIConfig)
+		**out = **in
+	}
 	if in.ReloadConfigFilePath != nil {
 		in, out := &in.ReloadConfigFilePath, &out.ReloadConfigFilePath
 		*out = new(string)
@@ -1193,6 +1276,11 @@ func (in *WorkerList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WorkerPool) DeepCopyInto(out *WorkerPool) {
 	*out = *in
+	if in.CRIConfig != nil {
+		in, out := &in.CRIConfig, &out.CRIConfig
+		*out = new(CRIConfig)
+		**out = **in
+	}
 	out.MaxSurge = in.MaxSurge
 	out.MaxUnavailable = in.MaxUnavailable
 	if in.Annotations != nil {
@@ -59,12 +59,27 @@ func ValidateOperatingSystemConfigSpec(spec *extensionsv1alpha1.OperatingSystemC
 		}
 	}
 
+	if spec.CRIConfig != nil {
+		allErrs = append(allErrs, ValidateCRIConfig(spec.CRIConfig, fldPath.Child("criConfig"))...)
+	}
+
 	allErrs = append(allErrs, ValidateUnits(spec.Units, fldPath.Child("units"))...)
 	allErrs = append(allErrs, ValidateFiles(spec.Files, fldPath.Child("files"))...)
 
 	return allErrs
 }
 
+// ValidateCRIConfig validates the configuration of the CRI library.
+func ValidateCRIConfig(criConfig *extensionsv1alpha1.CRIConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if criConfig.Name != extensionsv1alpha1.CRINameContainerD && criConfig.Name != extensionsv1alpha1.CRINameDocker {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("name"), criConfig.Name, []string{string(extensionsv1alpha1.CRINameContainerD), string(extensionsv1alpha1.CRINameDocker)}))
+	}
+
+	return allErrs
+}
+
 // ValidateUnits validates operating system config units.
 func ValidateUnits(units []extensionsv1alpha1.Unit, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
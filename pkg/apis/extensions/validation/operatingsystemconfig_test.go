@@ -101,6 +101,18 @@ var _ = Describe("OperatingSystemConfig validation tests", func() {
 			}))))
 		})
 
+		It("should forbid OperatingSystemConfig resources with unsupported CRI name", func() {
+			oscCopy := osc.DeepCopy()
+			oscCopy.Spec.CRIConfig = &extensionsv1alpha1.CRIConfig{Name: "foo"}
+
+			errorList := ValidateOperatingSystemConfig(oscCopy)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("spec.criConfig.name"),
+			}))))
+		})
+
 		It("should forbid OperatingSystemConfig resources with invalid units", func() {
 			oscCopy := osc.DeepCopy()
 			oscCopy.Spec.Units[0].Name = ""
@@ -88,6 +88,10 @@ func ValidateWorkerPools(pools []extensionsv1alpha1.WorkerPool, fldPath *field.P
 		if pool.UserData == nil {
 			allErrs = append(allErrs, field.Required(idxPath.Child("userData"), "field is required"))
 		}
+
+		if pool.CRIConfig != nil {
+			allErrs = append(allErrs, ValidateCRIConfig(pool.CRIConfig, idxPath.Child("criConfig"))...)
+		}
 	}
 
 	return allErrs
@@ -108,6 +108,19 @@ var _ = Describe("Worker validation tests", func() {
 			}))))
 		})
 
+		It("should forbid Worker resources with unsupported CRI name", func() {
+			workerCopy := worker.DeepCopy()
+
+			workerCopy.Spec.Pools[0].CRIConfig = &extensionsv1alpha1.CRIConfig{Name: "foo"}
+
+			errorList := ValidateWorker(workerCopy)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("spec.pools[0].criConfig.name"),
+			}))))
+		})
+
 		It("should allow valid worker resources", func() {
 			errorList := ValidateWorker(worker)
 
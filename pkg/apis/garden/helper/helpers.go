@@ -269,3 +269,16 @@ func QuotaScope(scopeRef corev1.ObjectReference) (string, error) {
 	}
 	return "", fmt.Errorf("unknown quota scope")
 }
+
+// GetIPFamilies returns the given IP families or only IPv4 if the list is empty.
+func GetIPFamilies(ipFamilies []garden.IPFamily) []garden.IPFamily {
+	if len(ipFamilies) == 0 {
+		return []garden.IPFamily{garden.IPFamilyIPv4}
+	}
+	return ipFamilies
+}
+
+// IsDualStack returns true if the given IP families configure dual-stack networking.
+func IsDualStack(ipFamilies []garden.IPFamily) bool {
+	return len(GetIPFamilies(ipFamilies)) > 1
+}
@@ -420,6 +420,15 @@ type SeedBackup struct {
 	// the object store where backups should be stored. It should have enough privileges to manipulate
 	// the objects as well as buckets.
 	SecretRef corev1.SecretReference
+	// Secondary is a secondary location into which the backups are replicated. It uses the same provider and
+	// credentials as the primary location.
+	Secondary *SecondaryBackupLocation
+}
+
+// SecondaryBackupLocation contains information about a secondary location into which the backups are replicated.
+type SecondaryBackupLocation struct {
+	// Region is the region of the secondary location. It must differ from the region of the primary location.
+	Region string
 }
 
 ////////////////////////////////////////////////////
@@ -469,6 +478,8 @@ type ProjectSpec struct {
 	ProjectMembers []ProjectMember
 	// Namespace is the name of the namespace that has been created for the Project object.
 	Namespace *string
+	// BackupRetentionPolicy is the default retention policy for the backups of the Shoots in the project.
+	BackupRetentionPolicy *BackupRetentionPolicy
 }
 
 // ProjectMember is a member of a project.
@@ -478,6 +489,8 @@ type ProjectMember struct {
 	rbacv1.Subject
 	// Role represents the role of this member.
 	Role string
+	// Roles represents the list of additional roles of this member.
+	Roles []string
 }
 
 const (
@@ -485,6 +498,12 @@ const (
 	ProjectMemberAdmin = "admin"
 	// ProjectMemberViewer is a const for a role that provides limited permissions to only view some resources.
 	ProjectMemberViewer = "viewer"
+	// ProjectMemberUserAccessManager is a const for a role that provides permissions to manage the members of the
+	// project.
+	ProjectMemberUserAccessManager = "uam"
+	// ProjectMemberExtensionPrefix is a prefix for custom roles whose permissions are defined by ClusterRoles carrying
+	// the label `rbac.gardener.cloud/aggregate-to-extension-role=<name>`.
+	ProjectMemberExtensionPrefix = "extension:"
 )
 
 // ProjectStatus holds the most recently observed status of the project.
@@ -582,6 +601,8 @@ const (
 	MigrationCloudProfileKubernetes     = "migration.cloudprofile.gardener.cloud/kubernetes"
 	MigrationCloudProfileMachineImages  = "migration.cloudprofile.gardener.cloud/machineImages"
 	MigrationCloudProfileMachineTypes   = "migration.cloudprofile.gardener.cloud/machineTypes"
+
+	MigrationProjectMemberRoles = "migration.project.gardener.cloud/memberRoles"
 )
 
 // SeedStatus holds the most recently observed status of the Seed cluster.
@@ -642,6 +663,13 @@ type SeedNetworks struct {
 	Services string
 	// ShootDefaults contains the default networks CIDRs for shoots.
 	ShootDefaults *ShootNetworks
+	// ShootPools contains network pools from which non-overlapping networks are allocated to shoots which do not
+	// specify their node or pod network.
+	ShootPools *ShootNetworkPools
+	// IPFamilies specifies the IP protocol versions used by the networks of the Seed. The first family is the primary
+	// one. Dual-stack networking is configured by specifying both IPv4 and IPv6, in this case the pod, node and service
+	// networks contain one CIDR per IP family, separated by a comma and in the same order. Defaults to IPv4 if empty.
+	IPFamilies []IPFamily
 	// BlockCIDRs is a list of network addresses that should be blocked for shoot control plane components running
 	// in the seed cluster.
 	BlockCIDRs []string
@@ -655,6 +683,23 @@ type ShootNetworks struct {
 	Services *string
 }
 
+// ShootNetworkPools contains network pools from which networks are allocated to shoots.
+type ShootNetworkPools struct {
+	// Nodes is the pool from which the node networks of shoots are allocated.
+	Nodes *NetworkPool
+	// Pods is the pool from which the pod networks of shoots are allocated.
+	Pods *NetworkPool
+}
+
+// NetworkPool is a network from which equally sized networks are allocated.
+type NetworkPool struct {
+	// CIDR is the network from which the networks are allocated.
+	CIDR string
+	// PrefixLength is the prefix length of the allocated networks. It must not be smaller than the prefix length of
+	// the pool CIDR.
+	PrefixLength int32
+}
+
 // SeedTaint describes a taint on a seed.
 type SeedTaint struct {
 	// Key is the taint key to be applied to a seed.
@@ -798,10 +843,14 @@ type ShootList struct {
 type ShootSpec struct {
 	// Addons contains information about enabled/disabled addons and their configuration.
 	Addons *Addons
+	// Backup contains configuration settings for the backup of the Shoot's etcd.
+	Backup *ShootBackup
 	// Cloud contains information about the cloud environment and their specific settings.
 	Cloud Cloud
 	// CloudProfileName is a name of a CloudProfile object.
 	CloudProfileName string
+	// ControlPlane contains configuration settings for the control plane of the Shoot.
+	ControlPlane *ControlPlane
 	// DNS contains information about the DNS settings of the Shoot.
 	DNS *DNS
 	// Extensions contain type and provider information for Shoot extensions.
@@ -828,6 +877,77 @@ type ShootSpec struct {
 	SeedName *string
 }
 
+// ShootBackup contains configuration settings for the backup of the Shoot's etcd.
+type ShootBackup struct {
+	// RetentionPolicy defines how long the snapshots of the Shoot's etcd are kept. It overrides the default retention
+	// policy of the Project.
+	RetentionPolicy *BackupRetentionPolicy
+}
+
+// ControlPlane contains configuration settings for the control plane of the Shoot.
+type ControlPlane struct {
+	// HighAvailability configures the control plane of the Shoot to be highly available. It can only be set when the
+	// Shoot is created.
+	HighAvailability *HighAvailability
+	// NetworkPolicyRules is a list of additional rules allowing egress traffic from pods of the control plane of the
+	// Shoot in the seed cluster. The destinations must not overlap with the blocked CIDRs or the node, pod and service
+	// networks of the seed.
+	NetworkPolicyRules []NetworkPolicyRule
+}
+
+// NetworkPolicyRule allows egress traffic from selected pods of the control plane of a Shoot to the given networks.
+type NetworkPolicyRule struct {
+	// Name is the name of the rule. It must be unique among the rules of the Shoot and is part of the name of the
+	// generated NetworkPolicy.
+	Name string
+	// PodSelector selects the pods of the control plane in the seed cluster to which the rule applies. It must not be
+	// empty, and the etcd pods are never selected.
+	PodSelector metav1.LabelSelector
+	// CIDRs is a list of destination networks to which egress traffic is allowed.
+	CIDRs []string
+	// Ports is a list of destination ports to which egress traffic is allowed. If empty, all ports are allowed.
+	Ports []NetworkPolicyRulePort
+}
+
+// NetworkPolicyRulePort is a destination port of a network policy rule.
+type NetworkPolicyRulePort struct {
+	// Protocol is the protocol of the port (TCP, UDP or SCTP). Defaults to TCP.
+	Protocol *corev1.Protocol
+	// Port is the number of the port.
+	Port int32
+}
+
+// HighAvailability specifies the configuration settings for a highly available control plane.
+type HighAvailability struct {
+	// FailureTolerance holds information about the failure tolerance of the control plane.
+	FailureTolerance FailureTolerance
+}
+
+// FailureTolerance describes the failure tolerance of a highly available control plane.
+type FailureTolerance struct {
+	// Type specifies the failure domain of the seed across which the replicas of the control plane components are
+	// spread, i.e. whether the control plane tolerates the loss of a node or of an entire zone.
+	Type FailureToleranceType
+}
+
+// FailureToleranceType specifies the failure domain across which a highly available control plane is spread.
+type FailureToleranceType string
+
+const (
+	// FailureToleranceTypeNode specifies that the control plane tolerates the loss of a single node of the seed.
+	FailureToleranceTypeNode FailureToleranceType = "node"
+	// FailureToleranceTypeZone specifies that the control plane tolerates the loss of a single zone of the seed.
+	FailureToleranceTypeZone FailureToleranceType = "zone"
+)
+
+// BackupRetentionPolicy defines how long the snapshots of a backup are kept.
+type BackupRetentionPolicy struct {
+	// DailySnapshots is the number of days for which the latest snapshot of each day is kept.
+	DailySnapshots *int32
+	// WeeklySnapshots is the number of weeks for which the latest snapshot of each week is kept.
+	WeeklySnapshots *int32
+}
+
 const (
 	MigrationShootCloudControllerManager  = "migration.shoot.gardener.cloud/cloudControllerManager"
 	MigrationShootDNSProviders            = "migration.shoot.gardener.cloud/dnsProviders"
@@ -843,13 +963,27 @@ const (
 
 // ShootStatus holds the most recently observed status of the Shoot cluster.
 type ShootStatus struct {
+	// Availability contains the availability of the Shoot's components over several periods as derived from the
+	// health checks of the care controller.
+	Availability *ShootAvailability
 	// Conditions represents the latest available observations of a Shoots's current state.
 	Conditions []Condition
 	// Constraints represents conditions of a Shoot's current state that constraint some operations on it.
 	// +optional
 	Constraints []Condition
+	// ConditionHistories contains a bounded history of the status transitions of the Shoot's conditions.
+	ConditionHistories []ConditionHistory
+	// Credentials contains information about the credentials of the Shoot cluster, e.g. the status of their
+	// rotation.
+	Credentials *ShootCredentials
+	// ETCDBackup contains information about the on-demand operations on the backup of the Shoot's etcd, i.e., taking
+	// snapshots and restoring the etcd from its backup.
+	ETCDBackup *ShootETCDBackup
 	// Gardener holds information about the Gardener which last acted on the Shoot.
 	Gardener Gardener
+	// KubeletConfigDrifts contains the nodes of the Shoot whose effective kubelet configuration differs from the
+	// desired one as detected by the care controller. At most 10 nodes are listed.
+	KubeletConfigDrifts []NodeKubeletConfigDrift
 	// LastOperation holds information about the last operation on the Shoot.
 	LastOperation *LastOperation
 	// LastErrors holds information about the last occurred error(s) during an operation.
@@ -873,6 +1007,129 @@ type ShootStatus struct {
 	UID types.UID
 }
 
+// NodeKubeletConfigDrift contains the differences between the desired and the effective kubelet configuration of a
+// node.
+type NodeKubeletConfigDrift struct {
+	// Node is the name of the node.
+	Node string
+	// WorkerPool is the name of the worker pool the node belongs to.
+	WorkerPool *string
+	// Fields contains the fields of the kubelet configuration whose effective values differ from the desired ones.
+	Fields []KubeletConfigFieldDrift
+}
+
+// KubeletConfigFieldDrift contains the desired and the effective value of a field of the kubelet configuration.
+type KubeletConfigFieldDrift struct {
+	// Name is the name of the field in the kubelet configuration file, e.g. `maxPods` or
+	// `evictionHard[memory.available]`.
+	Name string
+	// Desired is the desired value of the field.
+	Desired string
+	// Actual is the effective value of the field on the node. It is empty if the field is not set.
+	Actual string
+}
+
+// ShootAvailability contains the availability of the Shoot's components over several periods.
+type ShootAvailability struct {
+	// APIServer contains the availability ratios of the Shoot's API server as derived from the health checks.
+	APIServer []AvailabilityRatio
+}
+
+// AvailabilityRatio is the ratio of successful availability probes within a period.
+type AvailabilityRatio struct {
+	// Period is the duration the ratio has been computed for.
+	Period metav1.Duration
+	// Ratio is the percentage of successful probes within the period, e.g. "99.950".
+	Ratio string
+	// Probes is the number of probes which have been performed within the period.
+	Probes int64
+}
+
+// ShootETCDBackup contains information about the on-demand operations on the backup of the Shoot's etcd.
+type ShootETCDBackup struct {
+	// LastSnapshot contains information about the last on-demand full snapshot of the etcd.
+	LastSnapshot *ETCDBackupOperation
+	// LastRestore contains information about the last restoration of the etcd from its backup.
+	LastRestore *ETCDBackupOperation
+}
+
+// ETCDBackupOperation contains information about an on-demand operation on the backup of the Shoot's etcd.
+type ETCDBackupOperation struct {
+	// State is the current state of the operation.
+	State LastOperationState
+	// Description is a human-readable message describing the progress of the operation.
+	Description string
+	// Progress is the progress of the operation in percent.
+	Progress int
+	// LastUpdateTime is the time at which the operation was updated the last time.
+	LastUpdateTime metav1.Time
+	// Snapshot is the name of the full snapshot which has been taken. For restorations, it is the final snapshot of the
+	// replaced etcd.
+	Snapshot *string
+}
+
+// ShootCredentials contains information about the credentials of the Shoot cluster.
+type ShootCredentials struct {
+	// Rotation contains information about the rotation of credentials.
+	Rotation *ShootCredentialsRotation
+}
+
+// ShootCredentialsRotation contains information about the rotation of credentials.
+type ShootCredentialsRotation struct {
+	// CertificateAuthorities contains information about the rotation of the certificate authorities.
+	CertificateAuthorities *CARotation
+	// StaticCredentials contains information about the rotation of the static tokens and the basic authentication
+	// password of the kube-apiserver.
+	StaticCredentials *StaticCredentialsRotation
+	// ETCDEncryptionKey contains information about the rotation of the key which is used to encrypt resources in etcd.
+	ETCDEncryptionKey *ETCDEncryptionKeyRotation
+}
+
+// StaticCredentialsRotation contains information about the rotation of the static tokens and the basic authentication
+// password of the kube-apiserver of the Shoot cluster.
+type StaticCredentialsRotation struct {
+	// LastRotationTime is the most recent time when the static credentials were rotated.
+	LastRotationTime *metav1.Time
+}
+
+// CARotation contains information about the rotation of the certificate authorities of the Shoot cluster.
+type CARotation struct {
+	// Phase describes the phase of the certificate authority rotation.
+	Phase CredentialsRotationPhase
+	// LastInitiationTime is the most recent time when the certificate authority rotation was initiated.
+	LastInitiationTime *metav1.Time
+	// LastCompletionTime is the most recent time when the certificate authority rotation was successfully completed.
+	LastCompletionTime *metav1.Time
+}
+
+// ETCDEncryptionKeyRotation contains information about the rotation of the key which is used by the kube-apiserver to
+// encrypt resources in etcd.
+type ETCDEncryptionKeyRotation struct {
+	// Phase describes the phase of the etcd encryption key rotation.
+	Phase CredentialsRotationPhase
+	// LastInitiationTime is the most recent time when the etcd encryption key rotation was initiated.
+	LastInitiationTime *metav1.Time
+	// LastCompletionTime is the most recent time when the etcd encryption key rotation was successfully completed.
+	LastCompletionTime *metav1.Time
+}
+
+// CredentialsRotationPhase is a string alias.
+type CredentialsRotationPhase string
+
+const (
+	// RotationPreparing is a constant for the credentials rotation phase describing that the procedure is being prepared,
+	// i.e. new credentials are introduced alongside the old ones.
+	RotationPreparing CredentialsRotationPhase = "Preparing"
+	// RotationPrepared is a constant for the credentials rotation phase describing that the procedure was prepared and
+	// that all components trust both the old and the new credentials.
+	RotationPrepared CredentialsRotationPhase = "Prepared"
+	// RotationCompleting is a constant for the credentials rotation phase describing that the procedure is being
+	// completed, i.e. the old credentials are removed.
+	RotationCompleting CredentialsRotationPhase = "Completing"
+	// RotationCompleted is a constant for the credentials rotation phase describing that the procedure was completed.
+	RotationCompleted CredentialsRotationPhase = "Completed"
+)
+
 ///////////////////////////////
 // Shoot Specification Types //
 ///////////////////////////////
@@ -892,8 +1149,22 @@ type Networking struct {
 	Nodes string
 	// Services is the CIDR of the service network.
 	Services *string
+	// IPFamilies specifies the IP protocol versions used by the networks of the Shoot. The first family is the primary
+	// one. Dual-stack networking is configured by specifying both IPv4 and IPv6, in this case the pod, node and service
+	// networks contain one CIDR per IP family, separated by a comma and in the same order. Defaults to IPv4 if empty.
+	IPFamilies []IPFamily
 }
 
+// IPFamily is a type for specifying an IP protocol version.
+type IPFamily string
+
+const (
+	// IPFamilyIPv4 is the IPv4 IP family.
+	IPFamilyIPv4 IPFamily = "IPv4"
+	// IPFamilyIPv6 is the IPv6 IP family.
+	IPFamilyIPv6 IPFamily = "IPv6"
+)
+
 // Cloud contains information about the cloud environment and their specific settings.
 // It must contain exactly one key of the below cloud providers.
 type Cloud struct {
@@ -1369,6 +1640,8 @@ type KubeAPIServerConfig struct {
 	AuditConfig *AuditConfig
 	// EnableBasicAuthentication defines whether basic authentication should be enabled for this cluster or not.
 	EnableBasicAuthentication *bool
+	// EncryptionConfig contains customizable encryption configuration of the kube-apiserver.
+	EncryptionConfig *EncryptionConfig
 	// OIDCConfig contains configuration settings for the OIDC provider.
 	OIDCConfig *OIDCConfig
 	// RuntimeConfig contains information about enabled or disabled APIs.
@@ -1376,6 +1649,60 @@ type KubeAPIServerConfig struct {
 	// ServiceAccountConfig contains configuration settings for the service account handling
 	// of the kube-apiserver.
 	ServiceAccountConfig *ServiceAccountConfig
+
+	// StaticCredentialsRotation contains configuration settings for the scheduled rotation of the static tokens and
+	// the basic authentication password of the kube-apiserver.
+	StaticCredentialsRotation *StaticCredentialsRotationConfig
+	// AuthenticationWebhook contains configuration settings for a webhook which authenticates bearer tokens in addition
+	// to the built-in authenticators of the kube-apiserver.
+	AuthenticationWebhook *AuthenticationWebhook
+	// AuthorizationWebhook contains configuration settings for a webhook which authorizes requests after the Node and
+	// RBAC authorizers of the kube-apiserver.
+	AuthorizationWebhook *AuthorizationWebhook
+	// AdmissionWebhookConfig contains configuration settings for the admission plugins which call the validating and
+	// mutating admission webhooks. It is written to the admission control config file of the kube-apiserver.
+	AdmissionWebhookConfig *AdmissionWebhookConfig
+}
+
+// StaticCredentialsRotationConfig contains configuration settings for the scheduled rotation of the static tokens and
+// the basic authentication password of the kube-apiserver.
+type StaticCredentialsRotationConfig struct {
+	// Period is the interval after which the static tokens and the basic authentication password are rotated.
+	Period metav1.Duration
+	// TransitionPeriod is the duration for which the previous static tokens remain valid after a rotation.
+	TransitionPeriod *metav1.Duration
+}
+
+// AuthenticationWebhook contains configuration settings for a webhook which authenticates bearer tokens.
+type AuthenticationWebhook struct {
+	// SecretRef is a reference to a secret in the same namespace as the Shoot which contains a kubeconfig (data key
+	// `kubeconfig`) that defines the address of and the credentials for the webhook. All certificates and credentials
+	// must be contained inline, references to files or credential plugins are not supported.
+	SecretRef corev1.LocalObjectReference
+	// CacheTTL is the duration to cache the responses of the webhook. Defaults to 2m.
+	CacheTTL *metav1.Duration
+}
+
+// AuthorizationWebhook contains configuration settings for a webhook which authorizes requests.
+type AuthorizationWebhook struct {
+	// SecretRef is a reference to a secret in the same namespace as the Shoot which contains a kubeconfig (data key
+	// `kubeconfig`) that defines the address of and the credentials for the webhook. All certificates and credentials
+	// must be contained inline, references to files or credential plugins are not supported.
+	SecretRef corev1.LocalObjectReference
+	// CacheAuthorizedTTL is the duration to cache authorized responses of the webhook. Defaults to 5m.
+	CacheAuthorizedTTL *metav1.Duration
+	// CacheUnauthorizedTTL is the duration to cache unauthorized responses of the webhook. Defaults to 30s.
+	CacheUnauthorizedTTL *metav1.Duration
+}
+
+// AdmissionWebhookConfig contains configuration settings for the MutatingAdmissionWebhook and
+// ValidatingAdmissionWebhook admission plugins.
+type AdmissionWebhookConfig struct {
+	// SecretRef is a reference to a secret in the same namespace as the Shoot which contains a kubeconfig (data key
+	// `kubeconfig`) with the credentials the kube-apiserver uses to authenticate to the servers of admission webhooks.
+	// The users of the kubeconfig are matched with the webhook servers by their names, i.e., the host name and optional
+	// port of the server (`*` wildcards are supported). All certificates and credentials must be contained inline.
+	SecretRef corev1.LocalObjectReference
 }
 
 // ServiceAccountConfig is the kube-apiserver configuration for service accounts.
@@ -1389,10 +1716,36 @@ type ServiceAccountConfig struct {
 	SigningKeySecret *corev1.LocalObjectReference
 }
 
+// EncryptionConfig contains customizable encryption configuration of the kube-apiserver.
+type EncryptionConfig struct {
+	// Resources contains the list of resources that shall be encrypted in etcd in addition to secrets. Each item is a
+	// Kubernetes resource name in plural (resource or resource.group), e.g., configmaps or flunders.example.com.
+	// Removed resources are decrypted again.
+	Resources []string
+	// KMS configures a KMS provider which is used to encrypt the resources instead of the aescbc provider. The KMS
+	// plugin is run as sidecar of the kube-apiserver by the extension which is responsible for the given type.
+	KMS *KMSProvider
+}
+
+// KMSProvider contains the configuration of a KMS provider for the encryption of resources in etcd.
+type KMSProvider struct {
+	// Type is the type of the KMS plugin. An extension must be registered for it which runs the plugin as sidecar of
+	// the kube-apiserver.
+	Type string
+	// CacheSize is the maximum number of data encryption keys which are cached in memory by the kube-apiserver.
+	// Defaults to 1000.
+	CacheSize *int32
+	// Timeout is the timeout for calls of the kube-apiserver to the KMS plugin. Defaults to 3s.
+	Timeout *metav1.Duration
+}
+
 // AuditConfig contains settings for audit of the api server
 type AuditConfig struct {
 	// AuditPolicy contains configuration settings for audit policy of the kube-apiserver.
 	AuditPolicy *AuditPolicy
+	// Backend contains configuration settings for the backend to which the kube-apiserver sends the audit events. If not
+	// set, the audit events are written to the log file of the kube-apiserver.
+	Backend *AuditBackend
 }
 
 // AuditPolicy contains audit policy for kube-apiserver
@@ -1402,6 +1755,75 @@ type AuditPolicy struct {
 	ConfigMapRef *corev1.ObjectReference
 }
 
+// AuditBackend contains settings for the backend to which the kube-apiserver sends the audit events. Exactly one of
+// Log or Webhook must be set.
+type AuditBackend struct {
+	// Log contains configuration settings for writing the audit events to the log file of the kube-apiserver.
+	Log *AuditLogBackend
+	// Webhook contains configuration settings for sending the audit events to an external webhook, e.g. a SIEM system.
+	// The log file of the kube-apiserver is not written if the webhook is configured.
+	Webhook *AuditWebhookBackend
+}
+
+// AuditLogBackend contains settings for writing the audit events to the log file of the kube-apiserver.
+type AuditLogBackend struct {
+	// MaxAge is the maximum number of days to retain old audit log files.
+	MaxAge *int32
+	// MaxBackups is the maximum number of old audit log files to retain. Defaults to 5.
+	MaxBackups *int32
+	// MaxSize is the maximum size in megabytes of the audit log file before it gets rotated. Defaults to 100.
+	MaxSize *int32
+	// Buffering contains configuration settings for buffering and batching the audit events.
+	Buffering *AuditBuffering
+}
+
+// AuditWebhookBackend contains settings for sending the audit events to an external webhook.
+type AuditWebhookBackend struct {
+	// SecretRef is a reference to a secret in the same namespace as the Shoot which contains a kubeconfig (data key
+	// `kubeconfig`) that defines the address of and the credentials for the webhook. All certificates and credentials
+	// must be contained inline, references to files or credential plugins are not supported.
+	SecretRef corev1.LocalObjectReference
+	// InitialBackoff is the time to wait before retrying the first failed request to the webhook. Defaults to 10s.
+	InitialBackoff *metav1.Duration
+	// Buffering contains configuration settings for buffering and batching the audit events.
+	Buffering *AuditBuffering
+}
+
+// AuditBuffering contains settings for buffering and batching audit events before they are sent to a backend.
+type AuditBuffering struct {
+	// Mode is the strategy for sending the audit events. In `batch` mode, the events are buffered and sent
+	// asynchronously. In `blocking` mode, the kube-apiserver blocks its responses until the events have been sent, and in
+	// `blocking-strict` mode, requests fail if the audit events cannot be sent.
+	Mode AuditMode
+	// BufferSize is the size of the buffer to store the audit events before batching and sending them. Only used in
+	// `batch` mode.
+	BufferSize *int32
+	// MaxBatchSize is the maximum number of audit events in one batch. Only used in `batch` mode.
+	MaxBatchSize *int32
+	// MaxBatchWait is the maximum time to wait before a batch is sent even if it is not full. Only used in `batch` mode.
+	MaxBatchWait *metav1.Duration
+	// ThrottleQPS is the maximum average number of batches per second. If set, the batches are throttled. Only used in
+	// `batch` mode.
+	ThrottleQPS *int32
+	// ThrottleBurst is the maximum number of batches sent at the same moment if ThrottleQPS was not utilized before.
+	// Only used in `batch` mode.
+	ThrottleBurst *int32
+}
+
+// AuditMode is a strategy for sending audit events to a backend.
+type AuditMode string
+
+const (
+	// AuditModeBatch is a constant for the audit mode which buffers the audit events and sends them asynchronously.
+	AuditModeBatch AuditMode = "batch"
+	// AuditModeBlocking is a constant for the audit mode which blocks the responses of the kube-apiserver until the
+	// audit events have been sent.
+	AuditModeBlocking AuditMode = "blocking"
+	// AuditModeBlockingStrict is a constant for the audit mode which is like the blocking mode, but lets the requests
+	// fail if the audit events cannot be sent.
+	AuditModeBlockingStrict AuditMode = "blocking-strict"
+)
+
 // OIDCConfig contains configuration settings for the OIDC provider.
 // Note: Descriptions were taken from the Kubernetes documentation.
 type OIDCConfig struct {
@@ -1457,6 +1879,34 @@ type KubeControllerManagerConfig struct {
 	HorizontalPodAutoscalerConfig *HorizontalPodAutoscalerConfig
 	// NodeCIDRMaskSize defines the mask size for node cidr in cluster (default is 24)
 	NodeCIDRMaskSize *int
+	// NodeMonitorGracePeriod is the amount of time which a running node is allowed to be unresponsive before it is
+	// marked unhealthy (default: 80s).
+	NodeMonitorGracePeriod *metav1.Duration
+	// PodEvictionTimeout is the grace period for deleting pods on failed nodes (default: 2m).
+	PodEvictionTimeout *metav1.Duration
+	// ConcurrentSyncs contains the number of objects which are allowed to be synced concurrently by the controllers of
+	// the kube-controller-manager.
+	ConcurrentSyncs *KubeControllerManagerConcurrentSyncs
+}
+
+// KubeControllerManagerConcurrentSyncs contains the number of objects which are allowed to be synced concurrently by
+// the controllers of the kube-controller-manager. Larger numbers result in more responsive controllers but cause more
+// load on the kube-apiserver.
+type KubeControllerManagerConcurrentSyncs struct {
+	// Deployments is the number of deployments that are allowed to sync concurrently (default: 10).
+	Deployments *int32
+	// Endpoints is the number of endpoints that are allowed to sync concurrently (default: 5).
+	Endpoints *int32
+	// GarbageCollector is the number of garbage collector workers that are allowed to sync concurrently (default: 20).
+	GarbageCollector *int32
+	// Namespaces is the number of namespaces that are allowed to sync concurrently (default: 10).
+	Namespaces *int32
+	// ReplicaSets is the number of replica sets that are allowed to sync concurrently (default: 10).
+	ReplicaSets *int32
+	// ResourceQuotas is the number of resource quotas that are allowed to sync concurrently (default: 5).
+	ResourceQuotas *int32
+	// ServiceAccountTokens is the number of service account token objects that are allowed to sync concurrently (default: 5).
+	ServiceAccountTokens *int32
 }
 
 // HorizontalPodAutoscalerConfig contains horizontal pod autoscaler configuration settings for the kube-controller-manager.
@@ -1481,8 +1931,22 @@ type HorizontalPodAutoscalerConfig struct {
 // KubeSchedulerConfig contains configuration settings for the kube-scheduler.
 type KubeSchedulerConfig struct {
 	KubernetesConfig
+	// Profile configures the scoring strategy of the kube-scheduler (default: balanced).
+	Profile *SchedulingProfile
 }
 
+// SchedulingProfile is a string alias for the scoring strategy of the kube-scheduler.
+type SchedulingProfile string
+
+const (
+	// SchedulingProfileBalanced is a scheduling profile that attempts to spread the pods evenly across the nodes to
+	// obtain a more balanced resource usage.
+	SchedulingProfileBalanced SchedulingProfile = "balanced"
+	// SchedulingProfileBinPacking is a scheduling profile that scores nodes based on the allocation of resources. It
+	// prioritizes nodes with the most allocated resources so that the number of required nodes is minimized.
+	SchedulingProfileBinPacking SchedulingProfile = "bin-packing"
+)
+
 // KubeProxyConfig contains configuration settings for the kube-proxy.
 type KubeProxyConfig struct {
 	KubernetesConfig
@@ -1657,6 +2121,8 @@ type Worker struct {
 	Annotations map[string]string
 	// CABundle is a certificate bundle which will be installed onto every machine of this worker pool.
 	CABundle *string
+	// CRI contains the configuration of the container runtime of every machine of this worker pool. Defaults to Docker.
+	CRI *CRI
 	// Kubernetes contains configuration for Kubernetes components related to this worker pool.
 	Kubernetes *WorkerKubernetes
 	// Labels is a map of key/value pairs for labels for all the `Node` objects in this worker pool.
@@ -1704,6 +2170,22 @@ type WorkerKubernetes struct {
 	Kubelet *KubeletConfig
 }
 
+// CRI contains information about the Container Runtimes.
+type CRI struct {
+	// Name is the name of the CRI library.
+	Name CRIName
+}
+
+// CRIName is a type for specifying the name of a container runtime interface implementation.
+type CRIName string
+
+const (
+	// CRINameContainerD is a constant for the ContainerD CRI name.
+	CRINameContainerD CRIName = "containerd"
+	// CRINameDocker is a constant for the Docker CRI name.
+	CRINameDocker CRIName = "docker"
+)
+
 // Machine contains information about the machine type and image.
 type Machine struct {
 	// Type is the machine type of the worker group.
@@ -1805,4 +2287,13 @@ const (
 	ShootAPIServerAvailable ConditionType = "APIServerAvailable"
 	// ShootHibernationPossible is a constant for a condition type indicating whether the Shoot can be hibernated.
 	ShootHibernationPossible ConditionType = "HibernationPossible"
+	// ShootConditionsStable is a constant for a condition type indicating whether the health conditions of the Shoot
+	// are stable, i.e., they did not flap within the flapping detection window.
+	ShootConditionsStable ConditionType = "ConditionsStable"
+	// ShootCertificatesValid is a constant for a condition type indicating whether the certificates which have been
+	// generated for the Shoot are valid and do not expire soon.
+	ShootCertificatesValid ConditionType = "CertificatesValid"
+	// ShootKubeletConfigInSync is a constant for a condition type indicating whether the effective kubelet
+	// configuration of all nodes matches the desired one.
+	ShootKubeletConfigInSync ConditionType = "KubeletConfigInSync"
 )
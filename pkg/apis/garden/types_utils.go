@@ -42,6 +42,22 @@ type Condition struct {
 	Message string
 }
 
+// ConditionHistory contains the most recent status transitions of a condition.
+type ConditionHistory struct {
+	// Type is the type of the condition.
+	Type ConditionType
+	// Transitions are the most recent status transitions of the condition, ordered from oldest to newest.
+	Transitions []ConditionTransition
+}
+
+// ConditionTransition is a single status transition of a condition.
+type ConditionTransition struct {
+	// Status is the status the condition transitioned to.
+	Status ConditionStatus
+	// Time is the time of the transition.
+	Time metav1.Time
+}
+
 // ConditionStatus is the status of a condition.
 type ConditionStatus string
 
@@ -23,6 +23,7 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
@@ -44,6 +45,8 @@ var _ = Describe("Machine Image Conversion", func() {
 			Convert_garden_Seed_To_v1beta1_Seed,
 			Convert_v1beta1_Quota_To_garden_Quota,
 			Convert_garden_Quota_To_v1beta1_Quota,
+			Convert_v1beta1_Project_To_garden_Project,
+			Convert_garden_Project_To_v1beta1_Project,
 		)).NotTo(HaveOccurred())
 	})
 
@@ -473,6 +476,85 @@ var _ = Describe("Machine Image Conversion", func() {
 			})
 		})
 	})
+	Context("project conversions", func() {
+		var (
+			admin  = rbacv1.Subject{Kind: rbacv1.UserKind, Name: "admin"}
+			viewer = rbacv1.Subject{Kind: rbacv1.UserKind, Name: "viewer"}
+			uam    = rbacv1.Subject{Kind: rbacv1.UserKind, Name: "uam"}
+		)
+
+		It("should round-trip roles which cannot be expressed in v1beta1", func() {
+			in := &garden.Project{
+				Spec: garden.ProjectSpec{
+					ProjectMembers: []garden.ProjectMember{
+						{Subject: admin, Role: garden.ProjectMemberAdmin, Roles: []string{garden.ProjectMemberUserAccessManager}},
+						{Subject: viewer, Role: garden.ProjectMemberViewer},
+						{Subject: uam, Role: garden.ProjectMemberUserAccessManager, Roles: []string{"extension:foo"}},
+					},
+				},
+			}
+
+			external := &Project{}
+			Expect(scheme.Convert(in, external, nil)).To(Succeed())
+			Expect(external.Spec.Members).To(ConsistOf(admin))
+			Expect(external.Spec.Viewers).To(ConsistOf(viewer))
+			Expect(external.Annotations).To(HaveKey(garden.MigrationProjectMemberRoles))
+
+			out := &garden.Project{}
+			Expect(scheme.Convert(external, out, nil)).To(Succeed())
+			Expect(out.Annotations).NotTo(HaveKey(garden.MigrationProjectMemberRoles))
+			Expect(out.Spec.ProjectMembers).To(ConsistOf(in.Spec.ProjectMembers))
+		})
+
+		It("should not add the annotation if all roles can be expressed in v1beta1", func() {
+			in := &garden.Project{
+				Spec: garden.ProjectSpec{
+					ProjectMembers: []garden.ProjectMember{
+						{Subject: admin, Role: garden.ProjectMemberAdmin},
+						{Subject: viewer, Role: garden.ProjectMemberViewer},
+					},
+				},
+			}
+
+			out := &Project{}
+			Expect(scheme.Convert(in, out, nil)).To(Succeed())
+			Expect(out.Annotations).NotTo(HaveKey(garden.MigrationProjectMemberRoles))
+		})
+
+		It("should drop the additional roles of admins removed via v1beta1 and merge roles of promoted members", func() {
+			external := &Project{}
+			Expect(scheme.Convert(&garden.Project{
+				Spec: garden.ProjectSpec{
+					ProjectMembers: []garden.ProjectMember{
+						{Subject: admin, Role: garden.ProjectMemberAdmin, Roles: []string{"extension:foo"}},
+						{Subject: uam, Role: garden.ProjectMemberUserAccessManager},
+					},
+				},
+			}, external, nil)).To(Succeed())
+
+			external.Spec.Members = []rbacv1.Subject{uam}
+
+			out := &garden.Project{}
+			Expect(scheme.Convert(external, out, nil)).To(Succeed())
+			Expect(out.Spec.ProjectMembers).To(ConsistOf(
+				garden.ProjectMember{Subject: uam, Role: garden.ProjectMemberAdmin, Roles: []string{garden.ProjectMemberUserAccessManager}},
+			))
+		})
+	})
+	Context("worker conversions", func() {
+		Describe("#Convert_garden_Worker_To_v1beta1_AWSWorker", func() {
+			It("should correctly convert the container runtime", func() {
+				in := &garden.Worker{
+					Name: "cpu-worker",
+					CRI:  &garden.CRI{Name: garden.CRINameContainerD},
+				}
+				out := &AWSWorker{}
+
+				Expect(Convert_garden_Worker_To_v1beta1_AWSWorker(in, out, nil)).To(Succeed())
+				Expect(out.CRI).To(Equal(&CRI{Name: CRINameContainerD}))
+			})
+		})
+	})
 })
 
 var _ = Describe("Kubernetes Constraint Conversion", func() {
@@ -355,6 +355,102 @@ func Convert_v1beta1_SeedNetworks_To_garden_SeedNetworks(in *SeedNetworks, out *
 	return autoConvert_v1beta1_SeedNetworks_To_garden_SeedNetworks(in, out, s)
 }
 
+func Convert_v1beta1_Project_To_garden_Project(in *Project, out *garden.Project, s conversion.Scope) error {
+	if err := autoConvert_v1beta1_Project_To_garden_Project(in, out, s); err != nil {
+		return err
+	}
+
+	v, ok := in.Annotations[garden.MigrationProjectMemberRoles]
+	if !ok {
+		return nil
+	}
+
+	var memberRoles []garden.ProjectMember
+	if err := json.Unmarshal([]byte(v), &memberRoles); err != nil {
+		return err
+	}
+
+	for _, memberRole := range memberRoles {
+		primaryRole := memberRole.Role == garden.ProjectMemberAdmin || memberRole.Role == garden.ProjectMemberViewer
+
+		found := false
+		for i, member := range out.Spec.ProjectMembers {
+			if member.Subject != memberRole.Subject {
+				continue
+			}
+			found = true
+
+			// The member might have been added as admin or viewer via the v1beta1 API in the meantime, hence, its
+			// additional roles are merged into the roles of the existing member.
+			roles := memberRole.Roles
+			if !primaryRole {
+				roles = append([]string{memberRole.Role}, roles...)
+			}
+			for _, role := range roles {
+				if role != member.Role && !utils.ValueExists(role, out.Spec.ProjectMembers[i].Roles) {
+					out.Spec.ProjectMembers[i].Roles = append(out.Spec.ProjectMembers[i].Roles, role)
+				}
+			}
+		}
+
+		// Admins and viewers which are no longer part of the v1beta1 members have been removed, so their
+		// additional roles are dropped as well.
+		if !found && !primaryRole {
+			out.Spec.ProjectMembers = append(out.Spec.ProjectMembers, memberRole)
+		}
+	}
+
+	out.Annotations = make(map[string]string, len(in.Annotations))
+	for k, v := range in.Annotations {
+		if k != garden.MigrationProjectMemberRoles {
+			out.Annotations[k] = v
+		}
+	}
+
+	return nil
+}
+
+func Convert_garden_Project_To_v1beta1_Project(in *garden.Project, out *Project, s conversion.Scope) error {
+	if err := autoConvert_garden_Project_To_v1beta1_Project(in, out, s); err != nil {
+		return err
+	}
+
+	var memberRoles []garden.ProjectMember
+	for _, member := range in.Spec.ProjectMembers {
+		if (member.Role == garden.ProjectMemberAdmin || member.Role == garden.ProjectMemberViewer) && len(member.Roles) == 0 {
+			continue
+		}
+		memberRoles = append(memberRoles, member)
+	}
+
+	if len(memberRoles) == 0 {
+		if _, ok := out.Annotations[garden.MigrationProjectMemberRoles]; ok {
+			old := out.Annotations
+			out.Annotations = make(map[string]string, len(old))
+			for k, v := range old {
+				if k != garden.MigrationProjectMemberRoles {
+					out.Annotations[k] = v
+				}
+			}
+		}
+		return nil
+	}
+
+	old := out.Annotations
+	out.Annotations = make(map[string]string, len(old)+1)
+	for k, v := range old {
+		out.Annotations[k] = v
+	}
+
+	data, err := json.Marshal(memberRoles)
+	if err != nil {
+		return err
+	}
+	out.Annotations[garden.MigrationProjectMemberRoles] = string(data)
+
+	return nil
+}
+
 func Convert_v1beta1_ProjectSpec_To_garden_ProjectSpec(in *ProjectSpec, out *garden.ProjectSpec, s conversion.Scope) error {
 	if err := autoConvert_v1beta1_ProjectSpec_To_garden_ProjectSpec(in, out, s); err != nil {
 		return err
@@ -1477,6 +1573,14 @@ func Convert_v1beta1_Shoot_To_garden_Shoot(in *Shoot, out *garden.Shoot, s conve
 				w.Kubernetes = &garden.WorkerKubernetes{Kubelet: kubeletConfig}
 			}
 
+			if worker.CRI != nil {
+				cri := &garden.CRI{}
+				if err := autoConvert_v1beta1_CRI_To_garden_CRI(worker.CRI, cri, s); err != nil {
+					return err
+				}
+				w.CRI = cri
+			}
+
 			if data, ok := workerMigrationInfo[worker.Name]; ok {
 				w.ProviderConfig = data.ProviderConfig
 				w.Zones = data.Zones
@@ -1619,6 +1723,14 @@ func Convert_v1beta1_Shoot_To_garden_Shoot(in *Shoot, out *garden.Shoot, s conve
 				w.Kubernetes = &garden.WorkerKubernetes{Kubelet: kubeletConfig}
 			}
 
+			if worker.CRI != nil {
+				cri := &garden.CRI{}
+				if err := autoConvert_v1beta1_CRI_To_garden_CRI(worker.CRI, cri, s); err != nil {
+					return err
+				}
+				w.CRI = cri
+			}
+
 			if data, ok := workerMigrationInfo[worker.Name]; ok {
 				w.ProviderConfig = data.ProviderConfig
 				w.Zones = data.Zones
@@ -1755,6 +1867,14 @@ func Convert_v1beta1_Shoot_To_garden_Shoot(in *Shoot, out *garden.Shoot, s conve
 				w.Kubernetes = &garden.WorkerKubernetes{Kubelet: kubeletConfig}
 			}
 
+			if worker.CRI != nil {
+				cri := &garden.CRI{}
+				if err := autoConvert_v1beta1_CRI_To_garden_CRI(worker.CRI, cri, s); err != nil {
+					return err
+				}
+				w.CRI = cri
+			}
+
 			if data, ok := workerMigrationInfo[worker.Name]; ok {
 				w.ProviderConfig = data.ProviderConfig
 				w.Zones = data.Zones
@@ -1891,6 +2011,14 @@ func Convert_v1beta1_Shoot_To_garden_Shoot(in *Shoot, out *garden.Shoot, s conve
 				w.Kubernetes = &garden.WorkerKubernetes{Kubelet: kubeletConfig}
 			}
 
+			if worker.CRI != nil {
+				cri := &garden.CRI{}
+				if err := autoConvert_v1beta1_CRI_To_garden_CRI(worker.CRI, cri, s); err != nil {
+					return err
+				}
+				w.CRI = cri
+			}
+
 			if data, ok := workerMigrationInfo[worker.Name]; ok {
 				w.ProviderConfig = data.ProviderConfig
 				w.Zones = data.Zones
@@ -2032,6 +2160,14 @@ func Convert_v1beta1_Shoot_To_garden_Shoot(in *Shoot, out *garden.Shoot, s conve
 				w.Kubernetes = &garden.WorkerKubernetes{Kubelet: kubeletConfig}
 			}
 
+			if worker.CRI != nil {
+				cri := &garden.CRI{}
+				if err := autoConvert_v1beta1_CRI_To_garden_CRI(worker.CRI, cri, s); err != nil {
+					return err
+				}
+				w.CRI = cri
+			}
+
 			if data, ok := workerMigrationInfo[worker.Name]; ok {
 				w.ProviderConfig = data.ProviderConfig
 				w.Zones = data.Zones
@@ -2134,6 +2270,14 @@ func Convert_v1beta1_Shoot_To_garden_Shoot(in *Shoot, out *garden.Shoot, s conve
 				w.Kubernetes = &garden.WorkerKubernetes{Kubelet: kubeletConfig}
 			}
 
+			if worker.CRI != nil {
+				cri := &garden.CRI{}
+				if err := autoConvert_v1beta1_CRI_To_garden_CRI(worker.CRI, cri, s); err != nil {
+					return err
+				}
+				w.CRI = cri
+			}
+
 			if data, ok := workerMigrationInfo[worker.Name]; ok {
 				w.ProviderConfig = data.ProviderConfig
 				w.Zones = data.Zones
@@ -2363,6 +2507,15 @@ func Convert_garden_Worker_To_v1beta1_AWSWorker(in *garden.Worker, out *AWSWorke
 	}
 	out.Kubelet = kubeletConfig
 
+	var cri *CRI
+	if in.CRI != nil {
+		cri = &CRI{}
+		if err := autoConvert_garden_CRI_To_v1beta1_CRI(in.CRI, cri, s); err != nil {
+			return err
+		}
+	}
+	out.CRI = cri
+
 	return nil
 }
 
@@ -2407,6 +2560,15 @@ func Convert_garden_Worker_To_v1beta1_AzureWorker(in *garden.Worker, out *AzureW
 	}
 	out.Kubelet = kubeletConfig
 
+	var cri *CRI
+	if in.CRI != nil {
+		cri = &CRI{}
+		if err := autoConvert_garden_CRI_To_v1beta1_CRI(in.CRI, cri, s); err != nil {
+			return err
+		}
+	}
+	out.CRI = cri
+
 	return nil
 }
 
@@ -2451,6 +2613,15 @@ func Convert_garden_Worker_To_v1beta1_GCPWorker(in *garden.Worker, out *GCPWorke
 	}
 	out.Kubelet = kubeletConfig
 
+	var cri *CRI
+	if in.CRI != nil {
+		cri = &CRI{}
+		if err := autoConvert_garden_CRI_To_v1beta1_CRI(in.CRI, cri, s); err != nil {
+			return err
+		}
+	}
+	out.CRI = cri
+
 	return nil
 }
 
@@ -2488,6 +2659,15 @@ func Convert_garden_Worker_To_v1beta1_OpenStackWorker(in *garden.Worker, out *Op
 	}
 	out.Kubelet = kubeletConfig
 
+	var cri *CRI
+	if in.CRI != nil {
+		cri = &CRI{}
+		if err := autoConvert_garden_CRI_To_v1beta1_CRI(in.CRI, cri, s); err != nil {
+			return err
+		}
+	}
+	out.CRI = cri
+
 	return nil
 }
 
@@ -2532,6 +2712,15 @@ func Convert_garden_Worker_To_v1beta1_AlicloudWorker(in *garden.Worker, out *Ali
 	}
 	out.Kubelet = kubeletConfig
 
+	var cri *CRI
+	if in.CRI != nil {
+		cri = &CRI{}
+		if err := autoConvert_garden_CRI_To_v1beta1_CRI(in.CRI, cri, s); err != nil {
+			return err
+		}
+	}
+	out.CRI = cri
+
 	return nil
 }
 
@@ -2576,6 +2765,15 @@ func Convert_garden_Worker_To_v1beta1_PacketWorker(in *garden.Worker, out *Packe
 	}
 	out.Kubelet = kubeletConfig
 
+	var cri *CRI
+	if in.CRI != nil {
+		cri = &CRI{}
+		if err := autoConvert_garden_CRI_To_v1beta1_CRI(in.CRI, cri, s); err != nil {
+			return err
+		}
+	}
+	out.CRI = cri
+
 	return nil
 }
 
@@ -16,12 +16,14 @@ package v1beta1
 
 import (
 	"math"
+	"time"
 
 	"github.com/gardener/gardener/pkg/apis/garden"
 	"github.com/gardener/gardener/pkg/utils"
 
 	rbacv1 "k8s.io/api/rbac/v1"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -185,6 +187,9 @@ func SetDefaults_Shoot(obj *Shoot) {
 			obj.Spec.Kubernetes.KubeAPIServer.EnableBasicAuthentication = &falseVar
 		}
 	}
+	if rotation := obj.Spec.Kubernetes.KubeAPIServer.StaticCredentialsRotation; rotation != nil && rotation.TransitionPeriod == nil {
+		rotation.TransitionPeriod = &metav1.Duration{Duration: 24 * time.Hour}
+	}
 
 	if obj.Spec.Kubernetes.KubeProxy == nil {
 		obj.Spec.Kubernetes.KubeProxy = &KubeProxyConfig{}
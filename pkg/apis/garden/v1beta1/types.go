@@ -465,6 +465,16 @@ type BackupProfile struct {
 	// the object store where backups should be stored. It should have enough privileges to manipulate
 	// the objects as well as buckets.
 	SecretRef corev1.SecretReference `json:"secretRef"`
+	// Secondary is a secondary location into which the backups are replicated. It uses the same provider and
+	// credentials as the primary location.
+	// +optional
+	Secondary *SecondaryBackupLocation `json:"secondary,omitempty"`
+}
+
+// SecondaryBackupLocation contains information about a secondary location into which the backups are replicated.
+type SecondaryBackupLocation struct {
+	// Region is the region of the secondary location. It must differ from the region of the primary location.
+	Region string `json:"region"`
 }
 
 ////////////////////////////////////////////////////
@@ -529,6 +539,9 @@ type ProjectSpec struct {
 	// that should be part of this project with limited permissions to only view some resources.
 	// +optional
 	Viewers []rbacv1.Subject `json:"viewers,omitempty"`
+	// BackupRetentionPolicy is the default retention policy for the backups of the Shoots in the project.
+	// +optional
+	BackupRetentionPolicy *BackupRetentionPolicy `json:"backupRetentionPolicy,omitempty"`
 }
 
 // ProjectStatus holds the most recently observed status of the project.
@@ -657,6 +670,15 @@ type SeedNetworks struct {
 	// ShootDefaults contains the default networks CIDRs for shoots.
 	// +optional
 	ShootDefaults *ShootNetworks `json:"shootDefaults,omitempty"`
+	// ShootPools contains network pools from which non-overlapping networks are allocated to shoots which do not
+	// specify their node or pod network.
+	// +optional
+	ShootPools *ShootNetworkPools `json:"shootPools,omitempty"`
+	// IPFamilies specifies the IP protocol versions used by the networks of the Seed. The first family is the primary
+	// one. Dual-stack networking is configured by specifying both IPv4 and IPv6, in this case the pod, node and service
+	// networks contain one CIDR per IP family, separated by a comma and in the same order. Defaults to IPv4 if empty.
+	// +optional
+	IPFamilies []IPFamily `json:"ipFamilies,omitempty"`
 }
 
 // ShootNetworks contains the default networks CIDRs for shoots.
@@ -669,6 +691,25 @@ type ShootNetworks struct {
 	Services *string `json:"services,omitempty"`
 }
 
+// ShootNetworkPools contains network pools from which networks are allocated to shoots.
+type ShootNetworkPools struct {
+	// Nodes is the pool from which the node networks of shoots are allocated.
+	// +optional
+	Nodes *NetworkPool `json:"nodes,omitempty"`
+	// Pods is the pool from which the pod networks of shoots are allocated.
+	// +optional
+	Pods *NetworkPool `json:"pods,omitempty"`
+}
+
+// NetworkPool is a network from which equally sized networks are allocated.
+type NetworkPool struct {
+	// CIDR is the network from which the networks are allocated.
+	CIDR string `json:"cidr"`
+	// PrefixLength is the prefix length of the allocated networks. It must not be smaller than the prefix length of
+	// the pool CIDR.
+	PrefixLength int32 `json:"prefixLength"`
+}
+
 ////////////////////////////////////////////////////
 //                      QUOTAS                    //
 ////////////////////////////////////////////////////
@@ -787,8 +828,14 @@ type ShootSpec struct {
 	// Addons contains information about enabled/disabled addons and their configuration.
 	// +optional
 	Addons *Addons `json:"addons,omitempty"`
+	// Backup contains configuration settings for the backup of the Shoot's etcd.
+	// +optional
+	Backup *ShootBackup `json:"backup,omitempty"`
 	// Cloud contains information about the cloud environment and their specific settings.
 	Cloud Cloud `json:"cloud"`
+	// ControlPlane contains configuration settings for the control plane of the Shoot.
+	// +optional
+	ControlPlane *ControlPlane `json:"controlPlane,omitempty"`
 	// DNS contains information about the DNS settings of the Shoot.
 	// +optional
 	DNS *DNS `json:"dns,omitempty"`
@@ -811,8 +858,90 @@ type ShootSpec struct {
 	Monitoring *Monitoring `json:"monitoring,omitempty"`
 }
 
+// ShootBackup contains configuration settings for the backup of the Shoot's etcd.
+type ShootBackup struct {
+	// RetentionPolicy defines how long the snapshots of the Shoot's etcd are kept. It overrides the default retention
+	// policy of the Project.
+	// +optional
+	RetentionPolicy *BackupRetentionPolicy `json:"retentionPolicy,omitempty"`
+}
+
+// ControlPlane contains configuration settings for the control plane of the Shoot.
+type ControlPlane struct {
+	// HighAvailability configures the control plane of the Shoot to be highly available. It can only be set when the
+	// Shoot is created.
+	// +optional
+	HighAvailability *HighAvailability `json:"highAvailability,omitempty"`
+	// NetworkPolicyRules is a list of additional rules allowing egress traffic from pods of the control plane of the
+	// Shoot in the seed cluster. The destinations must not overlap with the blocked CIDRs or the node, pod and service
+	// networks of the seed.
+	// +optional
+	NetworkPolicyRules []NetworkPolicyRule `json:"networkPolicyRules,omitempty"`
+}
+
+// NetworkPolicyRule allows egress traffic from selected pods of the control plane of a Shoot to the given networks.
+type NetworkPolicyRule struct {
+	// Name is the name of the rule. It must be unique among the rules of the Shoot and is part of the name of the
+	// generated NetworkPolicy.
+	Name string `json:"name"`
+	// PodSelector selects the pods of the control plane in the seed cluster to which the rule applies. It must not be
+	// empty, and the etcd pods are never selected.
+	PodSelector metav1.LabelSelector `json:"podSelector"`
+	// CIDRs is a list of destination networks to which egress traffic is allowed.
+	CIDRs []string `json:"cidrs"`
+	// Ports is a list of destination ports to which egress traffic is allowed. If empty, all ports are allowed.
+	// +optional
+	Ports []NetworkPolicyRulePort `json:"ports,omitempty"`
+}
+
+// NetworkPolicyRulePort is a destination port of a network policy rule.
+type NetworkPolicyRulePort struct {
+	// Protocol is the protocol of the port (TCP, UDP or SCTP). Defaults to TCP.
+	// +optional
+	Protocol *corev1.Protocol `json:"protocol,omitempty"`
+	// Port is the number of the port.
+	Port int32 `json:"port"`
+}
+
+// HighAvailability specifies the configuration settings for a highly available control plane.
+type HighAvailability struct {
+	// FailureTolerance holds information about the failure tolerance of the control plane.
+	FailureTolerance FailureTolerance `json:"failureTolerance"`
+}
+
+// FailureTolerance describes the failure tolerance of a highly available control plane.
+type FailureTolerance struct {
+	// Type specifies the failure domain of the seed across which the replicas of the control plane components are
+	// spread, i.e. whether the control plane tolerates the loss of a node or of an entire zone.
+	Type FailureToleranceType `json:"type"`
+}
+
+// FailureToleranceType specifies the failure domain across which a highly available control plane is spread.
+type FailureToleranceType string
+
+const (
+	// FailureToleranceTypeNode specifies that the control plane tolerates the loss of a single node of the seed.
+	FailureToleranceTypeNode FailureToleranceType = "node"
+	// FailureToleranceTypeZone specifies that the control plane tolerates the loss of a single zone of the seed.
+	FailureToleranceTypeZone FailureToleranceType = "zone"
+)
+
+// BackupRetentionPolicy defines how long the snapshots of a backup are kept.
+type BackupRetentionPolicy struct {
+	// DailySnapshots is the number of days for which the latest snapshot of each day is kept.
+	// +optional
+	DailySnapshots *int32 `json:"dailySnapshots,omitempty"`
+	// WeeklySnapshots is the number of weeks for which the latest snapshot of each week is kept.
+	// +optional
+	WeeklySnapshots *int32 `json:"weeklySnapshots,omitempty"`
+}
+
 // ShootStatus holds the most recently observed status of the Shoot cluster.
 type ShootStatus struct {
+	// Availability contains the availability of the Shoot's components over several periods as derived from the
+	// health checks of the care controller.
+	// +optional
+	Availability *ShootAvailability `json:"availability,omitempty"`
 	// Conditions represents the latest available observations of a Shoots's current state.
 	// +patchMergeKey=type
 	// +patchStrategy=merge
@@ -823,8 +952,25 @@ type ShootStatus struct {
 	// +patchStrategy=merge
 	// +optional
 	Constraints []gardencorev1alpha1.Condition `json:"constraints,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+	// ConditionHistories contains a bounded history of the status transitions of the Shoot's conditions.
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	// +optional
+	ConditionHistories []gardencorev1alpha1.ConditionHistory `json:"conditionHistories,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+	// Credentials contains information about the credentials of the Shoot cluster, e.g. the status of their
+	// rotation.
+	// +optional
+	Credentials *ShootCredentials `json:"credentials,omitempty"`
+	// ETCDBackup contains information about the on-demand operations on the backup of the Shoot's etcd, i.e., taking
+	// snapshots and restoring the etcd from its backup.
+	// +optional
+	ETCDBackup *ShootETCDBackup `json:"etcdBackup,omitempty"`
 	// Gardener holds information about the Gardener which last acted on the Shoot.
 	Gardener Gardener `json:"gardener"`
+	// KubeletConfigDrifts contains the nodes of the Shoot whose effective kubelet configuration differs from the
+	// desired one as detected by the care controller. At most 10 nodes are listed.
+	// +optional
+	KubeletConfigDrifts []NodeKubeletConfigDrift `json:"kubeletConfigDrifts,omitempty"`
 	// LastOperation holds information about the last operation on the Shoot.
 	// +optional
 	LastOperation *gardencorev1alpha1.LastOperation `json:"lastOperation,omitempty"`
@@ -856,6 +1002,144 @@ type ShootStatus struct {
 	UID types.UID `json:"uid"`
 }
 
+// NodeKubeletConfigDrift contains the differences between the desired and the effective kubelet configuration of a
+// node.
+type NodeKubeletConfigDrift struct {
+	// Node is the name of the node.
+	Node string `json:"node"`
+	// WorkerPool is the name of the worker pool the node belongs to.
+	// +optional
+	WorkerPool *string `json:"workerPool,omitempty"`
+	// Fields contains the fields of the kubelet configuration whose effective values differ from the desired ones.
+	Fields []KubeletConfigFieldDrift `json:"fields"`
+}
+
+// KubeletConfigFieldDrift contains the desired and the effective value of a field of the kubelet configuration.
+type KubeletConfigFieldDrift struct {
+	// Name is the name of the field in the kubelet configuration file, e.g. `maxPods` or
+	// `evictionHard[memory.available]`.
+	Name string `json:"name"`
+	// Desired is the desired value of the field.
+	Desired string `json:"desired"`
+	// Actual is the effective value of the field on the node. It is empty if the field is not set.
+	// +optional
+	Actual string `json:"actual,omitempty"`
+}
+
+// ShootAvailability contains the availability of the Shoot's components over several periods.
+type ShootAvailability struct {
+	// APIServer contains the availability ratios of the Shoot's API server as derived from the health checks.
+	// +optional
+	APIServer []AvailabilityRatio `json:"apiServer,omitempty"`
+}
+
+// AvailabilityRatio is the ratio of successful availability probes within a period.
+type AvailabilityRatio struct {
+	// Period is the duration the ratio has been computed for.
+	Period metav1.Duration `json:"period"`
+	// Ratio is the percentage of successful probes within the period, e.g. "99.950".
+	Ratio string `json:"ratio"`
+	// Probes is the number of probes which have been performed within the period.
+	Probes int64 `json:"probes"`
+}
+
+// ShootETCDBackup contains information about the on-demand operations on the backup of the Shoot's etcd.
+type ShootETCDBackup struct {
+	// LastSnapshot contains information about the last on-demand full snapshot of the etcd.
+	// +optional
+	LastSnapshot *ETCDBackupOperation `json:"lastSnapshot,omitempty"`
+	// LastRestore contains information about the last restoration of the etcd from its backup.
+	// +optional
+	LastRestore *ETCDBackupOperation `json:"lastRestore,omitempty"`
+}
+
+// ETCDBackupOperation contains information about an on-demand operation on the backup of the Shoot's etcd.
+type ETCDBackupOperation struct {
+	// State is the current state of the operation.
+	State gardencorev1alpha1.LastOperationState `json:"state"`
+	// Description is a human-readable message describing the progress of the operation.
+	Description string `json:"description"`
+	// Progress is the progress of the operation in percent.
+	Progress int `json:"progress"`
+	// LastUpdateTime is the time at which the operation was updated the last time.
+	LastUpdateTime metav1.Time `json:"lastUpdateTime"`
+	// Snapshot is the name of the full snapshot which has been taken. For restorations, it is the final snapshot of the
+	// replaced etcd.
+	// +optional
+	Snapshot *string `json:"snapshot,omitempty"`
+}
+
+// ShootCredentials contains information about the credentials of the Shoot cluster.
+type ShootCredentials struct {
+	// Rotation contains information about the rotation of credentials.
+	// +optional
+	Rotation *ShootCredentialsRotation `json:"rotation,omitempty"`
+}
+
+// ShootCredentialsRotation contains information about the rotation of credentials.
+type ShootCredentialsRotation struct {
+	// CertificateAuthorities contains information about the rotation of the certificate authorities.
+	// +optional
+	CertificateAuthorities *CARotation `json:"certificateAuthorities,omitempty"`
+	// StaticCredentials contains information about the rotation of the static tokens and the basic authentication
+	// password of the kube-apiserver.
+	// +optional
+	StaticCredentials *StaticCredentialsRotation `json:"staticCredentials,omitempty"`
+	// ETCDEncryptionKey contains information about the rotation of the key which is used to encrypt resources in etcd.
+	// +optional
+	ETCDEncryptionKey *ETCDEncryptionKeyRotation `json:"etcdEncryptionKey,omitempty"`
+}
+
+// StaticCredentialsRotation contains information about the rotation of the static tokens and the basic authentication
+// password of the kube-apiserver of the Shoot cluster.
+type StaticCredentialsRotation struct {
+	// LastRotationTime is the most recent time when the static credentials were rotated.
+	// +optional
+	LastRotationTime *metav1.Time `json:"lastRotationTime,omitempty"`
+}
+
+// CARotation contains information about the rotation of the certificate authorities of the Shoot cluster.
+type CARotation struct {
+	// Phase describes the phase of the certificate authority rotation.
+	Phase CredentialsRotationPhase `json:"phase"`
+	// LastInitiationTime is the most recent time when the certificate authority rotation was initiated.
+	// +optional
+	LastInitiationTime *metav1.Time `json:"lastInitiationTime,omitempty"`
+	// LastCompletionTime is the most recent time when the certificate authority rotation was successfully completed.
+	// +optional
+	LastCompletionTime *metav1.Time `json:"lastCompletionTime,omitempty"`
+}
+
+// ETCDEncryptionKeyRotation contains information about the rotation of the key which is used by the kube-apiserver to
+// encrypt resources in etcd.
+type ETCDEncryptionKeyRotation struct {
+	// Phase describes the phase of the etcd encryption key rotation.
+	Phase CredentialsRotationPhase `json:"phase"`
+	// LastInitiationTime is the most recent time when the etcd encryption key rotation was initiated.
+	// +optional
+	LastInitiationTime *metav1.Time `json:"lastInitiationTime,omitempty"`
+	// LastCompletionTime is the most recent time when the etcd encryption key rotation was successfully completed.
+	// +optional
+	LastCompletionTime *metav1.Time `json:"lastCompletionTime,omitempty"`
+}
+
+// CredentialsRotationPhase is a string alias.
+type CredentialsRotationPhase string
+
+const (
+	// RotationPreparing is a constant for the credentials rotation phase describing that the procedure is being prepared,
+	// i.e. new credentials are introduced alongside the old ones.
+	RotationPreparing CredentialsRotationPhase = "Preparing"
+	// RotationPrepared is a constant for the credentials rotation phase describing that the procedure was prepared and
+	// that all components trust both the old and the new credentials.
+	RotationPrepared CredentialsRotationPhase = "Prepared"
+	// RotationCompleting is a constant for the credentials rotation phase describing that the procedure is being
+	// completed, i.e. the old credentials are removed.
+	RotationCompleting CredentialsRotationPhase = "Completing"
+	// RotationCompleted is a constant for the credentials rotation phase describing that the procedure was completed.
+	RotationCompleted CredentialsRotationPhase = "Completed"
+)
+
 ///////////////////////////////
 // Shoot Specification Types //
 ///////////////////////////////
@@ -871,8 +1155,23 @@ type Networking struct {
 	// ProviderConfig is the configuration passed to network resource.
 	// +optional
 	ProviderConfig *gardencorev1alpha1.ProviderConfig `json:"providerConfig,omitempty"`
+	// IPFamilies specifies the IP protocol versions used by the networks of the Shoot. The first family is the primary
+	// one. Dual-stack networking is configured by specifying both IPv4 and IPv6, in this case the pod, node and service
+	// networks contain one CIDR per IP family, separated by a comma and in the same order. Defaults to IPv4 if empty.
+	// +optional
+	IPFamilies []IPFamily `json:"ipFamilies,omitempty"`
 }
 
+// IPFamily is a type for specifying an IP protocol version.
+type IPFamily string
+
+const (
+	// IPFamilyIPv4 is the IPv4 IP family.
+	IPFamilyIPv4 IPFamily = "IPv4"
+	// IPFamilyIPv6 is the IPv6 IP family.
+	IPFamilyIPv6 IPFamily = "IPv6"
+)
+
 // Cloud contains information about the cloud environment and their specific settings.
 // It must contain exactly one key of the below cloud providers.
 type Cloud struct {
@@ -1235,8 +1534,27 @@ type Worker struct {
 	// CABundle is a certificate bundle which will be installed onto every machine of this worker pool.
 	// +optional
 	CABundle *string `json:"caBundle,omitempty"`
+	// CRI contains the configuration of the container runtime of every machine of this worker pool. Defaults to Docker.
+	// +optional
+	CRI *CRI `json:"cri,omitempty"`
+}
+
+// CRI contains information about the Container Runtimes.
+type CRI struct {
+	// Name is the name of the CRI library.
+	Name CRIName `json:"name"`
 }
 
+// CRIName is a type for specifying the name of a container runtime interface implementation.
+type CRIName string
+
+const (
+	// CRINameContainerD is a constant for the ContainerD CRI name.
+	CRINameContainerD CRIName = "containerd"
+	// CRINameDocker is a constant for the Docker CRI name.
+	CRINameDocker CRIName = "docker"
+)
+
 var (
 	// DefaultWorkerMaxSurge is the default value for Worker MaxSurge.
 	DefaultWorkerMaxSurge = intstr.FromInt(1)
@@ -1530,6 +1848,9 @@ type KubeAPIServerConfig struct {
 	// EnableBasicAuthentication defines whether basic authentication should be enabled for this cluster or not.
 	// +optional
 	EnableBasicAuthentication *bool `json:"enableBasicAuthentication,omitempty"`
+	// EncryptionConfig contains customizable encryption configuration of the kube-apiserver.
+	// +optional
+	EncryptionConfig *EncryptionConfig `json:"encryptionConfig,omitempty"`
 	// OIDCConfig contains configuration settings for the OIDC provider.
 	// +optional
 	OIDCConfig *OIDCConfig `json:"oidcConfig,omitempty"`
@@ -1540,6 +1861,69 @@ type KubeAPIServerConfig struct {
 	// of the kube-apiserver.
 	// +optional
 	ServiceAccountConfig *ServiceAccountConfig `json:"serviceAccountConfig,omitempty"`
+
+	// StaticCredentialsRotation contains configuration settings for the scheduled rotation of the static tokens and
+	// the basic authentication password of the kube-apiserver.
+	// +optional
+	StaticCredentialsRotation *StaticCredentialsRotationConfig `json:"staticCredentialsRotation,omitempty"`
+	// AuthenticationWebhook contains configuration settings for a webhook which authenticates bearer tokens in addition
+	// to the built-in authenticators of the kube-apiserver.
+	// +optional
+	AuthenticationWebhook *AuthenticationWebhook `json:"authenticationWebhook,omitempty"`
+	// AuthorizationWebhook contains configuration settings for a webhook which authorizes requests after the Node and
+	// RBAC authorizers of the kube-apiserver.
+	// +optional
+	AuthorizationWebhook *AuthorizationWebhook `json:"authorizationWebhook,omitempty"`
+	// AdmissionWebhookConfig contains configuration settings for the admission plugins which call the validating and
+	// mutating admission webhooks. It is written to the admission control config file of the kube-apiserver.
+	// +optional
+	AdmissionWebhookConfig *AdmissionWebhookConfig `json:"admissionWebhookConfig,omitempty"`
+}
+
+// StaticCredentialsRotationConfig contains configuration settings for the scheduled rotation of the static tokens and
+// the basic authentication password of the kube-apiserver.
+type StaticCredentialsRotationConfig struct {
+	// Period is the interval after which the static tokens and the basic authentication password are rotated.
+	Period metav1.Duration `json:"period"`
+	// TransitionPeriod is the duration for which the previous static tokens remain valid after a rotation.
+	// Defaults to 24h.
+	// +optional
+	TransitionPeriod *metav1.Duration `json:"transitionPeriod,omitempty"`
+}
+
+// AuthenticationWebhook contains configuration settings for a webhook which authenticates bearer tokens.
+type AuthenticationWebhook struct {
+	// SecretRef is a reference to a secret in the same namespace as the Shoot which contains a kubeconfig (data key
+	// `kubeconfig`) that defines the address of and the credentials for the webhook. All certificates and credentials
+	// must be contained inline, references to files or credential plugins are not supported.
+	SecretRef corev1.LocalObjectReference `json:"secretRef"`
+	// CacheTTL is the duration to cache the responses of the webhook. Defaults to 2m.
+	// +optional
+	CacheTTL *metav1.Duration `json:"cacheTTL,omitempty"`
+}
+
+// AuthorizationWebhook contains configuration settings for a webhook which authorizes requests.
+type AuthorizationWebhook struct {
+	// SecretRef is a reference to a secret in the same namespace as the Shoot which contains a kubeconfig (data key
+	// `kubeconfig`) that defines the address of and the credentials for the webhook. All certificates and credentials
+	// must be contained inline, references to files or credential plugins are not supported.
+	SecretRef corev1.LocalObjectReference `json:"secretRef"`
+	// CacheAuthorizedTTL is the duration to cache authorized responses of the webhook. Defaults to 5m.
+	// +optional
+	CacheAuthorizedTTL *metav1.Duration `json:"cacheAuthorizedTTL,omitempty"`
+	// CacheUnauthorizedTTL is the duration to cache unauthorized responses of the webhook. Defaults to 30s.
+	// +optional
+	CacheUnauthorizedTTL *metav1.Duration `json:"cacheUnauthorizedTTL,omitempty"`
+}
+
+// AdmissionWebhookConfig contains configuration settings for the MutatingAdmissionWebhook and
+// ValidatingAdmissionWebhook admission plugins.
+type AdmissionWebhookConfig struct {
+	// SecretRef is a reference to a secret in the same namespace as the Shoot which contains a kubeconfig (data key
+	// `kubeconfig`) with the credentials the kube-apiserver uses to authenticate to the servers of admission webhooks.
+	// The users of the kubeconfig are matched with the webhook servers by their names, i.e., the host name and optional
+	// port of the server (`*` wildcards are supported). All certificates and credentials must be contained inline.
+	SecretRef corev1.LocalObjectReference `json:"secretRef"`
 }
 
 // ServiceAccountConfig is the kube-apiserver configuration for service accounts.
@@ -1555,11 +1939,42 @@ type ServiceAccountConfig struct {
 	SigningKeySecret *corev1.LocalObjectReference `json:"signingKeySecretName,omitempty"`
 }
 
+// EncryptionConfig contains customizable encryption configuration of the kube-apiserver.
+type EncryptionConfig struct {
+	// Resources contains the list of resources that shall be encrypted in etcd in addition to secrets. Each item is a
+	// Kubernetes resource name in plural (resource or resource.group), e.g., configmaps or flunders.example.com.
+	// Removed resources are decrypted again.
+	// +optional
+	Resources []string `json:"resources,omitempty"`
+	// KMS configures a KMS provider which is used to encrypt the resources instead of the aescbc provider. The KMS
+	// plugin is run as sidecar of the kube-apiserver by the extension which is responsible for the given type.
+	// +optional
+	KMS *KMSProvider `json:"kms,omitempty"`
+}
+
+// KMSProvider contains the configuration of a KMS provider for the encryption of resources in etcd.
+type KMSProvider struct {
+	// Type is the type of the KMS plugin. An extension must be registered for it which runs the plugin as sidecar of
+	// the kube-apiserver.
+	Type string `json:"type"`
+	// CacheSize is the maximum number of data encryption keys which are cached in memory by the kube-apiserver.
+	// Defaults to 1000.
+	// +optional
+	CacheSize *int32 `json:"cacheSize,omitempty"`
+	// Timeout is the timeout for calls of the kube-apiserver to the KMS plugin. Defaults to 3s.
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+}
+
 // AuditConfig contains settings for audit of the api server
 type AuditConfig struct {
 	// AuditPolicy contains configuration settings for audit policy of the kube-apiserver.
 	// +optional
 	AuditPolicy *AuditPolicy `json:"auditPolicy,omitempty"`
+	// Backend contains configuration settings for the backend to which the kube-apiserver sends the audit events. If not
+	// set, the audit events are written to the log file of the kube-apiserver.
+	// +optional
+	Backend *AuditBackend `json:"backend,omitempty"`
 }
 
 // AuditPolicy contains audit policy for kube-apiserver
@@ -1570,6 +1985,88 @@ type AuditPolicy struct {
 	ConfigMapRef *corev1.ObjectReference `json:"configMapRef,omitempty"`
 }
 
+// AuditBackend contains settings for the backend to which the kube-apiserver sends the audit events. Exactly one of
+// Log or Webhook must be set.
+type AuditBackend struct {
+	// Log contains configuration settings for writing the audit events to the log file of the kube-apiserver.
+	// +optional
+	Log *AuditLogBackend `json:"log,omitempty"`
+	// Webhook contains configuration settings for sending the audit events to an external webhook, e.g. a SIEM system.
+	// The log file of the kube-apiserver is not written if the webhook is configured.
+	// +optional
+	Webhook *AuditWebhookBackend `json:"webhook,omitempty"`
+}
+
+// AuditLogBackend contains settings for writing the audit events to the log file of the kube-apiserver.
+type AuditLogBackend struct {
+	// MaxAge is the maximum number of days to retain old audit log files.
+	// +optional
+	MaxAge *int32 `json:"maxAge,omitempty"`
+	// MaxBackups is the maximum number of old audit log files to retain. Defaults to 5.
+	// +optional
+	MaxBackups *int32 `json:"maxBackups,omitempty"`
+	// MaxSize is the maximum size in megabytes of the audit log file before it gets rotated. Defaults to 100.
+	// +optional
+	MaxSize *int32 `json:"maxSize,omitempty"`
+	// Buffering contains configuration settings for buffering and batching the audit events.
+	// +optional
+	Buffering *AuditBuffering `json:"buffering,omitempty"`
+}
+
+// AuditWebhookBackend contains settings for sending the audit events to an external webhook.
+type AuditWebhookBackend struct {
+	// SecretRef is a reference to a secret in the same namespace as the Shoot which contains a kubeconfig (data key
+	// `kubeconfig`) that defines the address of and the credentials for the webhook. All certificates and credentials
+	// must be contained inline, references to files or credential plugins are not supported.
+	SecretRef corev1.LocalObjectReference `json:"secretRef"`
+	// InitialBackoff is the time to wait before retrying the first failed request to the webhook. Defaults to 10s.
+	// +optional
+	InitialBackoff *metav1.Duration `json:"initialBackoff,omitempty"`
+	// Buffering contains configuration settings for buffering and batching the audit events.
+	// +optional
+	Buffering *AuditBuffering `json:"buffering,omitempty"`
+}
+
+// AuditBuffering contains settings for buffering and batching audit events before they are sent to a backend.
+type AuditBuffering struct {
+	// Mode is the strategy for sending the audit events. In `batch` mode, the events are buffered and sent
+	// asynchronously. In `blocking` mode, the kube-apiserver blocks its responses until the events have been sent, and in
+	// `blocking-strict` mode, requests fail if the audit events cannot be sent.
+	Mode AuditMode `json:"mode"`
+	// BufferSize is the size of the buffer to store the audit events before batching and sending them. Only used in
+	// `batch` mode.
+	// +optional
+	BufferSize *int32 `json:"bufferSize,omitempty"`
+	// MaxBatchSize is the maximum number of audit events in one batch. Only used in `batch` mode.
+	// +optional
+	MaxBatchSize *int32 `json:"maxBatchSize,omitempty"`
+	// MaxBatchWait is the maximum time to wait before a batch is sent even if it is not full. Only used in `batch` mode.
+	// +optional
+	MaxBatchWait *metav1.Duration `json:"maxBatchWait,omitempty"`
+	// ThrottleQPS is the maximum average number of batches per second. If set, the batches are throttled. Only used in
+	// `batch` mode.
+	// +optional
+	ThrottleQPS *int32 `json:"throttleQPS,omitempty"`
+	// ThrottleBurst is the maximum number of batches sent at the same moment if ThrottleQPS was not utilized before.
+	// Only used in `batch` mode.
+	// +optional
+	ThrottleBurst *int32 `json:"throttleBurst,omitempty"`
+}
+
+// AuditMode is a strategy for sending audit events to a backend.
+type AuditMode string
+
+const (
+	// AuditModeBatch is a constant for the audit mode which buffers the audit events and sends them asynchronously.
+	AuditModeBatch AuditMode = "batch"
+	// AuditModeBlocking is a constant for the audit mode which blocks the responses of the kube-apiserver until the
+	// audit events have been sent.
+	AuditModeBlocking AuditMode = "blocking"
+	// AuditModeBlockingStrict is a constant for the audit mode which is like the blocking mode, but lets the requests
+	// fail if the audit events cannot be sent.
+	AuditModeBlockingStrict AuditMode = "blocking-strict"
+)
+
 // OIDCConfig contains configuration settings for the OIDC provider.
 // Note: Descriptions were taken from the Kubernetes documentation.
 type OIDCConfig struct {
@@ -1640,6 +2137,44 @@ type KubeControllerManagerConfig struct {
 	// NodeCIDRMaskSize defines the mask size for node cidr in cluster (default is 24)
 	// +optional
 	NodeCIDRMaskSize *int `json:"nodeCIDRMaskSize,omitempty"`
+	// NodeMonitorGracePeriod is the amount of time which a running node is allowed to be unresponsive before it is
+	// marked unhealthy (default: 80s).
+	// +optional
+	NodeMonitorGracePeriod *metav1.Duration `json:"nodeMonitorGracePeriod,omitempty"`
+	// PodEvictionTimeout is the grace period for deleting pods on failed nodes (default: 2m).
+	// +optional
+	PodEvictionTimeout *metav1.Duration `json:"podEvictionTimeout,omitempty"`
+	// ConcurrentSyncs contains the number of objects which are allowed to be synced concurrently by the controllers of
+	// the kube-controller-manager.
+	// +optional
+	ConcurrentSyncs *KubeControllerManagerConcurrentSyncs `json:"concurrentSyncs,omitempty"`
+}
+
+// KubeControllerManagerConcurrentSyncs contains the number of objects which are allowed to be synced concurrently by
+// the controllers of the kube-controller-manager. Larger numbers result in more responsive controllers but cause more
+// load on the kube-apiserver.
+type KubeControllerManagerConcurrentSyncs struct {
+	// Deployments is the number of deployments that are allowed to sync concurrently (default: 10).
+	// +optional
+	Deployments *int32 `json:"deployments,omitempty"`
+	// Endpoints is the number of endpoints that are allowed to sync concurrently (default: 5).
+	// +optional
+	Endpoints *int32 `json:"endpoints,omitempty"`
+	// GarbageCollector is the number of garbage collector workers that are allowed to sync concurrently (default: 20).
+	// +optional
+	GarbageCollector *int32 `json:"garbageCollector,omitempty"`
+	// Namespaces is the number of namespaces that are allowed to sync concurrently (default: 10).
+	// +optional
+	Namespaces *int32 `json:"namespaces,omitempty"`
+	// ReplicaSets is the number of replica sets that are allowed to sync concurrently (default: 10).
+	// +optional
+	ReplicaSets *int32 `json:"replicaSets,omitempty"`
+	// ResourceQuotas is the number of resource quotas that are allowed to sync concurrently (default: 5).
+	// +optional
+	ResourceQuotas *int32 `json:"resourceQuotas,omitempty"`
+	// ServiceAccountTokens is the number of service account token objects that are allowed to sync concurrently (default: 5).
+	// +optional
+	ServiceAccountTokens *int32 `json:"serviceAccountTokens,omitempty"`
 }
 
 // GardenerDuration is a workaround for missing OpenAPI functions on metav1.Duration struct.
@@ -1724,8 +2259,23 @@ const (
 // KubeSchedulerConfig contains configuration settings for the kube-scheduler.
 type KubeSchedulerConfig struct {
 	KubernetesConfig `json:",inline"`
+	// Profile configures the scoring strategy of the kube-scheduler (default: balanced).
+	// +optional
+	Profile *SchedulingProfile `json:"profile,omitempty"`
 }
 
+// SchedulingProfile is a string alias for the scoring strategy of the kube-scheduler.
+type SchedulingProfile string
+
+const (
+	// SchedulingProfileBalanced is a scheduling profile that attempts to spread the pods evenly across the nodes to
+	// obtain a more balanced resource usage.
+	SchedulingProfileBalanced SchedulingProfile = "balanced"
+	// SchedulingProfileBinPacking is a scheduling profile that scores nodes based on the allocation of resources. It
+	// prioritizes nodes with the most allocated resources so that the number of required nodes is minimized.
+	SchedulingProfileBinPacking SchedulingProfile = "bin-packing"
+)
+
 // KubeProxyConfig contains configuration settings for the kube-proxy.
 type KubeProxyConfig struct {
 	KubernetesConfig `json:",inline"`
@@ -2001,6 +2551,15 @@ const (
 	ShootAPIServerAvailable gardencorev1alpha1.ConditionType = "APIServerAvailable"
 	// ShootHibernationPossible is a constant for a condition type indicating whether the Shoot can be hibernated.
 	ShootHibernationPossible gardencorev1alpha1.ConditionType = "HibernationPossible"
+	// ShootConditionsStable is a constant for a condition type indicating whether the health conditions of the Shoot
+	// are stable, i.e., they did not flap within the flapping detection window.
+	ShootConditionsStable gardencorev1alpha1.ConditionType = "ConditionsStable"
+	// ShootCertificatesValid is a constant for a condition type indicating whether the certificates which have been
+	// generated for the Shoot are valid and do not expire soon.
+	ShootCertificatesValid gardencorev1alpha1.ConditionType = "CertificatesValid"
+	// ShootKubeletConfigInSync is a constant for a condition type indicating whether the effective kubelet
+	// configuration of all nodes matches the desired one.
+	ShootKubeletConfigInSync gardencorev1alpha1.ConditionType = "KubeletConfigInSync"
 )
 
 const (
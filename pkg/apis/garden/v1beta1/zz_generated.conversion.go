@@ -9,10 +9,10 @@ import (
 
 	v1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
 	garden "github.com/gardener/gardener/pkg/apis/garden"
-	v1 "k8s.io/api/core/v1"
+	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	resource "k8s.io/apimachinery/pkg/api/resource"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 	types "k8s.io/apimachinery/pkg/types"
@@ -116,6 +116,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*AdmissionWebhookConfig)(nil), (*garden.AdmissionWebhookConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_AdmissionWebhookConfig_To_garden_AdmissionWebhookConfig(a.(*AdmissionWebhookConfig), b.(*garden.AdmissionWebhookConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.AdmissionWebhookConfig)(nil), (*AdmissionWebhookConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_AdmissionWebhookConfig_To_v1beta1_AdmissionWebhookConfig(a.(*garden.AdmissionWebhookConfig), b.(*AdmissionWebhookConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Alerting)(nil), (*garden.Alerting)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_Alerting_To_garden_Alerting(a.(*Alerting), b.(*garden.Alerting), scope)
 	}); err != nil {
@@ -196,6 +206,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*AuditBackend)(nil), (*garden.AuditBackend)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_AuditBackend_To_garden_AuditBackend(a.(*AuditBackend), b.(*garden.AuditBackend), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.AuditBackend)(nil), (*AuditBackend)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_AuditBackend_To_v1beta1_AuditBackend(a.(*garden.AuditBackend), b.(*AuditBackend), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AuditBuffering)(nil), (*garden.AuditBuffering)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_AuditBuffering_To_garden_AuditBuffering(a.(*AuditBuffering), b.(*garden.AuditBuffering), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.AuditBuffering)(nil), (*AuditBuffering)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_AuditBuffering_To_v1beta1_AuditBuffering(a.(*garden.AuditBuffering), b.(*AuditBuffering), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*AuditConfig)(nil), (*garden.AuditConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_AuditConfig_To_garden_AuditConfig(a.(*AuditConfig), b.(*garden.AuditConfig), scope)
 	}); err != nil {
@@ -206,6 +236,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*AuditLogBackend)(nil), (*garden.AuditLogBackend)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_AuditLogBackend_To_garden_AuditLogBackend(a.(*AuditLogBackend), b.(*garden.AuditLogBackend), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.AuditLogBackend)(nil), (*AuditLogBackend)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_AuditLogBackend_To_v1beta1_AuditLogBackend(a.(*garden.AuditLogBackend), b.(*AuditLogBackend), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*AuditPolicy)(nil), (*garden.AuditPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_AuditPolicy_To_garden_AuditPolicy(a.(*AuditPolicy), b.(*garden.AuditPolicy), scope)
 	}); err != nil {
@@ -216,6 +256,46 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*AuditWebhookBackend)(nil), (*garden.AuditWebhookBackend)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_AuditWebhookBackend_To_garden_AuditWebhookBackend(a.(*AuditWebhookBackend), b.(*garden.AuditWebhookBackend), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.AuditWebhookBackend)(nil), (*AuditWebhookBackend)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_AuditWebhookBackend_To_v1beta1_AuditWebhookBackend(a.(*garden.AuditWebhookBackend), b.(*AuditWebhookBackend), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AuthenticationWebhook)(nil), (*garden.AuthenticationWebhook)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_AuthenticationWebhook_To_garden_AuthenticationWebhook(a.(*AuthenticationWebhook), b.(*garden.AuthenticationWebhook), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.AuthenticationWebhook)(nil), (*AuthenticationWebhook)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_AuthenticationWebhook_To_v1beta1_AuthenticationWebhook(a.(*garden.AuthenticationWebhook), b.(*AuthenticationWebhook), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AuthorizationWebhook)(nil), (*garden.AuthorizationWebhook)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_AuthorizationWebhook_To_garden_AuthorizationWebhook(a.(*AuthorizationWebhook), b.(*garden.AuthorizationWebhook), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.AuthorizationWebhook)(nil), (*AuthorizationWebhook)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_AuthorizationWebhook_To_v1beta1_AuthorizationWebhook(a.(*garden.AuthorizationWebhook), b.(*AuthorizationWebhook), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AvailabilityRatio)(nil), (*garden.AvailabilityRatio)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_AvailabilityRatio_To_garden_AvailabilityRatio(a.(*AvailabilityRatio), b.(*garden.AvailabilityRatio), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.AvailabilityRatio)(nil), (*AvailabilityRatio)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_AvailabilityRatio_To_v1beta1_AvailabilityRatio(a.(*garden.AvailabilityRatio), b.(*AvailabilityRatio), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*AzureCloud)(nil), (*garden.AzureCloud)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_AzureCloud_To_garden_AzureCloud(a.(*AzureCloud), b.(*garden.AzureCloud), scope)
 	}); err != nil {
@@ -286,6 +366,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*BackupRetentionPolicy)(nil), (*garden.BackupRetentionPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_BackupRetentionPolicy_To_garden_BackupRetentionPolicy(a.(*BackupRetentionPolicy), b.(*garden.BackupRetentionPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.BackupRetentionPolicy)(nil), (*BackupRetentionPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_BackupRetentionPolicy_To_v1beta1_BackupRetentionPolicy(a.(*garden.BackupRetentionPolicy), b.(*BackupRetentionPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*CARotation)(nil), (*garden.CARotation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_CARotation_To_garden_CARotation(a.(*CARotation), b.(*garden.CARotation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.CARotation)(nil), (*CARotation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_CARotation_To_v1beta1_CARotation(a.(*garden.CARotation), b.(*CARotation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*CRI)(nil), (*garden.CRI)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_CRI_To_garden_CRI(a.(*CRI), b.(*garden.CRI), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.CRI)(nil), (*CRI)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_CRI_To_v1beta1_CRI(a.(*garden.CRI), b.(*CRI), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Cloud)(nil), (*garden.Cloud)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_Cloud_To_garden_Cloud(a.(*Cloud), b.(*garden.Cloud), scope)
 	}); err != nil {
@@ -346,6 +456,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ControlPlane)(nil), (*garden.ControlPlane)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ControlPlane_To_garden_ControlPlane(a.(*ControlPlane), b.(*garden.ControlPlane), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.ControlPlane)(nil), (*ControlPlane)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_ControlPlane_To_v1beta1_ControlPlane(a.(*garden.ControlPlane), b.(*ControlPlane), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*DNS)(nil), (*garden.DNS)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_DNS_To_garden_DNS(a.(*DNS), b.(*garden.DNS), scope)
 	}); err != nil {
@@ -366,6 +486,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ETCDBackupOperation)(nil), (*garden.ETCDBackupOperation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ETCDBackupOperation_To_garden_ETCDBackupOperation(a.(*ETCDBackupOperation), b.(*garden.ETCDBackupOperation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.ETCDBackupOperation)(nil), (*ETCDBackupOperation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_ETCDBackupOperation_To_v1beta1_ETCDBackupOperation(a.(*garden.ETCDBackupOperation), b.(*ETCDBackupOperation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ETCDEncryptionKeyRotation)(nil), (*garden.ETCDEncryptionKeyRotation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ETCDEncryptionKeyRotation_To_garden_ETCDEncryptionKeyRotation(a.(*ETCDEncryptionKeyRotation), b.(*garden.ETCDEncryptionKeyRotation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.ETCDEncryptionKeyRotation)(nil), (*ETCDEncryptionKeyRotation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_ETCDEncryptionKeyRotation_To_v1beta1_ETCDEncryptionKeyRotation(a.(*garden.ETCDEncryptionKeyRotation), b.(*ETCDEncryptionKeyRotation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*EncryptionConfig)(nil), (*garden.EncryptionConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_EncryptionConfig_To_garden_EncryptionConfig(a.(*EncryptionConfig), b.(*garden.EncryptionConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.EncryptionConfig)(nil), (*EncryptionConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_EncryptionConfig_To_v1beta1_EncryptionConfig(a.(*garden.EncryptionConfig), b.(*EncryptionConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Extension)(nil), (*garden.Extension)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_Extension_To_garden_Extension(a.(*Extension), b.(*garden.Extension), scope)
 	}); err != nil {
@@ -376,6 +526,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*FailureTolerance)(nil), (*garden.FailureTolerance)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_FailureTolerance_To_garden_FailureTolerance(a.(*FailureTolerance), b.(*garden.FailureTolerance), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.FailureTolerance)(nil), (*FailureTolerance)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_FailureTolerance_To_v1beta1_FailureTolerance(a.(*garden.FailureTolerance), b.(*FailureTolerance), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*GCPCloud)(nil), (*garden.GCPCloud)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_GCPCloud_To_garden_GCPCloud(a.(*GCPCloud), b.(*garden.GCPCloud), scope)
 	}); err != nil {
@@ -476,6 +636,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*HighAvailability)(nil), (*garden.HighAvailability)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_HighAvailability_To_garden_HighAvailability(a.(*HighAvailability), b.(*garden.HighAvailability), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.HighAvailability)(nil), (*HighAvailability)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_HighAvailability_To_v1beta1_HighAvailability(a.(*garden.HighAvailability), b.(*HighAvailability), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*HorizontalPodAutoscalerConfig)(nil), (*garden.HorizontalPodAutoscalerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_HorizontalPodAutoscalerConfig_To_garden_HorizontalPodAutoscalerConfig(a.(*HorizontalPodAutoscalerConfig), b.(*garden.HorizontalPodAutoscalerConfig), scope)
 	}); err != nil {
@@ -496,6 +666,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*KMSProvider)(nil), (*garden.KMSProvider)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_KMSProvider_To_garden_KMSProvider(a.(*KMSProvider), b.(*garden.KMSProvider), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.KMSProvider)(nil), (*KMSProvider)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_KMSProvider_To_v1beta1_KMSProvider(a.(*garden.KMSProvider), b.(*KMSProvider), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Kube2IAM)(nil), (*garden.Kube2IAM)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_Kube2IAM_To_garden_Kube2IAM(a.(*Kube2IAM), b.(*garden.Kube2IAM), scope)
 	}); err != nil {
@@ -526,6 +706,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*KubeControllerManagerConcurrentSyncs)(nil), (*garden.KubeControllerManagerConcurrentSyncs)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_KubeControllerManagerConcurrentSyncs_To_garden_KubeControllerManagerConcurrentSyncs(a.(*KubeControllerManagerConcurrentSyncs), b.(*garden.KubeControllerManagerConcurrentSyncs), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.KubeControllerManagerConcurrentSyncs)(nil), (*KubeControllerManagerConcurrentSyncs)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_KubeControllerManagerConcurrentSyncs_To_v1beta1_KubeControllerManagerConcurrentSyncs(a.(*garden.KubeControllerManagerConcurrentSyncs), b.(*KubeControllerManagerConcurrentSyncs), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*KubeControllerManagerConfig)(nil), (*garden.KubeControllerManagerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_KubeControllerManagerConfig_To_garden_KubeControllerManagerConfig(a.(*KubeControllerManagerConfig), b.(*garden.KubeControllerManagerConfig), scope)
 	}); err != nil {
@@ -606,6 +796,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*KubeletConfigFieldDrift)(nil), (*garden.KubeletConfigFieldDrift)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_KubeletConfigFieldDrift_To_garden_KubeletConfigFieldDrift(a.(*KubeletConfigFieldDrift), b.(*garden.KubeletConfigFieldDrift), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.KubeletConfigFieldDrift)(nil), (*KubeletConfigFieldDrift)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_KubeletConfigFieldDrift_To_v1beta1_KubeletConfigFieldDrift(a.(*garden.KubeletConfigFieldDrift), b.(*KubeletConfigFieldDrift), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Kubernetes)(nil), (*garden.Kubernetes)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_Kubernetes_To_garden_Kubernetes(a.(*Kubernetes), b.(*garden.Kubernetes), scope)
 	}); err != nil {
@@ -746,6 +946,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*NetworkPolicyRule)(nil), (*garden.NetworkPolicyRule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_NetworkPolicyRule_To_garden_NetworkPolicyRule(a.(*NetworkPolicyRule), b.(*garden.NetworkPolicyRule), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.NetworkPolicyRule)(nil), (*NetworkPolicyRule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_NetworkPolicyRule_To_v1beta1_NetworkPolicyRule(a.(*garden.NetworkPolicyRule), b.(*NetworkPolicyRule), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NetworkPolicyRulePort)(nil), (*garden.NetworkPolicyRulePort)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_NetworkPolicyRulePort_To_garden_NetworkPolicyRulePort(a.(*NetworkPolicyRulePort), b.(*garden.NetworkPolicyRulePort), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.NetworkPolicyRulePort)(nil), (*NetworkPolicyRulePort)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_NetworkPolicyRulePort_To_v1beta1_NetworkPolicyRulePort(a.(*garden.NetworkPolicyRulePort), b.(*NetworkPolicyRulePort), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NetworkPool)(nil), (*garden.NetworkPool)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_NetworkPool_To_garden_NetworkPool(a.(*NetworkPool), b.(*garden.NetworkPool), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.NetworkPool)(nil), (*NetworkPool)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_NetworkPool_To_v1beta1_NetworkPool(a.(*garden.NetworkPool), b.(*NetworkPool), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Networking)(nil), (*garden.Networking)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_Networking_To_garden_Networking(a.(*Networking), b.(*garden.Networking), scope)
 	}); err != nil {
@@ -766,6 +996,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*NodeKubeletConfigDrift)(nil), (*garden.NodeKubeletConfigDrift)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_NodeKubeletConfigDrift_To_garden_NodeKubeletConfigDrift(a.(*NodeKubeletConfigDrift), b.(*garden.NodeKubeletConfigDrift), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.NodeKubeletConfigDrift)(nil), (*NodeKubeletConfigDrift)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_NodeKubeletConfigDrift_To_v1beta1_NodeKubeletConfigDrift(a.(*garden.NodeKubeletConfigDrift), b.(*NodeKubeletConfigDrift), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*OIDCConfig)(nil), (*garden.OIDCConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_OIDCConfig_To_garden_OIDCConfig(a.(*OIDCConfig), b.(*garden.OIDCConfig), scope)
 	}); err != nil {
@@ -986,6 +1226,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*SecondaryBackupLocation)(nil), (*garden.SecondaryBackupLocation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_SecondaryBackupLocation_To_garden_SecondaryBackupLocation(a.(*SecondaryBackupLocation), b.(*garden.SecondaryBackupLocation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.SecondaryBackupLocation)(nil), (*SecondaryBackupLocation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_SecondaryBackupLocation_To_v1beta1_SecondaryBackupLocation(a.(*garden.SecondaryBackupLocation), b.(*SecondaryBackupLocation), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*SecretBinding)(nil), (*garden.SecretBinding)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_SecretBinding_To_garden_SecretBinding(a.(*SecretBinding), b.(*garden.SecretBinding), scope)
 	}); err != nil {
@@ -1086,6 +1336,56 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ShootAvailability)(nil), (*garden.ShootAvailability)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ShootAvailability_To_garden_ShootAvailability(a.(*ShootAvailability), b.(*garden.ShootAvailability), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.ShootAvailability)(nil), (*ShootAvailability)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_ShootAvailability_To_v1beta1_ShootAvailability(a.(*garden.ShootAvailability), b.(*ShootAvailability), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ShootBackup)(nil), (*garden.ShootBackup)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ShootBackup_To_garden_ShootBackup(a.(*ShootBackup), b.(*garden.ShootBackup), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.ShootBackup)(nil), (*ShootBackup)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_ShootBackup_To_v1beta1_ShootBackup(a.(*garden.ShootBackup), b.(*ShootBackup), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ShootCredentials)(nil), (*garden.ShootCredentials)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ShootCredentials_To_garden_ShootCredentials(a.(*ShootCredentials), b.(*garden.ShootCredentials), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.ShootCredentials)(nil), (*ShootCredentials)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_ShootCredentials_To_v1beta1_ShootCredentials(a.(*garden.ShootCredentials), b.(*ShootCredentials), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ShootCredentialsRotation)(nil), (*garden.ShootCredentialsRotation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ShootCredentialsRotation_To_garden_ShootCredentialsRotation(a.(*ShootCredentialsRotation), b.(*garden.ShootCredentialsRotation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.ShootCredentialsRotation)(nil), (*ShootCredentialsRotation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_ShootCredentialsRotation_To_v1beta1_ShootCredentialsRotation(a.(*garden.ShootCredentialsRotation), b.(*ShootCredentialsRotation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ShootETCDBackup)(nil), (*garden.ShootETCDBackup)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ShootETCDBackup_To_garden_ShootETCDBackup(a.(*ShootETCDBackup), b.(*garden.ShootETCDBackup), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.ShootETCDBackup)(nil), (*ShootETCDBackup)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_ShootETCDBackup_To_v1beta1_ShootETCDBackup(a.(*garden.ShootETCDBackup), b.(*ShootETCDBackup), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ShootList)(nil), (*garden.ShootList)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_ShootList_To_garden_ShootList(a.(*ShootList), b.(*garden.ShootList), scope)
 	}); err != nil {
@@ -1106,6 +1406,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ShootNetworkPools)(nil), (*garden.ShootNetworkPools)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ShootNetworkPools_To_garden_ShootNetworkPools(a.(*ShootNetworkPools), b.(*garden.ShootNetworkPools), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.ShootNetworkPools)(nil), (*ShootNetworkPools)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_ShootNetworkPools_To_v1beta1_ShootNetworkPools(a.(*garden.ShootNetworkPools), b.(*ShootNetworkPools), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ShootNetworks)(nil), (*garden.ShootNetworks)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_ShootNetworks_To_garden_ShootNetworks(a.(*ShootNetworks), b.(*garden.ShootNetworks), scope)
 	}); err != nil {
@@ -1136,6 +1446,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*StaticCredentialsRotation)(nil), (*garden.StaticCredentialsRotation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_StaticCredentialsRotation_To_garden_StaticCredentialsRotation(a.(*StaticCredentialsRotation), b.(*garden.StaticCredentialsRotation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.StaticCredentialsRotation)(nil), (*StaticCredentialsRotation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_StaticCredentialsRotation_To_v1beta1_StaticCredentialsRotation(a.(*garden.StaticCredentialsRotation), b.(*StaticCredentialsRotation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*StaticCredentialsRotationConfig)(nil), (*garden.StaticCredentialsRotationConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_StaticCredentialsRotationConfig_To_garden_StaticCredentialsRotationConfig(a.(*StaticCredentialsRotationConfig), b.(*garden.StaticCredentialsRotationConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.StaticCredentialsRotationConfig)(nil), (*StaticCredentialsRotationConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_StaticCredentialsRotationConfig_To_v1beta1_StaticCredentialsRotationConfig(a.(*garden.StaticCredentialsRotationConfig), b.(*StaticCredentialsRotationConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*VolumeType)(nil), (*garden.VolumeType)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_VolumeType_To_garden_VolumeType(a.(*VolumeType), b.(*garden.VolumeType), scope)
 	}); err != nil {
@@ -1201,6 +1531,11 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*garden.Project)(nil), (*Project)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_Project_To_v1beta1_Project(a.(*garden.Project), b.(*Project), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*garden.QuotaSpec)(nil), (*QuotaSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_garden_QuotaSpec_To_v1beta1_QuotaSpec(a.(*garden.QuotaSpec), b.(*QuotaSpec), scope)
 	}); err != nil {
@@ -1336,6 +1671,11 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*Project)(nil), (*garden.Project)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_Project_To_garden_Project(a.(*Project), b.(*garden.Project), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*QuotaSpec)(nil), (*garden.QuotaSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_QuotaSpec_To_garden_QuotaSpec(a.(*QuotaSpec), b.(*garden.QuotaSpec), scope)
 	}); err != nil {
@@ -1679,6 +2019,26 @@ func Convert_garden_AdmissionPlugin_To_v1beta1_AdmissionPlugin(in *garden.Admiss
 	return autoConvert_garden_AdmissionPlugin_To_v1beta1_AdmissionPlugin(in, out, s)
 }
 
+func autoConvert_v1beta1_AdmissionWebhookConfig_To_garden_AdmissionWebhookConfig(in *AdmissionWebhookConfig, out *garden.AdmissionWebhookConfig, s conversion.Scope) error {
+	out.SecretRef = in.SecretRef
+	return nil
+}
+
+// Convert_v1beta1_AdmissionWebhookConfig_To_garden_AdmissionWebhookConfig is an autogenerated conversion function.
+func Convert_v1beta1_AdmissionWebhookConfig_To_garden_AdmissionWebhookConfig(in *AdmissionWebhookConfig, out *garden.AdmissionWebhookConfig, s conversion.Scope) error {
+	return autoConvert_v1beta1_AdmissionWebhookConfig_To_garden_AdmissionWebhookConfig(in, out, s)
+}
+
+func autoConvert_garden_AdmissionWebhookConfig_To_v1beta1_AdmissionWebhookConfig(in *garden.AdmissionWebhookConfig, out *AdmissionWebhookConfig, s conversion.Scope) error {
+	out.SecretRef = in.SecretRef
+	return nil
+}
+
+// Convert_garden_AdmissionWebhookConfig_To_v1beta1_AdmissionWebhookConfig is an autogenerated conversion function.
+func Convert_garden_AdmissionWebhookConfig_To_v1beta1_AdmissionWebhookConfig(in *garden.AdmissionWebhookConfig, out *AdmissionWebhookConfig, s conversion.Scope) error {
+	return autoConvert_garden_AdmissionWebhookConfig_To_v1beta1_AdmissionWebhookConfig(in, out, s)
+}
+
 func autoConvert_v1beta1_Alerting_To_garden_Alerting(in *Alerting, out *garden.Alerting, s conversion.Scope) error {
 	out.EmailReceivers = *(*[]string)(unsafe.Pointer(&in.EmailReceivers))
 	return nil
@@ -1949,8 +2309,61 @@ func Convert_garden_AlicloudVolumeType_To_v1beta1_AlicloudVolumeType(in *garden.
 	return autoConvert_garden_AlicloudVolumeType_To_v1beta1_AlicloudVolumeType(in, out, s)
 }
 
+func autoConvert_v1beta1_AuditBackend_To_garden_AuditBackend(in *AuditBackend, out *garden.AuditBackend, s conversion.Scope) error {
+	out.Log = (*garden.AuditLogBackend)(unsafe.Pointer(in.Log))
+	out.Webhook = (*garden.AuditWebhookBackend)(unsafe.Pointer(in.Webhook))
+	return nil
+}
+
+// Convert_v1beta1_AuditBackend_To_garden_AuditBackend is an autogenerated conversion function.
+func Convert_v1beta1_AuditBackend_To_garden_AuditBackend(in *AuditBackend, out *garden.AuditBackend, s conversion.Scope) error {
+	return autoConvert_v1beta1_AuditBackend_To_garden_AuditBackend(in, out, s)
+}
+
+func autoConvert_garden_AuditBackend_To_v1beta1_AuditBackend(in *garden.AuditBackend, out *AuditBackend, s conversion.Scope) error {
+	out.Log = (*AuditLogBackend)(unsafe.Pointer(in.Log))
+	out.Webhook = (*AuditWebhookBackend)(unsafe.Pointer(in.Webhook))
+	return nil
+}
+
+// Convert_garden_AuditBackend_To_v1beta1_AuditBackend is an autogenerated conversion function.
+func Convert_garden_AuditBackend_To_v1beta1_AuditBackend(in *garden.AuditBackend, out *AuditBackend, s conversion.Scope) error {
+	return autoConvert_garden_AuditBackend_To_v1beta1_AuditBackend(in, out, s)
+}
+
+func autoConvert_v1beta1_AuditBuffering_To_garden_AuditBuffering(in *AuditBuffering, out *garden.AuditBuffering, s conversion.Scope) error {
+	out.Mode = garden.AuditMode(in.Mode)
+	out.BufferSize = (*int32)(unsafe.Pointer(in.BufferSize))
+	out.MaxBatchSize = (*int32)(unsafe.Pointer(in.MaxBatchSize))
+	out.MaxBatchWait = (*v1.Duration)(unsafe.Pointer(in.MaxBatchWait))
+	out.ThrottleQPS = (*int32)(unsafe.Pointer(in.ThrottleQPS))
+	out.ThrottleBurst = (*int32)(unsafe.Pointer(in.ThrottleBurst))
+	return nil
+}
+
+// Convert_v1beta1_AuditBuffering_To_garden_AuditBuffering is an autogenerated conversion function.
+func Convert_v1beta1_AuditBuffering_To_garden_AuditBuffering(in *AuditBuffering, out *garden.AuditBuffering, s conversion.Scope) error {
+	return autoConvert_v1beta1_AuditBuffering_To_garden_AuditBuffering(in, out, s)
+}
+
+func autoConvert_garden_AuditBuffering_To_v1beta1_AuditBuffering(in *garden.AuditBuffering, out *AuditBuffering, s conversion.Scope) error {
+	out.Mode = AuditMode(in.Mode)
+	out.BufferSize = (*int32)(unsafe.Pointer(in.BufferSize))
+	out.MaxBatchSize = (*int32)(unsafe.Pointer(in.MaxBatchSize))
+	out.MaxBatchWait = (*v1.Duration)(unsafe.Pointer(in.MaxBatchWait))
+	out.ThrottleQPS = (*int32)(unsafe.Pointer(in.ThrottleQPS))
+	out.ThrottleBurst = (*int32)(unsafe.Pointer(in.ThrottleBurst))
+	return nil
+}
+
+// Convert_garden_AuditBuffering_To_v1beta1_AuditBuffering is an autogenerated conversion function.
+func Convert_garden_AuditBuffering_To_v1beta1_AuditBuffering(in *garden.AuditBuffering, out *AuditBuffering, s conversion.Scope) error {
+	return autoConvert_garden_AuditBuffering_To_v1beta1_AuditBuffering(in, out, s)
+}
+
 func autoConvert_v1beta1_AuditConfig_To_garden_AuditConfig(in *AuditConfig, out *garden.AuditConfig, s conversion.Scope) error {
 	out.AuditPolicy = (*garden.AuditPolicy)(unsafe.Pointer(in.AuditPolicy))
+	out.Backend = (*garden.AuditBackend)(unsafe.Pointer(in.Backend))
 	return nil
 }
 
@@ -1961,6 +2374,7 @@ func Convert_v1beta1_AuditConfig_To_garden_AuditConfig(in *AuditConfig, out *gar
 
 func autoConvert_garden_AuditConfig_To_v1beta1_AuditConfig(in *garden.AuditConfig, out *AuditConfig, s conversion.Scope) error {
 	out.AuditPolicy = (*AuditPolicy)(unsafe.Pointer(in.AuditPolicy))
+	out.Backend = (*AuditBackend)(unsafe.Pointer(in.Backend))
 	return nil
 }
 
@@ -1969,8 +2383,34 @@ func Convert_garden_AuditConfig_To_v1beta1_AuditConfig(in *garden.AuditConfig, o
 	return autoConvert_garden_AuditConfig_To_v1beta1_AuditConfig(in, out, s)
 }
 
+func autoConvert_v1beta1_AuditLogBackend_To_garden_AuditLogBackend(in *AuditLogBackend, out *garden.AuditLogBackend, s conversion.Scope) error {
+	out.MaxAge = (*int32)(unsafe.Pointer(in.MaxAge))
+	out.MaxBackups = (*int32)(unsafe.Pointer(in.MaxBackups))
+	out.MaxSize = (*int32)(unsafe.Pointer(in.MaxSize))
+	out.Buffering = (*garden.AuditBuffering)(unsafe.Pointer(in.Buffering))
+	return nil
+}
+
+// Convert_v1beta1_AuditLogBackend_To_garden_AuditLogBackend is an autogenerated conversion function.
+func Convert_v1beta1_AuditLogBackend_To_garden_AuditLogBackend(in *AuditLogBackend, out *garden.AuditLogBackend, s conversion.Scope) error {
+	return autoConvert_v1beta1_AuditLogBackend_To_garden_AuditLogBackend(in, out, s)
+}
+
+func autoConvert_garden_AuditLogBackend_To_v1beta1_AuditLogBackend(in *garden.AuditLogBackend, out *AuditLogBackend, s conversion.Scope) error {
+	out.MaxAge = (*int32)(unsafe.Pointer(in.MaxAge))
+	out.MaxBackups = (*int32)(unsafe.Pointer(in.MaxBackups))
+	out.MaxSize = (*int32)(unsafe.Pointer(in.MaxSize))
+	out.Buffering = (*AuditBuffering)(unsafe.Pointer(in.Buffering))
+	return nil
+}
+
+// Convert_garden_AuditLogBackend_To_v1beta1_AuditLogBackend is an autogenerated conversion function.
+func Convert_garden_AuditLogBackend_To_v1beta1_AuditLogBackend(in *garden.AuditLogBackend, out *AuditLogBackend, s conversion.Scope) error {
+	return autoConvert_garden_AuditLogBackend_To_v1beta1_AuditLogBackend(in, out, s)
+}
+
 func autoConvert_v1beta1_AuditPolicy_To_garden_AuditPolicy(in *AuditPolicy, out *garden.AuditPolicy, s conversion.Scope) error {
-	out.ConfigMapRef = (*v1.ObjectReference)(unsafe.Pointer(in.ConfigMapRef))
+	out.ConfigMapRef = (*corev1.ObjectReference)(unsafe.Pointer(in.ConfigMapRef))
 	return nil
 }
 
@@ -1980,7 +2420,7 @@ func Convert_v1beta1_AuditPolicy_To_garden_AuditPolicy(in *AuditPolicy, out *gar
 }
 
 func autoConvert_garden_AuditPolicy_To_v1beta1_AuditPolicy(in *garden.AuditPolicy, out *AuditPolicy, s conversion.Scope) error {
-	out.ConfigMapRef = (*v1.ObjectReference)(unsafe.Pointer(in.ConfigMapRef))
+	out.ConfigMapRef = (*corev1.ObjectReference)(unsafe.Pointer(in.ConfigMapRef))
 	return nil
 }
 
@@ -1989,6 +2429,100 @@ func Convert_garden_AuditPolicy_To_v1beta1_AuditPolicy(in *garden.AuditPolicy, o
 	return autoConvert_garden_AuditPolicy_To_v1beta1_AuditPolicy(in, out, s)
 }
 
+func autoConvert_v1beta1_AuditWebhookBackend_To_garden_AuditWebhookBackend(in *AuditWebhookBackend, out *garden.AuditWebhookBackend, s conversion.Scope) error {
+	out.SecretRef = in.SecretRef
+	out.InitialBackoff = (*v1.Duration)(unsafe.Pointer(in.InitialBackoff))
+	out.Buffering = (*garden.AuditBuffering)(unsafe.Pointer(in.Buffering))
+	return nil
+}
+
+// Convert_v1beta1_AuditWebhookBackend_To_garden_AuditWebhookBackend is an autogenerated conversion function.
+func Convert_v1beta1_AuditWebhookBackend_To_garden_AuditWebhookBackend(in *AuditWebhookBackend, out *garden.AuditWebhookBackend, s conversion.Scope) error {
+	return autoConvert_v1beta1_AuditWebhookBackend_To_garden_AuditWebhookBackend(in, out, s)
+}
+
+func autoConvert_garden_AuditWebhookBackend_To_v1beta1_AuditWebhookBackend(in *garden.AuditWebhookBackend, out *AuditWebhookBackend, s conversion.Scope) error {
+	out.SecretRef = in.SecretRef
+	out.InitialBackoff = (*v1.Duration)(unsafe.Pointer(in.InitialBackoff))
+	out.Buffering = (*AuditBuffering)(unsafe.Pointer(in.Buffering))
+	return nil
+}
+
+// Convert_garden_AuditWebhookBackend_To_v1beta1_AuditWebhookBackend is an autogenerated conversion function.
+func Convert_garden_AuditWebhookBackend_To_v1beta1_AuditWebhookBackend(in *garden.AuditWebhookBackend, out *AuditWebhookBackend, s conversion.Scope) error {
+	return autoConvert_garden_AuditWebhookBackend_To_v1beta1_AuditWebhookBackend(in, out, s)
+}
+
+func autoConvert_v1beta1_AuthenticationWebhook_To_garden_AuthenticationWebhook(in *AuthenticationWebhook, out *garden.AuthenticationWebhook, s conversion.Scope) error {
+	out.SecretRef = in.SecretRef
+	out.CacheTTL = (*v1.Duration)(unsafe.Pointer(in.CacheTTL))
+	return nil
+}
+
+// Convert_v1beta1_AuthenticationWebhook_To_garden_AuthenticationWebhook is an autogenerated conversion function.
+func Convert_v1beta1_AuthenticationWebhook_To_garden_AuthenticationWebhook(in *AuthenticationWebhook, out *garden.AuthenticationWebhook, s conversion.Scope) error {
+	return autoConvert_v1beta1_AuthenticationWebhook_To_garden_AuthenticationWebhook(in, out, s)
+}
+
+func autoConvert_garden_AuthenticationWebhook_To_v1beta1_AuthenticationWebhook(in *garden.AuthenticationWebhook, out *AuthenticationWebhook, s conversion.Scope) error {
+	out.SecretRef = in.SecretRef
+	out.CacheTTL = (*v1.Duration)(unsafe.Pointer(in.CacheTTL))
+	return nil
+}
+
+// Convert_garden_AuthenticationWebhook_To_v1beta1_AuthenticationWebhook is an autogenerated conversion function.
+func Convert_garden_AuthenticationWebhook_To_v1beta1_AuthenticationWebhook(in *garden.AuthenticationWebhook, out *AuthenticationWebhook, s conversion.Scope) error {
+	return autoConvert_garden_AuthenticationWebhook_To_v1beta1_AuthenticationWebhook(in, out, s)
+}
+
+func autoConvert_v1beta1_AuthorizationWebhook_To_garden_AuthorizationWebhook(in *AuthorizationWebhook, out *garden.AuthorizationWebhook, s conversion.Scope) error {
+	out.SecretRef = in.SecretRef
+	out.CacheAuthorizedTTL = (*v1.Duration)(unsafe.Pointer(in.CacheAuthorizedTTL))
+	out.CacheUnauthorizedTTL = (*v1.Duration)(unsafe.Pointer(in.CacheUnauthorizedTTL))
+	return nil
+}
+
+// Convert_v1beta1_AuthorizationWebhook_To_garden_AuthorizationWebhook is an autogenerated conversion function.
+func Convert_v1beta1_AuthorizationWebhook_To_garden_AuthorizationWebhook(in *AuthorizationWebhook, out *garden.AuthorizationWebhook, s conversion.Scope) error {
+	return autoConvert_v1beta1_AuthorizationWebhook_To_garden_AuthorizationWebhook(in, out, s)
+}
+
+func autoConvert_garden_AuthorizationWebhook_To_v1beta1_AuthorizationWebhook(in *garden.AuthorizationWebhook, out *AuthorizationWebhook, s conversion.Scope) error {
+	out.SecretRef = in.SecretRef
+	out.CacheAuthorizedTTL = (*v1.Duration)(unsafe.Pointer(in.CacheAuthorizedTTL))
+	out.CacheUnauthorizedTTL = (*v1.Duration)(unsafe.Pointer(in.CacheUnauthorizedTTL))
+	return nil
+}
+
+// Convert_garden_AuthorizationWebhook_To_v1beta1_AuthorizationWebhook is an autogenerated conversion function.
+func Convert_garden_AuthorizationWebhook_To_v1beta1_AuthorizationWebhook(in *garden.AuthorizationWebhook, out *AuthorizationWebhook, s conversion.Scope) error {
+	return autoConvert_garden_AuthorizationWebhook_To_v1beta1_AuthorizationWebhook(in, out, s)
+}
+
+func autoConvert_v1beta1_AvailabilityRatio_To_garden_AvailabilityRatio(in *AvailabilityRatio, out *garden.AvailabilityRatio, s conversion.Scope) error {
+	out.Period = in.Period
+	out.Ratio = in.Ratio
+	out.Probes = in.Probes
+	return nil
+}
+
+// Convert_v1beta1_AvailabilityRatio_To_garden_AvailabilityRatio is an autogenerated conversion function.
+func Convert_v1beta1_AvailabilityRatio_To_garden_AvailabilityRatio(in *AvailabilityRatio, out *garden.AvailabilityRatio, s conversion.Scope) error {
+	return autoConvert_v1beta1_AvailabilityRatio_To_garden_AvailabilityRatio(in, out, s)
+}
+
+func autoConvert_garden_AvailabilityRatio_To_v1beta1_AvailabilityRatio(in *garden.AvailabilityRatio, out *AvailabilityRatio, s conversion.Scope) error {
+	out.Period = in.Period
+	out.Ratio = in.Ratio
+	out.Probes = in.Probes
+	return nil
+}
+
+// Convert_garden_AvailabilityRatio_To_v1beta1_AvailabilityRatio is an autogenerated conversion function.
+func Convert_garden_AvailabilityRatio_To_v1beta1_AvailabilityRatio(in *garden.AvailabilityRatio, out *AvailabilityRatio, s conversion.Scope) error {
+	return autoConvert_garden_AvailabilityRatio_To_v1beta1_AvailabilityRatio(in, out, s)
+}
+
 func autoConvert_v1beta1_AzureCloud_To_garden_AzureCloud(in *AzureCloud, out *garden.AzureCloud, s conversion.Scope) error {
 	if in.MachineImage != nil {
 		in, out := &in.MachineImage, &out.MachineImage
@@ -2210,33 +2744,99 @@ func autoConvert_garden_AzureResourceGroup_To_v1beta1_AzureResourceGroup(in *gar
 	return nil
 }
 
-// Convert_garden_AzureResourceGroup_To_v1beta1_AzureResourceGroup is an autogenerated conversion function.
-func Convert_garden_AzureResourceGroup_To_v1beta1_AzureResourceGroup(in *garden.AzureResourceGroup, out *AzureResourceGroup, s conversion.Scope) error {
-	return autoConvert_garden_AzureResourceGroup_To_v1beta1_AzureResourceGroup(in, out, s)
+// Convert_garden_AzureResourceGroup_To_v1beta1_AzureResourceGroup is an autogenerated conversion function.
+func Convert_garden_AzureResourceGroup_To_v1beta1_AzureResourceGroup(in *garden.AzureResourceGroup, out *AzureResourceGroup, s conversion.Scope) error {
+	return autoConvert_garden_AzureResourceGroup_To_v1beta1_AzureResourceGroup(in, out, s)
+}
+
+func autoConvert_v1beta1_AzureVNet_To_garden_AzureVNet(in *AzureVNet, out *garden.AzureVNet, s conversion.Scope) error {
+	out.Name = (*string)(unsafe.Pointer(in.Name))
+	out.ResourceGroup = (*string)(unsafe.Pointer(in.ResourceGroup))
+	out.CIDR = (*string)(unsafe.Pointer(in.CIDR))
+	return nil
+}
+
+// Convert_v1beta1_AzureVNet_To_garden_AzureVNet is an autogenerated conversion function.
+func Convert_v1beta1_AzureVNet_To_garden_AzureVNet(in *AzureVNet, out *garden.AzureVNet, s conversion.Scope) error {
+	return autoConvert_v1beta1_AzureVNet_To_garden_AzureVNet(in, out, s)
+}
+
+func autoConvert_garden_AzureVNet_To_v1beta1_AzureVNet(in *garden.AzureVNet, out *AzureVNet, s conversion.Scope) error {
+	out.Name = (*string)(unsafe.Pointer(in.Name))
+	out.ResourceGroup = (*string)(unsafe.Pointer(in.ResourceGroup))
+	out.CIDR = (*string)(unsafe.Pointer(in.CIDR))
+	return nil
+}
+
+// Convert_garden_AzureVNet_To_v1beta1_AzureVNet is an autogenerated conversion function.
+func Convert_garden_AzureVNet_To_v1beta1_AzureVNet(in *garden.AzureVNet, out *AzureVNet, s conversion.Scope) error {
+	return autoConvert_garden_AzureVNet_To_v1beta1_AzureVNet(in, out, s)
+}
+
+func autoConvert_v1beta1_BackupRetentionPolicy_To_garden_BackupRetentionPolicy(in *BackupRetentionPolicy, out *garden.BackupRetentionPolicy, s conversion.Scope) error {
+	out.DailySnapshots = (*int32)(unsafe.Pointer(in.DailySnapshots))
+	out.WeeklySnapshots = (*int32)(unsafe.Pointer(in.WeeklySnapshots))
+	return nil
+}
+
+// Convert_v1beta1_BackupRetentionPolicy_To_garden_BackupRetentionPolicy is an autogenerated conversion function.
+func Convert_v1beta1_BackupRetentionPolicy_To_garden_BackupRetentionPolicy(in *BackupRetentionPolicy, out *garden.BackupRetentionPolicy, s conversion.Scope) error {
+	return autoConvert_v1beta1_BackupRetentionPolicy_To_garden_BackupRetentionPolicy(in, out, s)
+}
+
+func autoConvert_garden_BackupRetentionPolicy_To_v1beta1_BackupRetentionPolicy(in *garden.BackupRetentionPolicy, out *BackupRetentionPolicy, s conversion.Scope) error {
+	out.DailySnapshots = (*int32)(unsafe.Pointer(in.DailySnapshots))
+	out.WeeklySnapshots = (*int32)(unsafe.Pointer(in.WeeklySnapshots))
+	return nil
+}
+
+// Convert_garden_BackupRetentionPolicy_To_v1beta1_BackupRetentionPolicy is an autogenerated conversion function.
+func Convert_garden_BackupRetentionPolicy_To_v1beta1_BackupRetentionPolicy(in *garden.BackupRetentionPolicy, out *BackupRetentionPolicy, s conversion.Scope) error {
+	return autoConvert_garden_BackupRetentionPolicy_To_v1beta1_BackupRetentionPolicy(in, out, s)
+}
+
+func autoConvert_v1beta1_CARotation_To_garden_CARotation(in *CARotation, out *garden.CARotation, s conversion.Scope) error {
+	out.Phase = garden.CredentialsRotationPhase(in.Phase)
+	out.LastInitiationTime = (*v1.Time)(unsafe.Pointer(in.LastInitiationTime))
+	out.LastCompletionTime = (*v1.Time)(unsafe.Pointer(in.LastCompletionTime))
+	return nil
+}
+
+// Convert_v1beta1_CARotation_To_garden_CARotation is an autogenerated conversion function.
+func Convert_v1beta1_CARotation_To_garden_CARotation(in *CARotation, out *garden.CARotation, s conversion.Scope) error {
+	return autoConvert_v1beta1_CARotation_To_garden_CARotation(in, out, s)
+}
+
+func autoConvert_garden_CARotation_To_v1beta1_CARotation(in *garden.CARotation, out *CARotation, s conversion.Scope) error {
+	out.Phase = CredentialsRotationPhase(in.Phase)
+	out.LastInitiationTime = (*v1.Time)(unsafe.Pointer(in.LastInitiationTime))
+	out.LastCompletionTime = (*v1.Time)(unsafe.Pointer(in.LastCompletionTime))
+	return nil
+}
+
+// Convert_garden_CARotation_To_v1beta1_CARotation is an autogenerated conversion function.
+func Convert_garden_CARotation_To_v1beta1_CARotation(in *garden.CARotation, out *CARotation, s conversion.Scope) error {
+	return autoConvert_garden_CARotation_To_v1beta1_CARotation(in, out, s)
 }
 
-func autoConvert_v1beta1_AzureVNet_To_garden_AzureVNet(in *AzureVNet, out *garden.AzureVNet, s conversion.Scope) error {
-	out.Name = (*string)(unsafe.Pointer(in.Name))
-	out.ResourceGroup = (*string)(unsafe.Pointer(in.ResourceGroup))
-	out.CIDR = (*string)(unsafe.Pointer(in.CIDR))
+func autoConvert_v1beta1_CRI_To_garden_CRI(in *CRI, out *garden.CRI, s conversion.Scope) error {
+	out.Name = garden.CRIName(in.Name)
 	return nil
 }
 
-// Convert_v1beta1_AzureVNet_To_garden_AzureVNet is an autogenerated conversion function.
-func Convert_v1beta1_AzureVNet_To_garden_AzureVNet(in *AzureVNet, out *garden.AzureVNet, s conversion.Scope) error {
-	return autoConvert_v1beta1_AzureVNet_To_garden_AzureVNet(in, out, s)
+// Convert_v1beta1_CRI_To_garden_CRI is an autogenerated conversion function.
+func Convert_v1beta1_CRI_To_garden_CRI(in *CRI, out *garden.CRI, s conversion.Scope) error {
+	return autoConvert_v1beta1_CRI_To_garden_CRI(in, out, s)
 }
 
-func autoConvert_garden_AzureVNet_To_v1beta1_AzureVNet(in *garden.AzureVNet, out *AzureVNet, s conversion.Scope) error {
-	out.Name = (*string)(unsafe.Pointer(in.Name))
-	out.ResourceGroup = (*string)(unsafe.Pointer(in.ResourceGroup))
-	out.CIDR = (*string)(unsafe.Pointer(in.CIDR))
+func autoConvert_garden_CRI_To_v1beta1_CRI(in *garden.CRI, out *CRI, s conversion.Scope) error {
+	out.Name = CRIName(in.Name)
 	return nil
 }
 
-// Convert_garden_AzureVNet_To_v1beta1_AzureVNet is an autogenerated conversion function.
-func Convert_garden_AzureVNet_To_v1beta1_AzureVNet(in *garden.AzureVNet, out *AzureVNet, s conversion.Scope) error {
-	return autoConvert_garden_AzureVNet_To_v1beta1_AzureVNet(in, out, s)
+// Convert_garden_CRI_To_v1beta1_CRI is an autogenerated conversion function.
+func Convert_garden_CRI_To_v1beta1_CRI(in *garden.CRI, out *CRI, s conversion.Scope) error {
+	return autoConvert_garden_CRI_To_v1beta1_CRI(in, out, s)
 }
 
 func autoConvert_v1beta1_Cloud_To_garden_Cloud(in *Cloud, out *garden.Cloud, s conversion.Scope) error {
@@ -2583,11 +3183,11 @@ func autoConvert_garden_CloudProfileSpec_To_v1beta1_CloudProfileSpec(in *garden.
 
 func autoConvert_v1beta1_ClusterAutoscaler_To_garden_ClusterAutoscaler(in *ClusterAutoscaler, out *garden.ClusterAutoscaler, s conversion.Scope) error {
 	out.ScaleDownUtilizationThreshold = (*float64)(unsafe.Pointer(in.ScaleDownUtilizationThreshold))
-	out.ScaleDownUnneededTime = (*metav1.Duration)(unsafe.Pointer(in.ScaleDownUnneededTime))
-	out.ScaleDownDelayAfterAdd = (*metav1.Duration)(unsafe.Pointer(in.ScaleDownDelayAfterAdd))
-	out.ScaleDownDelayAfterFailure = (*metav1.Duration)(unsafe.Pointer(in.ScaleDownDelayAfterFailure))
-	out.ScaleDownDelayAfterDelete = (*metav1.Duration)(unsafe.Pointer(in.ScaleDownDelayAfterDelete))
-	out.ScanInterval = (*metav1.Duration)(unsafe.Pointer(in.ScanInterval))
+	out.ScaleDownUnneededTime = (*v1.Duration)(unsafe.Pointer(in.ScaleDownUnneededTime))
+	out.ScaleDownDelayAfterAdd = (*v1.Duration)(unsafe.Pointer(in.ScaleDownDelayAfterAdd))
+	out.ScaleDownDelayAfterFailure = (*v1.Duration)(unsafe.Pointer(in.ScaleDownDelayAfterFailure))
+	out.ScaleDownDelayAfterDelete = (*v1.Duration)(unsafe.Pointer(in.ScaleDownDelayAfterDelete))
+	out.ScanInterval = (*v1.Duration)(unsafe.Pointer(in.ScanInterval))
 	return nil
 }
 
@@ -2598,11 +3198,11 @@ func Convert_v1beta1_ClusterAutoscaler_To_garden_ClusterAutoscaler(in *ClusterAu
 
 func autoConvert_garden_ClusterAutoscaler_To_v1beta1_ClusterAutoscaler(in *garden.ClusterAutoscaler, out *ClusterAutoscaler, s conversion.Scope) error {
 	out.ScaleDownUtilizationThreshold = (*float64)(unsafe.Pointer(in.ScaleDownUtilizationThreshold))
-	out.ScaleDownUnneededTime = (*metav1.Duration)(unsafe.Pointer(in.ScaleDownUnneededTime))
-	out.ScaleDownDelayAfterAdd = (*metav1.Duration)(unsafe.Pointer(in.ScaleDownDelayAfterAdd))
-	out.ScaleDownDelayAfterFailure = (*metav1.Duration)(unsafe.Pointer(in.ScaleDownDelayAfterFailure))
-	out.ScaleDownDelayAfterDelete = (*metav1.Duration)(unsafe.Pointer(in.ScaleDownDelayAfterDelete))
-	out.ScanInterval = (*metav1.Duration)(unsafe.Pointer(in.ScanInterval))
+	out.ScaleDownUnneededTime = (*v1.Duration)(unsafe.Pointer(in.ScaleDownUnneededTime))
+	out.ScaleDownDelayAfterAdd = (*v1.Duration)(unsafe.Pointer(in.ScaleDownDelayAfterAdd))
+	out.ScaleDownDelayAfterFailure = (*v1.Duration)(unsafe.Pointer(in.ScaleDownDelayAfterFailure))
+	out.ScaleDownDelayAfterDelete = (*v1.Duration)(unsafe.Pointer(in.ScaleDownDelayAfterDelete))
+	out.ScanInterval = (*v1.Duration)(unsafe.Pointer(in.ScanInterval))
 	return nil
 }
 
@@ -2611,6 +3211,28 @@ func Convert_garden_ClusterAutoscaler_To_v1beta1_ClusterAutoscaler(in *garden.Cl
 	return autoConvert_garden_ClusterAutoscaler_To_v1beta1_ClusterAutoscaler(in, out, s)
 }
 
+func autoConvert_v1beta1_ControlPlane_To_garden_ControlPlane(in *ControlPlane, out *garden.ControlPlane, s conversion.Scope) error {
+	out.HighAvailability = (*garden.HighAvailability)(unsafe.Pointer(in.HighAvailability))
+	out.NetworkPolicyRules = *(*[]garden.NetworkPolicyRule)(unsafe.Pointer(&in.NetworkPolicyRules))
+	return nil
+}
+
+// Convert_v1beta1_ControlPlane_To_garden_ControlPlane is an autogenerated conversion function.
+func Convert_v1beta1_ControlPlane_To_garden_ControlPlane(in *ControlPlane, out *garden.ControlPlane, s conversion.Scope) error {
+	return autoConvert_v1beta1_ControlPlane_To_garden_ControlPlane(in, out, s)
+}
+
+func autoConvert_garden_ControlPlane_To_v1beta1_ControlPlane(in *garden.ControlPlane, out *ControlPlane, s conversion.Scope) error {
+	out.HighAvailability = (*HighAvailability)(unsafe.Pointer(in.HighAvailability))
+	out.NetworkPolicyRules = *(*[]NetworkPolicyRule)(unsafe.Pointer(&in.NetworkPolicyRules))
+	return nil
+}
+
+// Convert_garden_ControlPlane_To_v1beta1_ControlPlane is an autogenerated conversion function.
+func Convert_garden_ControlPlane_To_v1beta1_ControlPlane(in *garden.ControlPlane, out *ControlPlane, s conversion.Scope) error {
+	return autoConvert_garden_ControlPlane_To_v1beta1_ControlPlane(in, out, s)
+}
+
 func autoConvert_v1beta1_DNS_To_garden_DNS(in *DNS, out *garden.DNS, s conversion.Scope) error {
 	out.Domain = (*string)(unsafe.Pointer(in.Domain))
 	// WARNING: in.SecretName requires manual conversion: does not exist in peer-type
@@ -2648,6 +3270,80 @@ func Convert_garden_DNSProviderConstraint_To_v1beta1_DNSProviderConstraint(in *g
 	return autoConvert_garden_DNSProviderConstraint_To_v1beta1_DNSProviderConstraint(in, out, s)
 }
 
+func autoConvert_v1beta1_ETCDBackupOperation_To_garden_ETCDBackupOperation(in *ETCDBackupOperation, out *garden.ETCDBackupOperation, s conversion.Scope) error {
+	out.State = garden.LastOperationState(in.State)
+	out.Description = in.Description
+	out.Progress = in.Progress
+	out.LastUpdateTime = in.LastUpdateTime
+	out.Snapshot = (*string)(unsafe.Pointer(in.Snapshot))
+	return nil
+}
+
+// Convert_v1beta1_ETCDBackupOperation_To_garden_ETCDBackupOperation is an autogenerated conversion function.
+func Convert_v1beta1_ETCDBackupOperation_To_garden_ETCDBackupOperation(in *ETCDBackupOperation, out *garden.ETCDBackupOperation, s conversion.Scope) error {
+	return autoConvert_v1beta1_ETCDBackupOperation_To_garden_ETCDBackupOperation(in, out, s)
+}
+
+func autoConvert_garden_ETCDBackupOperation_To_v1beta1_ETCDBackupOperation(in *garden.ETCDBackupOperation, out *ETCDBackupOperation, s conversion.Scope) error {
+	out.State = v1alpha1.LastOperationState(in.State)
+	out.Description = in.Description
+	out.Progress = in.Progress
+	out.LastUpdateTime = in.LastUpdateTime
+	out.Snapshot = (*string)(unsafe.Pointer(in.Snapshot))
+	return nil
+}
+
+// Convert_garden_ETCDBackupOperation_To_v1beta1_ETCDBackupOperation is an autogenerated conversion function.
+func Convert_garden_ETCDBackupOperation_To_v1beta1_ETCDBackupOperation(in *garden.ETCDBackupOperation, out *ETCDBackupOperation, s conversion.Scope) error {
+	return autoConvert_garden_ETCDBackupOperation_To_v1beta1_ETCDBackupOperation(in, out, s)
+}
+
+func autoConvert_v1beta1_ETCDEncryptionKeyRotation_To_garden_ETCDEncryptionKeyRotation(in *ETCDEncryptionKeyRotation, out *garden.ETCDEncryptionKeyRotation, s conversion.Scope) error {
+	out.Phase = garden.CredentialsRotationPhase(in.Phase)
+	out.LastInitiationTime = (*v1.Time)(unsafe.Pointer(in.LastInitiationTime))
+	out.LastCompletionTime = (*v1.Time)(unsafe.Pointer(in.LastCompletionTime))
+	return nil
+}
+
+// Convert_v1beta1_ETCDEncryptionKeyRotation_To_garden_ETCDEncryptionKeyRotation is an autogenerated conversion function.
+func Convert_v1beta1_ETCDEncryptionKeyRotation_To_garden_ETCDEncryptionKeyRotation(in *ETCDEncryptionKeyRotation, out *garden.ETCDEncryptionKeyRotation, s conversion.Scope) error {
+	return autoConvert_v1beta1_ETCDEncryptionKeyRotation_To_garden_ETCDEncryptionKeyRotation(in, out, s)
+}
+
+func autoConvert_garden_ETCDEncryptionKeyRotation_To_v1beta1_ETCDEncryptionKeyRotation(in *garden.ETCDEncryptionKeyRotation, out *ETCDEncryptionKeyRotation, s conversion.Scope) error {
+	out.Phase = CredentialsRotationPhase(in.Phase)
+	out.LastInitiationTime = (*v1.Time)(unsafe.Pointer(in.LastInitiationTime))
+	out.LastCompletionTime = (*v1.Time)(unsafe.Pointer(in.LastCompletionTime))
+	return nil
+}
+
+// Convert_garden_ETCDEncryptionKeyRotation_To_v1beta1_ETCDEncryptionKeyRotation is an autogenerated conversion function.
+func Convert_garden_ETCDEncryptionKeyRotation_To_v1beta1_ETCDEncryptionKeyRotation(in *garden.ETCDEncryptionKeyRotation, out *ETCDEncryptionKeyRotation, s conversion.Scope) error {
+	return autoConvert_garden_ETCDEncryptionKeyRotation_To_v1beta1_ETCDEncryptionKeyRotation(in, out, s)
+}
+
+func autoConvert_v1beta1_EncryptionConfig_To_garden_EncryptionConfig(in *EncryptionConfig, out *garden.EncryptionConfig, s conversion.Scope) error {
+	out.Resources = *(*[]string)(unsafe.Pointer(&in.Resources))
+	out.KMS = (*garden.KMSProvider)(unsafe.Pointer(in.KMS))
+	return nil
+}
+
+// Convert_v1beta1_EncryptionConfig_To_garden_EncryptionConfig is an autogenerated conversion function.
+func Convert_v1beta1_EncryptionConfig_To_garden_EncryptionConfig(in *EncryptionConfig, out *garden.EncryptionConfig, s conversion.Scope) error {
+	return autoConvert_v1beta1_EncryptionConfig_To_garden_EncryptionConfig(in, out, s)
+}
+
+func autoConvert_garden_EncryptionConfig_To_v1beta1_EncryptionConfig(in *garden.EncryptionConfig, out *EncryptionConfig, s conversion.Scope) error {
+	out.Resources = *(*[]string)(unsafe.Pointer(&in.Resources))
+	out.KMS = (*KMSProvider)(unsafe.Pointer(in.KMS))
+	return nil
+}
+
+// Convert_garden_EncryptionConfig_To_v1beta1_EncryptionConfig is an autogenerated conversion function.
+func Convert_garden_EncryptionConfig_To_v1beta1_EncryptionConfig(in *garden.EncryptionConfig, out *EncryptionConfig, s conversion.Scope) error {
+	return autoConvert_garden_EncryptionConfig_To_v1beta1_EncryptionConfig(in, out, s)
+}
+
 func autoConvert_v1beta1_Extension_To_garden_Extension(in *Extension, out *garden.Extension, s conversion.Scope) error {
 	out.Type = in.Type
 	out.ProviderConfig = (*garden.ProviderConfig)(unsafe.Pointer(in.ProviderConfig))
@@ -2670,6 +3366,26 @@ func Convert_garden_Extension_To_v1beta1_Extension(in *garden.Extension, out *Ex
 	return autoConvert_garden_Extension_To_v1beta1_Extension(in, out, s)
 }
 
+func autoConvert_v1beta1_FailureTolerance_To_garden_FailureTolerance(in *FailureTolerance, out *garden.FailureTolerance, s conversion.Scope) error {
+	out.Type = garden.FailureToleranceType(in.Type)
+	return nil
+}
+
+// Convert_v1beta1_FailureTolerance_To_garden_FailureTolerance is an autogenerated conversion function.
+func Convert_v1beta1_FailureTolerance_To_garden_FailureTolerance(in *FailureTolerance, out *garden.FailureTolerance, s conversion.Scope) error {
+	return autoConvert_v1beta1_FailureTolerance_To_garden_FailureTolerance(in, out, s)
+}
+
+func autoConvert_garden_FailureTolerance_To_v1beta1_FailureTolerance(in *garden.FailureTolerance, out *FailureTolerance, s conversion.Scope) error {
+	out.Type = FailureToleranceType(in.Type)
+	return nil
+}
+
+// Convert_garden_FailureTolerance_To_v1beta1_FailureTolerance is an autogenerated conversion function.
+func Convert_garden_FailureTolerance_To_v1beta1_FailureTolerance(in *garden.FailureTolerance, out *FailureTolerance, s conversion.Scope) error {
+	return autoConvert_garden_FailureTolerance_To_v1beta1_FailureTolerance(in, out, s)
+}
+
 func autoConvert_v1beta1_GCPCloud_To_garden_GCPCloud(in *GCPCloud, out *garden.GCPCloud, s conversion.Scope) error {
 	if in.MachineImage != nil {
 		in, out := &in.MachineImage, &out.MachineImage
@@ -2982,14 +3698,38 @@ func Convert_garden_HibernationSchedule_To_v1beta1_HibernationSchedule(in *garde
 	return autoConvert_garden_HibernationSchedule_To_v1beta1_HibernationSchedule(in, out, s)
 }
 
+func autoConvert_v1beta1_HighAvailability_To_garden_HighAvailability(in *HighAvailability, out *garden.HighAvailability, s conversion.Scope) error {
+	if err := Convert_v1beta1_FailureTolerance_To_garden_FailureTolerance(&in.FailureTolerance, &out.FailureTolerance, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_HighAvailability_To_garden_HighAvailability is an autogenerated conversion function.
+func Convert_v1beta1_HighAvailability_To_garden_HighAvailability(in *HighAvailability, out *garden.HighAvailability, s conversion.Scope) error {
+	return autoConvert_v1beta1_HighAvailability_To_garden_HighAvailability(in, out, s)
+}
+
+func autoConvert_garden_HighAvailability_To_v1beta1_HighAvailability(in *garden.HighAvailability, out *HighAvailability, s conversion.Scope) error {
+	if err := Convert_garden_FailureTolerance_To_v1beta1_FailureTolerance(&in.FailureTolerance, &out.FailureTolerance, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_garden_HighAvailability_To_v1beta1_HighAvailability is an autogenerated conversion function.
+func Convert_garden_HighAvailability_To_v1beta1_HighAvailability(in *garden.HighAvailability, out *HighAvailability, s conversion.Scope) error {
+	return autoConvert_garden_HighAvailability_To_v1beta1_HighAvailability(in, out, s)
+}
+
 func autoConvert_v1beta1_HorizontalPodAutoscalerConfig_To_garden_HorizontalPodAutoscalerConfig(in *HorizontalPodAutoscalerConfig, out *garden.HorizontalPodAutoscalerConfig, s conversion.Scope) error {
-	out.DownscaleDelay = (*metav1.Duration)(unsafe.Pointer(in.DownscaleDelay))
-	out.SyncPeriod = (*metav1.Duration)(unsafe.Pointer(in.SyncPeriod))
+	out.DownscaleDelay = (*v1.Duration)(unsafe.Pointer(in.DownscaleDelay))
+	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
 	out.Tolerance = (*float64)(unsafe.Pointer(in.Tolerance))
-	out.UpscaleDelay = (*metav1.Duration)(unsafe.Pointer(in.UpscaleDelay))
-	out.DownscaleStabilization = (*metav1.Duration)(unsafe.Pointer(in.DownscaleStabilization))
-	out.InitialReadinessDelay = (*metav1.Duration)(unsafe.Pointer(in.InitialReadinessDelay))
-	out.CPUInitializationPeriod = (*metav1.Duration)(unsafe.Pointer(in.CPUInitializationPeriod))
+	out.UpscaleDelay = (*v1.Duration)(unsafe.Pointer(in.UpscaleDelay))
+	out.DownscaleStabilization = (*v1.Duration)(unsafe.Pointer(in.DownscaleStabilization))
+	out.InitialReadinessDelay = (*v1.Duration)(unsafe.Pointer(in.InitialReadinessDelay))
+	out.CPUInitializationPeriod = (*v1.Duration)(unsafe.Pointer(in.CPUInitializationPeriod))
 	return nil
 }
 
@@ -3038,6 +3778,30 @@ func Convert_garden_K8SNetworks_To_v1beta1_K8SNetworks(in *garden.K8SNetworks, o
 	return autoConvert_garden_K8SNetworks_To_v1beta1_K8SNetworks(in, out, s)
 }
 
+func autoConvert_v1beta1_KMSProvider_To_garden_KMSProvider(in *KMSProvider, out *garden.KMSProvider, s conversion.Scope) error {
+	out.Type = in.Type
+	out.CacheSize = (*int32)(unsafe.Pointer(in.CacheSize))
+	out.Timeout = (*v1.Duration)(unsafe.Pointer(in.Timeout))
+	return nil
+}
+
+// Convert_v1beta1_KMSProvider_To_garden_KMSProvider is an autogenerated conversion function.
+func Convert_v1beta1_KMSProvider_To_garden_KMSProvider(in *KMSProvider, out *garden.KMSProvider, s conversion.Scope) error {
+	return autoConvert_v1beta1_KMSProvider_To_garden_KMSProvider(in, out, s)
+}
+
+func autoConvert_garden_KMSProvider_To_v1beta1_KMSProvider(in *garden.KMSProvider, out *KMSProvider, s conversion.Scope) error {
+	out.Type = in.Type
+	out.CacheSize = (*int32)(unsafe.Pointer(in.CacheSize))
+	out.Timeout = (*v1.Duration)(unsafe.Pointer(in.Timeout))
+	return nil
+}
+
+// Convert_garden_KMSProvider_To_v1beta1_KMSProvider is an autogenerated conversion function.
+func Convert_garden_KMSProvider_To_v1beta1_KMSProvider(in *garden.KMSProvider, out *KMSProvider, s conversion.Scope) error {
+	return autoConvert_garden_KMSProvider_To_v1beta1_KMSProvider(in, out, s)
+}
+
 func autoConvert_v1beta1_Kube2IAM_To_garden_Kube2IAM(in *Kube2IAM, out *garden.Kube2IAM, s conversion.Scope) error {
 	if err := Convert_v1beta1_Addon_To_garden_Addon(&in.Addon, &out.Addon, s); err != nil {
 		return err
@@ -3096,9 +3860,14 @@ func autoConvert_v1beta1_KubeAPIServerConfig_To_garden_KubeAPIServerConfig(in *K
 	out.APIAudiences = *(*[]string)(unsafe.Pointer(&in.APIAudiences))
 	out.AuditConfig = (*garden.AuditConfig)(unsafe.Pointer(in.AuditConfig))
 	out.EnableBasicAuthentication = (*bool)(unsafe.Pointer(in.EnableBasicAuthentication))
+	out.EncryptionConfig = (*garden.EncryptionConfig)(unsafe.Pointer(in.EncryptionConfig))
 	out.OIDCConfig = (*garden.OIDCConfig)(unsafe.Pointer(in.OIDCConfig))
 	out.RuntimeConfig = *(*map[string]bool)(unsafe.Pointer(&in.RuntimeConfig))
 	out.ServiceAccountConfig = (*garden.ServiceAccountConfig)(unsafe.Pointer(in.ServiceAccountConfig))
+	out.StaticCredentialsRotation = (*garden.StaticCredentialsRotationConfig)(unsafe.Pointer(in.StaticCredentialsRotation))
+	out.AuthenticationWebhook = (*garden.AuthenticationWebhook)(unsafe.Pointer(in.AuthenticationWebhook))
+	out.AuthorizationWebhook = (*garden.AuthorizationWebhook)(unsafe.Pointer(in.AuthorizationWebhook))
+	out.AdmissionWebhookConfig = (*garden.AdmissionWebhookConfig)(unsafe.Pointer(in.AdmissionWebhookConfig))
 	return nil
 }
 
@@ -3115,9 +3884,14 @@ func autoConvert_garden_KubeAPIServerConfig_To_v1beta1_KubeAPIServerConfig(in *g
 	out.APIAudiences = *(*[]string)(unsafe.Pointer(&in.APIAudiences))
 	out.AuditConfig = (*AuditConfig)(unsafe.Pointer(in.AuditConfig))
 	out.EnableBasicAuthentication = (*bool)(unsafe.Pointer(in.EnableBasicAuthentication))
+	out.EncryptionConfig = (*EncryptionConfig)(unsafe.Pointer(in.EncryptionConfig))
 	out.OIDCConfig = (*OIDCConfig)(unsafe.Pointer(in.OIDCConfig))
 	out.RuntimeConfig = *(*map[string]bool)(unsafe.Pointer(&in.RuntimeConfig))
 	out.ServiceAccountConfig = (*ServiceAccountConfig)(unsafe.Pointer(in.ServiceAccountConfig))
+	out.StaticCredentialsRotation = (*StaticCredentialsRotationConfig)(unsafe.Pointer(in.StaticCredentialsRotation))
+	out.AuthenticationWebhook = (*AuthenticationWebhook)(unsafe.Pointer(in.AuthenticationWebhook))
+	out.AuthorizationWebhook = (*AuthorizationWebhook)(unsafe.Pointer(in.AuthorizationWebhook))
+	out.AdmissionWebhookConfig = (*AdmissionWebhookConfig)(unsafe.Pointer(in.AdmissionWebhookConfig))
 	return nil
 }
 
@@ -3126,12 +3900,47 @@ func Convert_garden_KubeAPIServerConfig_To_v1beta1_KubeAPIServerConfig(in *garde
 	return autoConvert_garden_KubeAPIServerConfig_To_v1beta1_KubeAPIServerConfig(in, out, s)
 }
 
+func autoConvert_v1beta1_KubeControllerManagerConcurrentSyncs_To_garden_KubeControllerManagerConcurrentSyncs(in *KubeControllerManagerConcurrentSyncs, out *garden.KubeControllerManagerConcurrentSyncs, s conversion.Scope) error {
+	out.Deployments = (*int32)(unsafe.Pointer(in.Deployments))
+	out.Endpoints = (*int32)(unsafe.Pointer(in.Endpoints))
+	out.GarbageCollector = (*int32)(unsafe.Pointer(in.GarbageCollector))
+	out.Namespaces = (*int32)(unsafe.Pointer(in.Namespaces))
+	out.ReplicaSets = (*int32)(unsafe.Pointer(in.ReplicaSets))
+	out.ResourceQuotas = (*int32)(unsafe.Pointer(in.ResourceQuotas))
+	out.ServiceAccountTokens = (*int32)(unsafe.Pointer(in.ServiceAccountTokens))
+	return nil
+}
+
+// Convert_v1beta1_KubeControllerManagerConcurrentSyncs_To_garden_KubeControllerManagerConcurrentSyncs is an autogenerated conversion function.
+func Convert_v1beta1_KubeControllerManagerConcurrentSyncs_To_garden_KubeControllerManagerConcurrentSyncs(in *KubeControllerManagerConcurrentSyncs, out *garden.KubeControllerManagerConcurrentSyncs, s conversion.Scope) error {
+	return autoConvert_v1beta1_KubeControllerManagerConcurrentSyncs_To_garden_KubeControllerManagerConcurrentSyncs(in, out, s)
+}
+
+func autoConvert_garden_KubeControllerManagerConcurrentSyncs_To_v1beta1_KubeControllerManagerConcurrentSyncs(in *garden.KubeControllerManagerConcurrentSyncs, out *KubeControllerManagerConcurrentSyncs, s conversion.Scope) error {
+	out.Deployments = (*int32)(unsafe.Pointer(in.Deployments))
+	out.Endpoints = (*int32)(unsafe.Pointer(in.Endpoints))
+	out.GarbageCollector = (*int32)(unsafe.Pointer(in.GarbageCollector))
+	out.Namespaces = (*int32)(unsafe.Pointer(in.Namespaces))
+	out.ReplicaSets = (*int32)(unsafe.Pointer(in.ReplicaSets))
+	out.ResourceQuotas = (*int32)(unsafe.Pointer(in.ResourceQuotas))
+	out.ServiceAccountTokens = (*int32)(unsafe.Pointer(in.ServiceAccountTokens))
+	return nil
+}
+
+// Convert_garden_KubeControllerManagerConcurrentSyncs_To_v1beta1_KubeControllerManagerConcurrentSyncs is an autogenerated conversion function.
+func Convert_garden_KubeControllerManagerConcurrentSyncs_To_v1beta1_KubeControllerManagerConcurrentSyncs(in *garden.KubeControllerManagerConcurrentSyncs, out *KubeControllerManagerConcurrentSyncs, s conversion.Scope) error {
+	return autoConvert_garden_KubeControllerManagerConcurrentSyncs_To_v1beta1_KubeControllerManagerConcurrentSyncs(in, out, s)
+}
+
 func autoConvert_v1beta1_KubeControllerManagerConfig_To_garden_KubeControllerManagerConfig(in *KubeControllerManagerConfig, out *garden.KubeControllerManagerConfig, s conversion.Scope) error {
 	if err := Convert_v1beta1_KubernetesConfig_To_garden_KubernetesConfig(&in.KubernetesConfig, &out.KubernetesConfig, s); err != nil {
 		return err
 	}
 	out.HorizontalPodAutoscalerConfig = (*garden.HorizontalPodAutoscalerConfig)(unsafe.Pointer(in.HorizontalPodAutoscalerConfig))
 	out.NodeCIDRMaskSize = (*int)(unsafe.Pointer(in.NodeCIDRMaskSize))
+	out.NodeMonitorGracePeriod = (*v1.Duration)(unsafe.Pointer(in.NodeMonitorGracePeriod))
+	out.PodEvictionTimeout = (*v1.Duration)(unsafe.Pointer(in.PodEvictionTimeout))
+	out.ConcurrentSyncs = (*garden.KubeControllerManagerConcurrentSyncs)(unsafe.Pointer(in.ConcurrentSyncs))
 	return nil
 }
 
@@ -3146,6 +3955,9 @@ func autoConvert_garden_KubeControllerManagerConfig_To_v1beta1_KubeControllerMan
 	}
 	out.HorizontalPodAutoscalerConfig = (*HorizontalPodAutoscalerConfig)(unsafe.Pointer(in.HorizontalPodAutoscalerConfig))
 	out.NodeCIDRMaskSize = (*int)(unsafe.Pointer(in.NodeCIDRMaskSize))
+	out.NodeMonitorGracePeriod = (*v1.Duration)(unsafe.Pointer(in.NodeMonitorGracePeriod))
+	out.PodEvictionTimeout = (*v1.Duration)(unsafe.Pointer(in.PodEvictionTimeout))
+	out.ConcurrentSyncs = (*KubeControllerManagerConcurrentSyncs)(unsafe.Pointer(in.ConcurrentSyncs))
 	return nil
 }
 
@@ -3210,6 +4022,7 @@ func autoConvert_v1beta1_KubeSchedulerConfig_To_garden_KubeSchedulerConfig(in *K
 	if err := Convert_v1beta1_KubernetesConfig_To_garden_KubernetesConfig(&in.KubernetesConfig, &out.KubernetesConfig, s); err != nil {
 		return err
 	}
+	out.Profile = (*garden.SchedulingProfile)(unsafe.Pointer(in.Profile))
 	return nil
 }
 
@@ -3222,6 +4035,7 @@ func autoConvert_garden_KubeSchedulerConfig_To_v1beta1_KubeSchedulerConfig(in *g
 	if err := Convert_garden_KubernetesConfig_To_v1beta1_KubernetesConfig(&in.KubernetesConfig, &out.KubernetesConfig, s); err != nil {
 		return err
 	}
+	out.Profile = (*SchedulingProfile)(unsafe.Pointer(in.Profile))
 	return nil
 }
 
@@ -3242,7 +4056,7 @@ func autoConvert_v1beta1_KubeletConfig_To_garden_KubeletConfig(in *KubeletConfig
 	out.EvictionSoft = (*garden.KubeletConfigEviction)(unsafe.Pointer(in.EvictionSoft))
 	out.EvictionSoftGracePeriod = (*garden.KubeletConfigEvictionSoftGracePeriod)(unsafe.Pointer(in.EvictionSoftGracePeriod))
 	out.EvictionMinimumReclaim = (*garden.KubeletConfigEvictionMinimumReclaim)(unsafe.Pointer(in.EvictionMinimumReclaim))
-	out.EvictionPressureTransitionPeriod = (*metav1.Duration)(unsafe.Pointer(in.EvictionPressureTransitionPeriod))
+	out.EvictionPressureTransitionPeriod = (*v1.Duration)(unsafe.Pointer(in.EvictionPressureTransitionPeriod))
 	out.EvictionMaxPodGracePeriod = (*int32)(unsafe.Pointer(in.EvictionMaxPodGracePeriod))
 	return nil
 }
@@ -3264,7 +4078,7 @@ func autoConvert_garden_KubeletConfig_To_v1beta1_KubeletConfig(in *garden.Kubele
 	out.EvictionSoft = (*KubeletConfigEviction)(unsafe.Pointer(in.EvictionSoft))
 	out.EvictionSoftGracePeriod = (*KubeletConfigEvictionSoftGracePeriod)(unsafe.Pointer(in.EvictionSoftGracePeriod))
 	out.EvictionMinimumReclaim = (*KubeletConfigEvictionMinimumReclaim)(unsafe.Pointer(in.EvictionMinimumReclaim))
-	out.EvictionPressureTransitionPeriod = (*metav1.Duration)(unsafe.Pointer(in.EvictionPressureTransitionPeriod))
+	out.EvictionPressureTransitionPeriod = (*v1.Duration)(unsafe.Pointer(in.EvictionPressureTransitionPeriod))
 	out.EvictionMaxPodGracePeriod = (*int32)(unsafe.Pointer(in.EvictionMaxPodGracePeriod))
 	return nil
 }
@@ -3331,11 +4145,11 @@ func Convert_garden_KubeletConfigEvictionMinimumReclaim_To_v1beta1_KubeletConfig
 }
 
 func autoConvert_v1beta1_KubeletConfigEvictionSoftGracePeriod_To_garden_KubeletConfigEvictionSoftGracePeriod(in *KubeletConfigEvictionSoftGracePeriod, out *garden.KubeletConfigEvictionSoftGracePeriod, s conversion.Scope) error {
-	out.MemoryAvailable = (*metav1.Duration)(unsafe.Pointer(in.MemoryAvailable))
-	out.ImageFSAvailable = (*metav1.Duration)(unsafe.Pointer(in.ImageFSAvailable))
-	out.ImageFSInodesFree = (*metav1.Duration)(unsafe.Pointer(in.ImageFSInodesFree))
-	out.NodeFSAvailable = (*metav1.Duration)(unsafe.Pointer(in.NodeFSAvailable))
-	out.NodeFSInodesFree = (*metav1.Duration)(unsafe.Pointer(in.NodeFSInodesFree))
+	out.MemoryAvailable = (*v1.Duration)(unsafe.Pointer(in.MemoryAvailable))
+	out.ImageFSAvailable = (*v1.Duration)(unsafe.Pointer(in.ImageFSAvailable))
+	out.ImageFSInodesFree = (*v1.Duration)(unsafe.Pointer(in.ImageFSInodesFree))
+	out.NodeFSAvailable = (*v1.Duration)(unsafe.Pointer(in.NodeFSAvailable))
+	out.NodeFSInodesFree = (*v1.Duration)(unsafe.Pointer(in.NodeFSInodesFree))
 	return nil
 }
 
@@ -3345,11 +4159,11 @@ func Convert_v1beta1_KubeletConfigEvictionSoftGracePeriod_To_garden_KubeletConfi
 }
 
 func autoConvert_garden_KubeletConfigEvictionSoftGracePeriod_To_v1beta1_KubeletConfigEvictionSoftGracePeriod(in *garden.KubeletConfigEvictionSoftGracePeriod, out *KubeletConfigEvictionSoftGracePeriod, s conversion.Scope) error {
-	out.MemoryAvailable = (*metav1.Duration)(unsafe.Pointer(in.MemoryAvailable))
-	out.ImageFSAvailable = (*metav1.Duration)(unsafe.Pointer(in.ImageFSAvailable))
-	out.ImageFSInodesFree = (*metav1.Duration)(unsafe.Pointer(in.ImageFSInodesFree))
-	out.NodeFSAvailable = (*metav1.Duration)(unsafe.Pointer(in.NodeFSAvailable))
-	out.NodeFSInodesFree = (*metav1.Duration)(unsafe.Pointer(in.NodeFSInodesFree))
+	out.MemoryAvailable = (*v1.Duration)(unsafe.Pointer(in.MemoryAvailable))
+	out.ImageFSAvailable = (*v1.Duration)(unsafe.Pointer(in.ImageFSAvailable))
+	out.ImageFSInodesFree = (*v1.Duration)(unsafe.Pointer(in.ImageFSInodesFree))
+	out.NodeFSAvailable = (*v1.Duration)(unsafe.Pointer(in.NodeFSAvailable))
+	out.NodeFSInodesFree = (*v1.Duration)(unsafe.Pointer(in.NodeFSInodesFree))
 	return nil
 }
 
@@ -3358,6 +4172,30 @@ func Convert_garden_KubeletConfigEvictionSoftGracePeriod_To_v1beta1_KubeletConfi
 	return autoConvert_garden_KubeletConfigEvictionSoftGracePeriod_To_v1beta1_KubeletConfigEvictionSoftGracePeriod(in, out, s)
 }
 
+func autoConvert_v1beta1_KubeletConfigFieldDrift_To_garden_KubeletConfigFieldDrift(in *KubeletConfigFieldDrift, out *garden.KubeletConfigFieldDrift, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Desired = in.Desired
+	out.Actual = in.Actual
+	return nil
+}
+
+// Convert_v1beta1_KubeletConfigFieldDrift_To_garden_KubeletConfigFieldDrift is an autogenerated conversion function.
+func Convert_v1beta1_KubeletConfigFieldDrift_To_garden_KubeletConfigFieldDrift(in *KubeletConfigFieldDrift, out *garden.KubeletConfigFieldDrift, s conversion.Scope) error {
+	return autoConvert_v1beta1_KubeletConfigFieldDrift_To_garden_KubeletConfigFieldDrift(in, out, s)
+}
+
+func autoConvert_garden_KubeletConfigFieldDrift_To_v1beta1_KubeletConfigFieldDrift(in *garden.KubeletConfigFieldDrift, out *KubeletConfigFieldDrift, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Desired = in.Desired
+	out.Actual = in.Actual
+	return nil
+}
+
+// Convert_garden_KubeletConfigFieldDrift_To_v1beta1_KubeletConfigFieldDrift is an autogenerated conversion function.
+func Convert_garden_KubeletConfigFieldDrift_To_v1beta1_KubeletConfigFieldDrift(in *garden.KubeletConfigFieldDrift, out *KubeletConfigFieldDrift, s conversion.Scope) error {
+	return autoConvert_garden_KubeletConfigFieldDrift_To_v1beta1_KubeletConfigFieldDrift(in, out, s)
+}
+
 func autoConvert_v1beta1_Kubernetes_To_garden_Kubernetes(in *Kubernetes, out *garden.Kubernetes, s conversion.Scope) error {
 	out.AllowPrivilegedContainers = (*bool)(unsafe.Pointer(in.AllowPrivilegedContainers))
 	out.KubeAPIServer = (*garden.KubeAPIServerConfig)(unsafe.Pointer(in.KubeAPIServer))
@@ -3453,7 +4291,7 @@ func Convert_garden_KubernetesDashboard_To_v1beta1_KubernetesDashboard(in *garde
 
 func autoConvert_v1beta1_KubernetesVersion_To_garden_KubernetesVersion(in *KubernetesVersion, out *garden.KubernetesVersion, s conversion.Scope) error {
 	out.Version = in.Version
-	out.ExpirationDate = (*metav1.Time)(unsafe.Pointer(in.ExpirationDate))
+	out.ExpirationDate = (*v1.Time)(unsafe.Pointer(in.ExpirationDate))
 	return nil
 }
 
@@ -3464,7 +4302,7 @@ func Convert_v1beta1_KubernetesVersion_To_garden_KubernetesVersion(in *Kubernete
 
 func autoConvert_garden_KubernetesVersion_To_v1beta1_KubernetesVersion(in *garden.KubernetesVersion, out *KubernetesVersion, s conversion.Scope) error {
 	out.Version = in.Version
-	out.ExpirationDate = (*metav1.Time)(unsafe.Pointer(in.ExpirationDate))
+	out.ExpirationDate = (*v1.Time)(unsafe.Pointer(in.ExpirationDate))
 	return nil
 }
 
@@ -3488,7 +4326,7 @@ func autoConvert_garden_MachineImage_To_v1beta1_MachineImage(in *garden.MachineI
 
 func autoConvert_v1beta1_MachineImageVersion_To_garden_MachineImageVersion(in *MachineImageVersion, out *garden.MachineImageVersion, s conversion.Scope) error {
 	out.Version = in.Version
-	out.ExpirationDate = (*metav1.Time)(unsafe.Pointer(in.ExpirationDate))
+	out.ExpirationDate = (*v1.Time)(unsafe.Pointer(in.ExpirationDate))
 	return nil
 }
 
@@ -3499,7 +4337,7 @@ func Convert_v1beta1_MachineImageVersion_To_garden_MachineImageVersion(in *Machi
 
 func autoConvert_garden_MachineImageVersion_To_v1beta1_MachineImageVersion(in *garden.MachineImageVersion, out *MachineImageVersion, s conversion.Scope) error {
 	out.Version = in.Version
-	out.ExpirationDate = (*metav1.Time)(unsafe.Pointer(in.ExpirationDate))
+	out.ExpirationDate = (*v1.Time)(unsafe.Pointer(in.ExpirationDate))
 	return nil
 }
 
@@ -3672,10 +4510,81 @@ func Convert_garden_Monocular_To_v1beta1_Monocular(in *garden.Monocular, out *Mo
 	return autoConvert_garden_Monocular_To_v1beta1_Monocular(in, out, s)
 }
 
+func autoConvert_v1beta1_NetworkPolicyRule_To_garden_NetworkPolicyRule(in *NetworkPolicyRule, out *garden.NetworkPolicyRule, s conversion.Scope) error {
+	out.Name = in.Name
+	out.PodSelector = in.PodSelector
+	out.CIDRs = *(*[]string)(unsafe.Pointer(&in.CIDRs))
+	out.Ports = *(*[]garden.NetworkPolicyRulePort)(unsafe.Pointer(&in.Ports))
+	return nil
+}
+
+// Convert_v1beta1_NetworkPolicyRule_To_garden_NetworkPolicyRule is an autogenerated conversion function.
+func Convert_v1beta1_NetworkPolicyRule_To_garden_NetworkPolicyRule(in *NetworkPolicyRule, out *garden.NetworkPolicyRule, s conversion.Scope) error {
+	return autoConvert_v1beta1_NetworkPolicyRule_To_garden_NetworkPolicyRule(in, out, s)
+}
+
+func autoConvert_garden_NetworkPolicyRule_To_v1beta1_NetworkPolicyRule(in *garden.NetworkPolicyRule, out *NetworkPolicyRule, s conversion.Scope) error {
+	out.Name = in.Name
+	out.PodSelector = in.PodSelector
+	out.CIDRs = *(*[]string)(unsafe.Pointer(&in.CIDRs))
+	out.Ports = *(*[]NetworkPolicyRulePort)(unsafe.Pointer(&in.Ports))
+	return nil
+}
+
+// Convert_garden_NetworkPolicyRule_To_v1beta1_NetworkPolicyRule is an autogenerated conversion function.
+func Convert_garden_NetworkPolicyRule_To_v1beta1_NetworkPolicyRule(in *garden.NetworkPolicyRule, out *NetworkPolicyRule, s conversion.Scope) error {
+	return autoConvert_garden_NetworkPolicyRule_To_v1beta1_NetworkPolicyRule(in, out, s)
+}
+
+func autoConvert_v1beta1_NetworkPolicyRulePort_To_garden_NetworkPolicyRulePort(in *NetworkPolicyRulePort, out *garden.NetworkPolicyRulePort, s conversion.Scope) error {
+	out.Protocol = (*corev1.Protocol)(unsafe.Pointer(in.Protocol))
+	out.Port = in.Port
+	return nil
+}
+
+// Convert_v1beta1_NetworkPolicyRulePort_To_garden_NetworkPolicyRulePort is an autogenerated conversion function.
+func Convert_v1beta1_NetworkPolicyRulePort_To_garden_NetworkPolicyRulePort(in *NetworkPolicyRulePort, out *garden.NetworkPolicyRulePort, s conversion.Scope) error {
+	return autoConvert_v1beta1_NetworkPolicyRulePort_To_garden_NetworkPolicyRulePort(in, out, s)
+}
+
+func autoConvert_garden_NetworkPolicyRulePort_To_v1beta1_NetworkPolicyRulePort(in *garden.NetworkPolicyRulePort, out *NetworkPolicyRulePort, s conversion.Scope) error {
+	out.Protocol = (*corev1.Protocol)(unsafe.Pointer(in.Protocol))
+	out.Port = in.Port
+	return nil
+}
+
+// Convert_garden_NetworkPolicyRulePort_To_v1beta1_NetworkPolicyRulePort is an autogenerated conversion function.
+func Convert_garden_NetworkPolicyRulePort_To_v1beta1_NetworkPolicyRulePort(in *garden.NetworkPolicyRulePort, out *NetworkPolicyRulePort, s conversion.Scope) error {
+	return autoConvert_garden_NetworkPolicyRulePort_To_v1beta1_NetworkPolicyRulePort(in, out, s)
+}
+
+func autoConvert_v1beta1_NetworkPool_To_garden_NetworkPool(in *NetworkPool, out *garden.NetworkPool, s conversion.Scope) error {
+	out.CIDR = in.CIDR
+	out.PrefixLength = in.PrefixLength
+	return nil
+}
+
+// Convert_v1beta1_NetworkPool_To_garden_NetworkPool is an autogenerated conversion function.
+func Convert_v1beta1_NetworkPool_To_garden_NetworkPool(in *NetworkPool, out *garden.NetworkPool, s conversion.Scope) error {
+	return autoConvert_v1beta1_NetworkPool_To_garden_NetworkPool(in, out, s)
+}
+
+func autoConvert_garden_NetworkPool_To_v1beta1_NetworkPool(in *garden.NetworkPool, out *NetworkPool, s conversion.Scope) error {
+	out.CIDR = in.CIDR
+	out.PrefixLength = in.PrefixLength
+	return nil
+}
+
+// Convert_garden_NetworkPool_To_v1beta1_NetworkPool is an autogenerated conversion function.
+func Convert_garden_NetworkPool_To_v1beta1_NetworkPool(in *garden.NetworkPool, out *NetworkPool, s conversion.Scope) error {
+	return autoConvert_garden_NetworkPool_To_v1beta1_NetworkPool(in, out, s)
+}
+
 func autoConvert_v1beta1_Networking_To_garden_Networking(in *Networking, out *garden.Networking, s conversion.Scope) error {
 	// WARNING: in.K8SNetworks requires manual conversion: does not exist in peer-type
 	out.Type = in.Type
 	out.ProviderConfig = (*garden.ProviderConfig)(unsafe.Pointer(in.ProviderConfig))
+	out.IPFamilies = *(*[]garden.IPFamily)(unsafe.Pointer(&in.IPFamilies))
 	return nil
 }
 
@@ -3685,6 +4594,7 @@ func autoConvert_garden_Networking_To_v1beta1_Networking(in *garden.Networking,
 	// WARNING: in.Pods requires manual conversion: does not exist in peer-type
 	// WARNING: in.Nodes requires manual conversion: does not exist in peer-type
 	// WARNING: in.Services requires manual conversion: does not exist in peer-type
+	out.IPFamilies = *(*[]IPFamily)(unsafe.Pointer(&in.IPFamilies))
 	return nil
 }
 
@@ -3694,7 +4604,7 @@ func autoConvert_v1beta1_NginxIngress_To_garden_NginxIngress(in *NginxIngress, o
 	}
 	out.LoadBalancerSourceRanges = *(*[]string)(unsafe.Pointer(&in.LoadBalancerSourceRanges))
 	out.Config = *(*map[string]string)(unsafe.Pointer(&in.Config))
-	out.ExternalTrafficPolicy = (*v1.ServiceExternalTrafficPolicyType)(unsafe.Pointer(in.ExternalTrafficPolicy))
+	out.ExternalTrafficPolicy = (*corev1.ServiceExternalTrafficPolicyType)(unsafe.Pointer(in.ExternalTrafficPolicy))
 	return nil
 }
 
@@ -3709,7 +4619,7 @@ func autoConvert_garden_NginxIngress_To_v1beta1_NginxIngress(in *garden.NginxIng
 	}
 	out.LoadBalancerSourceRanges = *(*[]string)(unsafe.Pointer(&in.LoadBalancerSourceRanges))
 	out.Config = *(*map[string]string)(unsafe.Pointer(&in.Config))
-	out.ExternalTrafficPolicy = (*v1.ServiceExternalTrafficPolicyType)(unsafe.Pointer(in.ExternalTrafficPolicy))
+	out.ExternalTrafficPolicy = (*corev1.ServiceExternalTrafficPolicyType)(unsafe.Pointer(in.ExternalTrafficPolicy))
 	return nil
 }
 
@@ -3718,6 +4628,30 @@ func Convert_garden_NginxIngress_To_v1beta1_NginxIngress(in *garden.NginxIngress
 	return autoConvert_garden_NginxIngress_To_v1beta1_NginxIngress(in, out, s)
 }
 
+func autoConvert_v1beta1_NodeKubeletConfigDrift_To_garden_NodeKubeletConfigDrift(in *NodeKubeletConfigDrift, out *garden.NodeKubeletConfigDrift, s conversion.Scope) error {
+	out.Node = in.Node
+	out.WorkerPool = (*string)(unsafe.Pointer(in.WorkerPool))
+	out.Fields = *(*[]garden.KubeletConfigFieldDrift)(unsafe.Pointer(&in.Fields))
+	return nil
+}
+
+// Convert_v1beta1_NodeKubeletConfigDrift_To_garden_NodeKubeletConfigDrift is an autogenerated conversion function.
+func Convert_v1beta1_NodeKubeletConfigDrift_To_garden_NodeKubeletConfigDrift(in *NodeKubeletConfigDrift, out *garden.NodeKubeletConfigDrift, s conversion.Scope) error {
+	return autoConvert_v1beta1_NodeKubeletConfigDrift_To_garden_NodeKubeletConfigDrift(in, out, s)
+}
+
+func autoConvert_garden_NodeKubeletConfigDrift_To_v1beta1_NodeKubeletConfigDrift(in *garden.NodeKubeletConfigDrift, out *NodeKubeletConfigDrift, s conversion.Scope) error {
+	out.Node = in.Node
+	out.WorkerPool = (*string)(unsafe.Pointer(in.WorkerPool))
+	out.Fields = *(*[]KubeletConfigFieldDrift)(unsafe.Pointer(&in.Fields))
+	return nil
+}
+
+// Convert_garden_NodeKubeletConfigDrift_To_v1beta1_NodeKubeletConfigDrift is an autogenerated conversion function.
+func Convert_garden_NodeKubeletConfigDrift_To_v1beta1_NodeKubeletConfigDrift(in *garden.NodeKubeletConfigDrift, out *NodeKubeletConfigDrift, s conversion.Scope) error {
+	return autoConvert_garden_NodeKubeletConfigDrift_To_v1beta1_NodeKubeletConfigDrift(in, out, s)
+}
+
 func autoConvert_v1beta1_OIDCConfig_To_garden_OIDCConfig(in *OIDCConfig, out *garden.OIDCConfig, s conversion.Scope) error {
 	out.CABundle = (*string)(unsafe.Pointer(in.CABundle))
 	out.ClientID = (*string)(unsafe.Pointer(in.ClientID))
@@ -4261,11 +5195,6 @@ func autoConvert_v1beta1_Project_To_garden_Project(in *Project, out *garden.Proj
 	return nil
 }
 
-// Convert_v1beta1_Project_To_garden_Project is an autogenerated conversion function.
-func Convert_v1beta1_Project_To_garden_Project(in *Project, out *garden.Project, s conversion.Scope) error {
-	return autoConvert_v1beta1_Project_To_garden_Project(in, out, s)
-}
-
 func autoConvert_garden_Project_To_v1beta1_Project(in *garden.Project, out *Project, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_garden_ProjectSpec_To_v1beta1_ProjectSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -4277,11 +5206,6 @@ func autoConvert_garden_Project_To_v1beta1_Project(in *garden.Project, out *Proj
 	return nil
 }
 
-// Convert_garden_Project_To_v1beta1_Project is an autogenerated conversion function.
-func Convert_garden_Project_To_v1beta1_Project(in *garden.Project, out *Project, s conversion.Scope) error {
-	return autoConvert_garden_Project_To_v1beta1_Project(in, out, s)
-}
-
 func autoConvert_v1beta1_ProjectList_To_garden_ProjectList(in *ProjectList, out *garden.ProjectList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
 	if in.Items != nil {
@@ -4332,6 +5256,7 @@ func autoConvert_v1beta1_ProjectSpec_To_garden_ProjectSpec(in *ProjectSpec, out
 	// WARNING: in.Members requires manual conversion: does not exist in peer-type
 	out.Namespace = (*string)(unsafe.Pointer(in.Namespace))
 	// WARNING: in.Viewers requires manual conversion: does not exist in peer-type
+	out.BackupRetentionPolicy = (*garden.BackupRetentionPolicy)(unsafe.Pointer(in.BackupRetentionPolicy))
 	return nil
 }
 
@@ -4342,6 +5267,7 @@ func autoConvert_garden_ProjectSpec_To_v1beta1_ProjectSpec(in *garden.ProjectSpe
 	out.Purpose = (*string)(unsafe.Pointer(in.Purpose))
 	// WARNING: in.ProjectMembers requires manual conversion: does not exist in peer-type
 	out.Namespace = (*string)(unsafe.Pointer(in.Namespace))
+	out.BackupRetentionPolicy = (*BackupRetentionPolicy)(unsafe.Pointer(in.BackupRetentionPolicy))
 	return nil
 }
 
@@ -4437,22 +5363,42 @@ func Convert_garden_QuotaList_To_v1beta1_QuotaList(in *garden.QuotaList, out *Qu
 
 func autoConvert_v1beta1_QuotaSpec_To_garden_QuotaSpec(in *QuotaSpec, out *garden.QuotaSpec, s conversion.Scope) error {
 	out.ClusterLifetimeDays = (*int)(unsafe.Pointer(in.ClusterLifetimeDays))
-	out.Metrics = *(*v1.ResourceList)(unsafe.Pointer(&in.Metrics))
+	out.Metrics = *(*corev1.ResourceList)(unsafe.Pointer(&in.Metrics))
 	// WARNING: in.Scope requires manual conversion: inconvertible types (github.com/gardener/gardener/pkg/apis/garden/v1beta1.QuotaScope vs k8s.io/api/core/v1.ObjectReference)
 	return nil
 }
 
 func autoConvert_garden_QuotaSpec_To_v1beta1_QuotaSpec(in *garden.QuotaSpec, out *QuotaSpec, s conversion.Scope) error {
 	out.ClusterLifetimeDays = (*int)(unsafe.Pointer(in.ClusterLifetimeDays))
-	out.Metrics = *(*v1.ResourceList)(unsafe.Pointer(&in.Metrics))
+	out.Metrics = *(*corev1.ResourceList)(unsafe.Pointer(&in.Metrics))
 	// WARNING: in.Scope requires manual conversion: inconvertible types (k8s.io/api/core/v1.ObjectReference vs github.com/gardener/gardener/pkg/apis/garden/v1beta1.QuotaScope)
 	return nil
 }
 
+func autoConvert_v1beta1_SecondaryBackupLocation_To_garden_SecondaryBackupLocation(in *SecondaryBackupLocation, out *garden.SecondaryBackupLocation, s conversion.Scope) error {
+	out.Region = in.Region
+	return nil
+}
+
+// Convert_v1beta1_SecondaryBackupLocation_To_garden_SecondaryBackupLocation is an autogenerated conversion function.
+func Convert_v1beta1_SecondaryBackupLocation_To_garden_SecondaryBackupLocation(in *SecondaryBackupLocation, out *garden.SecondaryBackupLocation, s conversion.Scope) error {
+	return autoConvert_v1beta1_SecondaryBackupLocation_To_garden_SecondaryBackupLocation(in, out, s)
+}
+
+func autoConvert_garden_SecondaryBackupLocation_To_v1beta1_SecondaryBackupLocation(in *garden.SecondaryBackupLocation, out *SecondaryBackupLocation, s conversion.Scope) error {
+	out.Region = in.Region
+	return nil
+}
+
+// Convert_garden_SecondaryBackupLocation_To_v1beta1_SecondaryBackupLocation is an autogenerated conversion function.
+func Convert_garden_SecondaryBackupLocation_To_v1beta1_SecondaryBackupLocation(in *garden.SecondaryBackupLocation, out *SecondaryBackupLocation, s conversion.Scope) error {
+	return autoConvert_garden_SecondaryBackupLocation_To_v1beta1_SecondaryBackupLocation(in, out, s)
+}
+
 func autoConvert_v1beta1_SecretBinding_To_garden_SecretBinding(in *SecretBinding, out *garden.SecretBinding, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	out.SecretRef = in.SecretRef
-	out.Quotas = *(*[]v1.ObjectReference)(unsafe.Pointer(&in.Quotas))
+	out.Quotas = *(*[]corev1.ObjectReference)(unsafe.Pointer(&in.Quotas))
 	return nil
 }
 
@@ -4464,7 +5410,7 @@ func Convert_v1beta1_SecretBinding_To_garden_SecretBinding(in *SecretBinding, ou
 func autoConvert_garden_SecretBinding_To_v1beta1_SecretBinding(in *garden.SecretBinding, out *SecretBinding, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	out.SecretRef = in.SecretRef
-	out.Quotas = *(*[]v1.ObjectReference)(unsafe.Pointer(&in.Quotas))
+	out.Quotas = *(*[]corev1.ObjectReference)(unsafe.Pointer(&in.Quotas))
 	return nil
 }
 
@@ -4586,6 +5532,8 @@ func autoConvert_v1beta1_SeedNetworks_To_garden_SeedNetworks(in *SeedNetworks, o
 	out.Pods = in.Pods
 	out.Services = in.Services
 	out.ShootDefaults = (*garden.ShootNetworks)(unsafe.Pointer(in.ShootDefaults))
+	out.ShootPools = (*garden.ShootNetworkPools)(unsafe.Pointer(in.ShootPools))
+	out.IPFamilies = *(*[]garden.IPFamily)(unsafe.Pointer(&in.IPFamilies))
 	return nil
 }
 
@@ -4594,6 +5542,8 @@ func autoConvert_garden_SeedNetworks_To_v1beta1_SeedNetworks(in *garden.SeedNetw
 	out.Pods = in.Pods
 	out.Services = in.Services
 	out.ShootDefaults = (*ShootNetworks)(unsafe.Pointer(in.ShootDefaults))
+	out.ShootPools = (*ShootNetworkPools)(unsafe.Pointer(in.ShootPools))
+	out.IPFamilies = *(*[]IPFamily)(unsafe.Pointer(&in.IPFamilies))
 	// WARNING: in.BlockCIDRs requires manual conversion: does not exist in peer-type
 	return nil
 }
@@ -4603,7 +5553,7 @@ func autoConvert_v1beta1_SeedSpec_To_garden_SeedSpec(in *SeedSpec, out *garden.S
 		return err
 	}
 	out.IngressDomain = in.IngressDomain
-	out.SecretRef = (*v1.SecretReference)(unsafe.Pointer(in.SecretRef))
+	out.SecretRef = (*corev1.SecretReference)(unsafe.Pointer(in.SecretRef))
 	if err := Convert_v1beta1_SeedNetworks_To_garden_SeedNetworks(&in.Networks, &out.Networks, s); err != nil {
 		return err
 	}
@@ -4620,7 +5570,7 @@ func autoConvert_garden_SeedSpec_To_v1beta1_SeedSpec(in *garden.SeedSpec, out *S
 	}
 	// WARNING: in.Provider requires manual conversion: does not exist in peer-type
 	out.IngressDomain = in.IngressDomain
-	out.SecretRef = (*v1.SecretReference)(unsafe.Pointer(in.SecretRef))
+	out.SecretRef = (*corev1.SecretReference)(unsafe.Pointer(in.SecretRef))
 	if err := Convert_garden_SeedNetworks_To_v1beta1_SeedNetworks(&in.Networks, &out.Networks, s); err != nil {
 		return err
 	}
@@ -4658,7 +5608,7 @@ func Convert_garden_SeedStatus_To_v1beta1_SeedStatus(in *garden.SeedStatus, out
 
 func autoConvert_v1beta1_ServiceAccountConfig_To_garden_ServiceAccountConfig(in *ServiceAccountConfig, out *garden.ServiceAccountConfig, s conversion.Scope) error {
 	out.Issuer = (*string)(unsafe.Pointer(in.Issuer))
-	out.SigningKeySecret = (*v1.LocalObjectReference)(unsafe.Pointer(in.SigningKeySecret))
+	out.SigningKeySecret = (*corev1.LocalObjectReference)(unsafe.Pointer(in.SigningKeySecret))
 	return nil
 }
 
@@ -4669,7 +5619,7 @@ func Convert_v1beta1_ServiceAccountConfig_To_garden_ServiceAccountConfig(in *Ser
 
 func autoConvert_garden_ServiceAccountConfig_To_v1beta1_ServiceAccountConfig(in *garden.ServiceAccountConfig, out *ServiceAccountConfig, s conversion.Scope) error {
 	out.Issuer = (*string)(unsafe.Pointer(in.Issuer))
-	out.SigningKeySecret = (*v1.LocalObjectReference)(unsafe.Pointer(in.SigningKeySecret))
+	out.SigningKeySecret = (*corev1.LocalObjectReference)(unsafe.Pointer(in.SigningKeySecret))
 	return nil
 }
 
@@ -4700,6 +5650,112 @@ func autoConvert_garden_Shoot_To_v1beta1_Shoot(in *garden.Shoot, out *Shoot, s c
 	return nil
 }
 
+func autoConvert_v1beta1_ShootAvailability_To_garden_ShootAvailability(in *ShootAvailability, out *garden.ShootAvailability, s conversion.Scope) error {
+	out.APIServer = *(*[]garden.AvailabilityRatio)(unsafe.Pointer(&in.APIServer))
+	return nil
+}
+
+// Convert_v1beta1_ShootAvailability_To_garden_ShootAvailability is an autogenerated conversion function.
+func Convert_v1beta1_ShootAvailability_To_garden_ShootAvailability(in *ShootAvailability, out *garden.ShootAvailability, s conversion.Scope) error {
+	return autoConvert_v1beta1_ShootAvailability_To_garden_ShootAvailability(in, out, s)
+}
+
+func autoConvert_garden_ShootAvailability_To_v1beta1_ShootAvailability(in *garden.ShootAvailability, out *ShootAvailability, s conversion.Scope) error {
+	out.APIServer = *(*[]AvailabilityRatio)(unsafe.Pointer(&in.APIServer))
+	return nil
+}
+
+// Convert_garden_ShootAvailability_To_v1beta1_ShootAvailability is an autogenerated conversion function.
+func Convert_garden_ShootAvailability_To_v1beta1_ShootAvailability(in *garden.ShootAvailability, out *ShootAvailability, s conversion.Scope) error {
+	return autoConvert_garden_ShootAvailability_To_v1beta1_ShootAvailability(in, out, s)
+}
+
+func autoConvert_v1beta1_ShootBackup_To_garden_ShootBackup(in *ShootBackup, out *garden.ShootBackup, s conversion.Scope) error {
+	out.RetentionPolicy = (*garden.BackupRetentionPolicy)(unsafe.Pointer(in.RetentionPolicy))
+	return nil
+}
+
+// Convert_v1beta1_ShootBackup_To_garden_ShootBackup is an autogenerated conversion function.
+func Convert_v1beta1_ShootBackup_To_garden_ShootBackup(in *ShootBackup, out *garden.ShootBackup, s conversion.Scope) error {
+	return autoConvert_v1beta1_ShootBackup_To_garden_ShootBackup(in, out, s)
+}
+
+func autoConvert_garden_ShootBackup_To_v1beta1_ShootBackup(in *garden.ShootBackup, out *ShootBackup, s conversion.Scope) error {
+	out.RetentionPolicy = (*BackupRetentionPolicy)(unsafe.Pointer(in.RetentionPolicy))
+	return nil
+}
+
+// Convert_garden_ShootBackup_To_v1beta1_ShootBackup is an autogenerated conversion function.
+func Convert_garden_ShootBackup_To_v1beta1_ShootBackup(in *garden.ShootBackup, out *ShootBackup, s conversion.Scope) error {
+	return autoConvert_garden_ShootBackup_To_v1beta1_ShootBackup(in, out, s)
+}
+
+func autoConvert_v1beta1_ShootCredentials_To_garden_ShootCredentials(in *ShootCredentials, out *garden.ShootCredentials, s conversion.Scope) error {
+	out.Rotation = (*garden.ShootCredentialsRotation)(unsafe.Pointer(in.Rotation))
+	return nil
+}
+
+// Convert_v1beta1_ShootCredentials_To_garden_ShootCredentials is an autogenerated conversion function.
+func Convert_v1beta1_ShootCredentials_To_garden_ShootCredentials(in *ShootCredentials, out *garden.ShootCredentials, s conversion.Scope) error {
+	return autoConvert_v1beta1_ShootCredentials_To_garden_ShootCredentials(in, out, s)
+}
+
+func autoConvert_garden_ShootCredentials_To_v1beta1_ShootCredentials(in *garden.ShootCredentials, out *ShootCredentials, s conversion.Scope) error {
+	out.Rotation = (*ShootCredentialsRotation)(unsafe.Pointer(in.Rotation))
+	return nil
+}
+
+// Convert_garden_ShootCredentials_To_v1beta1_ShootCredentials is an autogenerated conversion function.
+func Convert_garden_ShootCredentials_To_v1beta1_ShootCredentials(in *garden.ShootCredentials, out *ShootCredentials, s conversion.Scope) error {
+	return autoConvert_garden_ShootCredentials_To_v1beta1_ShootCredentials(in, out, s)
+}
+
+func autoConvert_v1beta1_ShootCredentialsRotation_To_garden_ShootCredentialsRotation(in *ShootCredentialsRotation, out *garden.ShootCredentialsRotation, s conversion.Scope) error {
+	out.CertificateAuthorities = (*garden.CARotation)(unsafe.Pointer(in.CertificateAuthorities))
+	out.StaticCredentials = (*garden.StaticCredentialsRotation)(unsafe.Pointer(in.StaticCredentials))
+	out.ETCDEncryptionKey = (*garden.ETCDEncryptionKeyRotation)(unsafe.Pointer(in.ETCDEncryptionKey))
+	return nil
+}
+
+// Convert_v1beta1_ShootCredentialsRotation_To_garden_ShootCredentialsRotation is an autogenerated conversion function.
+func Convert_v1beta1_ShootCredentialsRotation_To_garden_ShootCredentialsRotation(in *ShootCredentialsRotation, out *garden.ShootCredentialsRotation, s conversion.Scope) error {
+	return autoConvert_v1beta1_ShootCredentialsRotation_To_garden_ShootCredentialsRotation(in, out, s)
+}
+
+func autoConvert_garden_ShootCredentialsRotation_To_v1beta1_ShootCredentialsRotation(in *garden.ShootCredentialsRotation, out *ShootCredentialsRotation, s conversion.Scope) error {
+	out.CertificateAuthorities = (*CARotation)(unsafe.Pointer(in.CertificateAuthorities))
+	out.StaticCredentials = (*StaticCredentialsRotation)(unsafe.Pointer(in.StaticCredentials))
+	out.ETCDEncryptionKey = (*ETCDEncryptionKeyRotation)(unsafe.Pointer(in.ETCDEncryptionKey))
+	return nil
+}
+
+// Convert_garden_ShootCredentialsRotation_To_v1beta1_ShootCredentialsRotation is an autogenerated conversion function.
+func Convert_garden_ShootCredentialsRotation_To_v1beta1_ShootCredentialsRotation(in *garden.ShootCredentialsRotation, out *ShootCredentialsRotation, s conversion.Scope) error {
+	return autoConvert_garden_ShootCredentialsRotation_To_v1beta1_ShootCredentialsRotation(in, out, s)
+}
+
+func autoConvert_v1beta1_ShootETCDBackup_To_garden_ShootETCDBackup(in *ShootETCDBackup, out *garden.ShootETCDBackup, s conversion.Scope) error {
+	out.LastSnapshot = (*garden.ETCDBackupOperation)(unsafe.Pointer(in.LastSnapshot))
+	out.LastRestore = (*garden.ETCDBackupOperation)(unsafe.Pointer(in.LastRestore))
+	return nil
+}
+
+// Convert_v1beta1_ShootETCDBackup_To_garden_ShootETCDBackup is an autogenerated conversion function.
+func Convert_v1beta1_ShootETCDBackup_To_garden_ShootETCDBackup(in *ShootETCDBackup, out *garden.ShootETCDBackup, s conversion.Scope) error {
+	return autoConvert_v1beta1_ShootETCDBackup_To_garden_ShootETCDBackup(in, out, s)
+}
+
+func autoConvert_garden_ShootETCDBackup_To_v1beta1_ShootETCDBackup(in *garden.ShootETCDBackup, out *ShootETCDBackup, s conversion.Scope) error {
+	out.LastSnapshot = (*ETCDBackupOperation)(unsafe.Pointer(in.LastSnapshot))
+	out.LastRestore = (*ETCDBackupOperation)(unsafe.Pointer(in.LastRestore))
+	return nil
+}
+
+// Convert_garden_ShootETCDBackup_To_v1beta1_ShootETCDBackup is an autogenerated conversion function.
+func Convert_garden_ShootETCDBackup_To_v1beta1_ShootETCDBackup(in *garden.ShootETCDBackup, out *ShootETCDBackup, s conversion.Scope) error {
+	return autoConvert_garden_ShootETCDBackup_To_v1beta1_ShootETCDBackup(in, out, s)
+}
+
 func autoConvert_v1beta1_ShootList_To_garden_ShootList(in *ShootList, out *garden.ShootList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
 	if in.Items != nil {
@@ -4766,6 +5822,28 @@ func Convert_garden_ShootMachineImage_To_v1beta1_ShootMachineImage(in *garden.Sh
 	return autoConvert_garden_ShootMachineImage_To_v1beta1_ShootMachineImage(in, out, s)
 }
 
+func autoConvert_v1beta1_ShootNetworkPools_To_garden_ShootNetworkPools(in *ShootNetworkPools, out *garden.ShootNetworkPools, s conversion.Scope) error {
+	out.Nodes = (*garden.NetworkPool)(unsafe.Pointer(in.Nodes))
+	out.Pods = (*garden.NetworkPool)(unsafe.Pointer(in.Pods))
+	return nil
+}
+
+// Convert_v1beta1_ShootNetworkPools_To_garden_ShootNetworkPools is an autogenerated conversion function.
+func Convert_v1beta1_ShootNetworkPools_To_garden_ShootNetworkPools(in *ShootNetworkPools, out *garden.ShootNetworkPools, s conversion.Scope) error {
+	return autoConvert_v1beta1_ShootNetworkPools_To_garden_ShootNetworkPools(in, out, s)
+}
+
+func autoConvert_garden_ShootNetworkPools_To_v1beta1_ShootNetworkPools(in *garden.ShootNetworkPools, out *ShootNetworkPools, s conversion.Scope) error {
+	out.Nodes = (*NetworkPool)(unsafe.Pointer(in.Nodes))
+	out.Pods = (*NetworkPool)(unsafe.Pointer(in.Pods))
+	return nil
+}
+
+// Convert_garden_ShootNetworkPools_To_v1beta1_ShootNetworkPools is an autogenerated conversion function.
+func Convert_garden_ShootNetworkPools_To_v1beta1_ShootNetworkPools(in *garden.ShootNetworkPools, out *ShootNetworkPools, s conversion.Scope) error {
+	return autoConvert_garden_ShootNetworkPools_To_v1beta1_ShootNetworkPools(in, out, s)
+}
+
 func autoConvert_v1beta1_ShootNetworks_To_garden_ShootNetworks(in *ShootNetworks, out *garden.ShootNetworks, s conversion.Scope) error {
 	out.Pods = (*string)(unsafe.Pointer(in.Pods))
 	out.Services = (*string)(unsafe.Pointer(in.Services))
@@ -4790,9 +5868,11 @@ func Convert_garden_ShootNetworks_To_v1beta1_ShootNetworks(in *garden.ShootNetwo
 
 func autoConvert_v1beta1_ShootSpec_To_garden_ShootSpec(in *ShootSpec, out *garden.ShootSpec, s conversion.Scope) error {
 	out.Addons = (*garden.Addons)(unsafe.Pointer(in.Addons))
+	out.Backup = (*garden.ShootBackup)(unsafe.Pointer(in.Backup))
 	if err := Convert_v1beta1_Cloud_To_garden_Cloud(&in.Cloud, &out.Cloud, s); err != nil {
 		return err
 	}
+	out.ControlPlane = (*garden.ControlPlane)(unsafe.Pointer(in.ControlPlane))
 	if in.DNS != nil {
 		in, out := &in.DNS, &out.DNS
 		*out = new(garden.DNS)
@@ -4815,10 +5895,12 @@ func autoConvert_v1beta1_ShootSpec_To_garden_ShootSpec(in *ShootSpec, out *garde
 
 func autoConvert_garden_ShootSpec_To_v1beta1_ShootSpec(in *garden.ShootSpec, out *ShootSpec, s conversion.Scope) error {
 	out.Addons = (*Addons)(unsafe.Pointer(in.Addons))
+	out.Backup = (*ShootBackup)(unsafe.Pointer(in.Backup))
 	if err := Convert_garden_Cloud_To_v1beta1_Cloud(&in.Cloud, &out.Cloud, s); err != nil {
 		return err
 	}
 	// WARNING: in.CloudProfileName requires manual conversion: does not exist in peer-type
+	out.ControlPlane = (*ControlPlane)(unsafe.Pointer(in.ControlPlane))
 	if in.DNS != nil {
 		in, out := &in.DNS, &out.DNS
 		*out = new(DNS)
@@ -4844,16 +5926,21 @@ func autoConvert_garden_ShootSpec_To_v1beta1_ShootSpec(in *garden.ShootSpec, out
 }
 
 func autoConvert_v1beta1_ShootStatus_To_garden_ShootStatus(in *ShootStatus, out *garden.ShootStatus, s conversion.Scope) error {
+	out.Availability = (*garden.ShootAvailability)(unsafe.Pointer(in.Availability))
 	out.Conditions = *(*[]garden.Condition)(unsafe.Pointer(&in.Conditions))
 	out.Constraints = *(*[]garden.Condition)(unsafe.Pointer(&in.Constraints))
+	out.ConditionHistories = *(*[]garden.ConditionHistory)(unsafe.Pointer(&in.ConditionHistories))
+	out.Credentials = (*garden.ShootCredentials)(unsafe.Pointer(in.Credentials))
+	out.ETCDBackup = (*garden.ShootETCDBackup)(unsafe.Pointer(in.ETCDBackup))
 	if err := Convert_v1beta1_Gardener_To_garden_Gardener(&in.Gardener, &out.Gardener, s); err != nil {
 		return err
 	}
+	out.KubeletConfigDrifts = *(*[]garden.NodeKubeletConfigDrift)(unsafe.Pointer(&in.KubeletConfigDrifts))
 	out.LastOperation = (*garden.LastOperation)(unsafe.Pointer(in.LastOperation))
 	// WARNING: in.LastError requires manual conversion: does not exist in peer-type
 	out.LastErrors = *(*[]garden.LastError)(unsafe.Pointer(&in.LastErrors))
 	out.ObservedGeneration = in.ObservedGeneration
-	out.RetryCycleStartTime = (*metav1.Time)(unsafe.Pointer(in.RetryCycleStartTime))
+	out.RetryCycleStartTime = (*v1.Time)(unsafe.Pointer(in.RetryCycleStartTime))
 	// WARNING: in.Seed requires manual conversion: does not exist in peer-type
 	out.IsHibernated = (*bool)(unsafe.Pointer(in.IsHibernated))
 	out.TechnicalID = in.TechnicalID
@@ -4862,15 +5949,20 @@ func autoConvert_v1beta1_ShootStatus_To_garden_ShootStatus(in *ShootStatus, out
 }
 
 func autoConvert_garden_ShootStatus_To_v1beta1_ShootStatus(in *garden.ShootStatus, out *ShootStatus, s conversion.Scope) error {
+	out.Availability = (*ShootAvailability)(unsafe.Pointer(in.Availability))
 	out.Conditions = *(*[]v1alpha1.Condition)(unsafe.Pointer(&in.Conditions))
 	out.Constraints = *(*[]v1alpha1.Condition)(unsafe.Pointer(&in.Constraints))
+	out.ConditionHistories = *(*[]v1alpha1.ConditionHistory)(unsafe.Pointer(&in.ConditionHistories))
+	out.Credentials = (*ShootCredentials)(unsafe.Pointer(in.Credentials))
+	out.ETCDBackup = (*ShootETCDBackup)(unsafe.Pointer(in.ETCDBackup))
 	if err := Convert_garden_Gardener_To_v1beta1_Gardener(&in.Gardener, &out.Gardener, s); err != nil {
 		return err
 	}
+	out.KubeletConfigDrifts = *(*[]NodeKubeletConfigDrift)(unsafe.Pointer(&in.KubeletConfigDrifts))
 	out.LastOperation = (*v1alpha1.LastOperation)(unsafe.Pointer(in.LastOperation))
 	out.LastErrors = *(*[]v1alpha1.LastError)(unsafe.Pointer(&in.LastErrors))
 	out.ObservedGeneration = in.ObservedGeneration
-	out.RetryCycleStartTime = (*metav1.Time)(unsafe.Pointer(in.RetryCycleStartTime))
+	out.RetryCycleStartTime = (*v1.Time)(unsafe.Pointer(in.RetryCycleStartTime))
 	// WARNING: in.SeedName requires manual conversion: does not exist in peer-type
 	out.IsHibernated = (*bool)(unsafe.Pointer(in.IsHibernated))
 	out.TechnicalID = in.TechnicalID
@@ -4878,6 +5970,48 @@ func autoConvert_garden_ShootStatus_To_v1beta1_ShootStatus(in *garden.ShootStatu
 	return nil
 }
 
+func autoConvert_v1beta1_StaticCredentialsRotation_To_garden_StaticCredentialsRotation(in *StaticCredentialsRotation, out *garden.StaticCredentialsRotation, s conversion.Scope) error {
+	out.LastRotationTime = (*v1.Time)(unsafe.Pointer(in.LastRotationTime))
+	return nil
+}
+
+// Convert_v1beta1_StaticCredentialsRotation_To_garden_StaticCredentialsRotation is an autogenerated conversion function.
+func Convert_v1beta1_StaticCredentialsRotation_To_garden_StaticCredentialsRotation(in *StaticCredentialsRotation, out *garden.StaticCredentialsRotation, s conversion.Scope) error {
+	return autoConvert_v1beta1_StaticCredentialsRotation_To_garden_StaticCredentialsRotation(in, out, s)
+}
+
+func autoConvert_garden_StaticCredentialsRotation_To_v1beta1_StaticCredentialsRotation(in *garden.StaticCredentialsRotation, out *StaticCredentialsRotation, s conversion.Scope) error {
+	out.LastRotationTime = (*v1.Time)(unsafe.Pointer(in.LastRotationTime))
+	return nil
+}
+
+// Convert_garden_StaticCredentialsRotation_To_v1beta1_StaticCredentialsRotation is an autogenerated conversion function.
+func Convert_garden_StaticCredentialsRotation_To_v1beta1_StaticCredentialsRotation(in *garden.StaticCredentialsRotation, out *StaticCredentialsRotation, s conversion.Scope) error {
+	return autoConvert_garden_StaticCredentialsRotation_To_v1beta1_StaticCredentialsRotation(in, out, s)
+}
+
+func autoConvert_v1beta1_StaticCredentialsRotationConfig_To_garden_StaticCredentialsRotationConfig(in *StaticCredentialsRotationConfig, out *garden.StaticCredentialsRotationConfig, s conversion.Scope) error {
+	out.Period = in.Period
+	out.TransitionPeriod = (*v1.Duration)(unsafe.Pointer(in.TransitionPeriod))
+	return nil
+}
+
+// Convert_v1beta1_StaticCredentialsRotationConfig_To_garden_StaticCredentialsRotationConfig is an autogenerated conversion function.
+func Convert_v1beta1_StaticCredentialsRotationConfig_To_garden_StaticCredentialsRotationConfig(in *StaticCredentialsRotationConfig, out *garden.StaticCredentialsRotationConfig, s conversion.Scope) error {
+	return autoConvert_v1beta1_StaticCredentialsRotationConfig_To_garden_StaticCredentialsRotationConfig(in, out, s)
+}
+
+func autoConvert_garden_StaticCredentialsRotationConfig_To_v1beta1_StaticCredentialsRotationConfig(in *garden.StaticCredentialsRotationConfig, out *StaticCredentialsRotationConfig, s conversion.Scope) error {
+	out.Period = in.Period
+	out.TransitionPeriod = (*v1.Duration)(unsafe.Pointer(in.TransitionPeriod))
+	return nil
+}
+
+// Convert_garden_StaticCredentialsRotationConfig_To_v1beta1_StaticCredentialsRotationConfig is an autogenerated conversion function.
+func Convert_garden_StaticCredentialsRotationConfig_To_v1beta1_StaticCredentialsRotationConfig(in *garden.StaticCredentialsRotationConfig, out *StaticCredentialsRotationConfig, s conversion.Scope) error {
+	return autoConvert_garden_StaticCredentialsRotationConfig_To_v1beta1_StaticCredentialsRotationConfig(in, out, s)
+}
+
 func autoConvert_v1beta1_VolumeType_To_garden_VolumeType(in *VolumeType, out *garden.VolumeType, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Usable = (*bool)(unsafe.Pointer(in.Usable))
@@ -4912,15 +6046,17 @@ func autoConvert_v1beta1_Worker_To_garden_Worker(in *Worker, out *garden.Worker,
 	out.MaxUnavailable = (*intstr.IntOrString)(unsafe.Pointer(in.MaxUnavailable))
 	out.Annotations = *(*map[string]string)(unsafe.Pointer(&in.Annotations))
 	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
-	out.Taints = *(*[]v1.Taint)(unsafe.Pointer(&in.Taints))
+	out.Taints = *(*[]corev1.Taint)(unsafe.Pointer(&in.Taints))
 	// WARNING: in.Kubelet requires manual conversion: does not exist in peer-type
 	out.CABundle = (*string)(unsafe.Pointer(in.CABundle))
+	out.CRI = (*garden.CRI)(unsafe.Pointer(in.CRI))
 	return nil
 }
 
 func autoConvert_garden_Worker_To_v1beta1_Worker(in *garden.Worker, out *Worker, s conversion.Scope) error {
 	out.Annotations = *(*map[string]string)(unsafe.Pointer(&in.Annotations))
 	out.CABundle = (*string)(unsafe.Pointer(in.CABundle))
+	out.CRI = (*CRI)(unsafe.Pointer(in.CRI))
 	// WARNING: in.Kubernetes requires manual conversion: does not exist in peer-type
 	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
 	out.Name = in.Name
@@ -4930,7 +6066,7 @@ func autoConvert_garden_Worker_To_v1beta1_Worker(in *garden.Worker, out *Worker,
 	out.MaxSurge = (*intstr.IntOrString)(unsafe.Pointer(in.MaxSurge))
 	out.MaxUnavailable = (*intstr.IntOrString)(unsafe.Pointer(in.MaxUnavailable))
 	// WARNING: in.ProviderConfig requires manual conversion: does not exist in peer-type
-	out.Taints = *(*[]v1.Taint)(unsafe.Pointer(&in.Taints))
+	out.Taints = *(*[]corev1.Taint)(unsafe.Pointer(&in.Taints))
 	// WARNING: in.Volume requires manual conversion: does not exist in peer-type
 	// WARNING: in.Zones requires manual conversion: does not exist in peer-type
 	return nil
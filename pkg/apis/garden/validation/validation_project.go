@@ -15,14 +15,32 @@
 package validation
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/gardener/gardener/pkg/apis/garden"
 	rbacv1 "k8s.io/api/rbac/v1"
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 )
 
+// maxExtensionRoleNameLength is the maximum length of the name of an extension role (without its prefix).
+const maxExtensionRoleNameLength = 20
+
+var supportedRoles = sets.NewString(
+	garden.ProjectMemberAdmin,
+	garden.ProjectMemberViewer,
+	garden.ProjectMemberUserAccessManager,
+)
+
 // ValidateProject validates a Project object.
 func ValidateProject(project *garden.Project) field.ErrorList {
+	return validateProject(project, nil)
+}
+
+func validateProject(project, oldProject *garden.Project) field.ErrorList {
 	allErrs := field.ErrorList{}
 
 	allErrs = append(allErrs, apivalidation.ValidateObjectMeta(&project.ObjectMeta, false, ValidateName, field.NewPath("metadata"))...)
@@ -31,7 +49,12 @@ func ValidateProject(project *garden.Project) field.ErrorList {
 		allErrs = append(allErrs, field.TooLong(field.NewPath("metadata", "name"), project.Name, maxProjectNameLength))
 	}
 	allErrs = append(allErrs, validateNameConsecutiveHyphens(project.Name, field.NewPath("metadata", "name"))...)
-	allErrs = append(allErrs, ValidateProjectSpec(&project.Spec, field.NewPath("spec"))...)
+
+	var oldProjectSpec *garden.ProjectSpec
+	if oldProject != nil {
+		oldProjectSpec = &oldProject.Spec
+	}
+	allErrs = append(allErrs, validateProjectSpec(&project.Spec, oldProjectSpec, field.NewPath("spec"))...)
 
 	return allErrs
 }
@@ -41,7 +64,7 @@ func ValidateProjectUpdate(newProject, oldProject *garden.Project) field.ErrorLi
 	allErrs := field.ErrorList{}
 
 	allErrs = append(allErrs, apivalidation.ValidateObjectMetaUpdate(&newProject.ObjectMeta, &oldProject.ObjectMeta, field.NewPath("metadata"))...)
-	allErrs = append(allErrs, ValidateProject(newProject)...)
+	allErrs = append(allErrs, validateProject(newProject, oldProject)...)
 
 	if oldProject.Spec.CreatedBy != nil {
 		allErrs = append(allErrs, apivalidation.ValidateImmutableField(newProject.Spec.CreatedBy, oldProject.Spec.CreatedBy, field.NewPath("spec", "createdBy"))...)
@@ -58,10 +81,30 @@ func ValidateProjectUpdate(newProject, oldProject *garden.Project) field.ErrorLi
 
 // ValidateProjectSpec validates the specification of a Project object.
 func ValidateProjectSpec(projectSpec *garden.ProjectSpec, fldPath *field.Path) field.ErrorList {
+	return validateProjectSpec(projectSpec, nil, fldPath)
+}
+
+// validateProjectSpec validates the specification of a Project object. If the old specification is given then only the
+// roles newly granted to a member are validated, i.e., roles which are no longer supported remain valid for existing
+// members.
+func validateProjectSpec(projectSpec, oldProjectSpec *garden.ProjectSpec, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
+	existingRoles := map[rbacv1.Subject]sets.String{}
+	if oldProjectSpec != nil {
+		for _, member := range oldProjectSpec.ProjectMembers {
+			if _, ok := existingRoles[member.Subject]; !ok {
+				existingRoles[member.Subject] = sets.NewString()
+			}
+			existingRoles[member.Subject].Insert(member.Role)
+			existingRoles[member.Subject].Insert(member.Roles...)
+		}
+	}
+
 	for i, member := range projectSpec.ProjectMembers {
-		allErrs = append(allErrs, ValidateSubject(member.Subject, fldPath.Child("members").Index(i))...)
+		idxPath := fldPath.Child("members").Index(i)
+		allErrs = append(allErrs, ValidateSubject(member.Subject, idxPath)...)
+		allErrs = append(allErrs, validateProjectMemberRoles(member, existingRoles[member.Subject], idxPath)...)
 	}
 	if createdBy := projectSpec.CreatedBy; createdBy != nil {
 		allErrs = append(allErrs, ValidateSubject(*createdBy, fldPath.Child("createdBy"))...)
@@ -75,6 +118,62 @@ func ValidateProjectSpec(projectSpec *garden.ProjectSpec, fldPath *field.Path) f
 	if purpose := projectSpec.Description; purpose != nil && len(*purpose) == 0 {
 		allErrs = append(allErrs, field.Required(fldPath.Child("purpose"), "must provide a purpose when key is present"))
 	}
+	allErrs = append(allErrs, ValidateBackupRetentionPolicy(projectSpec.BackupRetentionPolicy, fldPath.Child("backupRetentionPolicy"))...)
+
+	return allErrs
+}
+
+// ValidateProjectMemberRoles validates the roles of a project member.
+func ValidateProjectMemberRoles(member garden.ProjectMember, fldPath *field.Path) field.ErrorList {
+	return validateProjectMemberRoles(member, nil, fldPath)
+}
+
+// validateProjectMemberRoles validates the roles of a project member. Roles contained in the given existing roles are
+// not validated again.
+func validateProjectMemberRoles(member garden.ProjectMember, existingRoles sets.String, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(member.Role) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("role"), "must provide a role"))
+	} else if !existingRoles.Has(member.Role) {
+		allErrs = append(allErrs, validateProjectMemberRole(member.Role, fldPath.Child("role"))...)
+	}
+
+	foundRoles := sets.NewString(member.Role)
+	for i, role := range member.Roles {
+		idxPath := fldPath.Child("roles").Index(i)
+
+		if foundRoles.Has(role) {
+			allErrs = append(allErrs, field.Duplicate(idxPath, role))
+			continue
+		}
+		foundRoles.Insert(role)
+
+		if !existingRoles.Has(role) {
+			allErrs = append(allErrs, validateProjectMemberRole(role, idxPath)...)
+		}
+	}
+
+	return allErrs
+}
+
+func validateProjectMemberRole(role string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if !strings.HasPrefix(role, garden.ProjectMemberExtensionPrefix) {
+		if !supportedRoles.Has(role) {
+			allErrs = append(allErrs, field.NotSupported(fldPath, role, append(supportedRoles.List(), garden.ProjectMemberExtensionPrefix+"*")))
+		}
+		return allErrs
+	}
+
+	extensionRoleName := strings.TrimPrefix(role, garden.ProjectMemberExtensionPrefix)
+	if len(extensionRoleName) > maxExtensionRoleNameLength {
+		allErrs = append(allErrs, field.TooLong(fldPath, role, len(garden.ProjectMemberExtensionPrefix)+maxExtensionRoleNameLength))
+	}
+	for _, msg := range validation.IsDNS1123Label(extensionRoleName) {
+		allErrs = append(allErrs, field.Invalid(fldPath, role, fmt.Sprintf("extension role name is invalid: %s", msg)))
+	}
 
 	return allErrs
 }
@@ -126,6 +126,20 @@ var _ = Describe("Project Validation Tests", func() {
 			}))))
 		})
 
+		It("should forbid a backup retention policy which does not keep any snapshots", func() {
+			project.Spec.BackupRetentionPolicy = &garden.BackupRetentionPolicy{
+				DailySnapshots:  makeInt32Pointer(7),
+				WeeklySnapshots: makeInt32Pointer(0),
+			}
+
+			errorList := ValidateProject(project)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.backupRetentionPolicy.weeklySnapshots"),
+			}))))
+		})
+
 		DescribeTable("owner validation",
 			func(apiGroup, kind, name, namespace string, expectType field.ErrorType, field string) {
 				subject := rbacv1.Subject{
@@ -174,6 +188,66 @@ var _ = Describe("Project Validation Tests", func() {
 			Entry("invalid api group name", "rbac.authorization.invalid", rbacv1.GroupKind, "groupname", "", field.ErrorTypeNotSupported, "apiGroup"),
 		)
 
+		DescribeTable("member role validation",
+			func(role string, roles []string, matcher gomegatypes.GomegaMatcher) {
+				project.Spec.ProjectMembers[0].Role = role
+				project.Spec.ProjectMembers[0].Roles = roles
+
+				errList := ValidateProject(project)
+
+				Expect(errList).To(matcher)
+			},
+
+			Entry("uam role", garden.ProjectMemberUserAccessManager, nil, BeEmpty()),
+			Entry("multiple roles", garden.ProjectMemberViewer, []string{garden.ProjectMemberUserAccessManager, "extension:shoot-operator"}, BeEmpty()),
+			Entry("empty role", "", nil, ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.members[0].role"),
+			})))),
+			Entry("unknown role", "foo", nil, ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("spec.members[0].role"),
+			})))),
+			Entry("unknown additional role", garden.ProjectMemberAdmin, []string{"foo"}, ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("spec.members[0].roles[0]"),
+			})))),
+			Entry("duplicate role", garden.ProjectMemberAdmin, []string{garden.ProjectMemberViewer, garden.ProjectMemberAdmin}, ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeDuplicate),
+				"Field": Equal("spec.members[0].roles[1]"),
+			})))),
+			Entry("invalid extension role name", "extension:Foo_", nil, ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.members[0].role"),
+			})))),
+			Entry("too long extension role name", "extension:this-role-name-is-too-long", nil, ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeTooLong),
+				"Field": Equal("spec.members[0].role"),
+			})))),
+		)
+
+		It("should allow Project updates keeping roles which are no longer supported", func() {
+			project.Spec.ProjectMembers[0].Roles = []string{"foo"}
+			newProject := prepareProjectForUpdate(project)
+			newProject.Spec.Description = makeStringPointer("description")
+
+			errorList := ValidateProjectUpdate(newProject, project)
+
+			Expect(errorList).To(BeEmpty())
+		})
+
+		It("should forbid Project updates granting unsupported roles", func() {
+			newProject := prepareProjectForUpdate(project)
+			newProject.Spec.ProjectMembers[0].Roles = []string{"foo"}
+
+			errorList := ValidateProjectUpdate(newProject, project)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("spec.members[0].roles[0]"),
+			}))))
+		})
+
 		DescribeTable("namespace immutability",
 			func(old, new *string, matcher gomegatypes.GomegaMatcher) {
 				project.Spec.Namespace = old
@@ -18,8 +18,10 @@ import (
 	"fmt"
 	"net"
 	"regexp"
+	"strings"
 
 	"github.com/gardener/gardener/pkg/apis/garden"
+	"github.com/gardener/gardener/pkg/apis/garden/helper"
 	"github.com/gardener/gardener/pkg/operation/common"
 	cidrvalidation "github.com/gardener/gardener/pkg/utils/validation/cidr"
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -91,23 +93,53 @@ func ValidateSeedSpec(seedSpec *garden.SeedSpec, fldPath *field.Path) field.Erro
 
 	networksPath := fldPath.Child("networks")
 
-	networks := []cidrvalidation.CIDR{
-		cidrvalidation.NewCIDR(seedSpec.Networks.Nodes, networksPath.Child("nodes")),
-		cidrvalidation.NewCIDR(seedSpec.Networks.Pods, networksPath.Child("pods")),
-		cidrvalidation.NewCIDR(seedSpec.Networks.Services, networksPath.Child("services")),
+	var networks []cidrvalidation.CIDR
+	addNetworks := func(cidrs string, fldPath *field.Path) {
+		for _, cidr := range strings.Split(cidrs, ",") {
+			networks = append(networks, cidrvalidation.NewCIDR(strings.TrimSpace(cidr), fldPath))
+		}
 	}
+
+	addNetworks(seedSpec.Networks.Nodes, networksPath.Child("nodes"))
+	addNetworks(seedSpec.Networks.Pods, networksPath.Child("pods"))
+	addNetworks(seedSpec.Networks.Services, networksPath.Child("services"))
 	if shootDefaults := seedSpec.Networks.ShootDefaults; shootDefaults != nil {
 		if shootDefaults.Pods != nil {
-			networks = append(networks, cidrvalidation.NewCIDR(*shootDefaults.Pods, networksPath.Child("shootDefaults", "pods")))
+			addNetworks(*shootDefaults.Pods, networksPath.Child("shootDefaults", "pods"))
 		}
 		if shootDefaults.Services != nil {
-			networks = append(networks, cidrvalidation.NewCIDR(*shootDefaults.Services, networksPath.Child("shootDefaults", "services")))
+			addNetworks(*shootDefaults.Services, networksPath.Child("shootDefaults", "services"))
+		}
+	}
+	if shootPools := seedSpec.Networks.ShootPools; shootPools != nil {
+		if shootPools.Nodes != nil {
+			networks = append(networks, cidrvalidation.NewCIDR(shootPools.Nodes.CIDR, networksPath.Child("shootPools", "nodes", "cidr")))
+		}
+		if shootPools.Pods != nil {
+			networks = append(networks, cidrvalidation.NewCIDR(shootPools.Pods.CIDR, networksPath.Child("shootPools", "pods", "cidr")))
 		}
 	}
 
-	allErrs = append(allErrs, cidrvalidation.ValidateCIDRParse(networks...)...)
+	parseErrs := cidrvalidation.ValidateCIDRParse(networks...)
+	allErrs = append(allErrs, parseErrs...)
 	allErrs = append(allErrs, cidrvalidation.ValidateCIDROverlap(networks, networks, false)...)
 
+	ipFamiliesErrs := validateIPFamilies(seedSpec.Networks.IPFamilies, networksPath.Child("ipFamilies"))
+	allErrs = append(allErrs, ipFamiliesErrs...)
+	if len(parseErrs) == 0 && len(ipFamiliesErrs) == 0 {
+		allErrs = append(allErrs, validateCIDRsForIPFamilies(seedSpec.Networks.Nodes, seedSpec.Networks.IPFamilies, networksPath.Child("nodes"))...)
+		allErrs = append(allErrs, validateCIDRsForIPFamilies(seedSpec.Networks.Pods, seedSpec.Networks.IPFamilies, networksPath.Child("pods"))...)
+		allErrs = append(allErrs, validateCIDRsForIPFamilies(seedSpec.Networks.Services, seedSpec.Networks.IPFamilies, networksPath.Child("services"))...)
+	}
+	if shootPools := seedSpec.Networks.ShootPools; shootPools != nil && len(parseErrs) == 0 {
+		if shootPools.Nodes != nil {
+			allErrs = append(allErrs, validateNetworkPool(*shootPools.Nodes, networksPath.Child("shootPools", "nodes"))...)
+		}
+		if shootPools.Pods != nil {
+			allErrs = append(allErrs, validateNetworkPool(*shootPools.Pods, networksPath.Child("shootPools", "pods"))...)
+		}
+	}
+
 	if seedSpec.Backup != nil {
 		if len(seedSpec.Backup.Provider) == 0 {
 			allErrs = append(allErrs, field.Required(fldPath.Child("backup", "provider"), "must provide a backup cloud provider name"))
@@ -123,6 +155,20 @@ func ValidateSeedSpec(seedSpec *garden.SeedSpec, fldPath *field.Path) field.Erro
 		//}
 
 		allErrs = append(allErrs, validateSecretReference(seedSpec.Backup.SecretRef, fldPath.Child("backup", "secretRef"))...)
+
+		if secondary := seedSpec.Backup.Secondary; secondary != nil {
+			primaryRegion := seedSpec.Provider.Region
+			if seedSpec.Backup.Region != nil {
+				primaryRegion = *seedSpec.Backup.Region
+			}
+
+			secondaryRegionPath := fldPath.Child("backup", "secondary", "region")
+			if len(secondary.Region) == 0 {
+				allErrs = append(allErrs, field.Required(secondaryRegionPath, "must provide a region for the secondary backup location"))
+			} else if secondary.Region == primaryRegion {
+				allErrs = append(allErrs, field.Invalid(secondaryRegionPath, secondary.Region, "must differ from the region of the primary backup location"))
+			}
+		}
 	}
 
 	var (
@@ -178,6 +224,23 @@ func validateCIDR(cidr string, fldPath *field.Path) field.ErrorList {
 	return allErrs
 }
 
+// validateNetworkPool validates that the CIDR of the given <pool> is canonical and that the prefix length of the
+// networks allocated from it fits into the pool.
+func validateNetworkPool(pool garden.NetworkPool, fldPath *field.Path) field.ErrorList {
+	allErrs := cidrvalidation.ValidateCIDRIsCanonical(fldPath.Child("cidr"), pool.CIDR)
+
+	_, ipNet, err := net.ParseCIDR(pool.CIDR)
+	if err != nil {
+		return allErrs
+	}
+
+	if ones, bits := ipNet.Mask.Size(); pool.PrefixLength < int32(ones) || pool.PrefixLength > int32(bits) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("prefixLength"), pool.PrefixLength, fmt.Sprintf("must be between the prefix length of the pool (%d) and %d", ones, bits)))
+	}
+
+	return allErrs
+}
+
 // ValidateSeedSpecUpdate validates the specification updates of a Seed object.
 func ValidateSeedSpecUpdate(newSeedSpec, oldSeedSpec *garden.SeedSpec, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
@@ -185,11 +248,17 @@ func ValidateSeedSpecUpdate(newSeedSpec, oldSeedSpec *garden.SeedSpec, fldPath *
 	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newSeedSpec.Networks.Pods, oldSeedSpec.Networks.Pods, fldPath.Child("networks", "pods"))...)
 	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newSeedSpec.Networks.Services, oldSeedSpec.Networks.Services, fldPath.Child("networks", "services"))...)
 	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newSeedSpec.Networks.Nodes, oldSeedSpec.Networks.Nodes, fldPath.Child("networks", "nodes"))...)
+	allErrs = append(allErrs, apivalidation.ValidateImmutableField(helper.GetIPFamilies(newSeedSpec.Networks.IPFamilies), helper.GetIPFamilies(oldSeedSpec.Networks.IPFamilies), fldPath.Child("networks", "ipFamilies"))...)
 
 	if oldSeedSpec.Backup != nil {
 		if newSeedSpec.Backup != nil {
 			allErrs = append(allErrs, apivalidation.ValidateImmutableField(newSeedSpec.Backup.Provider, oldSeedSpec.Backup.Provider, fldPath.Child("backup", "provider"))...)
 			allErrs = append(allErrs, apivalidation.ValidateImmutableField(newSeedSpec.Backup.Region, oldSeedSpec.Backup.Region, fldPath.Child("backup", "region"))...)
+			// A secondary backup location can be added, but it can neither be changed nor removed afterwards as the
+			// replica bucket would be orphaned.
+			if oldSeedSpec.Backup.Secondary != nil {
+				allErrs = append(allErrs, apivalidation.ValidateImmutableField(newSeedSpec.Backup.Secondary, oldSeedSpec.Backup.Secondary, fldPath.Child("backup", "secondary"))...)
+			}
 		} else {
 			allErrs = append(allErrs, apivalidation.ValidateImmutableField(newSeedSpec.Backup, oldSeedSpec.Backup, fldPath.Child("backup"))...)
 		}
@@ -299,6 +299,111 @@ var _ = Describe("Seed Validation Tests", func() {
 			}))
 		})
 
+		It("should allow valid network pools for shoots", func() {
+			seed.Spec.Networks.ShootPools = &garden.ShootNetworkPools{
+				Nodes: &garden.NetworkPool{CIDR: "10.16.0.0/12", PrefixLength: 20},
+				Pods:  &garden.NetworkPool{CIDR: "172.16.0.0/12", PrefixLength: 16},
+			}
+
+			Expect(ValidateSeed(seed)).To(BeEmpty())
+		})
+
+		It("should forbid invalid or overlapping network pools for shoots", func() {
+			seed.Spec.Networks.ShootPools = &garden.ShootNetworkPools{
+				Nodes: &garden.NetworkPool{CIDR: "10.16.0.1/12", PrefixLength: 8},
+				Pods:  &garden.NetworkPool{CIDR: "10.250.0.0/17", PrefixLength: 33},
+			}
+
+			errorList := ValidateSeed(seed)
+
+			Expect(errorList).To(ConsistOfFields(Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.networks.shootPools.nodes.cidr"),
+			}, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.networks.shootPools.nodes.prefixLength"),
+			}, Fields{
+				"Type":   Equal(field.ErrorTypeInvalid),
+				"Field":  Equal("spec.networks.shootPools.pods.cidr"),
+				"Detail": Equal(`must not be a subset of "spec.networks.nodes" ("10.250.0.0/16")`),
+			}, Fields{
+				"Type":   Equal(field.ErrorTypeInvalid),
+				"Field":  Equal("spec.networks.nodes"),
+				"Detail": Equal(`must not be a subset of "spec.networks.shootPools.pods.cidr" ("10.250.0.0/17")`),
+			}, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.networks.shootPools.pods.prefixLength"),
+			}))
+		})
+
+		It("should allow dual-stack and IPv6-only networks", func() {
+			seed.Spec.Networks.IPFamilies = []garden.IPFamily{garden.IPFamilyIPv4, garden.IPFamilyIPv6}
+			seed.Spec.Networks.Nodes = "10.250.0.0/16,fd00:10:250::/64"
+			seed.Spec.Networks.Pods = "100.96.0.0/11,fd00:100:96::/56"
+			seed.Spec.Networks.Services = "100.64.0.0/13,fd00:100:64::/108"
+
+			Expect(ValidateSeed(seed)).To(BeEmpty())
+
+			seed.Spec.Networks.IPFamilies = []garden.IPFamily{garden.IPFamilyIPv6}
+			seed.Spec.Networks.Nodes = "fd00:10:250::/64"
+			seed.Spec.Networks.Pods = "fd00:100:96::/56"
+			seed.Spec.Networks.Services = "fd00:100:64::/108"
+
+			Expect(ValidateSeed(seed)).To(BeEmpty())
+		})
+
+		It("should forbid networks not matching the IP families", func() {
+			seed.Spec.Networks.IPFamilies = []garden.IPFamily{garden.IPFamilyIPv6, garden.IPFamilyIPv4}
+			seed.Spec.Networks.Nodes = "10.250.0.0/16,fd00:10:250::/64"
+			seed.Spec.Networks.Pods = "fd00:100:96::/56"
+
+			errorList := ValidateSeed(seed)
+
+			Expect(errorList).To(ConsistOfFields(Fields{
+				"Type":   Equal(field.ErrorTypeInvalid),
+				"Field":  Equal("spec.networks.nodes"),
+				"Detail": Equal(`CIDR "10.250.0.0/16" must be of IP family IPv6`),
+			}, Fields{
+				"Type":   Equal(field.ErrorTypeInvalid),
+				"Field":  Equal("spec.networks.nodes"),
+				"Detail": Equal(`CIDR "fd00:10:250::/64" must be of IP family IPv4`),
+			}, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.networks.pods"),
+			}, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.networks.services"),
+			}))
+		})
+
+		It("should forbid unsupported or duplicate IP families", func() {
+			seed.Spec.Networks.IPFamilies = []garden.IPFamily{garden.IPFamilyIPv4, garden.IPFamilyIPv4, "IPv5"}
+
+			errorList := ValidateSeed(seed)
+
+			Expect(errorList).To(ConsistOfFields(Fields{
+				"Type":  Equal(field.ErrorTypeDuplicate),
+				"Field": Equal("spec.networks.ipFamilies[1]"),
+			}, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("spec.networks.ipFamilies[2]"),
+			}))
+		})
+
+		It("should forbid changing the IP families", func() {
+			newSeed := prepareSeedForUpdate(seed)
+			newSeed.Spec.Networks.IPFamilies = []garden.IPFamily{garden.IPFamilyIPv4}
+
+			Expect(ValidateSeedUpdate(newSeed, seed)).To(BeEmpty())
+
+			newSeed.Spec.Networks.IPFamilies = []garden.IPFamily{garden.IPFamilyIPv4, garden.IPFamilyIPv6}
+
+			Expect(ValidateSeedUpdate(newSeed, seed)).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.networks.ipFamilies"),
+			}))))
+		})
+
 		It("should fail updating immutable fields", func() {
 			newSeed := prepareSeedForUpdate(seed)
 			newSeed.Spec.Networks = garden.SeedNetworks{
@@ -335,7 +440,52 @@ var _ = Describe("Seed Validation Tests", func() {
 			}))
 		})
 
+		Context("secondary backup location", func() {
+			It("should allow a secondary backup location in another region", func() {
+				seed.Spec.Backup.Secondary = &garden.SecondaryBackupLocation{Region: "other-region"}
+
+				Expect(ValidateSeed(seed)).To(BeEmpty())
+			})
+
+			It("should forbid a secondary backup location without region or in the primary region", func() {
+				seed.Spec.Backup.Secondary = &garden.SecondaryBackupLocation{}
+				Expect(ValidateSeed(seed)).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.backup.secondary.region"),
+				}))
+
+				seed.Spec.Backup.Secondary = &garden.SecondaryBackupLocation{Region: *seed.Spec.Backup.Region}
+				Expect(ValidateSeed(seed)).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.backup.secondary.region"),
+				}))
+			})
+		})
+
 		Context("#validateSeedBackupUpdate", func() {
+			It("should allow adding a secondary backup location", func() {
+				newSeed := prepareSeedForUpdate(seed)
+				newSeed.Spec.Backup.Secondary = &garden.SecondaryBackupLocation{Region: "other-region"}
+
+				errorList := ValidateSeedUpdate(newSeed, seed)
+
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should forbid changing or removing the secondary backup location", func() {
+				seed.Spec.Backup.Secondary = &garden.SecondaryBackupLocation{Region: "other-region"}
+				newSeed := prepareSeedForUpdate(seed)
+				newSeed.Spec.Backup.Secondary = nil
+
+				errorList := ValidateSeedUpdate(newSeed, seed)
+
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("spec.backup.secondary"),
+					"Detail": Equal(`field is immutable`),
+				}))
+			})
+
 			It("should allow adding backup profile", func() {
 				seed.Spec.Backup = nil
 				newSeed := prepareSeedForUpdate(seed)
@@ -47,6 +47,28 @@ var (
 		string(garden.ProxyModeIPTables),
 		string(garden.ProxyModeIPVS),
 	)
+	availableFailureToleranceTypes = sets.NewString(
+		string(garden.FailureToleranceTypeNode),
+		string(garden.FailureToleranceTypeZone),
+	)
+	availableNetworkPolicyRuleProtocols = sets.NewString(
+		string(corev1.ProtocolTCP),
+		string(corev1.ProtocolUDP),
+		string(corev1.ProtocolSCTP),
+	)
+	availableAuditModes = sets.NewString(
+		string(garden.AuditModeBatch),
+		string(garden.AuditModeBlocking),
+		string(garden.AuditModeBlockingStrict),
+	)
+	admissionWebhookPlugins = sets.NewString(
+		"MutatingAdmissionWebhook",
+		"ValidatingAdmissionWebhook",
+	)
+	availableSchedulingProfiles = sets.NewString(
+		string(garden.SchedulingProfileBalanced),
+		string(garden.SchedulingProfileBinPacking),
+	)
 	availableKubernetesDashboardAuthenticationModes = sets.NewString(
 		garden.KubernetesDashboardAuthModeBasic,
 		garden.KubernetesDashboardAuthModeToken,
@@ -55,6 +77,10 @@ var (
 		string(corev1.ServiceExternalTrafficPolicyTypeCluster),
 		string(corev1.ServiceExternalTrafficPolicyTypeLocal),
 	)
+	availableWorkerCRINames = sets.NewString(
+		string(garden.CRINameContainerD),
+		string(garden.CRINameDocker),
+	)
 )
 
 // ValidatePositiveDuration validates that a duration is positive.
@@ -69,6 +95,21 @@ func ValidatePositiveDuration(duration *metav1.Duration, fldPath *field.Path) fi
 	return allErrs
 }
 
+// ValidateBackupRetentionPolicy validates a retention policy for backups.
+func ValidateBackupRetentionPolicy(policy *garden.BackupRetentionPolicy, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if policy == nil {
+		return allErrs
+	}
+	if policy.DailySnapshots != nil && *policy.DailySnapshots <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("dailySnapshots"), *policy.DailySnapshots, "must be greater than 0"))
+	}
+	if policy.WeeklySnapshots != nil && *policy.WeeklySnapshots <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("weeklySnapshots"), *policy.WeeklySnapshots, "must be greater than 0"))
+	}
+	return allErrs
+}
+
 // ValidateResourceQuantityOrPercent checks if a value can be parsed to either a resource.quantity, a positive int or percent.
 func ValidateResourceQuantityOrPercent(valuePtr *string, fldPath *field.Path, key string) field.ErrorList {
 	allErrs := field.ErrorList{}
@@ -157,15 +198,17 @@ func ValidateShootSpec(spec *garden.ShootSpec, fldPath *field.Path) field.ErrorL
 	allErrs := field.ErrorList{}
 
 	allErrs = append(allErrs, validateAddons(spec.Addons, spec.Kubernetes.KubeAPIServer, fldPath.Child("addons"))...)
+	allErrs = append(allErrs, validateShootBackup(spec.Backup, fldPath.Child("backup"))...)
 	allErrs = append(allErrs, validateCloud(spec.Cloud, spec.Kubernetes, fldPath.Child("cloud"))...)
+	allErrs = append(allErrs, validateControlPlane(spec.ControlPlane, fldPath.Child("controlPlane"))...)
 	allErrs = append(allErrs, validateDNS(spec.DNS, fldPath.Child("dns"))...)
 	allErrs = append(allErrs, validateExtensions(spec.Extensions, fldPath.Child("extensions"))...)
 	allErrs = append(allErrs, validateKubernetes(spec.Kubernetes, fldPath.Child("kubernetes"))...)
-	allErrs = append(allErrs, validateNetworking(spec.Networking, fldPath.Child("networking"))...)
+	allErrs = append(allErrs, validateNetworking(spec.Networking, spec.Kubernetes.Version, fldPath.Child("networking"))...)
 	allErrs = append(allErrs, validateMaintenance(spec.Maintenance, fldPath.Child("maintenance"))...)
 	allErrs = append(allErrs, validateMonitoring(spec.Monitoring, fldPath.Child("monitoring"))...)
 	allErrs = append(allErrs, ValidateHibernation(spec.Hibernation, fldPath.Child("hibernation"))...)
-	allErrs = append(allErrs, validateProvider(spec.Provider, fldPath.Child("provider"))...)
+	allErrs = append(allErrs, validateProvider(spec.Provider, spec.Kubernetes.Version, fldPath.Child("provider"))...)
 
 	if len(spec.CloudProfileName) == 0 {
 		allErrs = append(allErrs, field.Required(fldPath.Child("cloudProfileName"), "must specify a cloud profile"))
@@ -751,6 +794,7 @@ func ValidateShootSpecUpdate(newSpec, oldSpec *garden.ShootSpec, deletionTimesta
 	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newSpec.CloudProfileName, oldSpec.CloudProfileName, fldPath.Child("cloudProfileName"))...)
 	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newSpec.Cloud.Region, oldSpec.Cloud.Region, fldPath.Child("cloud", "region"))...)
 	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newSpec.Region, oldSpec.Region, fldPath.Child("region"))...)
+	allErrs = append(allErrs, validateHighAvailabilityUpdate(newSpec.ControlPlane, oldSpec.ControlPlane, fldPath.Child("controlPlane", "highAvailability"))...)
 	// allow initial seed assignment
 	if oldSpec.Cloud.Seed != nil {
 		allErrs = append(allErrs, apivalidation.ValidateImmutableField(newSpec.Cloud.Seed, oldSpec.Cloud.Seed, fldPath.Child("cloud", "seed"))...)
@@ -861,8 +905,10 @@ func ValidateShootSpecUpdate(newSpec, oldSpec *garden.ShootSpec, deletionTimesta
 	allErrs = append(allErrs, validateKubernetesVersionUpdate(newSpec.Kubernetes.Version, oldSpec.Kubernetes.Version, fldPath.Child("kubernetes", "version"))...)
 	allErrs = append(allErrs, validateKubeProxyModeUpdate(newSpec.Kubernetes.KubeProxy, oldSpec.Kubernetes.KubeProxy, newSpec.Kubernetes.Version, fldPath.Child("kubernetes", "kubeProxy"))...)
 	allErrs = append(allErrs, validateKubeControllerManagerConfiguration(newSpec.Kubernetes.KubeControllerManager, oldSpec.Kubernetes.KubeControllerManager, fldPath.Child("kubernetes", "kubeControllerManager"))...)
+	allErrs = append(allErrs, validateKMSProviderUpdate(newSpec.Kubernetes.KubeAPIServer, oldSpec.Kubernetes.KubeAPIServer, fldPath.Child("kubernetes", "kubeAPIServer", "encryptionConfig", "kms", "type"))...)
 
 	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newSpec.Provider.Type, oldSpec.Provider.Type, fldPath.Child("provider", "type"))...)
+	allErrs = append(allErrs, validateWorkersCRIUpdate(newSpec.Provider.Workers, oldSpec.Provider.Workers, fldPath.Child("provider", "workers"))...)
 	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newSpec.Networking.Type, oldSpec.Networking.Type, fldPath.Child("networking", "type"))...)
 
 	if oldSpec.Networking.Pods != nil {
@@ -871,7 +917,10 @@ func ValidateShootSpecUpdate(newSpec, oldSpec *garden.ShootSpec, deletionTimesta
 	if oldSpec.Networking.Services != nil {
 		allErrs = append(allErrs, apivalidation.ValidateImmutableField(newSpec.Networking.Services, oldSpec.Networking.Services, fldPath.Child("networking", "services"))...)
 	}
-	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newSpec.Networking.Nodes, oldSpec.Networking.Nodes, fldPath.Child("networking", "nodes"))...)
+	if len(oldSpec.Networking.Nodes) > 0 {
+		allErrs = append(allErrs, apivalidation.ValidateImmutableField(newSpec.Networking.Nodes, oldSpec.Networking.Nodes, fldPath.Child("networking", "nodes"))...)
+	}
+	allErrs = append(allErrs, apivalidation.ValidateImmutableField(helper.GetIPFamilies(newSpec.Networking.IPFamilies), helper.GetIPFamilies(oldSpec.Networking.IPFamilies), fldPath.Child("networking", "ipFamilies"))...)
 
 	return allErrs
 }
@@ -905,6 +954,69 @@ func validateKubeControllerManagerConfiguration(newConfig, oldConfig *garden.Kub
 	return allErrs
 }
 
+// validateHighAvailabilityUpdate forbids to enable, disable or change the high availability of the control plane as the
+// etcd cannot be migrated between a single member and a multi-member cluster.
+func validateHighAvailabilityUpdate(newControlPlane, oldControlPlane *garden.ControlPlane, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	var newHighAvailability, oldHighAvailability *garden.HighAvailability
+	if newControlPlane != nil {
+		newHighAvailability = newControlPlane.HighAvailability
+	}
+	if oldControlPlane != nil {
+		oldHighAvailability = oldControlPlane.HighAvailability
+	}
+
+	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newHighAvailability, oldHighAvailability, fldPath)...)
+	return allErrs
+}
+
+// validateWorkersCRIUpdate forbids to change the container runtime of existing worker pools as the nodes of a pool are
+// not rolled when the runtime changes, i.e., they would keep running with the previous one. A pool without a CRI
+// configuration is treated like a pool using Docker.
+func validateWorkersCRIUpdate(newWorkers, oldWorkers []garden.Worker, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	oldCRINames := make(map[string]garden.CRIName, len(oldWorkers))
+	for _, worker := range oldWorkers {
+		oldCRINames[worker.Name] = getCRIName(worker.CRI)
+	}
+
+	for i, worker := range newWorkers {
+		oldCRIName, ok := oldCRINames[worker.Name]
+		if !ok {
+			continue
+		}
+		allErrs = append(allErrs, apivalidation.ValidateImmutableField(getCRIName(worker.CRI), oldCRIName, fldPath.Index(i).Child("cri", "name"))...)
+	}
+
+	return allErrs
+}
+
+func getCRIName(cri *garden.CRI) garden.CRIName {
+	if cri == nil {
+		return garden.CRINameDocker
+	}
+	return cri.Name
+}
+
+// validateKMSProviderUpdate forbids to remove or change the type of a KMS provider for the etcd encryption as the
+// resources which have been encrypted with it could not be decrypted anymore without the respective KMS plugin.
+func validateKMSProviderUpdate(newConfig, oldConfig *garden.KubeAPIServerConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if oldConfig == nil || oldConfig.EncryptionConfig == nil || oldConfig.EncryptionConfig.KMS == nil {
+		return allErrs
+	}
+
+	var newType string
+	if newConfig != nil && newConfig.EncryptionConfig != nil && newConfig.EncryptionConfig.KMS != nil {
+		newType = newConfig.EncryptionConfig.KMS.Type
+	}
+
+	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newType, oldConfig.EncryptionConfig.KMS.Type, fldPath)...)
+	return allErrs
+}
+
 func validateKubeProxyModeUpdate(newConfig, oldConfig *garden.KubeProxyConfig, version string, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 	newMode := garden.ProxyModeIPTables
@@ -1146,10 +1258,83 @@ func validateKubernetes(kubernetes garden.Kubernetes, fldPath *field.Path) field
 			if auditPolicy := auditConfig.AuditPolicy; auditPolicy != nil && auditConfig.AuditPolicy.ConfigMapRef != nil {
 				allErrs = append(allErrs, validateAuditPolicyConfigMapReference(auditPolicy.ConfigMapRef, auditPath.Child("auditPolicy", "configMapRef"))...)
 			}
+			if auditConfig.Backend != nil {
+				allErrs = append(allErrs, validateAuditBackend(*auditConfig.Backend, auditPath.Child("backend"))...)
+			}
+		}
+
+		if webhook := kubeAPIServer.AuthenticationWebhook; webhook != nil {
+			webhookPath := fldPath.Child("kubeAPIServer", "authenticationWebhook")
+			allErrs = append(allErrs, validateWebhookSecretReference(webhook.SecretRef, webhookPath.Child("secretRef"))...)
+			allErrs = append(allErrs, validateNonNegativeDuration(webhook.CacheTTL, webhookPath.Child("cacheTTL"))...)
+		}
+
+		if webhook := kubeAPIServer.AuthorizationWebhook; webhook != nil {
+			webhookPath := fldPath.Child("kubeAPIServer", "authorizationWebhook")
+			allErrs = append(allErrs, validateWebhookSecretReference(webhook.SecretRef, webhookPath.Child("secretRef"))...)
+			allErrs = append(allErrs, validateNonNegativeDuration(webhook.CacheAuthorizedTTL, webhookPath.Child("cacheAuthorizedTTL"))...)
+			allErrs = append(allErrs, validateNonNegativeDuration(webhook.CacheUnauthorizedTTL, webhookPath.Child("cacheUnauthorizedTTL"))...)
+		}
+
+		if admissionWebhookConfig := kubeAPIServer.AdmissionWebhookConfig; admissionWebhookConfig != nil {
+			allErrs = append(allErrs, validateWebhookSecretReference(admissionWebhookConfig.SecretRef, fldPath.Child("kubeAPIServer", "admissionWebhookConfig", "secretRef"))...)
+
+			for i, plugin := range kubeAPIServer.AdmissionPlugins {
+				if plugin.Config != nil && admissionWebhookPlugins.Has(plugin.Name) {
+					allErrs = append(allErrs, field.Forbidden(admissionPluginsPath.Index(i).Child("config"), "must not provide a config for the admission webhook plugins if the admission webhook config is set"))
+				}
+			}
+		}
+
+		if encryptionConfig := kubeAPIServer.EncryptionConfig; encryptionConfig != nil {
+			resourcesPath := fldPath.Child("kubeAPIServer", "encryptionConfig", "resources")
+			resources := sets.NewString()
+			for i, resource := range encryptionConfig.Resources {
+				idxPath := resourcesPath.Index(i)
+				switch {
+				case resource == "secrets":
+					allErrs = append(allErrs, field.Forbidden(idxPath, "secrets are always encrypted"))
+				case resources.Has(resource):
+					allErrs = append(allErrs, field.Duplicate(idxPath, resource))
+				default:
+					for _, msg := range validation.IsDNS1123Subdomain(resource) {
+						allErrs = append(allErrs, field.Invalid(idxPath, resource, msg))
+					}
+				}
+				resources.Insert(resource)
+			}
+
+			if kms := encryptionConfig.KMS; kms != nil {
+				kmsPath := fldPath.Child("kubeAPIServer", "encryptionConfig", "kms")
+				if len(kms.Type) == 0 {
+					allErrs = append(allErrs, field.Required(kmsPath.Child("type"), "must provide a type"))
+				}
+				if kms.CacheSize != nil && *kms.CacheSize <= 0 {
+					allErrs = append(allErrs, field.Invalid(kmsPath.Child("cacheSize"), *kms.CacheSize, "cache size must be greater than 0"))
+				}
+				if kms.Timeout != nil && kms.Timeout.Duration <= 0 {
+					allErrs = append(allErrs, field.Invalid(kmsPath.Child("timeout"), kms.Timeout.Duration.String(), "timeout must be greater than 0"))
+				}
+			}
+		}
+
+		if rotation := kubeAPIServer.StaticCredentialsRotation; rotation != nil {
+			rotationPath := fldPath.Child("kubeAPIServer", "staticCredentialsRotation")
+			if rotation.Period.Duration <= 0 {
+				allErrs = append(allErrs, field.Invalid(rotationPath.Child("period"), rotation.Period.Duration.String(), "period must be greater than 0"))
+			}
+			if transitionPeriod := rotation.TransitionPeriod; transitionPeriod != nil {
+				if transitionPeriod.Duration < 0 {
+					allErrs = append(allErrs, field.Invalid(rotationPath.Child("transitionPeriod"), transitionPeriod.Duration.String(), "transition period must not be negative"))
+				} else if transitionPeriod.Duration >= rotation.Period.Duration {
+					allErrs = append(allErrs, field.Invalid(rotationPath.Child("transitionPeriod"), transitionPeriod.Duration.String(), "transition period must be less than the rotation period"))
+				}
+			}
 		}
 	}
 
 	allErrs = append(allErrs, validateKubeControllerManager(kubernetes.Version, kubernetes.KubeControllerManager, fldPath.Child("kubeControllerManager"))...)
+	allErrs = append(allErrs, validateKubeScheduler(kubernetes.KubeScheduler, fldPath.Child("kubeScheduler"))...)
 	allErrs = append(allErrs, validateKubeProxy(kubernetes.KubeProxy, fldPath.Child("kubeProxy"))...)
 	if clusterAutoscaler := kubernetes.ClusterAutoscaler; clusterAutoscaler != nil {
 		allErrs = append(allErrs, ValidateClusterAutoscaler(*clusterAutoscaler, fldPath.Child("clusterAutoscaler"))...)
@@ -1158,13 +1343,131 @@ func validateKubernetes(kubernetes garden.Kubernetes, fldPath *field.Path) field
 	return allErrs
 }
 
-func validateNetworking(networking garden.Networking, fldPath *field.Path) field.ErrorList {
+func validateAuditBackend(backend garden.AuditBackend, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	switch {
+	case backend.Log == nil && backend.Webhook == nil:
+		allErrs = append(allErrs, field.Required(fldPath, "must provide either a log or a webhook backend"))
+	case backend.Log != nil && backend.Webhook != nil:
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("webhook"), "must not provide a webhook backend together with a log backend"))
+	}
+
+	if log := backend.Log; log != nil {
+		logPath := fldPath.Child("log")
+		if log.MaxAge != nil {
+			allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(*log.MaxAge), logPath.Child("maxAge"))...)
+		}
+		if log.MaxBackups != nil {
+			allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(*log.MaxBackups), logPath.Child("maxBackups"))...)
+		}
+		if log.MaxSize != nil {
+			allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(*log.MaxSize), logPath.Child("maxSize"))...)
+		}
+		if log.Buffering != nil {
+			allErrs = append(allErrs, validateAuditBuffering(*log.Buffering, logPath.Child("buffering"))...)
+		}
+	}
+
+	if webhook := backend.Webhook; webhook != nil {
+		webhookPath := fldPath.Child("webhook")
+		allErrs = append(allErrs, validateWebhookSecretReference(webhook.SecretRef, webhookPath.Child("secretRef"))...)
+		if webhook.InitialBackoff != nil && webhook.InitialBackoff.Duration <= 0 {
+			allErrs = append(allErrs, field.Invalid(webhookPath.Child("initialBackoff"), webhook.InitialBackoff.Duration.String(), "initial backoff must be greater than 0"))
+		}
+		if webhook.Buffering != nil {
+			allErrs = append(allErrs, validateAuditBuffering(*webhook.Buffering, webhookPath.Child("buffering"))...)
+		}
+	}
+
+	return allErrs
+}
+
+func validateWebhookSecretReference(ref corev1.LocalObjectReference, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(ref.Name) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("name"), "must provide the name of the secret containing the webhook kubeconfig"))
+	}
+
+	return allErrs
+}
+
+func validateNonNegativeDuration(duration *metav1.Duration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if duration != nil && duration.Duration < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath, duration.Duration.String(), "must be non-negative"))
+	}
+
+	return allErrs
+}
+
+func validateAuditBuffering(buffering garden.AuditBuffering, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(buffering.Mode) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("mode"), "must provide an audit mode"))
+	} else if !availableAuditModes.Has(string(buffering.Mode)) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("mode"), buffering.Mode, availableAuditModes.List()))
+	}
+
+	validateBatchSetting := func(isPositive bool, value interface{}, fldPath *field.Path) {
+		if buffering.Mode != garden.AuditModeBatch {
+			allErrs = append(allErrs, field.Forbidden(fldPath, fmt.Sprintf("can only be set in %q mode", garden.AuditModeBatch)))
+		} else if !isPositive {
+			allErrs = append(allErrs, field.Invalid(fldPath, value, "must be greater than 0"))
+		}
+	}
+
+	if v := buffering.BufferSize; v != nil {
+		validateBatchSetting(*v > 0, *v, fldPath.Child("bufferSize"))
+	}
+	if v := buffering.MaxBatchSize; v != nil {
+		validateBatchSetting(*v > 0, *v, fldPath.Child("maxBatchSize"))
+	}
+	if v := buffering.MaxBatchWait; v != nil {
+		validateBatchSetting(v.Duration > 0, v.Duration.String(), fldPath.Child("maxBatchWait"))
+	}
+	if v := buffering.ThrottleQPS; v != nil {
+		validateBatchSetting(*v > 0, *v, fldPath.Child("throttleQPS"))
+	}
+	if v := buffering.ThrottleBurst; v != nil {
+		validateBatchSetting(*v > 0, *v, fldPath.Child("throttleBurst"))
+	}
+
+	return allErrs
+}
+
+func validateNetworking(networking garden.Networking, version string, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
 	if len(networking.Type) == 0 {
 		allErrs = append(allErrs, field.Required(fldPath.Child("type"), "networking type must be provided"))
 	}
 
+	ipFamiliesErrs := validateIPFamilies(networking.IPFamilies, fldPath.Child("ipFamilies"))
+	allErrs = append(allErrs, ipFamiliesErrs...)
+	if len(ipFamiliesErrs) > 0 {
+		return allErrs
+	}
+
+	if helper.IsDualStack(networking.IPFamilies) {
+		if ok, _ := utils.CheckVersionMeetsConstraint(version, ">= 1.17"); !ok {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("ipFamilies"), fmt.Sprintf("dual-stack networking is not supported for Kubernetes version %s, it requires version 1.17 or higher", version)))
+		}
+	}
+
+	if len(networking.Nodes) > 0 {
+		allErrs = append(allErrs, validateCIDRsForIPFamilies(networking.Nodes, networking.IPFamilies, fldPath.Child("nodes"))...)
+	}
+	if networking.Pods != nil {
+		allErrs = append(allErrs, validateCIDRsForIPFamilies(*networking.Pods, networking.IPFamilies, fldPath.Child("pods"))...)
+	}
+	if networking.Services != nil {
+		allErrs = append(allErrs, validateCIDRsForIPFamilies(*networking.Services, networking.IPFamilies, fldPath.Child("services"))...)
+	}
+
 	return allErrs
 }
 
@@ -1195,6 +1498,15 @@ func validateKubeControllerManager(kubernetesVersion string, kcm *garden.KubeCon
 				allErrs = append(allErrs, field.Invalid(fldPath.Child("nodeCIDRMaskSize"), *maskSize, "nodeCIDRMaskSize must be between 16 and 28"))
 			}
 		}
+		if gracePeriod := kcm.NodeMonitorGracePeriod; gracePeriod != nil && gracePeriod.Duration <= 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("nodeMonitorGracePeriod"), *gracePeriod, "node monitor grace period must be greater than 0"))
+		}
+		if timeout := kcm.PodEvictionTimeout; timeout != nil && timeout.Duration < 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("podEvictionTimeout"), *timeout, "pod eviction timeout must not be negative"))
+		}
+		if concurrentSyncs := kcm.ConcurrentSyncs; concurrentSyncs != nil {
+			allErrs = append(allErrs, validateKubeControllerManagerConcurrentSyncs(*concurrentSyncs, fldPath.Child("concurrentSyncs"))...)
+		}
 		if hpa := kcm.HorizontalPodAutoscalerConfig; hpa != nil {
 			fldPath = fldPath.Child("horizontalPodAutoscaler")
 
@@ -1243,6 +1555,36 @@ func validateKubeControllerManager(kubernetesVersion string, kcm *garden.KubeCon
 	return allErrs
 }
 
+func validateKubeControllerManagerConcurrentSyncs(concurrentSyncs garden.KubeControllerManagerConcurrentSyncs, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	validateConcurrentSyncs := func(value *int32, fldPath *field.Path) {
+		if value != nil && *value <= 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath, *value, "number of concurrent syncs must be greater than 0"))
+		}
+	}
+
+	validateConcurrentSyncs(concurrentSyncs.Deployments, fldPath.Child("deployments"))
+	validateConcurrentSyncs(concurrentSyncs.Endpoints, fldPath.Child("endpoints"))
+	validateConcurrentSyncs(concurrentSyncs.GarbageCollector, fldPath.Child("garbageCollector"))
+	validateConcurrentSyncs(concurrentSyncs.Namespaces, fldPath.Child("namespaces"))
+	validateConcurrentSyncs(concurrentSyncs.ReplicaSets, fldPath.Child("replicaSets"))
+	validateConcurrentSyncs(concurrentSyncs.ResourceQuotas, fldPath.Child("resourceQuotas"))
+	validateConcurrentSyncs(concurrentSyncs.ServiceAccountTokens, fldPath.Child("serviceAccountTokens"))
+
+	return allErrs
+}
+
+func validateKubeScheduler(ks *garden.KubeSchedulerConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if ks != nil && ks.Profile != nil {
+		if profile := *ks.Profile; !availableSchedulingProfiles.Has(string(profile)) {
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("profile"), profile, availableSchedulingProfiles.List()))
+		}
+	}
+	return allErrs
+}
+
 func validateKubeProxy(kp *garden.KubeProxyConfig, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 	if kp != nil {
@@ -1255,6 +1597,96 @@ func validateKubeProxy(kp *garden.KubeProxyConfig, fldPath *field.Path) field.Er
 	return allErrs
 }
 
+func validateControlPlane(controlPlane *garden.ControlPlane, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if controlPlane == nil {
+		return allErrs
+	}
+
+	if controlPlane.HighAvailability != nil {
+		typePath := fldPath.Child("highAvailability", "failureTolerance", "type")
+		if failureToleranceType := controlPlane.HighAvailability.FailureTolerance.Type; len(failureToleranceType) == 0 {
+			allErrs = append(allErrs, field.Required(typePath, "must be set when the control plane is highly available"))
+		} else if !availableFailureToleranceTypes.Has(string(failureToleranceType)) {
+			allErrs = append(allErrs, field.NotSupported(typePath, failureToleranceType, availableFailureToleranceTypes.List()))
+		}
+	}
+
+	allErrs = append(allErrs, validateNetworkPolicyRules(controlPlane.NetworkPolicyRules, fldPath.Child("networkPolicyRules"))...)
+
+	return allErrs
+}
+
+// maxNetworkPolicyRuleNameLength is the maximum length of the name of a network policy rule. The name is prefixed
+// with 'allow-custom-' for the generated NetworkPolicy whose name must be a DNS-1123 label.
+const maxNetworkPolicyRuleNameLength = validation.DNS1123LabelMaxLength - len("allow-custom-")
+
+func validateNetworkPolicyRules(rules []garden.NetworkPolicyRule, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	names := sets.NewString()
+
+	for i, rule := range rules {
+		idxPath := fldPath.Index(i)
+		namePath := idxPath.Child("name")
+
+		if len(rule.Name) == 0 {
+			allErrs = append(allErrs, field.Required(namePath, "must provide a name"))
+		} else {
+			for _, msg := range validation.IsDNS1123Label(rule.Name) {
+				allErrs = append(allErrs, field.Invalid(namePath, rule.Name, msg))
+			}
+			if len(rule.Name) > maxNetworkPolicyRuleNameLength {
+				allErrs = append(allErrs, field.TooLong(namePath, rule.Name, maxNetworkPolicyRuleNameLength))
+			}
+			if names.Has(rule.Name) {
+				allErrs = append(allErrs, field.Duplicate(namePath, rule.Name))
+			}
+			names.Insert(rule.Name)
+		}
+
+		selectorPath := idxPath.Child("podSelector")
+		if len(rule.PodSelector.MatchLabels) == 0 && len(rule.PodSelector.MatchExpressions) == 0 {
+			allErrs = append(allErrs, field.Required(selectorPath, "must select the pods to which the rule applies"))
+		}
+		if app, ok := rule.PodSelector.MatchLabels["app"]; ok && app == "etcd-statefulset" {
+			allErrs = append(allErrs, field.Forbidden(selectorPath.Child("matchLabels"), "rules must not apply to the etcd pods"))
+		}
+		allErrs = append(allErrs, metav1validation.ValidateLabelSelector(&rule.PodSelector, selectorPath)...)
+
+		if len(rule.CIDRs) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("cidrs"), "must provide at least one destination network"))
+		}
+		cidrs := make([]cidrvalidation.CIDR, 0, len(rule.CIDRs))
+		for j, cidr := range rule.CIDRs {
+			cidrs = append(cidrs, cidrvalidation.NewCIDR(cidr, idxPath.Child("cidrs").Index(j)))
+		}
+		allErrs = append(allErrs, cidrvalidation.ValidateCIDRParse(cidrs...)...)
+
+		for j, port := range rule.Ports {
+			portPath := idxPath.Child("ports").Index(j)
+			for _, msg := range validation.IsValidPortNum(int(port.Port)) {
+				allErrs = append(allErrs, field.Invalid(portPath.Child("port"), port.Port, msg))
+			}
+			if port.Protocol != nil && !availableNetworkPolicyRuleProtocols.Has(string(*port.Protocol)) {
+				allErrs = append(allErrs, field.NotSupported(portPath.Child("protocol"), *port.Protocol, availableNetworkPolicyRuleProtocols.List()))
+			}
+		}
+	}
+
+	return allErrs
+}
+
+func validateShootBackup(backup *garden.ShootBackup, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if backup == nil {
+		return allErrs
+	}
+
+	allErrs = append(allErrs, ValidateBackupRetentionPolicy(backup.RetentionPolicy, fldPath.Child("retentionPolicy"))...)
+
+	return allErrs
+}
+
 func validateMonitoring(monitoring *garden.Monitoring, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 	if monitoring != nil && monitoring.Alerting != nil {
@@ -1316,7 +1748,7 @@ func validateMaintenance(maintenance *garden.Maintenance, fldPath *field.Path) f
 	return allErrs
 }
 
-func validateProvider(provider garden.Provider, fldPath *field.Path) field.ErrorList {
+func validateProvider(provider garden.Provider, kubernetesVersion string, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
 	if len(provider.Type) == 0 {
@@ -1326,6 +1758,26 @@ func validateProvider(provider garden.Provider, fldPath *field.Path) field.Error
 	for i, worker := range provider.Workers {
 		idxPath := fldPath.Index(i)
 		allErrs = append(allErrs, ValidateWorker(worker, idxPath)...)
+		if worker.CRI != nil {
+			allErrs = append(allErrs, validateCRI(*worker.CRI, kubernetesVersion, fldPath.Child("workers").Index(i).Child("cri"))...)
+		}
+	}
+
+	return allErrs
+}
+
+func validateCRI(cri garden.CRI, kubernetesVersion string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if !availableWorkerCRINames.Has(string(cri.Name)) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("name"), string(cri.Name), availableWorkerCRINames.List()))
+		return allErrs
+	}
+
+	if cri.Name == garden.CRINameContainerD {
+		if ok, err := utils.CheckVersionMeetsConstraint(kubernetesVersion, ">= 1.11"); err == nil && !ok {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("name"), "containerd is only supported for Kubernetes versions >= 1.11"))
+		}
 	}
 
 	return allErrs
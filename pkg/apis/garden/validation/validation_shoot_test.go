@@ -3976,6 +3976,229 @@ var _ = Describe("Shoot Validation Tests", func() {
 				errorList := ValidateShoot(shoot)
 				Expect(errorList).To(BeEmpty())
 			})
+
+			It("should succeed with valid node monitoring, pod eviction and concurrent sync settings", func() {
+				shoot.Spec.Kubernetes.KubeControllerManager.NodeMonitorGracePeriod = makeDurationPointer(40 * time.Second)
+				shoot.Spec.Kubernetes.KubeControllerManager.PodEvictionTimeout = makeDurationPointer(0)
+				shoot.Spec.Kubernetes.KubeControllerManager.ConcurrentSyncs = &garden.KubeControllerManagerConcurrentSyncs{
+					Deployments:      makeInt32Pointer(20),
+					GarbageCollector: makeInt32Pointer(30),
+					ReplicaSets:      makeInt32Pointer(20),
+				}
+
+				errorList := ValidateShoot(shoot)
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should forbid invalid node monitoring, pod eviction and concurrent sync settings", func() {
+				shoot.Spec.Kubernetes.KubeControllerManager.NodeMonitorGracePeriod = makeDurationPointer(0)
+				shoot.Spec.Kubernetes.KubeControllerManager.PodEvictionTimeout = makeDurationPointer(-1 * time.Minute)
+				shoot.Spec.Kubernetes.KubeControllerManager.ConcurrentSyncs = &garden.KubeControllerManagerConcurrentSyncs{
+					Endpoints:            makeInt32Pointer(0),
+					ServiceAccountTokens: makeInt32Pointer(-1),
+				}
+
+				errorList := ValidateShoot(shoot)
+				Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.kubernetes.kubeControllerManager.nodeMonitorGracePeriod"),
+				})), PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.kubernetes.kubeControllerManager.podEvictionTimeout"),
+				})), PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.kubernetes.kubeControllerManager.concurrentSyncs.endpoints"),
+				})), PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.kubernetes.kubeControllerManager.concurrentSyncs.serviceAccountTokens"),
+				}))))
+			})
+		})
+
+		Context("ControlPlane validation", func() {
+			It("should allow a highly available control plane", func() {
+				shoot.Spec.ControlPlane = &garden.ControlPlane{HighAvailability: &garden.HighAvailability{
+					FailureTolerance: garden.FailureTolerance{Type: garden.FailureToleranceTypeZone},
+				}}
+
+				errorList := ValidateShoot(shoot)
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should forbid a missing failure tolerance type", func() {
+				shoot.Spec.ControlPlane = &garden.ControlPlane{HighAvailability: &garden.HighAvailability{}}
+
+				errorList := ValidateShoot(shoot)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.controlPlane.highAvailability.failureTolerance.type"),
+				}))
+			})
+
+			It("should forbid an unsupported failure tolerance type", func() {
+				shoot.Spec.ControlPlane = &garden.ControlPlane{HighAvailability: &garden.HighAvailability{
+					FailureTolerance: garden.FailureTolerance{Type: "region"},
+				}}
+
+				errorList := ValidateShoot(shoot)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("spec.controlPlane.highAvailability.failureTolerance.type"),
+				}))
+			})
+
+			It("should forbid making the control plane of an existing shoot highly available", func() {
+				newShoot := prepareShootForUpdate(shoot)
+				newShoot.Spec.ControlPlane = &garden.ControlPlane{HighAvailability: &garden.HighAvailability{
+					FailureTolerance: garden.FailureTolerance{Type: garden.FailureToleranceTypeNode},
+				}}
+
+				errorList := ValidateShootUpdate(newShoot, shoot)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.controlPlane.highAvailability"),
+				}))
+			})
+
+			It("should forbid changing the failure tolerance type", func() {
+				shoot.Spec.ControlPlane = &garden.ControlPlane{HighAvailability: &garden.HighAvailability{
+					FailureTolerance: garden.FailureTolerance{Type: garden.FailureToleranceTypeNode},
+				}}
+				newShoot := prepareShootForUpdate(shoot)
+				newShoot.Spec.ControlPlane.HighAvailability.FailureTolerance.Type = garden.FailureToleranceTypeZone
+
+				errorList := ValidateShootUpdate(newShoot, shoot)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.controlPlane.highAvailability"),
+				}))
+			})
+
+			It("should allow valid network policy rules", func() {
+				udp := corev1.ProtocolUDP
+				shoot.Spec.ControlPlane = &garden.ControlPlane{NetworkPolicyRules: []garden.NetworkPolicyRule{
+					{
+						Name:        "logging",
+						PodSelector: metav1.LabelSelector{MatchLabels: map[string]string{"app": "logging"}},
+						CIDRs:       []string{"1.2.3.4/32", "5.6.0.0/16"},
+						Ports:       []garden.NetworkPolicyRulePort{{Port: 443}, {Protocol: &udp, Port: 514}},
+					},
+					{
+						Name: "monitoring",
+						PodSelector: metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{
+							{Key: "role", Operator: metav1.LabelSelectorOpIn, Values: []string{"monitoring"}},
+						}},
+						CIDRs: []string{"8.8.8.8/32"},
+					},
+				}}
+
+				errorList := ValidateShoot(shoot)
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should forbid invalid network policy rules", func() {
+				icmp := corev1.Protocol("ICMP")
+				shoot.Spec.ControlPlane = &garden.ControlPlane{NetworkPolicyRules: []garden.NetworkPolicyRule{
+					{
+						Name:  "Logging",
+						CIDRs: []string{"1.2.3.4"},
+						Ports: []garden.NetworkPolicyRulePort{{Port: 0}, {Protocol: &icmp, Port: 443}},
+					},
+					{
+						Name: strings.Repeat("a", 51),
+						PodSelector: metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{
+							{Key: "app", Operator: "Foo"},
+						}},
+					},
+					{
+						CIDRs: []string{"1.2.3.4/32"},
+					},
+					{
+						Name:        "etcd",
+						PodSelector: metav1.LabelSelector{MatchLabels: map[string]string{"app": "etcd-statefulset"}},
+						CIDRs:       []string{"1.2.3.4/32"},
+					},
+				}}
+
+				errorList := ValidateShoot(shoot)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.controlPlane.networkPolicyRules[0].name"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.controlPlane.networkPolicyRules[0].cidrs[0]"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.controlPlane.networkPolicyRules[0].ports[0].port"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("spec.controlPlane.networkPolicyRules[0].ports[1].protocol"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeTooLong),
+					"Field": Equal("spec.controlPlane.networkPolicyRules[1].name"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.controlPlane.networkPolicyRules[1].podSelector.matchExpressions[0].operator"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.controlPlane.networkPolicyRules[1].cidrs"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.controlPlane.networkPolicyRules[0].podSelector"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.controlPlane.networkPolicyRules[2].name"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.controlPlane.networkPolicyRules[2].podSelector"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("spec.controlPlane.networkPolicyRules[3].podSelector.matchLabels"),
+				}))
+			})
+
+			It("should forbid duplicate network policy rule names", func() {
+				shoot.Spec.ControlPlane = &garden.ControlPlane{NetworkPolicyRules: []garden.NetworkPolicyRule{
+					{Name: "logging", PodSelector: metav1.LabelSelector{MatchLabels: map[string]string{"app": "foo"}}, CIDRs: []string{"1.2.3.4/32"}},
+					{Name: "logging", PodSelector: metav1.LabelSelector{MatchLabels: map[string]string{"app": "bar"}}, CIDRs: []string{"5.6.7.8/32"}},
+				}}
+
+				errorList := ValidateShoot(shoot)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("spec.controlPlane.networkPolicyRules[1].name"),
+				}))
+			})
+		})
+
+		Context("KubeScheduler validation", func() {
+			BeforeEach(func() {
+				shoot.Spec.Kubernetes.KubeScheduler = &garden.KubeSchedulerConfig{}
+			})
+
+			It("should succeed when no profile is set", func() {
+				errorList := ValidateShoot(shoot)
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should succeed when using the bin-packing profile", func() {
+				profile := garden.SchedulingProfileBinPacking
+				shoot.Spec.Kubernetes.KubeScheduler.Profile = &profile
+
+				errorList := ValidateShoot(shoot)
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should fail when using an unsupported profile", func() {
+				profile := garden.SchedulingProfile("foo")
+				shoot.Spec.Kubernetes.KubeScheduler.Profile = &profile
+
+				errorList := ValidateShoot(shoot)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("spec.kubernetes.kubeScheduler.profile"),
+				}))
+			})
 		})
 
 		Context("KubeProxy validation", func() {
@@ -4092,6 +4315,350 @@ var _ = Describe("Shoot Validation Tests", func() {
 
 				Expect(errorList).To(BeEmpty())
 			})
+
+			It("should allow a valid log backend", func() {
+				shoot.Spec.Kubernetes.KubeAPIServer.AuditConfig.Backend = &garden.AuditBackend{
+					Log: &garden.AuditLogBackend{
+						MaxAge:     makeInt32Pointer(7),
+						MaxBackups: makeInt32Pointer(0),
+						MaxSize:    makeInt32Pointer(200),
+						Buffering: &garden.AuditBuffering{
+							Mode:         garden.AuditModeBatch,
+							BufferSize:   makeInt32Pointer(10000),
+							MaxBatchSize: makeInt32Pointer(400),
+							MaxBatchWait: &metav1.Duration{Duration: 30 * time.Second},
+						},
+					},
+				}
+
+				Expect(ValidateShoot(shoot)).To(BeEmpty())
+			})
+
+			It("should allow a valid webhook backend", func() {
+				shoot.Spec.Kubernetes.KubeAPIServer.AuditConfig.Backend = &garden.AuditBackend{
+					Webhook: &garden.AuditWebhookBackend{
+						SecretRef:      corev1.LocalObjectReference{Name: "audit-webhook"},
+						InitialBackoff: &metav1.Duration{Duration: 5 * time.Second},
+						Buffering: &garden.AuditBuffering{
+							Mode:          garden.AuditModeBatch,
+							ThrottleQPS:   makeInt32Pointer(10),
+							ThrottleBurst: makeInt32Pointer(15),
+						},
+					},
+				}
+
+				Expect(ValidateShoot(shoot)).To(BeEmpty())
+			})
+
+			It("should forbid a backend without log and webhook", func() {
+				shoot.Spec.Kubernetes.KubeAPIServer.AuditConfig.Backend = &garden.AuditBackend{}
+
+				Expect(ValidateShoot(shoot)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.kubernetes.kubeAPIServer.auditConfig.backend"),
+				}))))
+			})
+
+			It("should forbid a backend with log and webhook", func() {
+				shoot.Spec.Kubernetes.KubeAPIServer.AuditConfig.Backend = &garden.AuditBackend{
+					Log: &garden.AuditLogBackend{},
+					Webhook: &garden.AuditWebhookBackend{
+						SecretRef: corev1.LocalObjectReference{Name: "audit-webhook"},
+					},
+				}
+
+				Expect(ValidateShoot(shoot)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("spec.kubernetes.kubeAPIServer.auditConfig.backend.webhook"),
+				}))))
+			})
+
+			It("should forbid invalid log backend settings", func() {
+				shoot.Spec.Kubernetes.KubeAPIServer.AuditConfig.Backend = &garden.AuditBackend{
+					Log: &garden.AuditLogBackend{
+						MaxAge:  makeInt32Pointer(-1),
+						MaxSize: makeInt32Pointer(-100),
+						Buffering: &garden.AuditBuffering{
+							Mode: garden.AuditMode("fire-and-forget"),
+						},
+					},
+				}
+
+				Expect(ValidateShoot(shoot)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.kubernetes.kubeAPIServer.auditConfig.backend.log.maxAge"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.kubernetes.kubeAPIServer.auditConfig.backend.log.maxSize"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("spec.kubernetes.kubeAPIServer.auditConfig.backend.log.buffering.mode"),
+					})),
+				))
+			})
+
+			It("should forbid invalid webhook backend settings", func() {
+				shoot.Spec.Kubernetes.KubeAPIServer.AuditConfig.Backend = &garden.AuditBackend{
+					Webhook: &garden.AuditWebhookBackend{
+						InitialBackoff: &metav1.Duration{},
+						Buffering: &garden.AuditBuffering{
+							Mode:         garden.AuditModeBatch,
+							BufferSize:   makeInt32Pointer(0),
+							MaxBatchWait: &metav1.Duration{Duration: -time.Second},
+						},
+					},
+				}
+
+				Expect(ValidateShoot(shoot)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("spec.kubernetes.kubeAPIServer.auditConfig.backend.webhook.secretRef.name"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.kubernetes.kubeAPIServer.auditConfig.backend.webhook.initialBackoff"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.kubernetes.kubeAPIServer.auditConfig.backend.webhook.buffering.bufferSize"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.kubernetes.kubeAPIServer.auditConfig.backend.webhook.buffering.maxBatchWait"),
+					})),
+				))
+			})
+
+			It("should forbid batch settings in blocking mode", func() {
+				shoot.Spec.Kubernetes.KubeAPIServer.AuditConfig.Backend = &garden.AuditBackend{
+					Webhook: &garden.AuditWebhookBackend{
+						SecretRef: corev1.LocalObjectReference{Name: "audit-webhook"},
+						Buffering: &garden.AuditBuffering{
+							Mode:         garden.AuditModeBlocking,
+							MaxBatchSize: makeInt32Pointer(100),
+						},
+					},
+				}
+
+				Expect(ValidateShoot(shoot)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("spec.kubernetes.kubeAPIServer.auditConfig.backend.webhook.buffering.maxBatchSize"),
+				}))))
+			})
+		})
+
+		Context("webhook validation", func() {
+			It("should allow valid authentication, authorization and admission webhook configs", func() {
+				shoot.Spec.Kubernetes.KubeAPIServer.AuthenticationWebhook = &garden.AuthenticationWebhook{
+					SecretRef: corev1.LocalObjectReference{Name: "authn-webhook"},
+					CacheTTL:  &metav1.Duration{Duration: time.Minute},
+				}
+				shoot.Spec.Kubernetes.KubeAPIServer.AuthorizationWebhook = &garden.AuthorizationWebhook{
+					SecretRef:            corev1.LocalObjectReference{Name: "authz-webhook"},
+					CacheAuthorizedTTL:   &metav1.Duration{Duration: 10 * time.Minute},
+					CacheUnauthorizedTTL: &metav1.Duration{},
+				}
+				shoot.Spec.Kubernetes.KubeAPIServer.AdmissionWebhookConfig = &garden.AdmissionWebhookConfig{
+					SecretRef: corev1.LocalObjectReference{Name: "admission-webhook"},
+				}
+
+				Expect(ValidateShoot(shoot)).To(BeEmpty())
+			})
+
+			It("should forbid invalid authentication and authorization webhook configs", func() {
+				shoot.Spec.Kubernetes.KubeAPIServer.AuthenticationWebhook = &garden.AuthenticationWebhook{
+					CacheTTL: &metav1.Duration{Duration: -time.Minute},
+				}
+				shoot.Spec.Kubernetes.KubeAPIServer.AuthorizationWebhook = &garden.AuthorizationWebhook{
+					SecretRef:            corev1.LocalObjectReference{Name: "authz-webhook"},
+					CacheUnauthorizedTTL: &metav1.Duration{Duration: -time.Second},
+				}
+
+				Expect(ValidateShoot(shoot)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("spec.kubernetes.kubeAPIServer.authenticationWebhook.secretRef.name"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.kubernetes.kubeAPIServer.authenticationWebhook.cacheTTL"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.kubernetes.kubeAPIServer.authorizationWebhook.cacheUnauthorizedTTL"),
+					})),
+				))
+			})
+
+			It("should forbid an admission webhook config without secret name", func() {
+				shoot.Spec.Kubernetes.KubeAPIServer.AdmissionWebhookConfig = &garden.AdmissionWebhookConfig{}
+
+				Expect(ValidateShoot(shoot)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.kubernetes.kubeAPIServer.admissionWebhookConfig.secretRef.name"),
+				}))))
+			})
+
+			It("should forbid configs for the admission webhook plugins if the admission webhook config is set", func() {
+				shoot.Spec.Kubernetes.KubeAPIServer.AdmissionWebhookConfig = &garden.AdmissionWebhookConfig{
+					SecretRef: corev1.LocalObjectReference{Name: "admission-webhook"},
+				}
+				shoot.Spec.Kubernetes.KubeAPIServer.AdmissionPlugins = append(shoot.Spec.Kubernetes.KubeAPIServer.AdmissionPlugins, garden.AdmissionPlugin{
+					Name:   "ValidatingAdmissionWebhook",
+					Config: &garden.ProviderConfig{RawExtension: runtime.RawExtension{Raw: []byte("{}")}},
+				})
+
+				Expect(ValidateShoot(shoot)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("spec.kubernetes.kubeAPIServer.admissionPlugins[1].config"),
+				}))))
+			})
+		})
+
+		Context("EncryptionConfig validation", func() {
+			It("should allow additional resources", func() {
+				shoot.Spec.Kubernetes.KubeAPIServer.EncryptionConfig = &garden.EncryptionConfig{
+					Resources: []string{"configmaps", "flunders.example.com"},
+				}
+
+				Expect(ValidateShoot(shoot)).To(BeEmpty())
+			})
+
+			It("should forbid secrets, duplicates and invalid resource names", func() {
+				shoot.Spec.Kubernetes.KubeAPIServer.EncryptionConfig = &garden.EncryptionConfig{
+					Resources: []string{"secrets", "configmaps", "configmaps", "*.apps"},
+				}
+
+				Expect(ValidateShoot(shoot)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("spec.kubernetes.kubeAPIServer.encryptionConfig.resources[0]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeDuplicate),
+						"Field": Equal("spec.kubernetes.kubeAPIServer.encryptionConfig.resources[2]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.kubernetes.kubeAPIServer.encryptionConfig.resources[3]"),
+					})),
+				))
+			})
+
+			It("should allow a valid KMS provider", func() {
+				shoot.Spec.Kubernetes.KubeAPIServer.EncryptionConfig = &garden.EncryptionConfig{
+					KMS: &garden.KMSProvider{
+						Type:      "foo-kms",
+						CacheSize: makeInt32Pointer(100),
+						Timeout:   makeDurationPointer(5 * time.Second),
+					},
+				}
+
+				Expect(ValidateShoot(shoot)).To(BeEmpty())
+			})
+
+			It("should forbid an invalid KMS provider", func() {
+				shoot.Spec.Kubernetes.KubeAPIServer.EncryptionConfig = &garden.EncryptionConfig{
+					KMS: &garden.KMSProvider{
+						CacheSize: makeInt32Pointer(0),
+						Timeout:   makeDurationPointer(-time.Second),
+					},
+				}
+
+				Expect(ValidateShoot(shoot)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("spec.kubernetes.kubeAPIServer.encryptionConfig.kms.type"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.kubernetes.kubeAPIServer.encryptionConfig.kms.cacheSize"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.kubernetes.kubeAPIServer.encryptionConfig.kms.timeout"),
+					})),
+				))
+			})
+
+			It("should allow to add a KMS provider", func() {
+				newShoot := prepareShootForUpdate(shoot)
+				newShoot.Spec.Kubernetes.KubeAPIServer.EncryptionConfig = &garden.EncryptionConfig{
+					KMS: &garden.KMSProvider{Type: "foo-kms"},
+				}
+
+				Expect(ValidateShootUpdate(newShoot, shoot)).To(BeEmpty())
+			})
+
+			It("should forbid to remove or change the type of a KMS provider", func() {
+				shoot.Spec.Kubernetes.KubeAPIServer.EncryptionConfig = &garden.EncryptionConfig{
+					KMS: &garden.KMSProvider{Type: "foo-kms"},
+				}
+
+				newShoot := prepareShootForUpdate(shoot)
+				newShoot.Spec.Kubernetes.KubeAPIServer.EncryptionConfig.KMS.Type = "bar-kms"
+				Expect(ValidateShootUpdate(newShoot, shoot)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.kubernetes.kubeAPIServer.encryptionConfig.kms.type"),
+					})),
+				))
+
+				newShoot.Spec.Kubernetes.KubeAPIServer.EncryptionConfig = nil
+				Expect(ValidateShootUpdate(newShoot, shoot)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.kubernetes.kubeAPIServer.encryptionConfig.kms.type"),
+					})),
+				))
+			})
+		})
+
+		Context("StaticCredentialsRotation validation", func() {
+			It("should allow a valid rotation configuration", func() {
+				shoot.Spec.Kubernetes.KubeAPIServer.StaticCredentialsRotation = &garden.StaticCredentialsRotationConfig{
+					Period:           metav1.Duration{Duration: 30 * 24 * time.Hour},
+					TransitionPeriod: &metav1.Duration{Duration: 24 * time.Hour},
+				}
+
+				Expect(ValidateShoot(shoot)).To(BeEmpty())
+			})
+
+			It("should forbid a non-positive period", func() {
+				shoot.Spec.Kubernetes.KubeAPIServer.StaticCredentialsRotation = &garden.StaticCredentialsRotationConfig{}
+
+				Expect(ValidateShoot(shoot)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.kubernetes.kubeAPIServer.staticCredentialsRotation.period"),
+				}))))
+			})
+
+			It("should forbid a negative transition period", func() {
+				shoot.Spec.Kubernetes.KubeAPIServer.StaticCredentialsRotation = &garden.StaticCredentialsRotationConfig{
+					Period:           metav1.Duration{Duration: time.Hour},
+					TransitionPeriod: &metav1.Duration{Duration: -time.Minute},
+				}
+
+				Expect(ValidateShoot(shoot)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.kubernetes.kubeAPIServer.staticCredentialsRotation.transitionPeriod"),
+				}))))
+			})
+
+			It("should forbid a transition period which is not less than the period", func() {
+				shoot.Spec.Kubernetes.KubeAPIServer.StaticCredentialsRotation = &garden.StaticCredentialsRotationConfig{
+					Period:           metav1.Duration{Duration: time.Hour},
+					TransitionPeriod: &metav1.Duration{Duration: time.Hour},
+				}
+
+				Expect(ValidateShoot(shoot)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.kubernetes.kubeAPIServer.staticCredentialsRotation.transitionPeriod"),
+				}))))
+			})
 		})
 
 		It("should require a kubernetes version", func() {
@@ -4148,6 +4715,116 @@ var _ = Describe("Shoot Validation Tests", func() {
 			}))
 		})
 
+		Context("backup section", func() {
+			It("should allow a valid retention policy", func() {
+				shoot.Spec.Backup = &garden.ShootBackup{
+					RetentionPolicy: &garden.BackupRetentionPolicy{
+						DailySnapshots:  makeInt32Pointer(7),
+						WeeklySnapshots: makeInt32Pointer(4),
+					},
+				}
+
+				Expect(ValidateShoot(shoot)).To(BeEmpty())
+			})
+
+			It("should forbid a retention policy which does not keep any snapshots", func() {
+				shoot.Spec.Backup = &garden.ShootBackup{
+					RetentionPolicy: &garden.BackupRetentionPolicy{
+						DailySnapshots:  makeInt32Pointer(0),
+						WeeklySnapshots: makeInt32Pointer(-1),
+					},
+				}
+
+				Expect(ValidateShoot(shoot)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.backup.retentionPolicy.dailySnapshots"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.backup.retentionPolicy.weeklySnapshots"),
+					})),
+				))
+			})
+		})
+
+		Context("worker CRI validation", func() {
+			It("should allow selecting containerd as container runtime", func() {
+				shoot.Spec.Provider.Workers[0].CRI = &garden.CRI{Name: garden.CRINameContainerD}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should forbid unsupported container runtimes", func() {
+				shoot.Spec.Provider.Workers[0].CRI = &garden.CRI{Name: "rkt"}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("spec.provider.workers[0].cri.name"),
+				}))))
+			})
+
+			It("should forbid containerd for Kubernetes versions < 1.11", func() {
+				shoot.Spec.Kubernetes.Version = "1.10.12"
+				shoot.Spec.Provider.Workers[0].CRI = &garden.CRI{Name: garden.CRINameContainerD}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("spec.provider.workers[0].cri.name"),
+				}))))
+			})
+
+			It("should allow docker for Kubernetes versions < 1.11", func() {
+				shoot.Spec.Kubernetes.Version = "1.10.12"
+				shoot.Spec.Provider.Workers[0].CRI = &garden.CRI{Name: garden.CRINameDocker}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).NotTo(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Field": Equal("spec.provider.workers[0].cri.name"),
+				}))))
+			})
+
+			It("should forbid changing the container runtime of an existing worker pool", func() {
+				newShoot := prepareShootForUpdate(shoot)
+				newShoot.Spec.Provider.Workers[0].CRI = &garden.CRI{Name: garden.CRINameContainerD}
+
+				errorList := ValidateShootUpdate(newShoot, shoot)
+
+				Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.provider.workers[0].cri.name"),
+				}))))
+			})
+
+			It("should allow specifying docker explicitly for an existing worker pool", func() {
+				newShoot := prepareShootForUpdate(shoot)
+				newShoot.Spec.Provider.Workers[0].CRI = &garden.CRI{Name: garden.CRINameDocker}
+
+				errorList := ValidateShootUpdate(newShoot, shoot)
+
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should allow choosing the container runtime of a new worker pool", func() {
+				newShoot := prepareShootForUpdate(shoot)
+				newWorker := *newShoot.Spec.Provider.Workers[0].DeepCopy()
+				newWorker.Name = "new-worker"
+				newWorker.CRI = &garden.CRI{Name: garden.CRINameContainerD}
+				newShoot.Spec.Provider.Workers = append(newShoot.Spec.Provider.Workers, newWorker)
+
+				errorList := ValidateShootUpdate(newShoot, shoot)
+
+				Expect(errorList).To(BeEmpty())
+			})
+		})
+
 		Context("networking section", func() {
 			It("should forbid not specifying a networking type", func() {
 				shoot.Spec.Networking.Type = ""
@@ -4171,6 +4848,119 @@ var _ = Describe("Shoot Validation Tests", func() {
 					"Field": Equal("spec.networking.type"),
 				}))))
 			})
+
+			It("should allow setting an empty node network but forbid changing it afterwards", func() {
+				shoot.Spec.Networking.Nodes = ""
+				newShoot := prepareShootForUpdate(shoot)
+				newShoot.Spec.Networking.Nodes = "10.250.0.0/16"
+
+				Expect(ValidateShootUpdate(newShoot, shoot)).To(BeEmpty())
+
+				newerShoot := prepareShootForUpdate(newShoot)
+				newerShoot.Spec.Networking.Nodes = "10.251.0.0/16"
+
+				Expect(ValidateShootUpdate(newerShoot, newShoot)).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.networking.nodes"),
+				}))))
+			})
+
+			It("should allow dual-stack networks", func() {
+				pods, services := "100.96.0.0/11,fd00:100:96::/56", "100.64.0.0/13,fd00:100:64::/108"
+				shoot.Spec.Kubernetes.Version = "1.17.0"
+				shoot.Spec.Kubernetes.KubeControllerManager = nil
+				shoot.Spec.Networking.IPFamilies = []garden.IPFamily{garden.IPFamilyIPv4, garden.IPFamilyIPv6}
+				shoot.Spec.Networking.Nodes = "10.250.0.0/16,fd00:10:250::/64"
+				shoot.Spec.Networking.Pods = &pods
+				shoot.Spec.Networking.Services = &services
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should forbid dual-stack networks for Kubernetes versions which do not support them", func() {
+				pods, services := "100.96.0.0/11,fd00:100:96::/56", "100.64.0.0/13,fd00:100:64::/108"
+				shoot.Spec.Kubernetes.Version = "1.16.4"
+				shoot.Spec.Kubernetes.KubeControllerManager = nil
+				shoot.Spec.Networking.IPFamilies = []garden.IPFamily{garden.IPFamilyIPv4, garden.IPFamilyIPv6}
+				shoot.Spec.Networking.Nodes = "10.250.0.0/16,fd00:10:250::/64"
+				shoot.Spec.Networking.Pods = &pods
+				shoot.Spec.Networking.Services = &services
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("spec.networking.ipFamilies"),
+				}))))
+			})
+
+			It("should allow IPv6-only networks", func() {
+				pods := "fd00:100:96::/56"
+				shoot.Spec.Networking.IPFamilies = []garden.IPFamily{garden.IPFamilyIPv6}
+				shoot.Spec.Networking.Nodes = "fd00:10:250::/64"
+				shoot.Spec.Networking.Pods = &pods
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should forbid networks not matching the IP families", func() {
+				pods, services := "fd00:100:96::/56", "100.64.0.0/13,fd00:100:64::/108"
+				shoot.Spec.Networking.Nodes = "10.250.0.0/16"
+				shoot.Spec.Networking.Pods = &pods
+				shoot.Spec.Networking.Services = &services
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("spec.networking.pods"),
+					"Detail": Equal(`CIDR "fd00:100:96::/56" must be of IP family IPv4`),
+				})), PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.networking.services"),
+				}))))
+			})
+
+			It("should forbid invalid networks", func() {
+				shoot.Spec.Networking.Nodes = "10.250.0.0"
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.networking.nodes"),
+				}))))
+			})
+
+			It("should forbid unsupported IP families", func() {
+				shoot.Spec.Networking.IPFamilies = []garden.IPFamily{"IPv5"}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("spec.networking.ipFamilies[0]"),
+				}))))
+			})
+
+			It("should forbid changing the IP families", func() {
+				newShoot := prepareShootForUpdate(shoot)
+				newShoot.Spec.Networking.IPFamilies = []garden.IPFamily{garden.IPFamilyIPv4}
+
+				Expect(ValidateShootUpdate(newShoot, shoot)).To(BeEmpty())
+
+				newShoot.Spec.Networking.IPFamilies = []garden.IPFamily{garden.IPFamilyIPv6}
+				errorList := ValidateShootUpdate(newShoot, shoot)
+
+				Expect(errorList).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.networking.ipFamilies"),
+				}))))
+			})
 		})
 
 		Context("maintenance section", func() {
@@ -4832,6 +5622,11 @@ func makeIntPointer(i int) *int {
 	return &ptr
 }
 
+func makeInt32Pointer(i int32) *int32 {
+	ptr := i
+	return &ptr
+}
+
 func makeBoolPointer(i bool) *bool {
 	ptr := i
 	return &ptr
@@ -15,10 +15,24 @@
 package validation
 
 import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/gardener/gardener/pkg/apis/garden"
+	"github.com/gardener/gardener/pkg/apis/garden/helper"
+
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation"
-	"strconv"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var availableIPFamilies = sets.NewString(
+	string(garden.IPFamilyIPv4),
+	string(garden.IPFamilyIPv6),
 )
 
 // ValidateName is a helper function for validating that a name is a DNS sub domain.
@@ -65,3 +79,52 @@ func equal(new, old []string) bool {
 	}
 	return true
 }
+
+// validateIPFamilies validates that the given IP families are supported and that each of them is specified at most once.
+func validateIPFamilies(ipFamilies []garden.IPFamily, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	seen := sets.NewString()
+
+	for i, ipFamily := range ipFamilies {
+		if !availableIPFamilies.Has(string(ipFamily)) {
+			allErrs = append(allErrs, field.NotSupported(fldPath.Index(i), ipFamily, availableIPFamilies.List()))
+		} else if seen.Has(string(ipFamily)) {
+			allErrs = append(allErrs, field.Duplicate(fldPath.Index(i), ipFamily))
+		}
+		seen.Insert(string(ipFamily))
+	}
+
+	return allErrs
+}
+
+// validateCIDRsForIPFamilies validates that the given comma-separated <cidrs> contain exactly one valid CIDR for each of
+// the given IP families, in the same order. An empty list of IP families means IPv4 only.
+func validateCIDRsForIPFamilies(cidrs string, ipFamilies []garden.IPFamily, fldPath *field.Path) field.ErrorList {
+	var (
+		allErrs = field.ErrorList{}
+		values  = strings.Split(cidrs, ",")
+	)
+
+	ipFamilies = helper.GetIPFamilies(ipFamilies)
+	if len(values) != len(ipFamilies) {
+		return append(allErrs, field.Invalid(fldPath, cidrs, fmt.Sprintf("must contain exactly one CIDR per IP family %v", ipFamilies)))
+	}
+
+	for i, value := range values {
+		ip, _, err := net.ParseCIDR(strings.TrimSpace(value))
+		if err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath, cidrs, err.Error()))
+			continue
+		}
+
+		ipFamily := garden.IPFamilyIPv6
+		if ip.To4() != nil {
+			ipFamily = garden.IPFamilyIPv4
+		}
+		if ipFamily != ipFamilies[i] {
+			allErrs = append(allErrs, field.Invalid(fldPath, cidrs, fmt.Sprintf("CIDR %q must be of IP family %s", strings.TrimSpace(value), ipFamilies[i])))
+		}
+	}
+
+	return allErrs
+}
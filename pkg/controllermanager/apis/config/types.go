@@ -95,6 +95,20 @@ type PlantControllerConfiguration struct {
 	ConcurrentSyncs int
 	// SyncPeriod is the duration how often the existing resources are reconciled.
 	SyncPeriod metav1.Duration
+	// KubeconfigExpirationThreshold is the duration before the expiration of the kubeconfig credentials of a Plant
+	// from which on a warning is raised and, if enabled, the credentials are renewed.
+	KubeconfigExpirationThreshold *metav1.Duration
+	// KubeconfigRenewal defines the configuration for renewing the kubeconfig credentials of Plants.
+	KubeconfigRenewal *PlantKubeconfigRenewalConfiguration
+}
+
+// PlantKubeconfigRenewalConfiguration defines the configuration for renewing the kubeconfig credentials of Plants.
+type PlantKubeconfigRenewalConfiguration struct {
+	// Enabled defines whether expiring kubeconfig credentials of Plants are renewed by minting a service account
+	// token in the Plant cluster.
+	Enabled bool
+	// TokenValidity is the requested validity of the minted service account tokens.
+	TokenValidity *metav1.Duration
 }
 
 // ProjectControllerConfiguration defines the configuration of the
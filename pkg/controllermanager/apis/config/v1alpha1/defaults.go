@@ -127,6 +127,16 @@ func SetDefaults_ControllerManagerConfiguration(obj *ControllerManagerConfigurat
 	}
 }
 
+// SetDefaults_PlantControllerConfiguration sets defaults for the Plant controller configuration.
+func SetDefaults_PlantControllerConfiguration(obj *PlantControllerConfiguration) {
+	if obj.KubeconfigExpirationThreshold == nil {
+		obj.KubeconfigExpirationThreshold = &metav1.Duration{Duration: 7 * 24 * time.Hour}
+	}
+	if obj.KubeconfigRenewal != nil && obj.KubeconfigRenewal.TokenValidity == nil {
+		obj.KubeconfigRenewal.TokenValidity = &metav1.Duration{Duration: 30 * 24 * time.Hour}
+	}
+}
+
 // SetDefaults_GardenClientConnection sets defaults for the client connection.
 func SetDefaults_GardenClientConnection(obj *componentbaseconfigv1alpha1.ClientConnectionConfiguration) {
 	//componentbaseconfigv1alpha1.RecommendedDefaultClientConnectionConfiguration(obj)
@@ -105,6 +105,23 @@ type PlantControllerConfiguration struct {
 	ConcurrentSyncs int `json:"concurrentSyncs"`
 	// SyncPeriod is the duration how often the existing resources are reconciled.
 	SyncPeriod metav1.Duration `json:"syncPeriod"`
+	// KubeconfigExpirationThreshold is the duration before the expiration of the kubeconfig credentials of a Plant
+	// from which on a warning is raised and, if enabled, the credentials are renewed.
+	// +optional
+	KubeconfigExpirationThreshold *metav1.Duration `json:"kubeconfigExpirationThreshold,omitempty"`
+	// KubeconfigRenewal defines the configuration for renewing the kubeconfig credentials of Plants.
+	// +optional
+	KubeconfigRenewal *PlantKubeconfigRenewalConfiguration `json:"kubeconfigRenewal,omitempty"`
+}
+
+// PlantKubeconfigRenewalConfiguration defines the configuration for renewing the kubeconfig credentials of Plants.
+type PlantKubeconfigRenewalConfiguration struct {
+	// Enabled defines whether expiring kubeconfig credentials of Plants are renewed by minting a service account
+	// token in the Plant cluster.
+	Enabled bool `json:"enabled"`
+	// TokenValidity is the requested validity of the minted service account tokens.
+	// +optional
+	TokenValidity *metav1.Duration `json:"tokenValidity,omitempty"`
 }
 
 // ProjectControllerConfiguration defines the configuration of the
@@ -118,6 +118,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*PlantKubeconfigRenewalConfiguration)(nil), (*config.PlantKubeconfigRenewalConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PlantKubeconfigRenewalConfiguration_To_config_PlantKubeconfigRenewalConfiguration(a.(*PlantKubeconfigRenewalConfiguration), b.(*config.PlantKubeconfigRenewalConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.PlantKubeconfigRenewalConfiguration)(nil), (*PlantKubeconfigRenewalConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_PlantKubeconfigRenewalConfiguration_To_v1alpha1_PlantKubeconfigRenewalConfiguration(a.(*config.PlantKubeconfigRenewalConfiguration), b.(*PlantKubeconfigRenewalConfiguration), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ProjectControllerConfiguration)(nil), (*config.ProjectControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ProjectControllerConfiguration_To_config_ProjectControllerConfiguration(a.(*ProjectControllerConfiguration), b.(*config.ProjectControllerConfiguration), scope)
 	}); err != nil {
@@ -450,6 +460,8 @@ func Convert_config_LeaderElectionConfiguration_To_v1alpha1_LeaderElectionConfig
 func autoConvert_v1alpha1_PlantControllerConfiguration_To_config_PlantControllerConfiguration(in *PlantControllerConfiguration, out *config.PlantControllerConfiguration, s conversion.Scope) error {
 	out.ConcurrentSyncs = in.ConcurrentSyncs
 	out.SyncPeriod = in.SyncPeriod
+	out.KubeconfigExpirationThreshold = (*v1.Duration)(unsafe.Pointer(in.KubeconfigExpirationThreshold))
+	out.KubeconfigRenewal = (*config.PlantKubeconfigRenewalConfiguration)(unsafe.Pointer(in.KubeconfigRenewal))
 	return nil
 }
 
@@ -461,6 +473,8 @@ func Convert_v1alpha1_PlantControllerConfiguration_To_config_PlantControllerConf
 func autoConvert_config_PlantControllerConfiguration_To_v1alpha1_PlantControllerConfiguration(in *config.PlantControllerConfiguration, out *PlantControllerConfiguration, s conversion.Scope) error {
 	out.ConcurrentSyncs = in.ConcurrentSyncs
 	out.SyncPeriod = in.SyncPeriod
+	out.KubeconfigExpirationThreshold = (*v1.Duration)(unsafe.Pointer(in.KubeconfigExpirationThreshold))
+	out.KubeconfigRenewal = (*PlantKubeconfigRenewalConfiguration)(unsafe.Pointer(in.KubeconfigRenewal))
 	return nil
 }
 
@@ -469,6 +483,28 @@ func Convert_config_PlantControllerConfiguration_To_v1alpha1_PlantControllerConf
 	return autoConvert_config_PlantControllerConfiguration_To_v1alpha1_PlantControllerConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_PlantKubeconfigRenewalConfiguration_To_config_PlantKubeconfigRenewalConfiguration(in *PlantKubeconfigRenewalConfiguration, out *config.PlantKubeconfigRenewalConfiguration, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.TokenValidity = (*v1.Duration)(unsafe.Pointer(in.TokenValidity))
+	return nil
+}
+
+// Convert_v1alpha1_PlantKubeconfigRenewalConfiguration_To_config_PlantKubeconfigRenewalConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_PlantKubeconfigRenewalConfiguration_To_config_PlantKubeconfigRenewalConfiguration(in *PlantKubeconfigRenewalConfiguration, out *config.PlantKubeconfigRenewalConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PlantKubeconfigRenewalConfiguration_To_config_PlantKubeconfigRenewalConfiguration(in, out, s)
+}
+
+func autoConvert_config_PlantKubeconfigRenewalConfiguration_To_v1alpha1_PlantKubeconfigRenewalConfiguration(in *config.PlantKubeconfigRenewalConfiguration, out *PlantKubeconfigRenewalConfiguration, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.TokenValidity = (*v1.Duration)(unsafe.Pointer(in.TokenValidity))
+	return nil
+}
+
+// Convert_config_PlantKubeconfigRenewalConfiguration_To_v1alpha1_PlantKubeconfigRenewalConfiguration is an autogenerated conversion function.
+func Convert_config_PlantKubeconfigRenewalConfiguration_To_v1alpha1_PlantKubeconfigRenewalConfiguration(in *config.PlantKubeconfigRenewalConfiguration, out *PlantKubeconfigRenewalConfiguration, s conversion.Scope) error {
+	return autoConvert_config_PlantKubeconfigRenewalConfiguration_To_v1alpha1_PlantKubeconfigRenewalConfiguration(in, out, s)
+}
+
 func autoConvert_v1alpha1_ProjectControllerConfiguration_To_config_ProjectControllerConfiguration(in *ProjectControllerConfiguration, out *config.ProjectControllerConfiguration, s conversion.Scope) error {
 	out.ConcurrentSyncs = in.ConcurrentSyncs
 	return nil
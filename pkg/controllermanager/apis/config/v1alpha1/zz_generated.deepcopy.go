@@ -94,7 +94,7 @@ func (in *ControllerManagerControllerConfiguration) DeepCopyInto(out *Controller
 	if in.Plant != nil {
 		in, out := &in.Plant, &out.Plant
 		*out = new(PlantControllerConfiguration)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.Project != nil {
 		in, out := &in.Project, &out.Project
@@ -218,6 +218,16 @@ func (in *LeaderElectionConfiguration) DeepCopy() *LeaderElectionConfiguration {
 func (in *PlantControllerConfiguration) DeepCopyInto(out *PlantControllerConfiguration) {
 	*out = *in
 	out.SyncPeriod = in.SyncPeriod
+	if in.KubeconfigExpirationThreshold != nil {
+		in, out := &in.KubeconfigExpirationThreshold, &out.KubeconfigExpirationThreshold
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.KubeconfigRenewal != nil {
+		in, out := &in.KubeconfigRenewal, &out.KubeconfigRenewal
+		*out = new(PlantKubeconfigRenewalConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -231,6 +241,27 @@ func (in *PlantControllerConfiguration) DeepCopy() *PlantControllerConfiguration
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlantKubeconfigRenewalConfiguration) DeepCopyInto(out *PlantKubeconfigRenewalConfiguration) {
+	*out = *in
+	if in.TokenValidity != nil {
+		in, out := &in.TokenValidity, &out.TokenValidity
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlantKubeconfigRenewalConfiguration.
+func (in *PlantKubeconfigRenewalConfiguration) DeepCopy() *PlantKubeconfigRenewalConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(PlantKubeconfigRenewalConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProjectControllerConfiguration) DeepCopyInto(out *ProjectControllerConfiguration) {
 	*out = *in
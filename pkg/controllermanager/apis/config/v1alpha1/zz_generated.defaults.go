@@ -20,5 +20,8 @@ func RegisterDefaults(scheme *runtime.Scheme) error {
 
 func SetObjectDefaults_ControllerManagerConfiguration(in *ControllerManagerConfiguration) {
 	SetDefaults_ControllerManagerConfiguration(in)
+	if in.Controllers.Plant != nil {
+		SetDefaults_PlantControllerConfiguration(in.Controllers.Plant)
+	}
 	SetDefaults_LeaderElectionConfiguration(&in.LeaderElection)
 }
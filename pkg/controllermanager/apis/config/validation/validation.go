@@ -0,0 +1,47 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+
+package validation
+
+import (
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ValidateControllerManagerConfiguration validates a ControllerManagerConfiguration object.
+func ValidateControllerManagerConfiguration(cfg *config.ControllerManagerConfiguration) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if cfg.Controllers.Plant != nil {
+		allErrs = append(allErrs, validatePlantControllerConfiguration(cfg.Controllers.Plant, field.NewPath("controllers", "plant"))...)
+	}
+
+	return allErrs
+}
+
+func validatePlantControllerConfiguration(cfg *config.PlantControllerConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if cfg.KubeconfigExpirationThreshold != nil && cfg.KubeconfigExpirationThreshold.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("kubeconfigExpirationThreshold"), cfg.KubeconfigExpirationThreshold.Duration.String(), "must be greater than 0"))
+	}
+
+	if renewal := cfg.KubeconfigRenewal; renewal != nil && renewal.TokenValidity != nil && cfg.KubeconfigExpirationThreshold != nil && renewal.TokenValidity.Duration <= cfg.KubeconfigExpirationThreshold.Duration {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("kubeconfigRenewal", "tokenValidity"), renewal.TokenValidity.Duration.String(), "must be greater than the kubeconfig expiration threshold"))
+	}
+
+	return allErrs
+}
@@ -0,0 +1,71 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+
+package validation_test
+
+import (
+	"time"
+
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	. "github.com/gardener/gardener/pkg/controllermanager/apis/config/validation"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var _ = Describe("ControllerManagerConfiguration", func() {
+	var cfg *config.ControllerManagerConfiguration
+
+	BeforeEach(func() {
+		cfg = &config.ControllerManagerConfiguration{
+			Controllers: config.ControllerManagerControllerConfiguration{
+				Plant: &config.PlantControllerConfiguration{
+					KubeconfigExpirationThreshold: &metav1.Duration{Duration: 7 * 24 * time.Hour},
+					KubeconfigRenewal: &config.PlantKubeconfigRenewalConfiguration{
+						Enabled:       true,
+						TokenValidity: &metav1.Duration{Duration: 30 * 24 * time.Hour},
+					},
+				},
+			},
+		}
+	})
+
+	Describe("#ValidateControllerManagerConfiguration", func() {
+		It("should allow valid configurations", func() {
+			Expect(ValidateControllerManagerConfiguration(cfg)).To(BeEmpty())
+		})
+
+		It("should forbid a non-positive kubeconfig expiration threshold", func() {
+			cfg.Controllers.Plant.KubeconfigExpirationThreshold.Duration = 0
+
+			Expect(ValidateControllerManagerConfiguration(cfg)).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("controllers.plant.kubeconfigExpirationThreshold"),
+			}))))
+		})
+
+		It("should forbid a token validity which does not exceed the kubeconfig expiration threshold", func() {
+			cfg.Controllers.Plant.KubeconfigRenewal.TokenValidity.Duration = 24 * time.Hour
+
+			Expect(ValidateControllerManagerConfiguration(cfg)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("controllers.plant.kubeconfigRenewal.tokenValidity"),
+			}))))
+		})
+	})
+})
@@ -36,6 +36,7 @@ import (
 	"github.com/gardener/gardener/pkg/operation/garden"
 	"github.com/gardener/gardener/pkg/version"
 
+	"github.com/prometheus/client_golang/prometheus"
 	"k8s.io/apimachinery/pkg/util/runtime"
 	kubeinformers "k8s.io/client-go/informers"
 	"k8s.io/client-go/tools/cache"
@@ -116,7 +117,7 @@ func (f *GardenControllerFactory) Run(ctx context.Context) {
 
 	// Initialize the Controller metrics collection.
 	gardenmetrics.RegisterControllerMetrics(
-		controllermanager.ControllerWorkerSum,
+		[]*prometheus.Desc{controllermanager.ControllerWorkerSum},
 		controllermanager.ScrapeFailures,
 		controllerRegistrationController,
 		cloudProfileController,
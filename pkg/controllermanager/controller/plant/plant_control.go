@@ -17,7 +17,9 @@ package plant
 import (
 	"context"
 	"fmt"
+	"strings"
 	"sync"
+	"time"
 
 	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
 	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
@@ -188,12 +190,14 @@ func (c *defaultPlantControl) reconcile(ctx context.Context, plant *gardencorev1
 	var (
 		conditionAPIServerAvailable = gardencorev1alpha1helper.GetOrInitCondition(plant.Status.Conditions, gardencorev1alpha1.PlantAPIServerAvailable)
 		conditionEveryNodeReady     = gardencorev1alpha1helper.GetOrInitCondition(plant.Status.Conditions, gardencorev1alpha1.PlantEveryNodeReady)
+		conditionKubeconfigValid    = gardencorev1alpha1helper.GetOrInitCondition(plant.Status.Conditions, gardencorev1alpha1.PlantKubeconfigValid)
+		conditionInventoryCollected = gardencorev1alpha1helper.GetOrInitCondition(plant.Status.Conditions, gardencorev1alpha1.PlantInventoryCollected)
 	)
 
 	kubeconfigSecret, err := c.secretsLister.Secrets(plant.Namespace).Get(plant.Spec.SecretRef.Name)
 	if err != nil {
 		if apierrors.IsNotFound(err) {
-			return c.updateStatusToUnknown(ctx, plant, "Referenced Plant secret could not be found.", conditionAPIServerAvailable, conditionEveryNodeReady)
+			return c.updateStatusToUnknown(ctx, plant, "Referenced Plant secret could not be found.", conditionAPIServerAvailable, conditionEveryNodeReady, conditionKubeconfigValid, conditionInventoryCollected)
 		}
 		return err
 	}
@@ -210,13 +214,18 @@ func (c *defaultPlantControl) reconcile(ctx context.Context, plant *gardencorev1
 	kubeconfig, ok := kubeconfigSecret.Data["kubeconfig"]
 	if !ok {
 		message := "Plant secret needs to contain a kubeconfig key."
-		return c.updateStatusToUnknown(ctx, plant, message, conditionAPIServerAvailable, conditionEveryNodeReady)
+		return c.updateStatusToUnknown(ctx, plant, message, conditionAPIServerAvailable, conditionEveryNodeReady, conditionKubeconfigValid, conditionInventoryCollected)
 	}
 
 	plantClusterClient, discoveryClient, err := c.initializePlantClients(plant, key, kubeconfig)
 	if err != nil {
 		message := fmt.Sprintf("Could not initialize Plant clients: %+v", err)
-		return c.updateStatusToUnknown(ctx, plant, message, conditionAPIServerAvailable, conditionEveryNodeReady)
+		return c.updateStatusToUnknown(ctx, plant, message, conditionAPIServerAvailable, conditionEveryNodeReady, conditionKubeconfigValid, conditionInventoryCollected)
+	}
+
+	kubeconfigInfo, conditionKubeconfigValid, err := c.checkKubeconfig(ctx, plant, kubeconfigSecret, kubeconfig, discoveryClient, logger, conditionKubeconfigValid)
+	if err != nil {
+		return err
 	}
 
 	healthChecker := NewHealthChecker(plantClusterClient, discoveryClient)
@@ -229,16 +238,123 @@ func (c *defaultPlantControl) reconcile(ctx context.Context, plant *gardencorev1
 		return err
 	}
 
-	return c.updateStatus(ctx, plant, cloudInfo, conditionAPIServerAvailable, conditionEveryNodeReady)
+	inventory, conditionInventoryCollected := c.collectInventory(ctx, plant, healthChecker, logger, cloudInfo.K8sVersion, kubeconfigInfo, conditionInventoryCollected)
+
+	return c.updateStatus(ctx, plant, cloudInfo, inventory, conditionAPIServerAvailable, conditionEveryNodeReady, conditionKubeconfigValid, conditionInventoryCollected)
+}
+
+// checkKubeconfig checks the expiration of the given kubeconfig credentials and records events if they expire soon
+// or have expired. If enabled, expiring credentials are renewed by minting a service account token in the Plant
+// cluster and storing the resulting kubeconfig in the Plant secret.
+func (c *defaultPlantControl) checkKubeconfig(ctx context.Context, plant *gardencorev1alpha1.Plant, kubeconfigSecret *corev1.Secret, kubeconfig []byte, plantClient kubernetesclientset.Interface, logger logrus.FieldLogger, condition gardencorev1alpha1.Condition) (*gardencorev1alpha1.KubeconfigInfo, gardencorev1alpha1.Condition, error) {
+	var (
+		plantConfig    = c.config.Controllers.Plant
+		threshold      time.Duration
+		previousReason = condition.Reason
+		now            = time.Now()
+	)
+
+	if plantConfig.KubeconfigExpirationThreshold != nil {
+		threshold = plantConfig.KubeconfigExpirationThreshold.Duration
+	}
+
+	kubeconfigInfo, err := GetKubeconfigInfo(kubeconfig)
+	if err != nil {
+		logger.Warnf("Could not determine the expiration time of the kubeconfig credentials: %+v", err)
+		return nil, gardencorev1alpha1helper.UpdatedConditionUnknownError(condition, err), nil
+	}
+	condition = CheckKubeconfigExpiration(condition, kubeconfigInfo, threshold, now)
+
+	if condition.Reason == "KubeconfigExpiresSoon" && plantConfig.KubeconfigRenewal != nil && plantConfig.KubeconfigRenewal.Enabled {
+		var validity time.Duration
+		if plantConfig.KubeconfigRenewal.TokenValidity != nil {
+			validity = plantConfig.KubeconfigRenewal.TokenValidity.Duration
+		}
+
+		renewedKubeconfig, err := RenewKubeconfig(plantClient, kubeconfig, validity)
+		if err != nil {
+			c.recorder.Eventf(plant, corev1.EventTypeWarning, gardencorev1alpha1.PlantEventKubeconfigRenewalFailed, "Could not renew the kubeconfig credentials: %v", err)
+			return kubeconfigInfo, condition, nil
+		}
+
+		// The secret is taken from the informer cache, hence, a copy must be updated.
+		renewedKubeconfigSecret := kubeconfigSecret.DeepCopy()
+		renewedKubeconfigSecret.Data["kubeconfig"] = renewedKubeconfig
+		if err := c.k8sGardenClient.Client().Update(ctx, renewedKubeconfigSecret); err != nil {
+			return nil, condition, err
+		}
+		c.recorder.Event(plant, corev1.EventTypeNormal, gardencorev1alpha1.PlantEventKubeconfigRenewed, "Renewed the kubeconfig credentials with a service account token.")
+
+		if kubeconfigInfo, err = GetKubeconfigInfo(renewedKubeconfig); err != nil {
+			return nil, condition, err
+		}
+		return kubeconfigInfo, CheckKubeconfigExpiration(condition, kubeconfigInfo, threshold, now), nil
+	}
+
+	if condition.Reason != previousReason {
+		switch condition.Reason {
+		case "KubeconfigExpiresSoon":
+			c.recorder.Event(plant, corev1.EventTypeWarning, gardencorev1alpha1.PlantEventKubeconfigExpiring, condition.Message)
+		case "KubeconfigExpired":
+			c.recorder.Event(plant, corev1.EventTypeWarning, gardencorev1alpha1.PlantEventKubeconfigExpired, condition.Message)
+		}
+	}
+
+	return kubeconfigInfo, condition, nil
+}
+
+// collectInventory collects the inventory of the Plant cluster. If parts of the inventory cannot be collected, the
+// previously reported values are kept and the given condition reports the error.
+func (c *defaultPlantControl) collectInventory(ctx context.Context, plant *gardencorev1alpha1.Plant, healthChecker *HealthChecker, logger logrus.FieldLogger, controlPlaneVersion string, kubeconfigInfo *gardencorev1alpha1.KubeconfigInfo, condition gardencorev1alpha1.Condition) (*StatusInventory, gardencorev1alpha1.Condition) {
+	var (
+		inventory = &StatusInventory{Kubeconfig: kubeconfigInfo}
+		failures  []string
+	)
+
+	if clusterInfo := plant.Status.ClusterInfo; clusterInfo != nil {
+		inventory.Nodes = clusterInfo.Nodes
+		inventory.NodeVersions = clusterInfo.Kubernetes.NodeVersions
+		inventory.NodeVersionSkew = clusterInfo.Kubernetes.NodeVersionSkew
+		inventory.Workloads = clusterInfo.Workloads
+	}
+
+	if nodesInfo, nodeVersions, err := healthChecker.CollectNodesInfo(ctx); err != nil {
+		failures = append(failures, fmt.Sprintf("could not collect the nodes: %v", err))
+	} else {
+		inventory.Nodes = nodesInfo
+		inventory.NodeVersions = nodeVersions
+		inventory.NodeVersionSkew = nil
+
+		if skew, err := ComputeNodeVersionSkew(controlPlaneVersion, nodeVersions); err != nil {
+			logger.Warnf("Could not compute the node version skew: %+v", err)
+		} else {
+			inventory.NodeVersionSkew = &skew
+		}
+	}
+
+	if workloadsInfo, err := healthChecker.CollectWorkloadsInfo(ctx); err != nil {
+		failures = append(failures, fmt.Sprintf("could not collect the workloads: %v", err))
+	} else {
+		inventory.Workloads = workloadsInfo
+	}
+
+	if len(failures) > 0 {
+		message := fmt.Sprintf("The inventory of the Plant cluster is incomplete: %s.", strings.Join(failures, ", "))
+		logger.Warn(message)
+		return inventory, gardencorev1alpha1helper.UpdatedConditionUnknownErrorMessage(condition, message)
+	}
+	return inventory, gardencorev1alpha1helper.UpdatedCondition(condition, gardencorev1alpha1.ConditionTrue, "InventoryCollected", "The inventory of the Plant cluster has been collected.")
 }
 
-func (c *defaultPlantControl) updateStatusToUnknown(ctx context.Context, plant *gardencorev1alpha1.Plant, message string, conditionAPIServerAvailable, conditionEveryNodeReady gardencorev1alpha1.Condition) error {
+func (c *defaultPlantControl) updateStatusToUnknown(ctx context.Context, plant *gardencorev1alpha1.Plant, message string, conditionAPIServerAvailable, conditionEveryNodeReady, conditionKubeconfigValid, conditionInventoryCollected gardencorev1alpha1.Condition) error {
 	conditionAPIServerAvailable = gardencorev1alpha1helper.UpdatedCondition(conditionAPIServerAvailable, gardencorev1alpha1.ConditionFalse, "APIServerDown", message)
 	conditionEveryNodeReady = gardencorev1alpha1helper.UpdatedCondition(conditionEveryNodeReady, gardencorev1alpha1.ConditionFalse, "Nodes not reachable", message)
-	return c.updateStatus(ctx, plant, &StatusCloudInfo{}, conditionAPIServerAvailable, conditionEveryNodeReady)
+	conditionKubeconfigValid = gardencorev1alpha1helper.UpdatedConditionUnknownErrorMessage(conditionKubeconfigValid, message)
+	conditionInventoryCollected = gardencorev1alpha1helper.UpdatedConditionUnknownErrorMessage(conditionInventoryCollected, message)
+	return c.updateStatus(ctx, plant, &StatusCloudInfo{}, &StatusInventory{}, conditionAPIServerAvailable, conditionEveryNodeReady, conditionKubeconfigValid, conditionInventoryCollected)
 }
 
-func (c *defaultPlantControl) updateStatus(ctx context.Context, plant *gardencorev1alpha1.Plant, cloudInfo *StatusCloudInfo, conditions ...gardencorev1alpha1.Condition) error {
+func (c *defaultPlantControl) updateStatus(ctx context.Context, plant *gardencorev1alpha1.Plant, cloudInfo *StatusCloudInfo, inventory *StatusInventory, conditions ...gardencorev1alpha1.Condition) error {
 	updatePlant := plant.DeepCopy()
 	if updatePlant.Status.ClusterInfo == nil {
 		updatePlant.Status.ClusterInfo = &gardencorev1alpha1.ClusterInfo{}
@@ -246,6 +362,11 @@ func (c *defaultPlantControl) updateStatus(ctx context.Context, plant *gardencor
 	updatePlant.Status.ClusterInfo.Cloud.Type = cloudInfo.CloudType
 	updatePlant.Status.ClusterInfo.Cloud.Region = cloudInfo.Region
 	updatePlant.Status.ClusterInfo.Kubernetes.Version = cloudInfo.K8sVersion
+	updatePlant.Status.ClusterInfo.Kubernetes.NodeVersions = inventory.NodeVersions
+	updatePlant.Status.ClusterInfo.Kubernetes.NodeVersionSkew = inventory.NodeVersionSkew
+	updatePlant.Status.ClusterInfo.Nodes = inventory.Nodes
+	updatePlant.Status.ClusterInfo.Workloads = inventory.Workloads
+	updatePlant.Status.ClusterInfo.Kubeconfig = inventory.Kubeconfig
 	updatePlant.Status.Conditions = conditions
 
 	if !equality.Semantic.DeepEqual(plant, updatePlant) {
@@ -17,12 +17,15 @@ package plant
 import (
 	"context"
 	"fmt"
+	"sync"
 
 	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
 	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
 	"github.com/gardener/gardener/pkg/utils/kubernetes/health"
 
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/discovery"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -39,12 +42,27 @@ func NewHealthChecker(plantClient client.Client, discoveryClient discovery.Disco
 type HealthChecker struct {
 	plantClient     client.Client
 	discoveryClient discovery.DiscoveryInterface
+
+	nodeListOnce sync.Once
+	nodeList     *corev1.NodeList
+	nodeListErr  error
+}
+
+// listNodes lists the nodes of the Plant cluster. The nodes are only listed once per health checker so that the
+// health checks and the inventory are based on the same list.
+func (h *HealthChecker) listNodes(ctx context.Context) (*corev1.NodeList, error) {
+	h.nodeListOnce.Do(func() {
+		nodeList := &corev1.NodeList{}
+		if h.nodeListErr = h.plantClient.List(ctx, nodeList); h.nodeListErr == nil {
+			h.nodeList = nodeList
+		}
+	})
+	return h.nodeList, h.nodeListErr
 }
 
 // CheckPlantClusterNodes checks whether cluster nodes in the given listers are complete and healthy.
 func (h *HealthChecker) CheckPlantClusterNodes(ctx context.Context, condition gardencorev1alpha1.Condition) gardencorev1alpha1.Condition {
-	nodeList := &corev1.NodeList{}
-	err := h.plantClient.List(ctx, nodeList)
+	nodeList, err := h.listNodes(ctx)
 	if err != nil {
 		return gardencorev1alpha1helper.UpdatedConditionUnknownError(condition, err)
 	}
@@ -64,6 +82,70 @@ func (h *HealthChecker) CheckAPIServerAvailability(condition gardencorev1alpha1.
 	})
 }
 
+// CollectNodesInfo collects the number and the total capacity of the nodes registered to the Plant cluster. It
+// additionally returns the sorted list of distinct kubelet versions of these nodes.
+func (h *HealthChecker) CollectNodesInfo(ctx context.Context) (*gardencorev1alpha1.NodesInfo, []string, error) {
+	nodeList, err := h.listNodes(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var (
+		capacity     = corev1.ResourceList{}
+		nodeVersions = sets.NewString()
+	)
+
+	for _, node := range nodeList.Items {
+		for name, quantity := range node.Status.Capacity {
+			if total, ok := capacity[name]; ok {
+				total.Add(quantity)
+				capacity[name] = total
+				continue
+			}
+			capacity[name] = quantity.DeepCopy()
+		}
+		if version := node.Status.NodeInfo.KubeletVersion; len(version) > 0 {
+			nodeVersions.Insert(version)
+		}
+	}
+
+	return &gardencorev1alpha1.NodesInfo{
+		Count:    int32(len(nodeList.Items)),
+		Capacity: capacity,
+	}, nodeVersions.List(), nil
+}
+
+// CollectWorkloadsInfo collects a health summary of the deployments and statefulsets of the Plant cluster.
+func (h *HealthChecker) CollectWorkloadsInfo(ctx context.Context) (*gardencorev1alpha1.WorkloadsInfo, error) {
+	deploymentList := &appsv1.DeploymentList{}
+	if err := h.plantClient.List(ctx, deploymentList); err != nil {
+		return nil, err
+	}
+
+	statefulSetList := &appsv1.StatefulSetList{}
+	if err := h.plantClient.List(ctx, statefulSetList); err != nil {
+		return nil, err
+	}
+
+	workloadsInfo := &gardencorev1alpha1.WorkloadsInfo{
+		Deployments:  gardencorev1alpha1.WorkloadHealthInfo{Total: int32(len(deploymentList.Items))},
+		StatefulSets: gardencorev1alpha1.WorkloadHealthInfo{Total: int32(len(statefulSetList.Items))},
+	}
+
+	for _, deployment := range deploymentList.Items {
+		if err := health.CheckDeployment(&deployment); err == nil {
+			workloadsInfo.Deployments.Healthy++
+		}
+	}
+	for _, statefulSet := range statefulSetList.Items {
+		if err := health.CheckStatefulSet(&statefulSet); err == nil {
+			workloadsInfo.StatefulSets.Healthy++
+		}
+	}
+
+	return workloadsInfo, nil
+}
+
 func (h *HealthChecker) checkNodes(condition gardencorev1alpha1.Condition, nodeList *corev1.NodeList) (gardencorev1alpha1.Condition, error) {
 	for _, object := range nodeList.Items {
 		if err := health.CheckNode(&object); err != nil {
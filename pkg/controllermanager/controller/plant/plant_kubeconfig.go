@@ -0,0 +1,329 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plant
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
+	"github.com/gardener/gardener/pkg/utils"
+
+	authenticationv1 "k8s.io/api/authentication/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubernetesclientset "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+const (
+	// ServiceAccountName is the name of the service account in the Plant cluster whose tokens are used to renew
+	// expiring kubeconfig credentials.
+	ServiceAccountName = "gardener-plant"
+	// ServiceAccountNamespace is the namespace of the service account in the Plant cluster whose tokens are used to
+	// renew expiring kubeconfig credentials.
+	ServiceAccountNamespace = metav1.NamespaceSystem
+	// ClusterRoleName is the name of the cluster role and cluster role binding which grant the service account in the
+	// Plant cluster the permissions required by the Plant controller.
+	ClusterRoleName = "gardener.cloud:system:plant"
+)
+
+// GetKubeconfigInfo returns the expiration times of the client certificate and of the bearer token of the current
+// context of the given kubeconfig. It returns nil if the current context neither uses an embedded client certificate
+// nor a bearer token with an expiration time.
+func GetKubeconfigInfo(kubeconfig []byte) (*gardencorev1alpha1.KubeconfigInfo, error) {
+	config, err := clientcmd.Load(kubeconfig)
+	if err != nil {
+		return nil, err
+	}
+
+	kubeContext, ok := config.Contexts[config.CurrentContext]
+	if !ok {
+		return nil, fmt.Errorf("current context %q not found in kubeconfig", config.CurrentContext)
+	}
+	authInfo, ok := config.AuthInfos[kubeContext.AuthInfo]
+	if !ok {
+		return nil, nil
+	}
+
+	info := &gardencorev1alpha1.KubeconfigInfo{}
+
+	if len(authInfo.ClientCertificateData) > 0 {
+		certificate, err := utils.DecodeCertificate(authInfo.ClientCertificateData)
+		if err != nil {
+			return nil, err
+		}
+		info.CertificateExpirationTime = &metav1.Time{Time: certificate.NotAfter}
+	}
+
+	if len(authInfo.Token) > 0 {
+		expirationTime, err := getTokenExpiration(authInfo.Token)
+		if err != nil {
+			return nil, err
+		}
+		info.TokenExpirationTime = expirationTime
+	}
+
+	if info.CertificateExpirationTime == nil && info.TokenExpirationTime == nil {
+		return nil, nil
+	}
+	return info, nil
+}
+
+// getTokenExpiration returns the expiration time of the given bearer token. It returns nil if the token is no JSON
+// web token or if it does not contain an expiration time. The signature of the token is not verified, hence, the
+// returned expiration time is advisory only and must not be used for any security decision.
+func getTokenExpiration(token string) (*metav1.Time, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, nil
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return nil, fmt.Errorf("could not decode the payload of the bearer token: %v", err)
+	}
+
+	claims := struct {
+		ExpiresAt *int64 `json:"exp"`
+	}{}
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return nil, fmt.Errorf("could not unmarshal the claims of the bearer token: %v", err)
+	}
+	if claims.ExpiresAt == nil {
+		return nil, nil
+	}
+
+	return &metav1.Time{Time: time.Unix(*claims.ExpiresAt, 0)}, nil
+}
+
+// KubeconfigExpirationTime returns the earliest expiration time of the given kubeconfig info, or nil if the
+// kubeconfig credentials do not expire.
+func KubeconfigExpirationTime(info *gardencorev1alpha1.KubeconfigInfo) *metav1.Time {
+	if info == nil {
+		return nil
+	}
+	if info.CertificateExpirationTime == nil {
+		return info.TokenExpirationTime
+	}
+	if info.TokenExpirationTime != nil && info.TokenExpirationTime.Before(info.CertificateExpirationTime) {
+		return info.TokenExpirationTime
+	}
+	return info.CertificateExpirationTime
+}
+
+// CheckKubeconfigExpiration checks whether the kubeconfig credentials described by the given info have expired or
+// expire within the given threshold.
+func CheckKubeconfigExpiration(condition gardencorev1alpha1.Condition, info *gardencorev1alpha1.KubeconfigInfo, threshold time.Duration, now time.Time) gardencorev1alpha1.Condition {
+	expirationTime := KubeconfigExpirationTime(info)
+
+	switch {
+	case expirationTime == nil:
+		return gardencorev1alpha1helper.UpdatedCondition(condition, gardencorev1alpha1.ConditionTrue, "KubeconfigValid", "The kubeconfig credentials do not expire.")
+	case !now.Before(expirationTime.Time):
+		return gardencorev1alpha1helper.UpdatedCondition(condition, gardencorev1alpha1.ConditionFalse, "KubeconfigExpired", fmt.Sprintf("The kubeconfig credentials expired at %s.", expirationTime.UTC().Format(time.RFC3339)))
+	case now.Add(threshold).After(expirationTime.Time):
+		return gardencorev1alpha1helper.UpdatedCondition(condition, gardencorev1alpha1.ConditionFalse, "KubeconfigExpiresSoon", fmt.Sprintf("The kubeconfig credentials expire at %s.", expirationTime.UTC().Format(time.RFC3339)))
+	default:
+		return gardencorev1alpha1helper.UpdatedCondition(condition, gardencorev1alpha1.ConditionTrue, "KubeconfigValid", fmt.Sprintf("The kubeconfig credentials are valid until %s.", expirationTime.UTC().Format(time.RFC3339)))
+	}
+}
+
+// RenewKubeconfig mints a new token for the Plant service account in the Plant cluster and returns a kubeconfig for
+// the cluster of the current context of the given kubeconfig which uses this token. The service account and its
+// permissions are created or reconciled before.
+func RenewKubeconfig(plantClient kubernetesclientset.Interface, kubeconfig []byte, validity time.Duration) ([]byte, error) {
+	config, err := clientcmd.Load(kubeconfig)
+	if err != nil {
+		return nil, err
+	}
+
+	kubeContext, ok := config.Contexts[config.CurrentContext]
+	if !ok {
+		return nil, fmt.Errorf("current context %q not found in kubeconfig", config.CurrentContext)
+	}
+	cluster, ok := config.Clusters[kubeContext.Cluster]
+	if !ok {
+		return nil, fmt.Errorf("cluster %q not found in kubeconfig", kubeContext.Cluster)
+	}
+
+	if err := ensureServiceAccount(plantClient); err != nil {
+		return nil, err
+	}
+
+	expirationSeconds := int64(validity / time.Second)
+	tokenRequest, err := plantClient.CoreV1().ServiceAccounts(ServiceAccountNamespace).CreateToken(ServiceAccountName, &authenticationv1.TokenRequest{
+		Spec: authenticationv1.TokenRequestSpec{
+			ExpirationSeconds: &expirationSeconds,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	values := map[string]interface{}{
+		"ContextName": config.CurrentContext,
+		"Server":      cluster.Server,
+		"Token":       tokenRequest.Status.Token,
+	}
+	if len(cluster.CertificateAuthorityData) > 0 {
+		values["CACertificate"] = utils.EncodeBase64(cluster.CertificateAuthorityData)
+	}
+
+	return utils.RenderLocalTemplate(kubeconfigTemplate, values)
+}
+
+// ensureServiceAccount ensures that the Plant service account exists together with a cluster role and a cluster role
+// binding granting it the permissions required by the Plant controller. The cluster role and the cluster role binding
+// are reconciled on every renewal so that modifications or missing permissions are corrected.
+func ensureServiceAccount(plantClient kubernetesclientset.Interface) error {
+	if err := ensureClusterRole(plantClient); err != nil {
+		return err
+	}
+	if err := ensureClusterRoleBinding(plantClient); err != nil {
+		return err
+	}
+
+	serviceAccount := &corev1.ServiceAccount{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      ServiceAccountName,
+			Namespace: ServiceAccountNamespace,
+		},
+	}
+	if _, err := plantClient.CoreV1().ServiceAccounts(ServiceAccountNamespace).Create(serviceAccount); err != nil && !apierrors.IsAlreadyExists(err) {
+		return err
+	}
+	return nil
+}
+
+func ensureClusterRole(plantClient kubernetesclientset.Interface) error {
+	rules := []rbacv1.PolicyRule{
+		{
+			APIGroups: []string{""},
+			Resources: []string{"nodes"},
+			Verbs:     []string{"get", "list", "watch"},
+		},
+		{
+			APIGroups: []string{"apps"},
+			Resources: []string{"deployments", "statefulsets"},
+			Verbs:     []string{"get", "list", "watch"},
+		},
+		{
+			APIGroups:     []string{""},
+			Resources:     []string{"serviceaccounts"},
+			ResourceNames: []string{ServiceAccountName},
+			Verbs:         []string{"get"},
+		},
+		{
+			APIGroups:     []string{""},
+			Resources:     []string{"serviceaccounts/token"},
+			ResourceNames: []string{ServiceAccountName},
+			Verbs:         []string{"create"},
+		},
+	}
+
+	clusterRole, err := plantClient.RbacV1().ClusterRoles().Get(ClusterRoleName, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		_, err = plantClient.RbacV1().ClusterRoles().Create(&rbacv1.ClusterRole{
+			ObjectMeta: metav1.ObjectMeta{Name: ClusterRoleName},
+			Rules:      rules,
+		})
+		return err
+	}
+	if err != nil {
+		return err
+	}
+
+	if apiequality.Semantic.DeepEqual(clusterRole.Rules, rules) {
+		return nil
+	}
+	clusterRole.Rules = rules
+	_, err = plantClient.RbacV1().ClusterRoles().Update(clusterRole)
+	return err
+}
+
+func ensureClusterRoleBinding(plantClient kubernetesclientset.Interface) error {
+	var (
+		roleRef = rbacv1.RoleRef{
+			APIGroup: rbacv1.GroupName,
+			Kind:     "ClusterRole",
+			Name:     ClusterRoleName,
+		}
+		subjects = []rbacv1.Subject{
+			{
+				Kind:      rbacv1.ServiceAccountKind,
+				Name:      ServiceAccountName,
+				Namespace: ServiceAccountNamespace,
+			},
+		}
+	)
+
+	clusterRoleBinding, err := plantClient.RbacV1().ClusterRoleBindings().Get(ClusterRoleName, metav1.GetOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+
+	if err == nil {
+		if clusterRoleBinding.RoleRef == roleRef {
+			if apiequality.Semantic.DeepEqual(clusterRoleBinding.Subjects, subjects) {
+				return nil
+			}
+			clusterRoleBinding.Subjects = subjects
+			_, err = plantClient.RbacV1().ClusterRoleBindings().Update(clusterRoleBinding)
+			return err
+		}
+
+		// The role reference of a cluster role binding is immutable, hence, it has to be recreated.
+		if err := plantClient.RbacV1().ClusterRoleBindings().Delete(ClusterRoleName, &metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+	}
+
+	_, err = plantClient.RbacV1().ClusterRoleBindings().Create(&rbacv1.ClusterRoleBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: ClusterRoleName},
+		RoleRef:    roleRef,
+		Subjects:   subjects,
+	})
+	return err
+}
+
+const kubeconfigTemplate = `---
+apiVersion: v1
+kind: Config
+current-context: {{ .ContextName }}
+clusters:
+- name: {{ .ContextName }}
+  cluster:
+{{- if .CACertificate }}
+    certificate-authority-data: {{ .CACertificate }}
+{{- end }}
+    server: {{ .Server }}
+contexts:
+- name: {{ .ContextName }}
+  context:
+    cluster: {{ .ContextName }}
+    user: {{ .ContextName }}
+users:
+- name: {{ .ContextName }}
+  user:
+    token: {{ .Token }}
+`
@@ -15,11 +15,13 @@ package plant_test
 
 import (
 	"context"
+	"encoding/base64"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
 	"testing"
+	"time"
 
 	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
 	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
@@ -29,17 +31,26 @@ import (
 	mockrest "github.com/gardener/gardener/pkg/mock/client-go/rest"
 	mockclient "github.com/gardener/gardener/pkg/mock/controller-runtime/client"
 	mockio "github.com/gardener/gardener/pkg/mock/go/io"
+	"github.com/gardener/gardener/pkg/utils"
+	"github.com/gardener/gardener/pkg/utils/secrets"
 
 	"github.com/golang/mock/gomock"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
 	"github.com/onsi/gomega/types"
+	appsv1 "k8s.io/api/apps/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
 	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/version"
+	fakeclientset "k8s.io/client-go/kubernetes/fake"
 	"k8s.io/client-go/rest"
+	k8stesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/clientcmd"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -60,6 +71,25 @@ func makeNodeWithProvider(provider string, withLabels map[string]string) corev1.
 	}
 }
 
+func makeKubeconfig(user string) []byte {
+	return []byte(`apiVersion: v1
+kind: Config
+current-context: plant
+clusters:
+- name: plant
+  cluster:
+    server: https://plant.example.com
+contexts:
+- name: plant
+  context:
+    cluster: plant
+    user: plant
+users:
+- name: plant
+  user:
+` + user)
+}
+
 func hasConditonTrue(cond gardencorev1alpha1.Condition) bool {
 	return cond.Status == gardencorev1alpha1.ConditionTrue
 }
@@ -110,6 +140,155 @@ var _ = Describe("Plant", func() {
 			Entry("It should return the provider successfully",
 				makeNodeWithProvider("aws://zones.something", map[string]string{labelZoneRegion: region}), BeNil(), &plant.StatusCloudInfo{CloudType: "aws", K8sVersion: k8sVersion, Region: region}),
 		)
+
+		DescribeTable("#ComputeNodeVersionSkew", func(controlPlaneVersion string, nodeVersions []string, errMatcher types.GomegaMatcher, expectedSkew int32) {
+			skew, err := plant.ComputeNodeVersionSkew(controlPlaneVersion, nodeVersions)
+			Expect(err).To(errMatcher)
+			Expect(skew).To(Equal(expectedSkew))
+		},
+			Entry("no nodes", "v1.15.3", nil, BeNil(), int32(0)),
+			Entry("same minor versions", "v1.15.3", []string{"v1.15.1", "v1.15.3"}, BeNil(), int32(0)),
+			Entry("outdated kubelets", "v1.15.3", []string{"v1.13.5", "v1.14.2"}, BeNil(), int32(2)),
+			Entry("newer kubelets", "v1.14.3", []string{"v1.15.0"}, BeNil(), int32(0)),
+			Entry("provider specific versions", "v1.15.3-gke.1", []string{"v1.14.7-gke.10"}, BeNil(), int32(1)),
+			Entry("invalid kubelet version", "v1.15.3", []string{"foo"}, HaveOccurred(), int32(0)),
+			Entry("different major version", "v1.15.3", []string{"v2.15.0"}, HaveOccurred(), int32(0)),
+		)
+
+		Describe("#GetKubeconfigInfo", func() {
+			It("should return the expiration time of the client certificate", func() {
+				validity := time.Hour
+				certificate, err := (&secrets.CertificateSecretConfig{
+					Name:       "plant",
+					CommonName: "plant",
+					CertType:   secrets.CACert,
+					Validity:   &validity,
+				}).GenerateCertificate()
+				Expect(err).NotTo(HaveOccurred())
+
+				info, err := plant.GetKubeconfigInfo(makeKubeconfig(fmt.Sprintf(`    client-certificate-data: %s
+    client-key-data: %s
+`, utils.EncodeBase64(certificate.CertificatePEM), utils.EncodeBase64(certificate.PrivateKeyPEM))))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(info.CertificateExpirationTime.Time).To(BeTemporally("~", certificate.Certificate.NotAfter, time.Second))
+				Expect(info.TokenExpirationTime).To(BeNil())
+			})
+
+			It("should return the expiration time of the bearer token", func() {
+				token := "eyJhbGciOiJSUzI1NiJ9." + base64.RawURLEncoding.EncodeToString([]byte(`{"exp":1577836800}`)) + ".c2lnbmF0dXJl"
+
+				info, err := plant.GetKubeconfigInfo(makeKubeconfig(fmt.Sprintf("    token: %s\n", token)))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(info.CertificateExpirationTime).To(BeNil())
+				Expect(info.TokenExpirationTime.Time.Equal(time.Unix(1577836800, 0))).To(BeTrue())
+			})
+
+			It("should return nil if the kubeconfig credentials do not expire", func() {
+				info, err := plant.GetKubeconfigInfo(makeKubeconfig("    token: foo\n"))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(info).To(BeNil())
+			})
+
+			It("should fail if the client certificate cannot be decoded", func() {
+				_, err := plant.GetKubeconfigInfo(makeKubeconfig(fmt.Sprintf("    client-certificate-data: %s\n", utils.EncodeBase64([]byte("foo")))))
+				Expect(err).To(HaveOccurred())
+			})
+		})
+
+		Describe("#CheckKubeconfigExpiration", func() {
+			var (
+				now       = time.Date(2019, 12, 1, 0, 0, 0, 0, time.UTC)
+				threshold = 7 * 24 * time.Hour
+				condition = gardencorev1alpha1helper.InitCondition(gardencorev1alpha1.PlantKubeconfigValid)
+				infoFor   = func(expirationTime time.Time) *gardencorev1alpha1.KubeconfigInfo {
+					return &gardencorev1alpha1.KubeconfigInfo{
+						CertificateExpirationTime: &metav1.Time{Time: expirationTime.Add(time.Hour)},
+						TokenExpirationTime:       &metav1.Time{Time: expirationTime},
+					}
+				}
+			)
+
+			DescribeTable("should compute the condition", func(info *gardencorev1alpha1.KubeconfigInfo, status gardencorev1alpha1.ConditionStatus, reason string) {
+				updatedCondition := plant.CheckKubeconfigExpiration(condition, info, threshold, now)
+				Expect(updatedCondition.Status).To(Equal(status))
+				Expect(updatedCondition.Reason).To(Equal(reason))
+			},
+				Entry("no expiration", nil, gardencorev1alpha1.ConditionTrue, "KubeconfigValid"),
+				Entry("valid", infoFor(now.Add(30*24*time.Hour)), gardencorev1alpha1.ConditionTrue, "KubeconfigValid"),
+				Entry("expiring soon", infoFor(now.Add(24*time.Hour)), gardencorev1alpha1.ConditionFalse, "KubeconfigExpiresSoon"),
+				Entry("expired", infoFor(now.Add(-time.Minute)), gardencorev1alpha1.ConditionFalse, "KubeconfigExpired"),
+			)
+		})
+
+		Describe("#RenewKubeconfig", func() {
+			It("should create the service account and return a kubeconfig with a fresh token", func() {
+				var (
+					plantClient       = fakeclientset.NewSimpleClientset()
+					expirationSeconds int64
+				)
+
+				plantClient.PrependReactor("create", "serviceaccounts", func(action k8stesting.Action) (bool, runtime.Object, error) {
+					if action.GetSubresource() != "token" {
+						return false, nil, nil
+					}
+					tokenRequest := action.(k8stesting.CreateAction).GetObject().(*authenticationv1.TokenRequest)
+					expirationSeconds = *tokenRequest.Spec.ExpirationSeconds
+					tokenRequest.Status.Token = "new-token"
+					return true, tokenRequest, nil
+				})
+
+				kubeconfig, err := plant.RenewKubeconfig(plantClient, makeKubeconfig("    token: old-token\n"), time.Hour)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(expirationSeconds).To(Equal(int64(3600)))
+
+				config, err := clientcmd.Load(kubeconfig)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(config.Clusters[config.CurrentContext].Server).To(Equal("https://plant.example.com"))
+				Expect(config.AuthInfos[config.CurrentContext].Token).To(Equal("new-token"))
+
+				_, err = plantClient.CoreV1().ServiceAccounts(plant.ServiceAccountNamespace).Get(plant.ServiceAccountName, metav1.GetOptions{})
+				Expect(err).NotTo(HaveOccurred())
+				_, err = plantClient.RbacV1().ClusterRoleBindings().Get(plant.ClusterRoleName, metav1.GetOptions{})
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should reconcile the permissions of an existing service account", func() {
+				var (
+					existingClusterRole        = &rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: plant.ClusterRoleName}}
+					existingClusterRoleBinding = &rbacv1.ClusterRoleBinding{
+						ObjectMeta: metav1.ObjectMeta{Name: plant.ClusterRoleName},
+						RoleRef:    rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: "ClusterRole", Name: "foo"},
+					}
+					existingServiceAccount = &corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Name: plant.ServiceAccountName, Namespace: plant.ServiceAccountNamespace}}
+					plantClient            = fakeclientset.NewSimpleClientset(existingClusterRole, existingClusterRoleBinding, existingServiceAccount)
+				)
+
+				plantClient.PrependReactor("create", "serviceaccounts", func(action k8stesting.Action) (bool, runtime.Object, error) {
+					if action.GetSubresource() != "token" {
+						return false, nil, nil
+					}
+					tokenRequest := action.(k8stesting.CreateAction).GetObject().(*authenticationv1.TokenRequest)
+					tokenRequest.Status.Token = "new-token"
+					return true, tokenRequest, nil
+				})
+
+				_, err := plant.RenewKubeconfig(plantClient, makeKubeconfig("    token: old-token\n"), time.Hour)
+				Expect(err).NotTo(HaveOccurred())
+
+				clusterRole, err := plantClient.RbacV1().ClusterRoles().Get(plant.ClusterRoleName, metav1.GetOptions{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(clusterRole.Rules).NotTo(BeEmpty())
+
+				clusterRoleBinding, err := plantClient.RbacV1().ClusterRoleBindings().Get(plant.ClusterRoleName, metav1.GetOptions{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(clusterRoleBinding.RoleRef.Name).To(Equal(plant.ClusterRoleName))
+				Expect(clusterRoleBinding.Subjects).To(ConsistOf(rbacv1.Subject{
+					Kind:      rbacv1.ServiceAccountKind,
+					Name:      plant.ServiceAccountName,
+					Namespace: plant.ServiceAccountNamespace,
+				}))
+			})
+		})
 	})
 	Context("HealthChecker", func() {
 		var (
@@ -192,5 +371,91 @@ var _ = Describe("Plant", func() {
 			},
 			Entry("no healthy cluster nodes", BeTrue()),
 		)
+
+		Describe("#CollectNodesInfo", func() {
+			It("should sum up the capacity and collect the kubelet versions", func() {
+				var (
+					runtimeClient = mockclient.NewMockClient(ctrl)
+					healthChecker = plant.NewHealthChecker(runtimeClient, discoveryMockclient)
+					makeNode      = func(cpu, kubeletVersion string) corev1.Node {
+						return corev1.Node{
+							Status: corev1.NodeStatus{
+								Capacity: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse(cpu)},
+								NodeInfo: corev1.NodeSystemInfo{KubeletVersion: kubeletVersion},
+							},
+						}
+					}
+				)
+
+				runtimeClient.EXPECT().List(context.TODO(), gomock.AssignableToTypeOf(&corev1.NodeList{})).DoAndReturn(func(ctx context.Context, list runtime.Object, opts ...client.ListOption) error {
+					list.(*corev1.NodeList).Items = []corev1.Node{makeNode("2", "v1.15.1"), makeNode("4", "v1.14.2"), makeNode("2", "v1.15.1")}
+					return nil
+				})
+
+				nodesInfo, nodeVersions, err := healthChecker.CollectNodesInfo(context.TODO())
+				Expect(err).NotTo(HaveOccurred())
+				Expect(nodesInfo.Count).To(Equal(int32(3)))
+				Expect(nodesInfo.Capacity.Cpu().Cmp(resource.MustParse("8"))).To(Equal(0))
+				Expect(nodeVersions).To(Equal([]string{"v1.14.2", "v1.15.1"}))
+			})
+
+			It("should reuse the nodes listed for the health check", func() {
+				var (
+					runtimeClient           = mockclient.NewMockClient(ctrl)
+					healthChecker           = plant.NewHealthChecker(runtimeClient, discoveryMockclient)
+					conditionEveryNodeReady = gardencorev1alpha1helper.InitCondition(gardencorev1alpha1.PlantEveryNodeReady)
+				)
+
+				runtimeClient.EXPECT().List(context.TODO(), gomock.AssignableToTypeOf(&corev1.NodeList{})).DoAndReturn(func(ctx context.Context, list runtime.Object, opts ...client.ListOption) error {
+					list.(*corev1.NodeList).Items = []corev1.Node{{
+						Status: corev1.NodeStatus{Conditions: []corev1.NodeCondition{{Type: corev1.NodeReady, Status: corev1.ConditionTrue}}},
+					}}
+					return nil
+				})
+
+				Expect(hasConditonTrue(healthChecker.CheckPlantClusterNodes(context.TODO(), conditionEveryNodeReady))).To(BeTrue())
+				nodesInfo, _, err := healthChecker.CollectNodesInfo(context.TODO())
+				Expect(err).NotTo(HaveOccurred())
+				Expect(nodesInfo.Count).To(Equal(int32(1)))
+			})
+		})
+
+		Describe("#CollectWorkloadsInfo", func() {
+			It("should count the total and healthy deployments and statefulsets", func() {
+				var (
+					runtimeClient = mockclient.NewMockClient(ctrl)
+					healthChecker = plant.NewHealthChecker(runtimeClient, discoveryMockclient)
+					replicas      = int32(1)
+				)
+
+				runtimeClient.EXPECT().List(context.TODO(), gomock.AssignableToTypeOf(&appsv1.DeploymentList{})).DoAndReturn(func(ctx context.Context, list runtime.Object, opts ...client.ListOption) error {
+					list.(*appsv1.DeploymentList).Items = []appsv1.Deployment{
+						{
+							Status: appsv1.DeploymentStatus{Conditions: []appsv1.DeploymentCondition{
+								{Type: appsv1.DeploymentAvailable, Status: corev1.ConditionTrue},
+							}},
+						},
+						{},
+					}
+					return nil
+				})
+				runtimeClient.EXPECT().List(context.TODO(), gomock.AssignableToTypeOf(&appsv1.StatefulSetList{})).DoAndReturn(func(ctx context.Context, list runtime.Object, opts ...client.ListOption) error {
+					list.(*appsv1.StatefulSetList).Items = []appsv1.StatefulSet{
+						{
+							Spec:   appsv1.StatefulSetSpec{Replicas: &replicas},
+							Status: appsv1.StatefulSetStatus{ReadyReplicas: 0},
+						},
+					}
+					return nil
+				})
+
+				workloadsInfo, err := healthChecker.CollectWorkloadsInfo(context.TODO())
+				Expect(err).NotTo(HaveOccurred())
+				Expect(workloadsInfo).To(Equal(&gardencorev1alpha1.WorkloadsInfo{
+					Deployments:  gardencorev1alpha1.WorkloadHealthInfo{Total: 2, Healthy: 1},
+					StatefulSets: gardencorev1alpha1.WorkloadHealthInfo{Total: 1, Healthy: 0},
+				}))
+			})
+		})
 	})
 })
@@ -15,6 +15,7 @@
 package plant
 
 import (
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
 	gardencorelisters "github.com/gardener/gardener/pkg/client/core/listers/core/v1alpha1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
@@ -37,3 +38,12 @@ type StatusCloudInfo struct {
 	Region     string
 	K8sVersion string
 }
+
+// StatusInventory contains the inventory of the plant cluster for the plant status
+type StatusInventory struct {
+	Nodes           *gardencorev1alpha1.NodesInfo
+	NodeVersions    []string
+	NodeVersionSkew *int32
+	Workloads       *gardencorev1alpha1.WorkloadsInfo
+	Kubeconfig      *gardencorev1alpha1.KubeconfigInfo
+}
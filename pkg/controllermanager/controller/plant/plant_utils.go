@@ -16,11 +16,13 @@ package plant
 
 import (
 	"context"
+	"fmt"
 	"strings"
 
 	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
 	utilclient "github.com/gardener/gardener/pkg/utils/kubernetes/client"
 
+	"github.com/Masterminds/semver"
 	"github.com/sirupsen/logrus"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/client-go/discovery"
@@ -92,6 +94,31 @@ func getRegionNameForNode(node corev1.Node) string {
 	return Unknown
 }
 
+// ComputeNodeVersionSkew computes the maximum number of minor versions the given kubelet versions are behind the given
+// control plane version. Kubelets which are newer than the control plane do not contribute to the skew.
+func ComputeNodeVersionSkew(controlPlaneVersion string, nodeVersions []string) (int32, error) {
+	controlPlane, err := semver.NewVersion(controlPlaneVersion)
+	if err != nil {
+		return 0, fmt.Errorf("invalid control plane version %q: %v", controlPlaneVersion, err)
+	}
+
+	var skew int32
+	for _, nodeVersion := range nodeVersions {
+		node, err := semver.NewVersion(nodeVersion)
+		if err != nil {
+			return 0, fmt.Errorf("invalid kubelet version %q: %v", nodeVersion, err)
+		}
+		if node.Major() != controlPlane.Major() {
+			return 0, fmt.Errorf("kubelet version %q has a different major version than the control plane version %q", nodeVersion, controlPlaneVersion)
+		}
+		if nodeSkew := int32(controlPlane.Minor() - node.Minor()); nodeSkew > skew {
+			skew = nodeSkew
+		}
+	}
+
+	return skew, nil
+}
+
 func isPlantSecret(plant *gardencorev1alpha1.Plant, secretKey client.ObjectKey) bool {
 	return plant.Spec.SecretRef.Name == secretKey.Name && plant.Namespace == secretKey.Namespace
 }
@@ -21,6 +21,7 @@ import (
 	"time"
 
 	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	v1alpha1constants "github.com/gardener/gardener/pkg/apis/core/v1alpha1/constants"
 	"github.com/gardener/gardener/pkg/chartrenderer"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/operation/common"
@@ -35,7 +36,10 @@ import (
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/util/retry"
 )
 
@@ -119,27 +123,31 @@ func (c *defaultControl) reconcile(project *gardencorev1alpha1.Project, projectL
 	// Create RBAC rules to allow project owner and project members to read, update, and delete the project.
 	// We also create a RoleBinding in the namespace that binds all members to the garden.sapcloud.io:system:project-member
 	// role to ensure access for listing shoots, creating secrets, etc.
+	// Members having extension roles are bound to ClusterRoles which aggregate the rules of all ClusterRoles labeled
+	// with the respective extension role name.
 	var (
-		admins  []rbacv1.Subject
-		viewers []rbacv1.Subject
+		admins, viewers, uams, extensionRoles = computeProjectRoleSubjects(project.Spec.Members)
+		extensionRoleNames                    = sets.NewString()
+		extensionRoleValues                   []interface{}
 	)
 
-	for _, member := range project.Spec.Members {
-		if member.Role == gardencorev1alpha1.ProjectMemberAdmin {
-			admins = append(admins, member.Subject)
-		}
-		if member.Role == gardencorev1alpha1.ProjectMemberViewer {
-			viewers = append(viewers, member.Subject)
-		}
+	for _, name := range sortedKeys(extensionRoles) {
+		extensionRoleNames.Insert(name)
+		extensionRoleValues = append(extensionRoleValues, map[string]interface{}{
+			"name":     name,
+			"subjects": extensionRoles[name],
+		})
 	}
 
 	if err := chartApplier.ApplyChart(ctx, filepath.Join(common.ChartPath, "garden-project", "charts", "project-rbac"), namespace.Name, "project-rbac", map[string]interface{}{
 		"project": map[string]interface{}{
-			"name":    project.Name,
-			"uid":     project.UID,
-			"owner":   project.Spec.Owner,
-			"members": admins,
-			"viewers": viewers,
+			"name":           project.Name,
+			"uid":            project.UID,
+			"owner":          project.Spec.Owner,
+			"members":        admins,
+			"viewers":        viewers,
+			"uams":           uams,
+			"extensionRoles": extensionRoleValues,
 		},
 	}, nil); err != nil {
 		c.reportEvent(project, true, gardencorev1alpha1.ProjectEventNamespaceReconcileFailed, "Error while creating RBAC rules for namespace %q: %+v", namespace.Name, err)
@@ -147,6 +155,12 @@ func (c *defaultControl) reconcile(project *gardencorev1alpha1.Project, projectL
 		return err
 	}
 
+	if err := c.deleteStaleExtensionRoles(ctx, project, namespace.Name, extensionRoleNames); err != nil {
+		c.reportEvent(project, true, gardencorev1alpha1.ProjectEventNamespaceReconcileFailed, "Error while cleaning up stale extension roles for namespace %q: %+v", namespace.Name, err)
+		c.updateProjectStatus(project.ObjectMeta, setProjectPhase(gardencorev1alpha1.ProjectFailed))
+		return err
+	}
+
 	// Delete legacy resources
 	// TODO: This can be removed in a future version of Gardener.
 	for _, obj := range []runtime.Object{
@@ -234,3 +248,41 @@ func (c *defaultControl) reconcileNamespaceForProject(project *gardencorev1alpha
 
 	return namespace, nil
 }
+
+// deleteStaleExtensionRoles deletes the ClusterRoles and RoleBindings of extension roles which are no longer held by
+// any member of the given project.
+func (c *defaultControl) deleteStaleExtensionRoles(ctx context.Context, project *gardencorev1alpha1.Project, namespace string, extensionRoleNames sets.String) error {
+	requirement, err := labels.NewRequirement(v1alpha1constants.LabelExtensionProjectRole, selection.Exists, nil)
+	if err != nil {
+		return err
+	}
+	selector := client.MatchingLabelsSelector{Selector: labels.SelectorFromSet(labels.Set{common.ProjectName: project.Name}).Add(*requirement)}
+
+	clusterRoleList := &rbacv1.ClusterRoleList{}
+	if err := c.k8sGardenClient.Client().List(ctx, clusterRoleList, selector); err != nil {
+		return err
+	}
+	for _, clusterRole := range clusterRoleList.Items {
+		if extensionRoleNames.Has(clusterRole.Labels[v1alpha1constants.LabelExtensionProjectRole]) {
+			continue
+		}
+		if err := c.k8sGardenClient.Client().Delete(ctx, clusterRole.DeepCopy()); client.IgnoreNotFound(err) != nil {
+			return err
+		}
+	}
+
+	roleBindingList := &rbacv1.RoleBindingList{}
+	if err := c.k8sGardenClient.Client().List(ctx, roleBindingList, client.InNamespace(namespace), selector); err != nil {
+		return err
+	}
+	for _, roleBinding := range roleBindingList.Items {
+		if extensionRoleNames.Has(roleBinding.Labels[v1alpha1constants.LabelExtensionProjectRole]) {
+			continue
+		}
+		if err := c.k8sGardenClient.Client().Delete(ctx, roleBinding.DeepCopy()); client.IgnoreNotFound(err) != nil {
+			return err
+		}
+	}
+
+	return nil
+}
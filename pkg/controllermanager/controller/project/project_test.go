@@ -0,0 +1,126 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package project
+
+import (
+	"context"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	v1alpha1constants "github.com/gardener/gardener/pkg/apis/core/v1alpha1/constants"
+	mockkubernetes "github.com/gardener/gardener/pkg/mock/gardener/kubernetes"
+	"github.com/gardener/gardener/pkg/operation/common"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("Project", func() {
+	var (
+		alice = rbacv1.Subject{APIGroup: rbacv1.GroupName, Kind: rbacv1.UserKind, Name: "alice"}
+		bob   = rbacv1.Subject{APIGroup: rbacv1.GroupName, Kind: rbacv1.UserKind, Name: "bob"}
+	)
+
+	Describe("#computeProjectRoleSubjects", func() {
+		It("should consider the primary and the additional roles of all members", func() {
+			admins, viewers, uams, extensionRoles := computeProjectRoleSubjects([]gardencorev1alpha1.ProjectMember{
+				{Subject: alice, Role: gardencorev1alpha1.ProjectMemberAdmin, Roles: []string{"extension:foo"}},
+				{Subject: bob, Role: gardencorev1alpha1.ProjectMemberViewer, Roles: []string{gardencorev1alpha1.ProjectMemberUserAccessManager, "extension:foo", "extension:bar"}},
+			})
+
+			Expect(admins).To(ConsistOf(alice))
+			Expect(viewers).To(ConsistOf(bob))
+			Expect(uams).To(ConsistOf(bob))
+			Expect(extensionRoles).To(Equal(map[string][]rbacv1.Subject{
+				"foo": {alice, bob},
+				"bar": {bob},
+			}))
+		})
+
+		It("should ignore unknown roles", func() {
+			admins, viewers, uams, extensionRoles := computeProjectRoleSubjects([]gardencorev1alpha1.ProjectMember{
+				{Subject: alice, Role: "foo"},
+			})
+
+			Expect(admins).To(BeEmpty())
+			Expect(viewers).To(BeEmpty())
+			Expect(uams).To(BeEmpty())
+			Expect(extensionRoles).To(BeEmpty())
+		})
+	})
+
+	Describe("#deleteStaleExtensionRoles", func() {
+		var (
+			ctx       = context.TODO()
+			ctrl      *gomock.Controller
+			c         client.Client
+			control   *defaultControl
+			namespace = "garden-dev"
+			project   = &gardencorev1alpha1.Project{ObjectMeta: metav1.ObjectMeta{Name: "dev"}}
+
+			extensionRoleLabels = func(projectName, name string) map[string]string {
+				return map[string]string{
+					common.ProjectName:                          projectName,
+					v1alpha1constants.LabelExtensionProjectRole: name,
+				}
+			}
+		)
+
+		BeforeEach(func() {
+			ctrl = gomock.NewController(GinkgoT())
+
+			c = fake.NewFakeClient(
+				&rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: "gardener.cloud:extension:project:dev:foo", Labels: extensionRoleLabels("dev", "foo")}},
+				&rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: "gardener.cloud:extension:project:dev:bar", Labels: extensionRoleLabels("dev", "bar")}},
+				&rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: "gardener.cloud:extension:project:prod:bar", Labels: extensionRoleLabels("prod", "bar")}},
+				&rbacv1.RoleBinding{ObjectMeta: metav1.ObjectMeta{Name: "gardener.cloud:extension:project:dev:foo", Namespace: namespace, Labels: extensionRoleLabels("dev", "foo")}},
+				&rbacv1.RoleBinding{ObjectMeta: metav1.ObjectMeta{Name: "gardener.cloud:extension:project:dev:bar", Namespace: namespace, Labels: extensionRoleLabels("dev", "bar")}},
+			)
+
+			k8sGardenClient := mockkubernetes.NewMockInterface(ctrl)
+			k8sGardenClient.EXPECT().Client().Return(c).AnyTimes()
+
+			control = &defaultControl{k8sGardenClient: k8sGardenClient}
+		})
+
+		AfterEach(func() {
+			ctrl.Finish()
+		})
+
+		It("should delete the roles of extension roles which are no longer held by any member", func() {
+			Expect(control.deleteStaleExtensionRoles(ctx, project, namespace, sets.NewString("foo"))).To(Succeed())
+
+			Expect(c.Get(ctx, client.ObjectKey{Name: "gardener.cloud:extension:project:dev:foo"}, &rbacv1.ClusterRole{})).To(Succeed())
+			Expect(c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: "gardener.cloud:extension:project:dev:foo"}, &rbacv1.RoleBinding{})).To(Succeed())
+
+			err := c.Get(ctx, client.ObjectKey{Name: "gardener.cloud:extension:project:dev:bar"}, &rbacv1.ClusterRole{})
+			Expect(apierrors.IsNotFound(err)).To(BeTrue())
+			err = c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: "gardener.cloud:extension:project:dev:bar"}, &rbacv1.RoleBinding{})
+			Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		})
+
+		It("should not delete the extension roles of other projects", func() {
+			Expect(control.deleteStaleExtensionRoles(ctx, project, namespace, sets.NewString())).To(Succeed())
+
+			Expect(c.Get(ctx, client.ObjectKey{Name: "gardener.cloud:extension:project:prod:bar"}, &rbacv1.ClusterRole{})).To(Succeed())
+		})
+	})
+})
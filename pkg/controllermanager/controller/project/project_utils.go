@@ -15,9 +15,14 @@
 package project
 
 import (
+	"sort"
+	"strings"
+
 	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
 	v1alpha1constants "github.com/gardener/gardener/pkg/apis/core/v1alpha1/constants"
 	"github.com/gardener/gardener/pkg/operation/common"
+
+	rbacv1 "k8s.io/api/rbac/v1"
 )
 
 func setProjectPhase(phase gardencorev1alpha1.ProjectPhase) func(*gardencorev1alpha1.Project) (*gardencorev1alpha1.Project, error) {
@@ -39,3 +44,37 @@ func namespaceAnnotationsFromProject(project *gardencorev1alpha1.Project) map[st
 		common.NamespaceProject: string(project.UID),
 	}
 }
+
+// computeProjectRoleSubjects computes the subjects holding the admin, viewer, and user access manager roles as well
+// as the subjects holding each extension role (keyed by the extension role name without its prefix). Both the
+// primary role and the additional roles of every member are considered.
+func computeProjectRoleSubjects(members []gardencorev1alpha1.ProjectMember) (admins, viewers, uams []rbacv1.Subject, extensionRoles map[string][]rbacv1.Subject) {
+	extensionRoles = make(map[string][]rbacv1.Subject)
+
+	for _, member := range members {
+		for _, role := range append([]string{member.Role}, member.Roles...) {
+			switch {
+			case role == gardencorev1alpha1.ProjectMemberAdmin:
+				admins = append(admins, member.Subject)
+			case role == gardencorev1alpha1.ProjectMemberViewer:
+				viewers = append(viewers, member.Subject)
+			case role == gardencorev1alpha1.ProjectMemberUserAccessManager:
+				uams = append(uams, member.Subject)
+			case strings.HasPrefix(role, gardencorev1alpha1.ProjectMemberExtensionPrefix):
+				name := strings.TrimPrefix(role, gardencorev1alpha1.ProjectMemberExtensionPrefix)
+				extensionRoles[name] = append(extensionRoles[name], member.Subject)
+			}
+		}
+	}
+
+	return
+}
+
+func sortedKeys(m map[string][]rbacv1.Subject) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
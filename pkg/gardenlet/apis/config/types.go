@@ -171,6 +171,60 @@ type ShootControllerConfiguration struct {
 	RetrySyncPeriod *metav1.Duration
 	// SyncPeriod is the duration how often the existing resources are reconciled.
 	SyncPeriod *metav1.Duration
+	// CertificateAuthorityRotation defines when the certificate authorities of Shoot clusters are rotated
+	// automatically.
+	CertificateAuthorityRotation *CertificateAuthorityRotationConfiguration
+	// SecretStore defines the backend in which the certificate authorities of Shoot clusters are kept. If not set,
+	// they are kept in the CA secrets in the Shoot namespaces in the Seed cluster.
+	SecretStore *SecretStoreConfiguration
+}
+
+// SecretStoreType is a type for the backends in which the certificate authorities of Shoot clusters are kept.
+type SecretStoreType string
+
+const (
+	// SecretStoreTypeKubernetes keeps the certificate authorities in dedicated secrets in the Shoot namespaces in the
+	// Seed cluster, separate from the CA secrets which only contain the public CA certificates.
+	SecretStoreTypeKubernetes SecretStoreType = "kubernetes"
+	// SecretStoreTypeVault keeps the certificate authorities in a Vault-compatible server.
+	SecretStoreTypeVault SecretStoreType = "vault"
+)
+
+// SecretStoreConfiguration defines the backend in which the certificate authorities of Shoot clusters are kept.
+type SecretStoreConfiguration struct {
+	// Type is the type of the backend.
+	Type SecretStoreType
+	// Vault contains the configuration of the Vault-compatible server if the type is 'vault'.
+	Vault *VaultSecretStoreConfiguration
+}
+
+// VaultSecretStoreConfiguration contains the configuration of a Vault-compatible server in which the certificate
+// authorities of Shoot clusters are kept.
+type VaultSecretStoreConfiguration struct {
+	// Address is the URL of the server, e.g. https://vault.example.com:8200.
+	Address string
+	// TokenFile is the path to the file containing the token used to authenticate against the server. It is read for
+	// every request so that the token can be renewed without restarting the Gardenlet.
+	TokenFile string
+	// MountPath is the path at which the key/value secrets engine (version 2) is mounted.
+	MountPath *string
+	// PathPrefix is prepended to the '<namespace>/<name>' path of every certificate authority.
+	PathPrefix *string
+}
+
+// CertificateAuthorityRotationConfiguration defines when the certificate authorities of Shoot clusters are rotated
+// automatically.
+type CertificateAuthorityRotationConfiguration struct {
+	// ExpirationThreshold is the duration before the expiration of a certificate authority after which its rotation
+	// is started automatically.
+	ExpirationThreshold *metav1.Duration
+	// MaxAge is the age of a certificate authority after which its rotation is started automatically, e.g. 8760h
+	// for a yearly rotation. If not set, certificate authorities are not rotated because of their age.
+	MaxAge *metav1.Duration
+	// CompletionDelay is the duration after the initiation of a certificate authority rotation after which a prepared
+	// rotation is completed automatically. If not set, prepared rotations are only completed when requested by the
+	// `rotate-ca-complete` operation annotation.
+	CompletionDelay *metav1.Duration
 }
 
 // ShootCareControllerConfiguration defines the configuration of the ShootCare
@@ -185,6 +239,23 @@ type ShootCareControllerConfiguration struct {
 	SyncPeriod *metav1.Duration
 	// ConditionThresholds defines the condition threshold per condition type.
 	ConditionThresholds []ConditionThreshold
+	// FlappingDetection defines how status transitions of the Shoot conditions are tracked in order to detect
+	// flapping conditions.
+	FlappingDetection *FlappingDetectionConfiguration
+	// CertificateExpirationThreshold is the duration before the expiration of a certificate generated for a Shoot
+	// after which the certificate is considered to expire soon and the Shoot is no longer reported as healthy.
+	CertificateExpirationThreshold *metav1.Duration
+}
+
+// FlappingDetectionConfiguration defines how flapping Shoot conditions are detected.
+type FlappingDetectionConfiguration struct {
+	// Window is the duration in which the status transitions of a condition are counted.
+	Window *metav1.Duration
+	// Threshold is the number of status transitions within the window after which a condition is considered
+	// to be flapping.
+	Threshold *int
+	// MaxTransitions is the maximum number of status transitions which are kept per condition.
+	MaxTransitions *int
 }
 
 // ConditionThreshold defines the duration how long a flappy condition stays in progressing state.
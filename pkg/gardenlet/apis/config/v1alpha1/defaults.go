@@ -247,6 +247,19 @@ func SetDefaults_ShootControllerConfiguration(obj *ShootControllerConfiguration)
 		v := metav1.Duration{Duration: 15 * time.Second}
 		obj.RetrySyncPeriod = &v
 	}
+
+	if obj.CertificateAuthorityRotation == nil {
+		obj.CertificateAuthorityRotation = &CertificateAuthorityRotationConfiguration{}
+	}
+}
+
+// SetDefaults_CertificateAuthorityRotationConfiguration sets defaults for the automatic rotation of the certificate
+// authorities of Shoot clusters.
+func SetDefaults_CertificateAuthorityRotationConfiguration(obj *CertificateAuthorityRotationConfiguration) {
+	if obj.ExpirationThreshold == nil {
+		v := metav1.Duration{Duration: 30 * 24 * time.Hour}
+		obj.ExpirationThreshold = &v
+	}
 }
 
 // SetDefaults_ShootCareControllerConfiguration sets defaults for the shoot care controller.
@@ -260,4 +273,31 @@ func SetDefaults_ShootCareControllerConfiguration(obj *ShootCareControllerConfig
 		v := metav1.Duration{Duration: time.Minute}
 		obj.SyncPeriod = &v
 	}
+
+	if obj.FlappingDetection == nil {
+		obj.FlappingDetection = &FlappingDetectionConfiguration{}
+	}
+
+	if obj.CertificateExpirationThreshold == nil {
+		v := metav1.Duration{Duration: 30 * 24 * time.Hour}
+		obj.CertificateExpirationThreshold = &v
+	}
+}
+
+// SetDefaults_FlappingDetectionConfiguration sets defaults for the flapping detection of the shoot care controller.
+func SetDefaults_FlappingDetectionConfiguration(obj *FlappingDetectionConfiguration) {
+	if obj.Window == nil {
+		v := metav1.Duration{Duration: time.Hour}
+		obj.Window = &v
+	}
+
+	if obj.Threshold == nil {
+		v := 5
+		obj.Threshold = &v
+	}
+
+	if obj.MaxTransitions == nil {
+		v := 20
+		obj.MaxTransitions = &v
+	}
 }
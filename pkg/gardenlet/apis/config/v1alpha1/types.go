@@ -205,6 +205,68 @@ type ShootControllerConfiguration struct {
 	// SyncPeriod is the duration how often the existing resources are reconciled.
 	// +optional
 	SyncPeriod *metav1.Duration `json:"syncPeriod,omitempty"`
+	// CertificateAuthorityRotation defines when the certificate authorities of Shoot clusters are rotated
+	// automatically.
+	// +optional
+	CertificateAuthorityRotation *CertificateAuthorityRotationConfiguration `json:"certificateAuthorityRotation,omitempty"`
+	// SecretStore defines the backend in which the certificate authorities of Shoot clusters are kept. If not set,
+	// they are kept in the CA secrets in the Shoot namespaces in the Seed cluster.
+	// +optional
+	SecretStore *SecretStoreConfiguration `json:"secretStore,omitempty"`
+}
+
+// SecretStoreType is a type for the backends in which the certificate authorities of Shoot clusters are kept.
+type SecretStoreType string
+
+const (
+	// SecretStoreTypeKubernetes keeps the certificate authorities in dedicated secrets in the Shoot namespaces in the
+	// Seed cluster, separate from the CA secrets which only contain the public CA certificates.
+	SecretStoreTypeKubernetes SecretStoreType = "kubernetes"
+	// SecretStoreTypeVault keeps the certificate authorities in a Vault-compatible server.
+	SecretStoreTypeVault SecretStoreType = "vault"
+)
+
+// SecretStoreConfiguration defines the backend in which the certificate authorities of Shoot clusters are kept.
+type SecretStoreConfiguration struct {
+	// Type is the type of the backend, either 'kubernetes' or 'vault'.
+	Type SecretStoreType `json:"type"`
+	// Vault contains the configuration of the Vault-compatible server if the type is 'vault'.
+	// +optional
+	Vault *VaultSecretStoreConfiguration `json:"vault,omitempty"`
+}
+
+// VaultSecretStoreConfiguration contains the configuration of a Vault-compatible server in which the certificate
+// authorities of Shoot clusters are kept.
+type VaultSecretStoreConfiguration struct {
+	// Address is the URL of the server, e.g. https://vault.example.com:8200.
+	Address string `json:"address"`
+	// TokenFile is the path to the file containing the token used to authenticate against the server. It is read for
+	// every request so that the token can be renewed without restarting the Gardenlet.
+	TokenFile string `json:"tokenFile"`
+	// MountPath is the path at which the key/value secrets engine (version 2) is mounted. Defaults to 'secret'.
+	// +optional
+	MountPath *string `json:"mountPath,omitempty"`
+	// PathPrefix is prepended to the '<namespace>/<name>' path of every certificate authority.
+	// +optional
+	PathPrefix *string `json:"pathPrefix,omitempty"`
+}
+
+// CertificateAuthorityRotationConfiguration defines when the certificate authorities of Shoot clusters are rotated
+// automatically.
+type CertificateAuthorityRotationConfiguration struct {
+	// ExpirationThreshold is the duration before the expiration of a certificate authority after which its rotation
+	// is started automatically. Defaults to 720h.
+	// +optional
+	ExpirationThreshold *metav1.Duration `json:"expirationThreshold,omitempty"`
+	// MaxAge is the age of a certificate authority after which its rotation is started automatically, e.g. 8760h
+	// for a yearly rotation. If not set, certificate authorities are not rotated because of their age.
+	// +optional
+	MaxAge *metav1.Duration `json:"maxAge,omitempty"`
+	// CompletionDelay is the duration after the initiation of a certificate authority rotation after which a prepared
+	// rotation is completed automatically. If not set, prepared rotations are only completed when requested by the
+	// `rotate-ca-complete` operation annotation.
+	// +optional
+	CompletionDelay *metav1.Duration `json:"completionDelay,omitempty"`
 }
 
 // ShootCareControllerConfiguration defines the configuration of the ShootCare
@@ -222,6 +284,28 @@ type ShootCareControllerConfiguration struct {
 	// ConditionThresholds defines the condition threshold per condition type.
 	// +optional
 	ConditionThresholds []ConditionThreshold `json:"conditionThresholds,omitempty"`
+	// FlappingDetection defines how status transitions of the Shoot conditions are tracked in order to detect
+	// flapping conditions.
+	// +optional
+	FlappingDetection *FlappingDetectionConfiguration `json:"flappingDetection,omitempty"`
+	// CertificateExpirationThreshold is the duration before the expiration of a certificate generated for a Shoot
+	// after which the certificate is considered to expire soon and the Shoot is no longer reported as healthy.
+	// +optional
+	CertificateExpirationThreshold *metav1.Duration `json:"certificateExpirationThreshold,omitempty"`
+}
+
+// FlappingDetectionConfiguration defines how flapping Shoot conditions are detected.
+type FlappingDetectionConfiguration struct {
+	// Window is the duration in which the status transitions of a condition are counted.
+	// +optional
+	Window *metav1.Duration `json:"window,omitempty"`
+	// Threshold is the number of status transitions within the window after which a condition is considered
+	// to be flapping.
+	// +optional
+	Threshold *int `json:"threshold,omitempty"`
+	// MaxTransitions is the maximum number of status transitions which are kept per condition.
+	// +optional
+	MaxTransitions *int `json:"maxTransitions,omitempty"`
 }
 
 // ConditionThreshold defines the duration how long a flappy condition stays in progressing state.
@@ -59,6 +59,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*CertificateAuthorityRotationConfiguration)(nil), (*config.CertificateAuthorityRotationConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_CertificateAuthorityRotationConfiguration_To_config_CertificateAuthorityRotationConfiguration(a.(*CertificateAuthorityRotationConfiguration), b.(*config.CertificateAuthorityRotationConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.CertificateAuthorityRotationConfiguration)(nil), (*CertificateAuthorityRotationConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_CertificateAuthorityRotationConfiguration_To_v1alpha1_CertificateAuthorityRotationConfiguration(a.(*config.CertificateAuthorityRotationConfiguration), b.(*CertificateAuthorityRotationConfiguration), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ConditionThreshold)(nil), (*config.ConditionThreshold)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ConditionThreshold_To_config_ConditionThreshold(a.(*ConditionThreshold), b.(*config.ConditionThreshold), scope)
 	}); err != nil {
@@ -89,6 +99,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*FlappingDetectionConfiguration)(nil), (*config.FlappingDetectionConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_FlappingDetectionConfiguration_To_config_FlappingDetectionConfiguration(a.(*FlappingDetectionConfiguration), b.(*config.FlappingDetectionConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.FlappingDetectionConfiguration)(nil), (*FlappingDetectionConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_FlappingDetectionConfiguration_To_v1alpha1_FlappingDetectionConfiguration(a.(*config.FlappingDetectionConfiguration), b.(*FlappingDetectionConfiguration), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*GardenClientConnection)(nil), (*config.GardenClientConnection)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_GardenClientConnection_To_config_GardenClientConnection(a.(*GardenClientConnection), b.(*config.GardenClientConnection), scope)
 	}); err != nil {
@@ -129,6 +149,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*SecretStoreConfiguration)(nil), (*config.SecretStoreConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SecretStoreConfiguration_To_config_SecretStoreConfiguration(a.(*SecretStoreConfiguration), b.(*config.SecretStoreConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.SecretStoreConfiguration)(nil), (*SecretStoreConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_SecretStoreConfiguration_To_v1alpha1_SecretStoreConfiguration(a.(*config.SecretStoreConfiguration), b.(*SecretStoreConfiguration), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*SeedClientConnection)(nil), (*config.SeedClientConnection)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_SeedClientConnection_To_config_SeedClientConnection(a.(*SeedClientConnection), b.(*config.SeedClientConnection), scope)
 	}); err != nil {
@@ -189,6 +219,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*VaultSecretStoreConfiguration)(nil), (*config.VaultSecretStoreConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VaultSecretStoreConfiguration_To_config_VaultSecretStoreConfiguration(a.(*VaultSecretStoreConfiguration), b.(*config.VaultSecretStoreConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.VaultSecretStoreConfiguration)(nil), (*VaultSecretStoreConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_VaultSecretStoreConfiguration_To_v1alpha1_VaultSecretStoreConfiguration(a.(*config.VaultSecretStoreConfiguration), b.(*VaultSecretStoreConfiguration), scope)
+	}); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -234,6 +274,30 @@ func Convert_config_BackupEntryControllerConfiguration_To_v1alpha1_BackupEntryCo
 	return autoConvert_config_BackupEntryControllerConfiguration_To_v1alpha1_BackupEntryControllerConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_CertificateAuthorityRotationConfiguration_To_config_CertificateAuthorityRotationConfiguration(in *CertificateAuthorityRotationConfiguration, out *config.CertificateAuthorityRotationConfiguration, s conversion.Scope) error {
+	out.ExpirationThreshold = (*v1.Duration)(unsafe.Pointer(in.ExpirationThreshold))
+	out.MaxAge = (*v1.Duration)(unsafe.Pointer(in.MaxAge))
+	out.CompletionDelay = (*v1.Duration)(unsafe.Pointer(in.CompletionDelay))
+	return nil
+}
+
+// Convert_v1alpha1_CertificateAuthorityRotationConfiguration_To_config_CertificateAuthorityRotationConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_CertificateAuthorityRotationConfiguration_To_config_CertificateAuthorityRotationConfiguration(in *CertificateAuthorityRotationConfiguration, out *config.CertificateAuthorityRotationConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CertificateAuthorityRotationConfiguration_To_config_CertificateAuthorityRotationConfiguration(in, out, s)
+}
+
+func autoConvert_config_CertificateAuthorityRotationConfiguration_To_v1alpha1_CertificateAuthorityRotationConfiguration(in *config.CertificateAuthorityRotationConfiguration, out *CertificateAuthorityRotationConfiguration, s conversion.Scope) error {
+	out.ExpirationThreshold = (*v1.Duration)(unsafe.Pointer(in.ExpirationThreshold))
+	out.MaxAge = (*v1.Duration)(unsafe.Pointer(in.MaxAge))
+	out.CompletionDelay = (*v1.Duration)(unsafe.Pointer(in.CompletionDelay))
+	return nil
+}
+
+// Convert_config_CertificateAuthorityRotationConfiguration_To_v1alpha1_CertificateAuthorityRotationConfiguration is an autogenerated conversion function.
+func Convert_config_CertificateAuthorityRotationConfiguration_To_v1alpha1_CertificateAuthorityRotationConfiguration(in *config.CertificateAuthorityRotationConfiguration, out *CertificateAuthorityRotationConfiguration, s conversion.Scope) error {
+	return autoConvert_config_CertificateAuthorityRotationConfiguration_To_v1alpha1_CertificateAuthorityRotationConfiguration(in, out, s)
+}
+
 func autoConvert_v1alpha1_ConditionThreshold_To_config_ConditionThreshold(in *ConditionThreshold, out *config.ConditionThreshold, s conversion.Scope) error {
 	out.Type = in.Type
 	if err := v1.Convert_v1_Duration_To_Pointer_v1_Duration(&in.Duration, &out.Duration, s); err != nil {
@@ -304,6 +368,30 @@ func Convert_config_DiscoveryConfiguration_To_v1alpha1_DiscoveryConfiguration(in
 	return autoConvert_config_DiscoveryConfiguration_To_v1alpha1_DiscoveryConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_FlappingDetectionConfiguration_To_config_FlappingDetectionConfiguration(in *FlappingDetectionConfiguration, out *config.FlappingDetectionConfiguration, s conversion.Scope) error {
+	out.Window = (*v1.Duration)(unsafe.Pointer(in.Window))
+	out.Threshold = (*int)(unsafe.Pointer(in.Threshold))
+	out.MaxTransitions = (*int)(unsafe.Pointer(in.MaxTransitions))
+	return nil
+}
+
+// Convert_v1alpha1_FlappingDetectionConfiguration_To_config_FlappingDetectionConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_FlappingDetectionConfiguration_To_config_FlappingDetectionConfiguration(in *FlappingDetectionConfiguration, out *config.FlappingDetectionConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_FlappingDetectionConfiguration_To_config_FlappingDetectionConfiguration(in, out, s)
+}
+
+func autoConvert_config_FlappingDetectionConfiguration_To_v1alpha1_FlappingDetectionConfiguration(in *config.FlappingDetectionConfiguration, out *FlappingDetectionConfiguration, s conversion.Scope) error {
+	out.Window = (*v1.Duration)(unsafe.Pointer(in.Window))
+	out.Threshold = (*int)(unsafe.Pointer(in.Threshold))
+	out.MaxTransitions = (*int)(unsafe.Pointer(in.MaxTransitions))
+	return nil
+}
+
+// Convert_config_FlappingDetectionConfiguration_To_v1alpha1_FlappingDetectionConfiguration is an autogenerated conversion function.
+func Convert_config_FlappingDetectionConfiguration_To_v1alpha1_FlappingDetectionConfiguration(in *config.FlappingDetectionConfiguration, out *FlappingDetectionConfiguration, s conversion.Scope) error {
+	return autoConvert_config_FlappingDetectionConfiguration_To_v1alpha1_FlappingDetectionConfiguration(in, out, s)
+}
+
 func autoConvert_v1alpha1_GardenClientConnection_To_config_GardenClientConnection(in *GardenClientConnection, out *config.GardenClientConnection, s conversion.Scope) error {
 	if err := configv1alpha1.Convert_v1alpha1_ClientConnectionConfiguration_To_config_ClientConnectionConfiguration(&in.ClientConnectionConfiguration, &out.ClientConnectionConfiguration, s); err != nil {
 		return err
@@ -530,6 +618,28 @@ func Convert_config_LeaderElectionConfiguration_To_v1alpha1_LeaderElectionConfig
 	return autoConvert_config_LeaderElectionConfiguration_To_v1alpha1_LeaderElectionConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_SecretStoreConfiguration_To_config_SecretStoreConfiguration(in *SecretStoreConfiguration, out *config.SecretStoreConfiguration, s conversion.Scope) error {
+	out.Type = config.SecretStoreType(in.Type)
+	out.Vault = (*config.VaultSecretStoreConfiguration)(unsafe.Pointer(in.Vault))
+	return nil
+}
+
+// Convert_v1alpha1_SecretStoreConfiguration_To_config_SecretStoreConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_SecretStoreConfiguration_To_config_SecretStoreConfiguration(in *SecretStoreConfiguration, out *config.SecretStoreConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SecretStoreConfiguration_To_config_SecretStoreConfiguration(in, out, s)
+}
+
+func autoConvert_config_SecretStoreConfiguration_To_v1alpha1_SecretStoreConfiguration(in *config.SecretStoreConfiguration, out *SecretStoreConfiguration, s conversion.Scope) error {
+	out.Type = SecretStoreType(in.Type)
+	out.Vault = (*VaultSecretStoreConfiguration)(unsafe.Pointer(in.Vault))
+	return nil
+}
+
+// Convert_config_SecretStoreConfiguration_To_v1alpha1_SecretStoreConfiguration is an autogenerated conversion function.
+func Convert_config_SecretStoreConfiguration_To_v1alpha1_SecretStoreConfiguration(in *config.SecretStoreConfiguration, out *SecretStoreConfiguration, s conversion.Scope) error {
+	return autoConvert_config_SecretStoreConfiguration_To_v1alpha1_SecretStoreConfiguration(in, out, s)
+}
+
 func autoConvert_v1alpha1_SeedClientConnection_To_config_SeedClientConnection(in *SeedClientConnection, out *config.SeedClientConnection, s conversion.Scope) error {
 	if err := configv1alpha1.Convert_v1alpha1_ClientConnectionConfiguration_To_config_ClientConnectionConfiguration(&in.ClientConnectionConfiguration, &out.ClientConnectionConfiguration, s); err != nil {
 		return err
@@ -612,6 +722,8 @@ func autoConvert_v1alpha1_ShootCareControllerConfiguration_To_config_ShootCareCo
 	} else {
 		out.ConditionThresholds = nil
 	}
+	out.FlappingDetection = (*config.FlappingDetectionConfiguration)(unsafe.Pointer(in.FlappingDetection))
+	out.CertificateExpirationThreshold = (*v1.Duration)(unsafe.Pointer(in.CertificateExpirationThreshold))
 	return nil
 }
 
@@ -634,6 +746,8 @@ func autoConvert_config_ShootCareControllerConfiguration_To_v1alpha1_ShootCareCo
 	} else {
 		out.ConditionThresholds = nil
 	}
+	out.FlappingDetection = (*FlappingDetectionConfiguration)(unsafe.Pointer(in.FlappingDetection))
+	out.CertificateExpirationThreshold = (*v1.Duration)(unsafe.Pointer(in.CertificateExpirationThreshold))
 	return nil
 }
 
@@ -673,6 +787,8 @@ func autoConvert_v1alpha1_ShootControllerConfiguration_To_config_ShootController
 	out.RetryDuration = (*v1.Duration)(unsafe.Pointer(in.RetryDuration))
 	out.RetrySyncPeriod = (*v1.Duration)(unsafe.Pointer(in.RetrySyncPeriod))
 	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
+	out.CertificateAuthorityRotation = (*config.CertificateAuthorityRotationConfiguration)(unsafe.Pointer(in.CertificateAuthorityRotation))
+	out.SecretStore = (*config.SecretStoreConfiguration)(unsafe.Pointer(in.SecretStore))
 	return nil
 }
 
@@ -688,6 +804,8 @@ func autoConvert_config_ShootControllerConfiguration_To_v1alpha1_ShootController
 	out.RetryDuration = (*v1.Duration)(unsafe.Pointer(in.RetryDuration))
 	out.RetrySyncPeriod = (*v1.Duration)(unsafe.Pointer(in.RetrySyncPeriod))
 	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
+	out.CertificateAuthorityRotation = (*CertificateAuthorityRotationConfiguration)(unsafe.Pointer(in.CertificateAuthorityRotation))
+	out.SecretStore = (*SecretStoreConfiguration)(unsafe.Pointer(in.SecretStore))
 	return nil
 }
 
@@ -695,3 +813,29 @@ func autoConvert_config_ShootControllerConfiguration_To_v1alpha1_ShootController
 func Convert_config_ShootControllerConfiguration_To_v1alpha1_ShootControllerConfiguration(in *config.ShootControllerConfiguration, out *ShootControllerConfiguration, s conversion.Scope) error {
 	return autoConvert_config_ShootControllerConfiguration_To_v1alpha1_ShootControllerConfiguration(in, out, s)
 }
+
+func autoConvert_v1alpha1_VaultSecretStoreConfiguration_To_config_VaultSecretStoreConfiguration(in *VaultSecretStoreConfiguration, out *config.VaultSecretStoreConfiguration, s conversion.Scope) error {
+	out.Address = in.Address
+	out.TokenFile = in.TokenFile
+	out.MountPath = (*string)(unsafe.Pointer(in.MountPath))
+	out.PathPrefix = (*string)(unsafe.Pointer(in.PathPrefix))
+	return nil
+}
+
+// Convert_v1alpha1_VaultSecretStoreConfiguration_To_config_VaultSecretStoreConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_VaultSecretStoreConfiguration_To_config_VaultSecretStoreConfiguration(in *VaultSecretStoreConfiguration, out *config.VaultSecretStoreConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_VaultSecretStoreConfiguration_To_config_VaultSecretStoreConfiguration(in, out, s)
+}
+
+func autoConvert_config_VaultSecretStoreConfiguration_To_v1alpha1_VaultSecretStoreConfiguration(in *config.VaultSecretStoreConfiguration, out *VaultSecretStoreConfiguration, s conversion.Scope) error {
+	out.Address = in.Address
+	out.TokenFile = in.TokenFile
+	out.MountPath = (*string)(unsafe.Pointer(in.MountPath))
+	out.PathPrefix = (*string)(unsafe.Pointer(in.PathPrefix))
+	return nil
+}
+
+// Convert_config_VaultSecretStoreConfiguration_To_v1alpha1_VaultSecretStoreConfiguration is an autogenerated conversion function.
+func Convert_config_VaultSecretStoreConfiguration_To_v1alpha1_VaultSecretStoreConfiguration(in *config.VaultSecretStoreConfiguration, out *VaultSecretStoreConfiguration, s conversion.Scope) error {
+	return autoConvert_config_VaultSecretStoreConfiguration_To_v1alpha1_VaultSecretStoreConfiguration(in, out, s)
+}
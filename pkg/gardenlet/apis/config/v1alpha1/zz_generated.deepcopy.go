@@ -74,6 +74,37 @@ func (in *BackupEntryControllerConfiguration) DeepCopy() *BackupEntryControllerC
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateAuthorityRotationConfiguration) DeepCopyInto(out *CertificateAuthorityRotationConfiguration) {
+	*out = *in
+	if in.ExpirationThreshold != nil {
+		in, out := &in.ExpirationThreshold, &out.ExpirationThreshold
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.MaxAge != nil {
+		in, out := &in.MaxAge, &out.MaxAge
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.CompletionDelay != nil {
+		in, out := &in.CompletionDelay, &out.CompletionDelay
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateAuthorityRotationConfiguration.
+func (in *CertificateAuthorityRotationConfiguration) DeepCopy() *CertificateAuthorityRotationConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateAuthorityRotationConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ConditionThreshold) DeepCopyInto(out *ConditionThreshold) {
 	*out = *in
@@ -143,6 +174,37 @@ func (in *DiscoveryConfiguration) DeepCopy() *DiscoveryConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FlappingDetectionConfiguration) DeepCopyInto(out *FlappingDetectionConfiguration) {
+	*out = *in
+	if in.Window != nil {
+		in, out := &in.Window, &out.Window
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Threshold != nil {
+		in, out := &in.Threshold, &out.Threshold
+		*out = new(int)
+		**out = **in
+	}
+	if in.MaxTransitions != nil {
+		in, out := &in.MaxTransitions, &out.MaxTransitions
+		*out = new(int)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlappingDetectionConfiguration.
+func (in *FlappingDetectionConfiguration) DeepCopy() *FlappingDetectionConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(FlappingDetectionConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GardenClientConnection) DeepCopyInto(out *GardenClientConnection) {
 	*out = *in
@@ -335,6 +397,27 @@ func (in *LeaderElectionConfiguration) DeepCopy() *LeaderElectionConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretStoreConfiguration) DeepCopyInto(out *SecretStoreConfiguration) {
+	*out = *in
+	if in.Vault != nil {
+		in, out := &in.Vault, &out.Vault
+		*out = new(VaultSecretStoreConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretStoreConfiguration.
+func (in *SecretStoreConfiguration) DeepCopy() *SecretStoreConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretStoreConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SeedClientConnection) DeepCopyInto(out *SeedClientConnection) {
 	*out = *in
@@ -418,6 +501,16 @@ func (in *ShootCareControllerConfiguration) DeepCopyInto(out *ShootCareControlle
 		*out = make([]ConditionThreshold, len(*in))
 		copy(*out, *in)
 	}
+	if in.FlappingDetection != nil {
+		in, out := &in.FlappingDetection, &out.FlappingDetection
+		*out = new(FlappingDetectionConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CertificateExpirationThreshold != nil {
+		in, out := &in.CertificateExpirationThreshold, &out.CertificateExpirationThreshold
+		*out = new(v1.Duration)
+		**out = **in
+	}
 	return
 }
 
@@ -481,6 +574,16 @@ func (in *ShootControllerConfiguration) DeepCopyInto(out *ShootControllerConfigu
 		*out = new(v1.Duration)
 		**out = **in
 	}
+	if in.CertificateAuthorityRotation != nil {
+		in, out := &in.CertificateAuthorityRotation, &out.CertificateAuthorityRotation
+		*out = new(CertificateAuthorityRotationConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SecretStore != nil {
+		in, out := &in.SecretStore, &out.SecretStore
+		*out = new(SecretStoreConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -493,3 +596,29 @@ func (in *ShootControllerConfiguration) DeepCopy() *ShootControllerConfiguration
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VaultSecretStoreConfiguration) DeepCopyInto(out *VaultSecretStoreConfiguration) {
+	*out = *in
+	if in.MountPath != nil {
+		in, out := &in.MountPath, &out.MountPath
+		*out = new(string)
+		**out = **in
+	}
+	if in.PathPrefix != nil {
+		in, out := &in.PathPrefix, &out.PathPrefix
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VaultSecretStoreConfiguration.
+func (in *VaultSecretStoreConfiguration) DeepCopy() *VaultSecretStoreConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(VaultSecretStoreConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -45,9 +45,15 @@ func SetObjectDefaults_GardenletConfiguration(in *GardenletConfiguration) {
 		}
 		if in.Controllers.Shoot != nil {
 			SetDefaults_ShootControllerConfiguration(in.Controllers.Shoot)
+			if in.Controllers.Shoot.CertificateAuthorityRotation != nil {
+				SetDefaults_CertificateAuthorityRotationConfiguration(in.Controllers.Shoot.CertificateAuthorityRotation)
+			}
 		}
 		if in.Controllers.ShootCare != nil {
 			SetDefaults_ShootCareControllerConfiguration(in.Controllers.ShootCare)
+			if in.Controllers.ShootCare.FlappingDetection != nil {
+				SetDefaults_FlappingDetectionConfiguration(in.Controllers.ShootCare.FlappingDetection)
+			}
 		}
 	}
 	if in.LeaderElection != nil {
@@ -28,5 +28,79 @@ func ValidateGardenletConfiguration(cfg *config.GardenletConfiguration) field.Er
 		allErrs = append(allErrs, field.Invalid(field.NewPath("seedSelector/seedConfig"), cfg, "exactly one of `seedConfig` and `seedSelector` is required"))
 	}
 
+	if cfg.Controllers != nil && cfg.Controllers.Shoot != nil && cfg.Controllers.Shoot.CertificateAuthorityRotation != nil {
+		allErrs = append(allErrs, validateCertificateAuthorityRotationConfiguration(cfg.Controllers.Shoot.CertificateAuthorityRotation, field.NewPath("controllers", "shoot", "certificateAuthorityRotation"))...)
+	}
+
+	if cfg.Controllers != nil && cfg.Controllers.Shoot != nil && cfg.Controllers.Shoot.SecretStore != nil {
+		allErrs = append(allErrs, validateSecretStoreConfiguration(cfg.Controllers.Shoot.SecretStore, field.NewPath("controllers", "shoot", "secretStore"))...)
+	}
+
+	if cfg.Controllers != nil && cfg.Controllers.ShootCare != nil && cfg.Controllers.ShootCare.FlappingDetection != nil {
+		allErrs = append(allErrs, validateFlappingDetectionConfiguration(cfg.Controllers.ShootCare.FlappingDetection, field.NewPath("controllers", "shootCare", "flappingDetection"))...)
+	}
+
+	if cfg.Controllers != nil && cfg.Controllers.ShootCare != nil && cfg.Controllers.ShootCare.CertificateExpirationThreshold != nil && cfg.Controllers.ShootCare.CertificateExpirationThreshold.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("controllers", "shootCare", "certificateExpirationThreshold"), cfg.Controllers.ShootCare.CertificateExpirationThreshold.Duration.String(), "must be greater than 0"))
+	}
+
+	return allErrs
+}
+
+func validateFlappingDetectionConfiguration(cfg *config.FlappingDetectionConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if cfg.Window != nil && cfg.Window.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("window"), cfg.Window.Duration.String(), "must be greater than 0"))
+	}
+	if cfg.Threshold != nil && *cfg.Threshold < 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("threshold"), *cfg.Threshold, "must be greater than 0"))
+	}
+	if cfg.MaxTransitions != nil && cfg.Threshold != nil && *cfg.MaxTransitions <= *cfg.Threshold {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxTransitions"), *cfg.MaxTransitions, "must be greater than the threshold"))
+	}
+
+	return allErrs
+}
+
+func validateSecretStoreConfiguration(cfg *config.SecretStoreConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	switch cfg.Type {
+	case config.SecretStoreTypeKubernetes:
+		if cfg.Vault != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("vault"), "must not be set if the type is not 'vault'"))
+		}
+	case config.SecretStoreTypeVault:
+		if cfg.Vault == nil {
+			allErrs = append(allErrs, field.Required(fldPath.Child("vault"), "must be set if the type is 'vault'"))
+			break
+		}
+		if len(cfg.Vault.Address) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Child("vault", "address"), "must provide the address of the server"))
+		}
+		if len(cfg.Vault.TokenFile) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Child("vault", "tokenFile"), "must provide the path to the token file"))
+		}
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("type"), cfg.Type, []string{string(config.SecretStoreTypeKubernetes), string(config.SecretStoreTypeVault)}))
+	}
+
+	return allErrs
+}
+
+func validateCertificateAuthorityRotationConfiguration(cfg *config.CertificateAuthorityRotationConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if cfg.ExpirationThreshold != nil && cfg.ExpirationThreshold.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("expirationThreshold"), cfg.ExpirationThreshold.Duration.String(), "must be greater than 0"))
+	}
+	if cfg.MaxAge != nil && cfg.MaxAge.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxAge"), cfg.MaxAge.Duration.String(), "must be greater than 0"))
+	}
+	if cfg.CompletionDelay != nil && cfg.CompletionDelay.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("completionDelay"), cfg.CompletionDelay.Duration.String(), "must be greater than 0"))
+	}
+
 	return allErrs
 }
@@ -64,5 +64,124 @@ var _ = Describe("GardenletConfiguration", func() {
 				"Field": Equal("seedSelector/seedConfig"),
 			}))))
 		})
+
+		It("should forbid invalid flapping detection configurations", func() {
+			var (
+				window         = metav1.Duration{}
+				threshold      = 0
+				maxTransitions = 0
+			)
+			cfg.Controllers = &config.GardenletControllerConfiguration{
+				ShootCare: &config.ShootCareControllerConfiguration{
+					FlappingDetection: &config.FlappingDetectionConfiguration{
+						Window:         &window,
+						Threshold:      &threshold,
+						MaxTransitions: &maxTransitions,
+					},
+				},
+			}
+
+			errorList := ValidateGardenletConfiguration(cfg)
+
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.shootCare.flappingDetection.window"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.shootCare.flappingDetection.threshold"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.shootCare.flappingDetection.maxTransitions"),
+				})),
+			))
+		})
+
+		It("should forbid invalid certificate authority rotation configurations", func() {
+			var (
+				expirationThreshold = metav1.Duration{}
+				maxAge              = metav1.Duration{Duration: -1}
+			)
+			cfg.Controllers = &config.GardenletControllerConfiguration{
+				Shoot: &config.ShootControllerConfiguration{
+					CertificateAuthorityRotation: &config.CertificateAuthorityRotationConfiguration{
+						ExpirationThreshold: &expirationThreshold,
+						MaxAge:              &maxAge,
+					},
+				},
+			}
+
+			errorList := ValidateGardenletConfiguration(cfg)
+
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.shoot.certificateAuthorityRotation.expirationThreshold"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.shoot.certificateAuthorityRotation.maxAge"),
+				})),
+			))
+		})
+
+		It("should forbid invalid secret store configurations", func() {
+			cfg.Controllers = &config.GardenletControllerConfiguration{
+				Shoot: &config.ShootControllerConfiguration{
+					SecretStore: &config.SecretStoreConfiguration{
+						Type:  config.SecretStoreTypeVault,
+						Vault: &config.VaultSecretStoreConfiguration{},
+					},
+				},
+			}
+
+			errorList := ValidateGardenletConfiguration(cfg)
+
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("controllers.shoot.secretStore.vault.address"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("controllers.shoot.secretStore.vault.tokenFile"),
+				})),
+			))
+		})
+
+		It("should forbid unsupported secret store types", func() {
+			cfg.Controllers = &config.GardenletControllerConfiguration{
+				Shoot: &config.ShootControllerConfiguration{
+					SecretStore: &config.SecretStoreConfiguration{Type: "foo"},
+				},
+			}
+
+			errorList := ValidateGardenletConfiguration(cfg)
+
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("controllers.shoot.secretStore.type"),
+				})),
+			))
+		})
+
+		It("should forbid an invalid certificate expiration threshold", func() {
+			certificateExpirationThreshold := metav1.Duration{}
+			cfg.Controllers = &config.GardenletControllerConfiguration{
+				ShootCare: &config.ShootCareControllerConfiguration{
+					CertificateExpirationThreshold: &certificateExpirationThreshold,
+				},
+			}
+
+			errorList := ValidateGardenletConfiguration(cfg)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("controllers.shootCare.certificateExpirationThreshold"),
+			}))))
+		})
 	})
 })
@@ -71,11 +71,22 @@ func newActuator(gardenClient, seedClient client.Client, bb *gardencorev1alpha1.
 
 func (a *actuator) Reconcile(ctx context.Context) error {
 	var (
-		g = flow.NewGraph("Backup Bucket Reconciliation")
+		g          = flow.NewGraph("Backup Bucket Reconciliation")
+		replicated = a.backupBucket.Spec.Secondary != nil
 
+		deployReplicaBackupBucketExtension = g.Add(flow.Task{
+			Name: "Deploying replica backup bucket extension resource",
+			Fn:   flow.TaskFn(a.deployReplicaBackupBucketExtension).RetryUntilTimeout(defaultInterval, defaultTimeout).DoIf(replicated),
+		})
+		waitUntilReplicaBackupBucketExtensionReconciled = g.Add(flow.Task{
+			Name:         "Waiting until replica backup bucket is reconciled",
+			Fn:           flow.TaskFn(a.waitUntilReplicaBackupBucketExtensionReconciled).DoIf(replicated),
+			Dependencies: flow.NewTaskIDs(deployReplicaBackupBucketExtension),
+		})
 		deployBackupBucketExtension = g.Add(flow.Task{
-			Name: "Deploying backup bucket extension resource",
-			Fn:   flow.TaskFn(a.deployBackupBucketExtension).RetryUntilTimeout(defaultInterval, defaultTimeout),
+			Name:         "Deploying backup bucket extension resource",
+			Fn:           flow.TaskFn(a.deployBackupBucketExtension).RetryUntilTimeout(defaultInterval, defaultTimeout),
+			Dependencies: flow.NewTaskIDs(waitUntilReplicaBackupBucketExtensionReconciled),
 		})
 
 		_ = g.Add(flow.Task{
@@ -94,10 +105,20 @@ func (a *actuator) Reconcile(ctx context.Context) error {
 
 func (a *actuator) Delete(ctx context.Context) error {
 	var (
-		g                  = flow.NewGraph("Backup bucket deletion")
+		g                         = flow.NewGraph("Backup bucket deletion")
+		deleteReplicaBackupBucket = g.Add(flow.Task{
+			Name: "Destroying replica backup bucket",
+			Fn:   flow.TaskFn(a.deleteReplicaBackupBucketExtension),
+		})
+		waitUntilReplicaBackupBucketExtensionDeleted = g.Add(flow.Task{
+			Name:         "Waiting until extension replica backup bucket is deleted",
+			Fn:           flow.TaskFn(a.waitUntilReplicaBackupBucketExtensionDeleted),
+			Dependencies: flow.NewTaskIDs(deleteReplicaBackupBucket),
+		})
 		deleteBackupBucket = g.Add(flow.Task{
-			Name: "Destroying backup bucket",
-			Fn:   flow.TaskFn(a.deleteBackupBucketExtension),
+			Name:         "Destroying backup bucket",
+			Fn:           flow.TaskFn(a.deleteBackupBucketExtension),
+			Dependencies: flow.NewTaskIDs(waitUntilReplicaBackupBucketExtensionDeleted),
 		})
 		_ = g.Add(flow.Task{
 			Name:         "Waiting until extension backup bucket is deleted",
@@ -133,8 +154,27 @@ func makeDescription(stats *flow.Stats) string {
 	return strings.Join(stats.Running.StringList(), ", ")
 }
 
-// deployBackupBucketExtension deploys the BackupBucket extension resource in Seed with the required secret.
+// deployBackupBucketExtension deploys the BackupBucket extension resource in Seed with the required secret. If the
+// BackupBucket has a secondary location then the objects are replicated into the replica bucket.
 func (a *actuator) deployBackupBucketExtension(ctx context.Context) error {
+	var replicaBucket *extensionsv1alpha1.BackupReplicaBucket
+	if secondary := a.backupBucket.Spec.Secondary; secondary != nil {
+		replicaBucket = &extensionsv1alpha1.BackupReplicaBucket{
+			Name:   common.GenerateReplicaBackupBucketName(a.backupBucket.Name),
+			Region: secondary.Region,
+		}
+	}
+
+	return a.deployExtensionBackupBucket(ctx, a.backupBucket.Name, a.backupBucket.Spec.Provider.Region, replicaBucket)
+}
+
+// deployReplicaBackupBucketExtension deploys the BackupBucket extension resource for the replica bucket in the
+// secondary location in Seed. It uses the same secret as the primary bucket.
+func (a *actuator) deployReplicaBackupBucketExtension(ctx context.Context) error {
+	return a.deployExtensionBackupBucket(ctx, common.GenerateReplicaBackupBucketName(a.backupBucket.Name), a.backupBucket.Spec.Secondary.Region, nil)
+}
+
+func (a *actuator) deployExtensionBackupBucket(ctx context.Context, name, region string, replicaBucket *extensionsv1alpha1.BackupReplicaBucket) error {
 	coreSecret, err := common.GetSecretFromSecretRef(ctx, a.gardenClient, &a.backupBucket.Spec.SecretRef)
 	if err != nil {
 		return err
@@ -157,7 +197,7 @@ func (a *actuator) deployBackupBucketExtension(ctx context.Context) error {
 	// create extension backup bucket resource in seed
 	extensionBackupBucket := &extensionsv1alpha1.BackupBucket{
 		ObjectMeta: metav1.ObjectMeta{
-			Name: a.backupBucket.Name,
+			Name: name,
 		},
 	}
 
@@ -168,36 +208,30 @@ func (a *actuator) deployBackupBucketExtension(ctx context.Context) error {
 			DefaultSpec: extensionsv1alpha1.DefaultSpec{
 				Type: a.backupBucket.Spec.Provider.Type,
 			},
-			Region: a.backupBucket.Spec.Provider.Region,
+			Region: region,
 			SecretRef: corev1.SecretReference{
 				Name:      generateBackupBucketSecretName(a.backupBucket.Name),
 				Namespace: v1alpha1constants.GardenNamespace,
 			},
+			ReplicaBucket: replicaBucket,
 		}
 		return nil
 	})
 }
 
+// waitUntilReplicaBackupBucketExtensionReconciled waits until the BackupBucket extension resource for the replica
+// bucket is reconciled in seed.
+func (a *actuator) waitUntilReplicaBackupBucketExtensionReconciled(ctx context.Context) error {
+	_, err := a.waitUntilExtensionBackupBucketReconciled(ctx, common.GenerateReplicaBackupBucketName(a.backupBucket.Name))
+	return err
+}
+
 // waitUntilBackupBucketExtensionReconciled waits until BackupBucket Extension resource reconciled from seed.
 // It also copies the generatedSecret from seed to garden.
 func (a *actuator) waitUntilBackupBucketExtensionReconciled(ctx context.Context) error {
-	var backupBucket *extensionsv1alpha1.BackupBucket
-
-	if err := retry.UntilTimeout(ctx, defaultInterval, defaultTimeout, func(ctx context.Context) (bool, error) {
-		bb := &extensionsv1alpha1.BackupBucket{}
-		if err := a.seedClient.Get(ctx, kutil.Key(a.backupBucket.Name), bb); err != nil {
-			return retry.SevereError(err)
-		}
-
-		if err := health.CheckExtensionObject(bb); err != nil {
-			a.logger.WithError(err).Error("Backup bucket did not get ready yet")
-			return retry.MinorError(err)
-		}
-
-		backupBucket = bb
-		return retry.Ok()
-	}); err != nil {
-		return gardencorev1alpha1helper.DetermineError(fmt.Sprintf("Error while waiting for backupBucket object to become ready: %v", err))
+	backupBucket, err := a.waitUntilExtensionBackupBucketReconciled(ctx, a.backupBucket.Name)
+	if err != nil {
+		return err
 	}
 
 	if backupBucket.Status.GeneratedSecretRef != nil {
@@ -239,6 +273,39 @@ func (a *actuator) waitUntilBackupBucketExtensionReconciled(ctx context.Context)
 	return nil
 }
 
+func (a *actuator) waitUntilExtensionBackupBucketReconciled(ctx context.Context, name string) (*extensionsv1alpha1.BackupBucket, error) {
+	var backupBucket *extensionsv1alpha1.BackupBucket
+
+	if err := retry.UntilTimeout(ctx, defaultInterval, defaultTimeout, func(ctx context.Context) (bool, error) {
+		bb := &extensionsv1alpha1.BackupBucket{}
+		if err := a.seedClient.Get(ctx, kutil.Key(name), bb); err != nil {
+			return retry.SevereError(err)
+		}
+
+		if err := health.CheckExtensionObject(bb); err != nil {
+			a.logger.WithError(err).Errorf("Backup bucket %s did not get ready yet", name)
+			return retry.MinorError(err)
+		}
+
+		backupBucket = bb
+		return retry.Ok()
+	}); err != nil {
+		return nil, gardencorev1alpha1helper.DetermineError(fmt.Sprintf("Error while waiting for backupBucket object %s to become ready: %v", name, err))
+	}
+
+	return backupBucket, nil
+}
+
+// deleteReplicaBackupBucketExtension deletes the BackupBucket extension resource for the replica bucket in seed.
+func (a *actuator) deleteReplicaBackupBucketExtension(ctx context.Context) error {
+	bb := &extensionsv1alpha1.BackupBucket{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: common.GenerateReplicaBackupBucketName(a.backupBucket.Name),
+		},
+	}
+	return client.IgnoreNotFound(a.seedClient.Delete(ctx, bb))
+}
+
 // deleteBackupBucketExtension deletes BackupBucket extension resource in seed .
 func (a *actuator) deleteBackupBucketExtension(ctx context.Context) error {
 	if err := a.deleteGeneratedBackupBucketSecretInGarden(ctx); err != nil {
@@ -257,13 +324,23 @@ func (a *actuator) deleteBackupBucketExtension(ctx context.Context) error {
 	return client.IgnoreNotFound(a.seedClient.Delete(ctx, bb))
 }
 
+// waitUntilReplicaBackupBucketExtensionDeleted waits until the BackupBucket extension resource for the replica bucket
+// is deleted in seed cluster.
+func (a *actuator) waitUntilReplicaBackupBucketExtensionDeleted(ctx context.Context) error {
+	return a.waitUntilExtensionBackupBucketDeleted(ctx, common.GenerateReplicaBackupBucketName(a.backupBucket.Name))
+}
+
 // waitUntilBackupBucketExtensionDeleted waits until backup bucket extension resource is deleted in seed cluster.
 func (a *actuator) waitUntilBackupBucketExtensionDeleted(ctx context.Context) error {
+	return a.waitUntilExtensionBackupBucketDeleted(ctx, a.backupBucket.Name)
+}
+
+func (a *actuator) waitUntilExtensionBackupBucketDeleted(ctx context.Context, name string) error {
 	var lastError *gardencorev1alpha1.LastError
 
 	if err := retry.UntilTimeout(ctx, defaultInterval, defaultTimeout, func(ctx context.Context) (bool, error) {
 		bb := &extensionsv1alpha1.BackupBucket{}
-		if err := a.seedClient.Get(ctx, kutil.Key(a.backupBucket.Name), bb); err != nil {
+		if err := a.seedClient.Get(ctx, kutil.Key(name), bb); err != nil {
 			if apierrors.IsNotFound(err) {
 				return retry.Ok()
 			}
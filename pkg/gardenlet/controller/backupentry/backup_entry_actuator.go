@@ -187,6 +187,34 @@ func (a *actuator) deployBackupEntryExtension(ctx context.Context) error {
 		return errors.Wrapf(err, "could not reconcile extension secret in seed")
 	}
 
+	var (
+		replicaBucket     *extensionsv1alpha1.BackupReplicaBucket
+		replicaBucketName *string
+	)
+	if secondary := bb.Spec.Secondary; secondary != nil {
+		replicaBucket = &extensionsv1alpha1.BackupReplicaBucket{
+			Name:   common.GenerateReplicaBackupBucketName(bb.Name),
+			Region: secondary.Region,
+		}
+		replicaBucketName = &replicaBucket.Name
+	}
+
+	// record the replica bucket so that both locations of the backups are known
+	if err := kutil.TryUpdateStatus(ctx, kretry.DefaultRetry, a.gardenClient, a.backupEntry, func() error {
+		a.backupEntry.Status.ReplicaBucketName = replicaBucketName
+		return nil
+	}); err != nil {
+		return errors.Wrapf(err, "could not record replica bucket in backup entry status")
+	}
+
+	var retentionPolicy *extensionsv1alpha1.BackupRetentionPolicy
+	if policy := a.backupEntry.Spec.RetentionPolicy; policy != nil {
+		retentionPolicy = &extensionsv1alpha1.BackupRetentionPolicy{
+			DailySnapshots:  policy.DailySnapshots,
+			WeeklySnapshots: policy.WeeklySnapshots,
+		}
+	}
+
 	// create extension BackupEntry resource in seed
 	extensionBackupEntry := &extensionsv1alpha1.BackupEntry{
 		ObjectMeta: metav1.ObjectMeta{
@@ -207,6 +235,8 @@ func (a *actuator) deployBackupEntryExtension(ctx context.Context) error {
 				Name:      extensionSecret.Name,
 				Namespace: extensionSecret.Namespace,
 			},
+			RetentionPolicy: retentionPolicy,
+			ReplicaBucket:   replicaBucket,
 		}
 		return nil
 	})
@@ -37,6 +37,7 @@ import (
 	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
 	"github.com/gardener/gardener/pkg/version"
 
+	"github.com/prometheus/client_golang/prometheus"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/runtime"
@@ -129,7 +130,13 @@ func (f *GardenletControllerFactory) Run(ctx context.Context) {
 
 	// Initialize the Controller metrics collection.
 	gardenmetrics.RegisterControllerMetrics(
-		gardenlet.ControllerWorkerSum,
+		[]*prometheus.Desc{
+			gardenlet.ControllerWorkerSum,
+			gardenlet.ShootConditionTransitions,
+			gardenlet.ShootAPIServerAvailability,
+			gardenlet.ShootCertificateExpiration,
+			gardenlet.SeedCertificateExpiration,
+		},
 		gardenlet.ScrapeFailures,
 		backupBucketController,
 		backupEntryController,
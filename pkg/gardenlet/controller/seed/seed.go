@@ -62,6 +62,8 @@ type Controller struct {
 
 	shootLister gardencorelisters.ShootLister
 
+	certificateExpirations *sync.Map
+
 	workerCh               chan int
 	numberOfRunningWorkers int
 }
@@ -87,16 +89,19 @@ func NewSeedController(
 		seedLister   = seedInformer.Lister()
 		secretLister = corev1Informer.Secrets().Lister()
 		shootLister  = gardenCoreV1alpha1Informer.Shoots().Lister()
+
+		certificateExpirations = &sync.Map{}
 	)
 
 	seedController := &Controller{
 		k8sGardenClient:        k8sGardenClient,
 		k8sGardenCoreInformers: gardenCoreInformerFactory,
-		control:                NewDefaultControl(k8sGardenClient, gardenCoreInformerFactory, secrets, imageVector, identity, recorder, config, secretLister, shootLister),
+		control:                NewDefaultControl(k8sGardenClient, gardenCoreInformerFactory, secrets, imageVector, identity, recorder, config, secretLister, shootLister, certificateExpirations),
 		heartbeatControl:       NewDefaultHeartbeatControl(k8sGardenClient, gardenCoreV1alpha1Informer, identity, config),
 		config:                 config,
 		recorder:               recorder,
 		seedLister:             seedLister,
+		certificateExpirations: certificateExpirations,
 		seedQueue:              workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "seed"),
 		seedHeartbeatQueue:     workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "seed-hearbeat"),
 		shootLister:            shootLister,
@@ -196,4 +201,15 @@ func (c *Controller) CollectMetrics(ch chan<- prometheus.Metric) {
 		return
 	}
 	ch <- metric
+
+	c.certificateExpirations.Range(func(name, expiration interface{}) bool {
+		metric, err := prometheus.NewConstMetric(gardenlet.SeedCertificateExpiration, prometheus.GaugeValue, float64(expiration.(time.Time).Unix()), name.(string))
+		if err != nil {
+			logger.Logger.Errorf("Could not collect the certificate expiration metric of Seed %s: %+v", name, err)
+			gardenlet.ScrapeFailures.With(prometheus.Labels{"kind": "seed-controller"}).Inc()
+			return true
+		}
+		ch <- metric
+		return true
+	})
 }
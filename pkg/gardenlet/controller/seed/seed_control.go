@@ -19,6 +19,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"sync"
 	"time"
 
 	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
@@ -126,6 +127,7 @@ func NewDefaultControl(
 	config *config.GardenletConfiguration,
 	secretLister kubecorev1listers.SecretLister,
 	shootLister gardencorelisters.ShootLister,
+	certificateExpirations *sync.Map,
 ) ControlInterface {
 	return &defaultControl{
 		k8sGardenClient,
@@ -137,6 +139,7 @@ func NewDefaultControl(
 		config,
 		secretLister,
 		shootLister,
+		certificateExpirations,
 	}
 }
 
@@ -150,6 +153,7 @@ type defaultControl struct {
 	config                 *config.GardenletConfiguration
 	secretLister           kubecorev1listers.SecretLister
 	shootLister            gardencorelisters.ShootLister
+	certificateExpirations *sync.Map
 }
 
 func (c *defaultControl) ReconcileSeed(obj *gardencorev1alpha1.Seed, key string) error {
@@ -224,6 +228,7 @@ func (c *defaultControl) ReconcileSeed(obj *gardencorev1alpha1.Seed, key string)
 				seedLogger.Error(err.Error())
 				return err
 			}
+			c.certificateExpirations.Delete(seed.Name)
 			return nil
 		}
 
@@ -320,6 +325,10 @@ func (c *defaultControl) ReconcileSeed(obj *gardencorev1alpha1.Seed, key string)
 	conditionSeedBootstrapped = gardencorev1alpha1helper.UpdatedCondition(conditionSeedBootstrapped, gardencorev1alpha1.ConditionTrue, "BootstrappingSucceeded", "Seed cluster has been bootstrapped successfully.")
 	c.updateSeedStatus(seed, seedKubernetesVersion, conditionSeedBootstrapped)
 
+	if err := c.recordCertificateExpiration(ctx, seed); err != nil {
+		seedLogger.Errorf("Could not determine the expiration of the Seed certificates: %+v", err)
+	}
+
 	if seed.Spec.Backup != nil {
 		// This should be post updating the seed is available. Since, scheduler will then mostly use
 		// same seed for deploying the backupBucket extension.
@@ -356,6 +365,31 @@ func (c *defaultControl) updateSeedStatus(seed *gardencorev1alpha1.Seed, k8sVers
 	return nil
 }
 
+// recordCertificateExpiration remembers the expiration time of the certificate which expires first among all
+// certificates generated for the given Seed. It is exposed when the metrics are collected. If the expiration cannot be
+// determined then the remembered expiration time is dropped so that no outdated value is exposed.
+func (c *defaultControl) recordCertificateExpiration(ctx context.Context, seed *gardencorev1alpha1.Seed) error {
+	k8sSeedClient, err := seedpkg.GetSeedClient(ctx, c.k8sGardenClient.Client(), c.config.SeedClientConnection.ClientConnectionConfiguration, c.config.SeedSelector == nil, seed.Name)
+	if err != nil {
+		c.certificateExpirations.Delete(seed.Name)
+		return err
+	}
+
+	expiration, err := seedpkg.GetEarliestCertificateExpiration(ctx, k8sSeedClient.Client())
+	if err != nil {
+		c.certificateExpirations.Delete(seed.Name)
+		return err
+	}
+
+	if expiration == nil {
+		c.certificateExpirations.Delete(seed.Name)
+		return nil
+	}
+
+	c.certificateExpirations.Store(seed.Name, expiration.NotAfter)
+	return nil
+}
+
 func deployBackupBucketInGarden(ctx context.Context, k8sGardenClient client.Client, seed *gardencorev1alpha1.Seed) error {
 	// By default, we assume the seed.Spec.Backup.Provider matches the seed.Spec.Provider.Type as per the validation logic.
 	// However, if the backup region is specified we take it.
@@ -370,6 +404,13 @@ func deployBackupBucketInGarden(ctx context.Context, k8sGardenClient client.Clie
 		},
 	}
 
+	var secondary *gardencorev1alpha1.SecondaryBackupLocation
+	if seed.Spec.Backup.Secondary != nil {
+		secondary = &gardencorev1alpha1.SecondaryBackupLocation{
+			Region: seed.Spec.Backup.Secondary.Region,
+		}
+	}
+
 	ownerRef := metav1.NewControllerRef(seed, gardencorev1alpha1.SchemeGroupVersion.WithKind("Seed"))
 
 	return kutil.CreateOrUpdate(ctx, k8sGardenClient, backupBucket, func() error {
@@ -383,7 +424,8 @@ func deployBackupBucketInGarden(ctx context.Context, k8sGardenClient client.Clie
 				Name:      seed.Spec.Backup.SecretRef.Name,
 				Namespace: seed.Spec.Backup.SecretRef.Namespace,
 			},
-			Seed: &seed.Name, // In future this will be moved to scheduler.
+			Seed:      &seed.Name, // In future this will be moved to scheduler.
+			Secondary: secondary,
 		}
 		return nil
 	})
@@ -322,6 +322,7 @@ func prepareSeedConfig(ctx context.Context, k8sGardenClient kubernetes.Interface
 			Services:      *shoot.Spec.Networking.Services,
 			Nodes:         shoot.Spec.Networking.Nodes,
 			ShootDefaults: shootedSeedConfig.ShootDefaults,
+			IPFamilies:    shoot.Spec.Networking.IPFamilies,
 		},
 		BlockCIDRs: shootedSeedConfig.BlockCIDRs,
 		Taints:     taints,
@@ -29,6 +29,7 @@ import (
 	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
 	confighelper "github.com/gardener/gardener/pkg/gardenlet/apis/config/helper"
 	"github.com/gardener/gardener/pkg/logger"
+	botanistpkg "github.com/gardener/gardener/pkg/operation/botanist"
 	"github.com/gardener/gardener/pkg/utils/imagevector"
 
 	"github.com/prometheus/client_golang/prometheus"
@@ -73,6 +74,9 @@ type Controller struct {
 	seedSynced                   cache.InformerSynced
 	shootSynced                  cache.InformerSynced
 
+	certificateExpirations *sync.Map
+	availabilityLedgers    *botanistpkg.AvailabilityLedgers
+
 	numberOfRunningWorkers int
 	workerCh               chan int
 }
@@ -92,6 +96,9 @@ func NewShootController(k8sGardenClient kubernetes.Interface, k8sGardenCoreInfor
 
 		shootInformer = gardenCoreV1alpha1Informer.Shoots()
 		shootLister   = shootInformer.Lister()
+
+		certificateExpirations = &sync.Map{}
+		availabilityLedgers    = botanistpkg.NewAvailabilityLedgers()
 	)
 
 	shootController := &Controller{
@@ -100,7 +107,7 @@ func NewShootController(k8sGardenClient kubernetes.Interface, k8sGardenCoreInfor
 
 		config:                        config,
 		identity:                      identity,
-		careControl:                   NewDefaultCareControl(k8sGardenClient, gardenCoreV1alpha1Informer, secrets, imageVector, identity, config),
+		careControl:                   NewDefaultCareControl(k8sGardenClient, gardenCoreV1alpha1Informer, secrets, imageVector, identity, config, certificateExpirations, availabilityLedgers),
 		controllerInstallationControl: NewDefaultControllerInstallationControl(k8sGardenClient, gardenCoreV1alpha1Informer, recorder),
 		seedRegistrationControl:       NewDefaultSeedRegistrationControl(k8sGardenClient, gardenCoreV1alpha1Informer, imageVector, config, recorder),
 		recorder:                      recorder,
@@ -111,6 +118,9 @@ func NewShootController(k8sGardenClient kubernetes.Interface, k8sGardenCoreInfor
 		shootLister:                  shootLister,
 		controllerInstallationLister: controllerInstallationLister,
 
+		certificateExpirations: certificateExpirations,
+		availabilityLedgers:    availabilityLedgers,
+
 		controllerInstallationQueue: workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "shoot-controllerinstallation"),
 		shootCareQueue:              workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "shoot-care"),
 		shootQueue:                  workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "shoot"),
@@ -256,6 +266,8 @@ func (c *Controller) CollectMetrics(ch chan<- prometheus.Metric) {
 		return
 	}
 	ch <- metric
+
+	c.collectShootCareMetrics(ch)
 }
 
 func (c *Controller) getShootQueue(obj interface{}) workqueue.RateLimitingInterface {
@@ -17,6 +17,7 @@ package shoot
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"sync"
 	"time"
 
@@ -24,16 +25,22 @@ import (
 	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
 	gardencoreinformers "github.com/gardener/gardener/pkg/client/core/informers/externalversions/core/v1alpha1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/controllerutils"
+	"github.com/gardener/gardener/pkg/gardenlet"
 	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
+	confighelper "github.com/gardener/gardener/pkg/gardenlet/apis/config/helper"
 	"github.com/gardener/gardener/pkg/logger"
 	"github.com/gardener/gardener/pkg/operation"
 	botanistpkg "github.com/gardener/gardener/pkg/operation/botanist"
 	"github.com/gardener/gardener/pkg/utils/flow"
 	"github.com/gardener/gardener/pkg/utils/imagevector"
 	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+	"github.com/gardener/gardener/pkg/utils/secrets"
 
+	"github.com/prometheus/client_golang/prometheus"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/util/retry"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -55,6 +62,8 @@ func (c *Controller) reconcileShootCareKey(key string) error {
 	shoot, err := c.shootLister.Shoots(namespace).Get(name)
 	if apierrors.IsNotFound(err) {
 		logger.Logger.Infof("[SHOOT CARE] Stopping care operations for Shoot %s since it has been deleted", key)
+		c.certificateExpirations.Delete(key)
+		c.availabilityLedgers.Delete(key)
 		c.shootCareQueue.Done(key)
 		return nil
 	}
@@ -85,8 +94,8 @@ type CareControlInterface interface {
 // NewDefaultCareControl returns a new instance of the default implementation CareControlInterface that
 // implements the documented semantics for caring for Shoots. You should use an instance returned from NewDefaultCareControl()
 // for any scenario other than testing.
-func NewDefaultCareControl(k8sGardenClient kubernetes.Interface, k8sGardenCoreInformers gardencoreinformers.Interface, secrets map[string]*corev1.Secret, imageVector imagevector.ImageVector, identity *gardencorev1alpha1.Gardener, config *config.GardenletConfiguration) CareControlInterface {
-	return &defaultCareControl{k8sGardenClient, k8sGardenCoreInformers, secrets, imageVector, identity, config}
+func NewDefaultCareControl(k8sGardenClient kubernetes.Interface, k8sGardenCoreInformers gardencoreinformers.Interface, secrets map[string]*corev1.Secret, imageVector imagevector.ImageVector, identity *gardencorev1alpha1.Gardener, config *config.GardenletConfiguration, certificateExpirations *sync.Map, availabilityLedgers *botanistpkg.AvailabilityLedgers) CareControlInterface {
+	return &defaultCareControl{k8sGardenClient, k8sGardenCoreInformers, secrets, imageVector, identity, config, certificateExpirations, availabilityLedgers}
 }
 
 type defaultCareControl struct {
@@ -96,6 +105,8 @@ type defaultCareControl struct {
 	imageVector            imagevector.ImageVector
 	identity               *gardencorev1alpha1.Gardener
 	config                 *config.GardenletConfiguration
+	certificateExpirations *sync.Map
+	availabilityLedgers    *botanistpkg.AvailabilityLedgers
 }
 
 func (c *defaultCareControl) conditionThresholdsToProgressingMapping() map[gardencorev1alpha1.ConditionType]time.Duration {
@@ -106,6 +117,15 @@ func (c *defaultCareControl) conditionThresholdsToProgressingMapping() map[garde
 	return out
 }
 
+func (c *defaultCareControl) certificateExpirationThreshold() time.Duration {
+	return c.config.Controllers.ShootCare.CertificateExpirationThreshold.Duration
+}
+
+func (c *defaultCareControl) flappingDetection() (time.Duration, int, int) {
+	flappingDetection := c.config.Controllers.ShootCare.FlappingDetection
+	return flappingDetection.Window.Duration, *flappingDetection.Threshold, *flappingDetection.MaxTransitions
+}
+
 func shootClientInitializer(b *botanistpkg.Botanist) func() error {
 	var (
 		once sync.Once
@@ -139,6 +159,11 @@ func (c *defaultCareControl) Care(shootObj *gardencorev1alpha1.Shoot, key string
 		conditionControlPlaneHealthy     = gardencorev1alpha1helper.GetOrInitCondition(shoot.Status.Conditions, gardencorev1alpha1.ShootControlPlaneHealthy)
 		conditionEveryNodeReady          = gardencorev1alpha1helper.GetOrInitCondition(shoot.Status.Conditions, gardencorev1alpha1.ShootEveryNodeReady)
 		conditionSystemComponentsHealthy = gardencorev1alpha1helper.GetOrInitCondition(shoot.Status.Conditions, gardencorev1alpha1.ShootSystemComponentsHealthy)
+		conditionConditionsStable        = gardencorev1alpha1helper.GetOrInitCondition(shoot.Status.Conditions, gardencorev1alpha1.ShootConditionsStable)
+		conditionCertificatesValid       = gardencorev1alpha1helper.GetOrInitCondition(shoot.Status.Conditions, gardencorev1alpha1.ShootCertificatesValid)
+		conditionKubeletConfigInSync     = gardencorev1alpha1helper.GetOrInitCondition(shoot.Status.Conditions, gardencorev1alpha1.ShootKubeletConfigInSync)
+
+		kubeletConfigDrifts = shoot.Status.KubeletConfigDrifts
 
 		seedConditions []gardencorev1alpha1.Condition
 
@@ -152,6 +177,9 @@ func (c *defaultCareControl) Care(shootObj *gardencorev1alpha1.Shoot, key string
 		conditionControlPlaneHealthy = gardencorev1alpha1helper.UpdatedConditionUnknownErrorMessage(conditionControlPlaneHealthy, message)
 		conditionEveryNodeReady = gardencorev1alpha1helper.UpdatedConditionUnknownErrorMessage(conditionEveryNodeReady, message)
 		conditionSystemComponentsHealthy = gardencorev1alpha1helper.UpdatedConditionUnknownErrorMessage(conditionSystemComponentsHealthy, message)
+		conditionConditionsStable = gardencorev1alpha1helper.UpdatedConditionUnknownErrorMessage(conditionConditionsStable, message)
+		conditionCertificatesValid = gardencorev1alpha1helper.UpdatedConditionUnknownErrorMessage(conditionCertificatesValid, message)
+		conditionKubeletConfigInSync = gardencorev1alpha1helper.UpdatedConditionUnknownErrorMessage(conditionKubeletConfigInSync, message)
 
 		constraintHibernationPossible = gardencorev1alpha1helper.UpdatedConditionUnknownErrorMessage(constraintHibernationPossible, message)
 
@@ -163,10 +191,16 @@ func (c *defaultCareControl) Care(shootObj *gardencorev1alpha1.Shoot, key string
 				conditionControlPlaneHealthy,
 				conditionEveryNodeReady,
 				conditionSystemComponentsHealthy,
+				conditionConditionsStable,
+				conditionCertificatesValid,
+				conditionKubeletConfigInSync,
 			},
 			[]gardencorev1alpha1.Condition{
 				constraintHibernationPossible,
 			},
+			shoot.Status.ConditionHistories,
+			shoot.Status.Availability,
+			kubeletConfigDrifts,
 		)
 
 		return nil // We do not want to run in the exponential backoff for the condition checks.
@@ -200,8 +234,48 @@ func (c *defaultCareControl) Care(shootObj *gardencorev1alpha1.Shoot, key string
 			constraintHibernationPossible = botanist.ConstraintsChecks(ctx, initializeShootClients, constraintHibernationPossible)
 			return nil
 		},
+		// Check the expiration of the certificates generated for the shoot
+		func(ctx context.Context) error {
+			expiration, err := botanist.GetEarliestCertificateExpiration(ctx)
+			if err != nil {
+				conditionCertificatesValid = gardencorev1alpha1helper.UpdatedConditionUnknownError(conditionCertificatesValid, err)
+				// Do not expose an outdated expiration time if the certificates could not be checked.
+				c.recordCertificateExpiration(key, nil)
+				return nil
+			}
+			conditionCertificatesValid = botanistpkg.NewHealthChecker(c.conditionThresholdsToProgressingMapping()).CheckCertificateExpiration(conditionCertificatesValid, expiration, c.certificateExpirationThreshold(), botanistpkg.Now())
+			c.recordCertificateExpiration(key, expiration)
+			return nil
+		},
+		// Detect drifts between the desired and the effective kubelet configuration of the nodes
+		func(ctx context.Context) error {
+			conditionKubeletConfigInSync, kubeletConfigDrifts = botanist.KubeletConfigChecks(ctx, initializeShootClients, c.conditionThresholdsToProgressingMapping(), conditionKubeletConfigInSync)
+			return nil
+		},
 	)(context.TODO())
 
+	// Record the status transitions of the health conditions and check whether any of them is flapping
+	var (
+		window, threshold, maxTransitions = c.flappingDetection()
+		conditionHistories                = gardencorev1alpha1helper.UpdatedConditionHistories(
+			shoot.Status.ConditionHistories,
+			window,
+			maxTransitions,
+			conditionAPIServerAvailable,
+			conditionControlPlaneHealthy,
+			conditionEveryNodeReady,
+			conditionSystemComponentsHealthy,
+		)
+	)
+	conditionConditionsStable = botanistpkg.NewHealthChecker(c.conditionThresholdsToProgressingMapping()).CheckConditionsStable(conditionConditionsStable, conditionHistories, window, threshold)
+
+	// Record the API server availability in the availability ledger of the Shoot
+	availability, err := botanist.RecordAPIServerAvailability(context.TODO(), c.availabilityLedgers, conditionAPIServerAvailable)
+	if err != nil {
+		botanist.Logger.Errorf("Could not record API server availability: %+v", err)
+		availability = shoot.Status.Availability
+	}
+
 	// Update Shoot status
 	updatedShoot, err := c.updateShootStatus(shoot,
 		append(
@@ -210,12 +284,18 @@ func (c *defaultCareControl) Care(shootObj *gardencorev1alpha1.Shoot, key string
 				conditionControlPlaneHealthy,
 				conditionEveryNodeReady,
 				conditionSystemComponentsHealthy,
+				conditionConditionsStable,
+				conditionCertificatesValid,
+				conditionKubeletConfigInSync,
 			},
 			seedConditions...,
 		),
 		[]gardencorev1alpha1.Condition{
 			constraintHibernationPossible,
 		},
+		conditionHistories,
+		availability,
+		kubeletConfigDrifts,
 	)
 	if err != nil {
 		botanist.Logger.Errorf("Could not update Shoot status: %+v", err)
@@ -236,6 +316,8 @@ func (c *defaultCareControl) Care(shootObj *gardencorev1alpha1.Shoot, key string
 				conditionControlPlaneHealthy,
 				conditionEveryNodeReady,
 				conditionSystemComponentsHealthy,
+				conditionCertificatesValid,
+				conditionKubeletConfigInSync,
 			),
 		),
 	)
@@ -247,17 +329,88 @@ func (c *defaultCareControl) Care(shootObj *gardencorev1alpha1.Shoot, key string
 	return nil // We do not want to run in the exponential backoff for the condition checks.
 }
 
-func (c *defaultCareControl) updateShootStatus(shoot *gardencorev1alpha1.Shoot, conditions, constraints []gardencorev1alpha1.Condition) (*gardencorev1alpha1.Shoot, error) {
+func (c *defaultCareControl) updateShootStatus(shoot *gardencorev1alpha1.Shoot, conditions, constraints []gardencorev1alpha1.Condition, conditionHistories []gardencorev1alpha1.ConditionHistory, availability *gardencorev1alpha1.ShootAvailability, kubeletConfigDrifts []gardencorev1alpha1.NodeKubeletConfigDrift) (*gardencorev1alpha1.Shoot, error) {
 	newShoot, err := kutil.TryUpdateShootStatus(c.k8sGardenClient.GardenCore(), retry.DefaultBackoff, shoot.ObjectMeta,
 		func(shoot *gardencorev1alpha1.Shoot) (*gardencorev1alpha1.Shoot, error) {
 			shoot.Status.Conditions = conditions
 			shoot.Status.Constraints = constraints
+			shoot.Status.ConditionHistories = conditionHistories
+			shoot.Status.Availability = availability
+			shoot.Status.KubeletConfigDrifts = kubeletConfigDrifts
 			return shoot, nil
 		})
 
 	return newShoot, err
 }
 
+// recordCertificateExpiration remembers the expiration time of the certificate which expires first among all
+// certificates generated for the Shoot with the given <key>. It is exposed when the metrics are collected.
+func (c *defaultCareControl) recordCertificateExpiration(key string, expiration *secrets.CertificateExpiration) {
+	if expiration == nil {
+		c.certificateExpirations.Delete(key)
+		return
+	}
+	c.certificateExpirations.Store(key, expiration.NotAfter)
+}
+
+// collectShootCareMetrics sends the metrics derived from the care operations of the Shoots this gardenlet is
+// responsible for to the given channel.
+func (c *Controller) collectShootCareMetrics(ch chan<- prometheus.Metric) {
+	shoots, err := c.shootLister.List(labels.Everything())
+	if err != nil {
+		gardenlet.ScrapeFailures.With(prometheus.Labels{"kind": "shoot-care"}).Inc()
+		return
+	}
+
+	var (
+		shootFilterFunc = controllerutils.ShootFilterFunc(confighelper.SeedNameFromSeedConfig(c.config.SeedConfig), c.seedLister, c.config.SeedSelector)
+		window          = c.config.Controllers.ShootCare.FlappingDetection.Window.Duration
+	)
+
+	for _, shoot := range shoots {
+		if !shootFilterFunc(shoot) {
+			continue
+		}
+
+		for _, history := range shoot.Status.ConditionHistories {
+			collectShootCareGauge(ch, gardenlet.ShootConditionTransitions, float64(gardencorev1alpha1helper.CountConditionTransitions(history, window)), shoot.Name, shoot.Namespace, string(history.Type))
+		}
+
+		if shoot.Status.Availability != nil {
+			for _, ratio := range shoot.Status.Availability.APIServer {
+				value, err := strconv.ParseFloat(ratio.Ratio, 64)
+				if err != nil {
+					continue
+				}
+				collectShootCareGauge(ch, gardenlet.ShootAPIServerAvailability, value, shoot.Name, shoot.Namespace, availabilityPeriodLabel(ratio.Period.Duration))
+			}
+		}
+	}
+
+	c.certificateExpirations.Range(func(k, v interface{}) bool {
+		namespace, name, err := cache.SplitMetaNamespaceKey(k.(string))
+		if err != nil {
+			return true
+		}
+		collectShootCareGauge(ch, gardenlet.ShootCertificateExpiration, float64(v.(time.Time).Unix()), name, namespace)
+		return true
+	})
+}
+
+func collectShootCareGauge(ch chan<- prometheus.Metric, desc *prometheus.Desc, value float64, labelValues ...string) {
+	metric, err := prometheus.NewConstMetric(desc, prometheus.GaugeValue, value, labelValues...)
+	if err != nil {
+		logger.Logger.Errorf("Could not collect a shoot care metric: %+v", err)
+		gardenlet.ScrapeFailures.With(prometheus.Labels{"kind": "shoot-care"}).Inc()
+		return
+	}
+	ch <- metric
+}
+
+func availabilityPeriodLabel(period time.Duration) string {
+	return fmt.Sprintf("%dd", int(period.Hours()/24))
+}
+
 // garbageCollection cleans the Seed and the Shoot cluster from no longer required
 // objects. It receives a botanist object <botanist> which stores the Shoot object.
 func garbageCollection(initShootClients func() error, botanist *botanistpkg.Botanist) {
@@ -284,7 +284,8 @@ func (c *Controller) reconcileShoot(shoot *gardencorev1alpha1.Shoot, logger *log
 		reconcileInMaintenanceOnly                 = c.reconcileInMaintenanceOnly()
 		isUpToDate                                 = common.IsObservedAtLatestGenerationAndSucceeded(shoot)
 		isNowInEffectiveShootMaintenanceTimeWindow = common.IsNowInEffectiveShootMaintenanceTimeWindow(shoot)
-		reconcileAllowed                           = !reconcileInMaintenanceOnly || !isUpToDate || isNowInEffectiveShootMaintenanceTimeWindow
+		etcdEncryptionKeyRotationInProgress        = gardencorev1alpha1helper.IsShootETCDEncryptionKeyRotationInProgress(shoot.Status.Credentials)
+		reconcileAllowed                           = !reconcileInMaintenanceOnly || !isUpToDate || isNowInEffectiveShootMaintenanceTimeWindow || etcdEncryptionKeyRotationInProgress
 		allowedToUpdate                            = !failedOrIgnored && reconcileAllowed
 	)
 	// need retry logic, because the scheduler is acting on it at the same time and cached object might not be up to date
@@ -315,6 +316,7 @@ func (c *Controller) reconcileShoot(shoot *gardencorev1alpha1.Shoot, logger *log
 		"reconcileInMaintenanceOnly": reconcileInMaintenanceOnly,
 		"isUpToDate":                 isUpToDate,
 		"isNowInEffectiveShootMaintenanceTimeWindow": isNowInEffectiveShootMaintenanceTimeWindow,
+		"etcdEncryptionKeyRotationInProgress":        etcdEncryptionKeyRotationInProgress,
 		"reconcileAllowed":                           reconcileAllowed,
 		"allowedToUpdate":                            allowedToUpdate,
 	}).Info("Checking if Shoot can be reconciled")
@@ -359,6 +361,15 @@ func (c *Controller) reconcileShoot(shoot *gardencorev1alpha1.Shoot, logger *log
 		return reconcile.Result{}, utilerrors.WithSuppressed(fmt.Errorf("shoot %s/%s has not yet been scheduled on a Seed", shoot.Namespace, shoot.Name), c.updateShootStatusProcessing(shoot, message))
 	}
 
+	// On-demand operations on the etcd backup are executed before the regular reconciliation which then makes sure that
+	// the control plane is in the desired state again.
+	if shootOperation := shoot.Annotations[common.ShootOperation]; isETCDBackupOperation(shootOperation) {
+		if err := c.runETCDBackupOperation(o, shootOperation); err != nil {
+			return reconcile.Result{}, err
+		}
+		shoot = o.Shoot.Info
+	}
+
 	c.recorder.Event(shoot, corev1.EventTypeNormal, gardencorev1alpha1.EventReconciling, "Reconciling Shoot cluster state")
 	if err := c.updateShootStatusReconcileStart(o, operationType); err != nil {
 		return reconcile.Result{}, err
@@ -374,6 +385,13 @@ func (c *Controller) reconcileShoot(shoot *gardencorev1alpha1.Shoot, logger *log
 		return reconcile.Result{}, err
 	}
 
+	// The etcd encryption key rotation requires several reconciliations which are triggered right away. Hibernated
+	// Shoots do not proceed as their secrets cannot be rewritten.
+	if gardencorev1alpha1helper.IsShootETCDEncryptionKeyRotationInProgress(o.Shoot.Info.Status.Credentials) && !o.Shoot.HibernationEnabled {
+		c.recorder.Event(shoot, corev1.EventTypeNormal, "ScheduledNextSync", "Scheduled next queuing time for Shoot immediately to continue the etcd encryption key rotation")
+		return reconcile.Result{Requeue: true}, nil
+	}
+
 	durationUntilNextSync := c.durationUntilNextShootSync(shoot)
 	message := fmt.Sprintf("Scheduled next queuing time for Shoot in %s (%s)", durationUntilNextSync, time.Now().UTC().Add(durationUntilNextSync))
 	c.recorder.Event(shoot, corev1.EventTypeNormal, "ScheduledNextSync", message)
@@ -0,0 +1,245 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shoot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
+	"github.com/gardener/gardener/pkg/operation"
+	botanistpkg "github.com/gardener/gardener/pkg/operation/botanist"
+	"github.com/gardener/gardener/pkg/operation/common"
+	"github.com/gardener/gardener/pkg/utils/flow"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+)
+
+// isETCDBackupOperation returns true if the given operation annotation value requests an on-demand operation on the
+// etcd backup of a Shoot.
+func isETCDBackupOperation(shootOperation string) bool {
+	return shootOperation == common.ShootOperationTakeETCDSnapshot || shootOperation == common.ShootOperationRestoreETCD
+}
+
+// runETCDBackupOperation takes a full snapshot of the etcd of the Shoot or restores it from its backup, depending on the
+// given operation. The operation annotation is only removed once the operation has succeeded or if it is not possible
+// at all, otherwise the operation is retried with the next reconciliation of the Shoot. The progress and the result are
+// reported in the Shoot status, hence, an error is only returned if the Shoot could not be updated.
+func (c *Controller) runETCDBackupOperation(o *operation.Operation, shootOperation string) error {
+	restore := shootOperation == common.ShootOperationRestoreETCD
+
+	if err := c.updateShootStatusETCDBackupOperation(o, restore, func(op *gardencorev1alpha1.ETCDBackupOperation) {
+		op.State = gardencorev1alpha1.LastOperationStateProcessing
+		op.Description = "Operation on the etcd backup has been requested"
+		op.Progress = 0
+		op.Snapshot = nil
+	}); err != nil {
+		return err
+	}
+
+	botanist, err := botanistpkg.New(o)
+	if err != nil {
+		return c.updateShootStatusETCDBackupOperationError(o, restore, err)
+	}
+	if err := botanist.ETCDBackupOperationAllowed(restore); err != nil {
+		if err := c.removeETCDBackupOperationAnnotation(o); err != nil {
+			return err
+		}
+		return c.updateShootStatusETCDBackupOperationError(o, restore, err)
+	}
+
+	snapshot, err := c.runETCDBackupOperationFlow(o, botanist, restore)
+	if err != nil {
+		return c.updateShootStatusETCDBackupOperationError(o, restore, err)
+	}
+
+	if err := c.removeETCDBackupOperationAnnotation(o); err != nil {
+		return err
+	}
+
+	description := "Full snapshot of etcd has been taken"
+	if restore {
+		description = "Etcd has been restored to the latest state of its backup"
+	}
+
+	c.recorder.Event(o.Shoot.Info, corev1.EventTypeNormal, "ETCDBackupOperationSucceeded", description)
+	return c.updateShootStatusETCDBackupOperation(o, restore, func(op *gardencorev1alpha1.ETCDBackupOperation) {
+		op.State = gardencorev1alpha1.LastOperationStateSucceeded
+		op.Description = description
+		op.Progress = 100
+		op.Snapshot = snapshot
+	})
+}
+
+// runETCDBackupOperationFlow runs the flow of the given operation and returns the name of the full snapshot which has
+// been taken. When the etcd is restored, this is the final snapshot of the replaced etcd. If the restoration fails, etcd
+// and the control plane are scaled up again so that the Shoot does not stay without a control plane.
+func (c *Controller) runETCDBackupOperationFlow(o *operation.Operation, botanist *botanistpkg.Botanist, restore bool) (*string, error) {
+
+	var (
+		defaultInterval = 5 * time.Second
+		snapshot        *string
+		g               *flow.Graph
+	)
+
+	if !restore {
+		g = flow.NewGraph("Shoot etcd snapshot")
+		waitUntilEtcdMainReady := g.Add(flow.Task{
+			Name: "Waiting until etcd-main is ready",
+			Fn:   botanist.WaitUntilEtcdMainReady,
+		})
+		_ = g.Add(flow.Task{
+			Name: "Taking full snapshot of etcd-main",
+			Fn: func(ctx context.Context) error {
+				name, err := botanist.TakeETCDMainSnapshot(ctx)
+				if err != nil {
+					return err
+				}
+				snapshot = &name
+				return nil
+			},
+			Dependencies: flow.NewTaskIDs(waitUntilEtcdMainReady),
+		})
+	} else {
+		g = flow.NewGraph("Shoot etcd restoration")
+		scaleDownControlPlane := g.Add(flow.Task{
+			Name: "Scaling down the control plane",
+			Fn:   flow.TaskFn(botanist.ScaleDownControlPlane).RetryUntilTimeout(defaultInterval, time.Minute),
+		})
+		waitUntilEtcdMainReady := g.Add(flow.Task{
+			Name:         "Waiting until etcd-main is ready",
+			Fn:           botanist.WaitUntilEtcdMainReady,
+			Dependencies: flow.NewTaskIDs(scaleDownControlPlane),
+		})
+		takeFinalETCDMainSnapshot := g.Add(flow.Task{
+			Name: "Taking and verifying a final full snapshot of etcd-main",
+			Fn: func(ctx context.Context) error {
+				name, err := botanist.TakeETCDMainSnapshot(ctx)
+				if err != nil {
+					return err
+				}
+				if err := botanist.VerifyETCDMainSnapshot(ctx, name); err != nil {
+					return err
+				}
+				snapshot = &name
+				return nil
+			},
+			Dependencies: flow.NewTaskIDs(waitUntilEtcdMainReady),
+		})
+		detachETCDMainVolume := g.Add(flow.Task{
+			Name:         "Detaching and retaining the data volume of etcd-main",
+			Fn:           botanist.DetachETCDMainVolume,
+			Dependencies: flow.NewTaskIDs(takeFinalETCDMainSnapshot),
+		})
+		scaleUpETCDMain := g.Add(flow.Task{
+			Name:         "Restoring etcd-main into a fresh volume",
+			Fn:           flow.TaskFn(botanist.ScaleUpETCDMain).RetryUntilTimeout(defaultInterval, time.Minute),
+			Dependencies: flow.NewTaskIDs(detachETCDMainVolume),
+		})
+		waitUntilEtcdMainRestored := g.Add(flow.Task{
+			Name:         "Waiting until etcd-main has been restored",
+			Fn:           flow.TaskFn(botanist.WaitUntilEtcdMainReady).RetryUntilTimeout(defaultInterval, 30*time.Minute),
+			Dependencies: flow.NewTaskIDs(scaleUpETCDMain),
+		})
+		_ = g.Add(flow.Task{
+			Name:         "Scaling up the control plane",
+			Fn:           flow.TaskFn(botanist.WakeUpControlPlane).RetryUntilTimeout(defaultInterval, 5*time.Minute),
+			Dependencies: flow.NewTaskIDs(waitUntilEtcdMainRestored),
+		})
+	}
+
+	f := g.Compile()
+	if err := f.Run(flow.Opts{Logger: o.Logger, ProgressReporter: c.reportETCDBackupOperationProgress(o, restore)}); err != nil {
+		flowErr := errors.New(gardencorev1alpha1helper.FormatLastErrDescription(err))
+		if restore {
+			o.Logger.Info("Scaling up etcd-main and the control plane again after the failed restoration")
+			if err := flow.TaskFn(botanist.WakeUpControlPlane).RetryUntilTimeout(defaultInterval, 30*time.Minute)(context.TODO()); err != nil {
+				return nil, fmt.Errorf("%v (the control plane could not be scaled up again: %v)", flowErr, err)
+			}
+		}
+		return nil, flowErr
+	}
+
+	o.Logger.Info("Successfully finished the operation on the etcd backup")
+	return snapshot, nil
+}
+
+// removeETCDBackupOperationAnnotation removes the operation annotation from the Shoot so that the operation is not
+// repeated.
+func (c *Controller) removeETCDBackupOperationAnnotation(o *operation.Operation) error {
+	newShoot, err := kutil.TryUpdateShootAnnotations(c.k8sGardenClient.GardenCore(), retry.DefaultRetry, o.Shoot.Info.ObjectMeta,
+		func(shoot *gardencorev1alpha1.Shoot) (*gardencorev1alpha1.Shoot, error) {
+			delete(shoot.Annotations, common.ShootOperation)
+			return shoot, nil
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	o.Shoot.Info = newShoot
+	return nil
+}
+
+func (c *Controller) updateShootStatusETCDBackupOperationError(o *operation.Operation, restore bool, err error) error {
+	c.recorder.Event(o.Shoot.Info, corev1.EventTypeWarning, "ETCDBackupOperationError", err.Error())
+	return c.updateShootStatusETCDBackupOperation(o, restore, func(op *gardencorev1alpha1.ETCDBackupOperation) {
+		op.State = gardencorev1alpha1.LastOperationStateFailed
+		op.Description = err.Error()
+	})
+}
+
+func (c *Controller) reportETCDBackupOperationProgress(o *operation.Operation, restore bool) func(context.Context, *flow.Stats) {
+	return func(_ context.Context, stats *flow.Stats) {
+		if err := c.updateShootStatusETCDBackupOperation(o, restore, func(op *gardencorev1alpha1.ETCDBackupOperation) {
+			op.Description = strings.Join(stats.Running.StringList(), ", ")
+			op.Progress = stats.ProgressPercent()
+		}); err != nil {
+			o.Logger.Errorf("Could not report progress of the operation on the etcd backup: %v", err)
+		}
+	}
+}
+
+func (c *Controller) updateShootStatusETCDBackupOperation(o *operation.Operation, restore bool, mutate func(*gardencorev1alpha1.ETCDBackupOperation)) error {
+	newShoot, err := kutil.TryUpdateShootStatus(c.k8sGardenClient.GardenCore(), retry.DefaultRetry, o.Shoot.Info.ObjectMeta,
+		func(shoot *gardencorev1alpha1.Shoot) (*gardencorev1alpha1.Shoot, error) {
+			gardencorev1alpha1helper.MutateShootETCDBackup(shoot, func(backup *gardencorev1alpha1.ShootETCDBackup) {
+				op := &backup.LastSnapshot
+				if restore {
+					op = &backup.LastRestore
+				}
+				if *op == nil {
+					*op = &gardencorev1alpha1.ETCDBackupOperation{}
+				}
+
+				mutate(*op)
+				(*op).LastUpdateTime = metav1.Now()
+			})
+			return shoot, nil
+		})
+	if err != nil {
+		return err
+	}
+
+	o.Shoot.Info = newShoot
+	return nil
+}
@@ -399,6 +399,28 @@ func (c *Controller) updateShootStatusReconcileSuccess(o *operation.Operation, o
 			shoot.Status.RetryCycleStartTime = nil
 			shoot.Status.Seed = &o.Seed.Info.Name
 			shoot.Status.IsHibernated = o.Shoot.HibernationEnabled
+
+			// All components have been rolled with the prepared or completed certificate authorities.
+			switch gardencorev1alpha1helper.GetShootCARotationPhase(shoot.Status.Credentials) {
+			case gardencorev1alpha1.RotationPreparing:
+				gardencorev1alpha1helper.MutateShootCARotation(shoot, func(rotation *gardencorev1alpha1.CARotation) {
+					rotation.Phase = gardencorev1alpha1.RotationPrepared
+				})
+			case gardencorev1alpha1.RotationCompleting:
+				gardencorev1alpha1helper.MutateShootCARotation(shoot, func(rotation *gardencorev1alpha1.CARotation) {
+					now := metav1.Now()
+					rotation.Phase = gardencorev1alpha1.RotationCompleted
+					rotation.LastCompletionTime = &now
+				})
+			}
+
+			// All kube-apiservers have been rolled with the new etcd encryption key.
+			if gardencorev1alpha1helper.GetShootETCDEncryptionKeyRotationPhase(shoot.Status.Credentials) == gardencorev1alpha1.RotationPreparing {
+				gardencorev1alpha1helper.MutateShootETCDEncryptionKeyRotation(shoot, func(rotation *gardencorev1alpha1.ETCDEncryptionKeyRotation) {
+					rotation.Phase = gardencorev1alpha1.RotationPrepared
+				})
+			}
+
 			shoot.Status.LastErrors = nil
 			shoot.Status.LastError = nil
 			shoot.Status.LastOperation = &gardencorev1alpha1.LastOperation{
@@ -16,6 +16,8 @@ package gardenlet
 
 import (
 	gardenmetrics "github.com/gardener/gardener/pkg/controllerutils/metrics"
+
+	"github.com/prometheus/client_golang/prometheus"
 )
 
 var (
@@ -24,4 +26,40 @@ var (
 
 	// ScrapeFailures is a metric descriptor which counts the amount scrape issues grouped by kind.
 	ScrapeFailures = gardenmetrics.NewCounterVec("gardenlet_scrape_failure_total", "Total count of scraping failures, grouped by kind/group of metric(s)")
+
+	// ShootConditionTransitions is a metric descriptor which collects the number of status transitions of a Shoot
+	// condition within the flapping detection window.
+	ShootConditionTransitions = prometheus.NewDesc(
+		"gardenlet_shoot_condition_transitions",
+		"Number of status transitions of a shoot condition within the flapping detection window",
+		[]string{"name", "namespace", "condition"},
+		nil,
+	)
+
+	// ShootAPIServerAvailability is a metric descriptor which collects the availability ratio of a Shoot's API server
+	// in percent over a certain period.
+	ShootAPIServerAvailability = prometheus.NewDesc(
+		"gardenlet_shoot_apiserver_availability_ratio",
+		"Availability ratio of a shoot API server in percent over a certain period, derived from the shoot health checks",
+		[]string{"name", "namespace", "period"},
+		nil,
+	)
+
+	// ShootCertificateExpiration is a metric descriptor which collects the expiration time of the certificate which
+	// expires first among all certificates generated for a Shoot.
+	ShootCertificateExpiration = prometheus.NewDesc(
+		"gardenlet_shoot_certificate_expiration_timestamp_seconds",
+		"Expiration time of the certificate which expires first among all certificates generated for a shoot, in seconds since the epoch",
+		[]string{"name", "namespace"},
+		nil,
+	)
+
+	// SeedCertificateExpiration is a metric descriptor which collects the expiration time of the certificate which
+	// expires first among all certificates generated for a Seed.
+	SeedCertificateExpiration = prometheus.NewDesc(
+		"gardenlet_seed_certificate_expiration_timestamp_seconds",
+		"Expiration time of the certificate which expires first among all certificates generated for a seed, in seconds since the epoch",
+		[]string{"name"},
+		nil,
+	)
 )
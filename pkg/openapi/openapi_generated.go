@@ -38,9 +38,17 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.Addon":                                 schema_pkg_apis_core_v1alpha1_Addon(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.Addons":                                schema_pkg_apis_core_v1alpha1_Addons(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.AdmissionPlugin":                       schema_pkg_apis_core_v1alpha1_AdmissionPlugin(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.AdmissionWebhookConfig":                schema_pkg_apis_core_v1alpha1_AdmissionWebhookConfig(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.Alerting":                              schema_pkg_apis_core_v1alpha1_Alerting(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.AuditBackend":                          schema_pkg_apis_core_v1alpha1_AuditBackend(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.AuditBuffering":                        schema_pkg_apis_core_v1alpha1_AuditBuffering(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.AuditConfig":                           schema_pkg_apis_core_v1alpha1_AuditConfig(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.AuditLogBackend":                       schema_pkg_apis_core_v1alpha1_AuditLogBackend(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.AuditPolicy":                           schema_pkg_apis_core_v1alpha1_AuditPolicy(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.AuditWebhookBackend":                   schema_pkg_apis_core_v1alpha1_AuditWebhookBackend(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.AuthenticationWebhook":                 schema_pkg_apis_core_v1alpha1_AuthenticationWebhook(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.AuthorizationWebhook":                  schema_pkg_apis_core_v1alpha1_AuthorizationWebhook(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.AvailabilityRatio":                     schema_pkg_apis_core_v1alpha1_AvailabilityRatio(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.AvailabilityZone":                      schema_pkg_apis_core_v1alpha1_AvailabilityZone(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.BackupBucket":                          schema_pkg_apis_core_v1alpha1_BackupBucket(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.BackupBucketList":                      schema_pkg_apis_core_v1alpha1_BackupBucketList(ref),
@@ -51,6 +59,9 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.BackupEntryList":                       schema_pkg_apis_core_v1alpha1_BackupEntryList(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.BackupEntrySpec":                       schema_pkg_apis_core_v1alpha1_BackupEntrySpec(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.BackupEntryStatus":                     schema_pkg_apis_core_v1alpha1_BackupEntryStatus(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.BackupRetentionPolicy":                 schema_pkg_apis_core_v1alpha1_BackupRetentionPolicy(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.CARotation":                            schema_pkg_apis_core_v1alpha1_CARotation(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.CRI":                                   schema_pkg_apis_core_v1alpha1_CRI(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.CloudInfo":                             schema_pkg_apis_core_v1alpha1_CloudInfo(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.CloudProfile":                          schema_pkg_apis_core_v1alpha1_CloudProfile(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.CloudProfileList":                      schema_pkg_apis_core_v1alpha1_CloudProfileList(ref),
@@ -58,6 +69,9 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ClusterAutoscaler":                     schema_pkg_apis_core_v1alpha1_ClusterAutoscaler(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ClusterInfo":                           schema_pkg_apis_core_v1alpha1_ClusterInfo(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.Condition":                             schema_pkg_apis_core_v1alpha1_Condition(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ConditionHistory":                      schema_pkg_apis_core_v1alpha1_ConditionHistory(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ConditionTransition":                   schema_pkg_apis_core_v1alpha1_ConditionTransition(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ControlPlane":                          schema_pkg_apis_core_v1alpha1_ControlPlane(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ControllerDeployment":                  schema_pkg_apis_core_v1alpha1_ControllerDeployment(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ControllerInstallation":                schema_pkg_apis_core_v1alpha1_ControllerInstallation(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ControllerInstallationList":            schema_pkg_apis_core_v1alpha1_ControllerInstallationList(ref),
@@ -70,24 +84,33 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.DNS":                                   schema_pkg_apis_core_v1alpha1_DNS(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.DNSIncludeExclude":                     schema_pkg_apis_core_v1alpha1_DNSIncludeExclude(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.DNSProvider":                           schema_pkg_apis_core_v1alpha1_DNSProvider(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ETCDBackupOperation":                   schema_pkg_apis_core_v1alpha1_ETCDBackupOperation(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ETCDEncryptionKeyRotation":             schema_pkg_apis_core_v1alpha1_ETCDEncryptionKeyRotation(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.EncryptionConfig":                      schema_pkg_apis_core_v1alpha1_EncryptionConfig(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.Endpoint":                              schema_pkg_apis_core_v1alpha1_Endpoint(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ExpirableVersion":                      schema_pkg_apis_core_v1alpha1_ExpirableVersion(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.Extension":                             schema_pkg_apis_core_v1alpha1_Extension(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ExtensionResourceState":                schema_pkg_apis_core_v1alpha1_ExtensionResourceState(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.FailureTolerance":                      schema_pkg_apis_core_v1alpha1_FailureTolerance(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.Gardener":                              schema_pkg_apis_core_v1alpha1_Gardener(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.GardenerDuration":                      schema_pkg_apis_core_v1alpha1_GardenerDuration(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.GardenerResourceData":                  schema_pkg_apis_core_v1alpha1_GardenerResourceData(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.Hibernation":                           schema_pkg_apis_core_v1alpha1_Hibernation(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.HibernationSchedule":                   schema_pkg_apis_core_v1alpha1_HibernationSchedule(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.HighAvailability":                      schema_pkg_apis_core_v1alpha1_HighAvailability(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.HorizontalPodAutoscalerConfig":         schema_pkg_apis_core_v1alpha1_HorizontalPodAutoscalerConfig(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.KMSProvider":                           schema_pkg_apis_core_v1alpha1_KMSProvider(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.KubeAPIServerConfig":                   schema_pkg_apis_core_v1alpha1_KubeAPIServerConfig(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.KubeControllerManagerConcurrentSyncs":  schema_pkg_apis_core_v1alpha1_KubeControllerManagerConcurrentSyncs(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.KubeControllerManagerConfig":           schema_pkg_apis_core_v1alpha1_KubeControllerManagerConfig(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.KubeProxyConfig":                       schema_pkg_apis_core_v1alpha1_KubeProxyConfig(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.KubeSchedulerConfig":                   schema_pkg_apis_core_v1alpha1_KubeSchedulerConfig(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.KubeconfigInfo":                        schema_pkg_apis_core_v1alpha1_KubeconfigInfo(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.KubeletConfig":                         schema_pkg_apis_core_v1alpha1_KubeletConfig(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.KubeletConfigEviction":                 schema_pkg_apis_core_v1alpha1_KubeletConfigEviction(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.KubeletConfigEvictionMinimumReclaim":   schema_pkg_apis_core_v1alpha1_KubeletConfigEvictionMinimumReclaim(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.KubeletConfigEvictionSoftGracePeriod":  schema_pkg_apis_core_v1alpha1_KubeletConfigEvictionSoftGracePeriod(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.KubeletConfigFieldDrift":               schema_pkg_apis_core_v1alpha1_KubeletConfigFieldDrift(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.Kubernetes":                            schema_pkg_apis_core_v1alpha1_Kubernetes(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.KubernetesConfig":                      schema_pkg_apis_core_v1alpha1_KubernetesConfig(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.KubernetesDashboard":                   schema_pkg_apis_core_v1alpha1_KubernetesDashboard(ref),
@@ -103,8 +126,13 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.MaintenanceAutoUpdate":                 schema_pkg_apis_core_v1alpha1_MaintenanceAutoUpdate(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.MaintenanceTimeWindow":                 schema_pkg_apis_core_v1alpha1_MaintenanceTimeWindow(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.Monitoring":                            schema_pkg_apis_core_v1alpha1_Monitoring(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.NetworkPolicyRule":                     schema_pkg_apis_core_v1alpha1_NetworkPolicyRule(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.NetworkPolicyRulePort":                 schema_pkg_apis_core_v1alpha1_NetworkPolicyRulePort(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.NetworkPool":                           schema_pkg_apis_core_v1alpha1_NetworkPool(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.Networking":                            schema_pkg_apis_core_v1alpha1_Networking(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.NginxIngress":                          schema_pkg_apis_core_v1alpha1_NginxIngress(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.NodeKubeletConfigDrift":                schema_pkg_apis_core_v1alpha1_NodeKubeletConfigDrift(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.NodesInfo":                             schema_pkg_apis_core_v1alpha1_NodesInfo(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.OIDCConfig":                            schema_pkg_apis_core_v1alpha1_OIDCConfig(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.OpenIDConnectClientAuthentication":     schema_pkg_apis_core_v1alpha1_OpenIDConnectClientAuthentication(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.Plant":                                 schema_pkg_apis_core_v1alpha1_Plant(ref),
@@ -122,6 +150,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.QuotaList":                             schema_pkg_apis_core_v1alpha1_QuotaList(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.QuotaSpec":                             schema_pkg_apis_core_v1alpha1_QuotaSpec(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.Region":                                schema_pkg_apis_core_v1alpha1_Region(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.SecondaryBackupLocation":               schema_pkg_apis_core_v1alpha1_SecondaryBackupLocation(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.SecretBinding":                         schema_pkg_apis_core_v1alpha1_SecretBinding(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.SecretBindingList":                     schema_pkg_apis_core_v1alpha1_SecretBindingList(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.Seed":                                  schema_pkg_apis_core_v1alpha1_Seed(ref),
@@ -137,24 +166,42 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.SeedVolumeProvider":                    schema_pkg_apis_core_v1alpha1_SeedVolumeProvider(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ServiceAccountConfig":                  schema_pkg_apis_core_v1alpha1_ServiceAccountConfig(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.Shoot":                                 schema_pkg_apis_core_v1alpha1_Shoot(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ShootAvailability":                     schema_pkg_apis_core_v1alpha1_ShootAvailability(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ShootBackup":                           schema_pkg_apis_core_v1alpha1_ShootBackup(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ShootCredentials":                      schema_pkg_apis_core_v1alpha1_ShootCredentials(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ShootCredentialsRotation":              schema_pkg_apis_core_v1alpha1_ShootCredentialsRotation(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ShootETCDBackup":                       schema_pkg_apis_core_v1alpha1_ShootETCDBackup(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ShootList":                             schema_pkg_apis_core_v1alpha1_ShootList(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ShootMachineImage":                     schema_pkg_apis_core_v1alpha1_ShootMachineImage(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ShootNetworkPools":                     schema_pkg_apis_core_v1alpha1_ShootNetworkPools(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ShootNetworks":                         schema_pkg_apis_core_v1alpha1_ShootNetworks(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ShootSpec":                             schema_pkg_apis_core_v1alpha1_ShootSpec(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ShootState":                            schema_pkg_apis_core_v1alpha1_ShootState(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ShootStateList":                        schema_pkg_apis_core_v1alpha1_ShootStateList(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ShootStateSpec":                        schema_pkg_apis_core_v1alpha1_ShootStateSpec(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ShootStatus":                           schema_pkg_apis_core_v1alpha1_ShootStatus(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.StaticCredentialsRotation":             schema_pkg_apis_core_v1alpha1_StaticCredentialsRotation(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.StaticCredentialsRotationConfig":       schema_pkg_apis_core_v1alpha1_StaticCredentialsRotationConfig(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.Volume":                                schema_pkg_apis_core_v1alpha1_Volume(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.VolumeType":                            schema_pkg_apis_core_v1alpha1_VolumeType(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.Worker":                                schema_pkg_apis_core_v1alpha1_Worker(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.WorkerKubernetes":                      schema_pkg_apis_core_v1alpha1_WorkerKubernetes(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.WorkloadHealthInfo":                    schema_pkg_apis_core_v1alpha1_WorkloadHealthInfo(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.WorkloadsInfo":                         schema_pkg_apis_core_v1alpha1_WorkloadsInfo(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Addon":                                  schema_pkg_apis_core_v1beta1_Addon(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Addons":                                 schema_pkg_apis_core_v1beta1_Addons(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.AdmissionPlugin":                        schema_pkg_apis_core_v1beta1_AdmissionPlugin(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.AdmissionWebhookConfig":                 schema_pkg_apis_core_v1beta1_AdmissionWebhookConfig(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Alerting":                               schema_pkg_apis_core_v1beta1_Alerting(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.AuditBackend":                           schema_pkg_apis_core_v1beta1_AuditBackend(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.AuditBuffering":                         schema_pkg_apis_core_v1beta1_AuditBuffering(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.AuditConfig":                            schema_pkg_apis_core_v1beta1_AuditConfig(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.AuditLogBackend":                        schema_pkg_apis_core_v1beta1_AuditLogBackend(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.AuditPolicy":                            schema_pkg_apis_core_v1beta1_AuditPolicy(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.AuditWebhookBackend":                    schema_pkg_apis_core_v1beta1_AuditWebhookBackend(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.AuthenticationWebhook":                  schema_pkg_apis_core_v1beta1_AuthenticationWebhook(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.AuthorizationWebhook":                   schema_pkg_apis_core_v1beta1_AuthorizationWebhook(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.AvailabilityRatio":                      schema_pkg_apis_core_v1beta1_AvailabilityRatio(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.AvailabilityZone":                       schema_pkg_apis_core_v1beta1_AvailabilityZone(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.BackupBucket":                           schema_pkg_apis_core_v1beta1_BackupBucket(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.BackupBucketList":                       schema_pkg_apis_core_v1beta1_BackupBucketList(ref),
@@ -165,6 +212,9 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.BackupEntryList":                        schema_pkg_apis_core_v1beta1_BackupEntryList(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.BackupEntrySpec":                        schema_pkg_apis_core_v1beta1_BackupEntrySpec(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.BackupEntryStatus":                      schema_pkg_apis_core_v1beta1_BackupEntryStatus(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.BackupRetentionPolicy":                  schema_pkg_apis_core_v1beta1_BackupRetentionPolicy(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.CARotation":                             schema_pkg_apis_core_v1beta1_CARotation(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.CRI":                                    schema_pkg_apis_core_v1beta1_CRI(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.CloudInfo":                              schema_pkg_apis_core_v1beta1_CloudInfo(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.CloudProfile":                           schema_pkg_apis_core_v1beta1_CloudProfile(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.CloudProfileList":                       schema_pkg_apis_core_v1beta1_CloudProfileList(ref),
@@ -172,6 +222,9 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ClusterAutoscaler":                      schema_pkg_apis_core_v1beta1_ClusterAutoscaler(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ClusterInfo":                            schema_pkg_apis_core_v1beta1_ClusterInfo(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Condition":                              schema_pkg_apis_core_v1beta1_Condition(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ConditionHistory":                       schema_pkg_apis_core_v1beta1_ConditionHistory(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ConditionTransition":                    schema_pkg_apis_core_v1beta1_ConditionTransition(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ControlPlane":                           schema_pkg_apis_core_v1beta1_ControlPlane(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ControllerDeployment":                   schema_pkg_apis_core_v1beta1_ControllerDeployment(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ControllerInstallation":                 schema_pkg_apis_core_v1beta1_ControllerInstallation(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ControllerInstallationList":             schema_pkg_apis_core_v1beta1_ControllerInstallationList(ref),
@@ -184,22 +237,31 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.DNS":                                    schema_pkg_apis_core_v1beta1_DNS(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.DNSIncludeExclude":                      schema_pkg_apis_core_v1beta1_DNSIncludeExclude(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.DNSProvider":                            schema_pkg_apis_core_v1beta1_DNSProvider(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ETCDBackupOperation":                    schema_pkg_apis_core_v1beta1_ETCDBackupOperation(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ETCDEncryptionKeyRotation":              schema_pkg_apis_core_v1beta1_ETCDEncryptionKeyRotation(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.EncryptionConfig":                       schema_pkg_apis_core_v1beta1_EncryptionConfig(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Endpoint":                               schema_pkg_apis_core_v1beta1_Endpoint(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ExpirableVersion":                       schema_pkg_apis_core_v1beta1_ExpirableVersion(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Extension":                              schema_pkg_apis_core_v1beta1_Extension(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.FailureTolerance":                       schema_pkg_apis_core_v1beta1_FailureTolerance(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Gardener":                               schema_pkg_apis_core_v1beta1_Gardener(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.GardenerDuration":                       schema_pkg_apis_core_v1beta1_GardenerDuration(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Hibernation":                            schema_pkg_apis_core_v1beta1_Hibernation(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.HibernationSchedule":                    schema_pkg_apis_core_v1beta1_HibernationSchedule(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.HighAvailability":                       schema_pkg_apis_core_v1beta1_HighAvailability(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.HorizontalPodAutoscalerConfig":          schema_pkg_apis_core_v1beta1_HorizontalPodAutoscalerConfig(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.KMSProvider":                            schema_pkg_apis_core_v1beta1_KMSProvider(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeAPIServerConfig":                    schema_pkg_apis_core_v1beta1_KubeAPIServerConfig(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeControllerManagerConcurrentSyncs":   schema_pkg_apis_core_v1beta1_KubeControllerManagerConcurrentSyncs(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeControllerManagerConfig":            schema_pkg_apis_core_v1beta1_KubeControllerManagerConfig(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeProxyConfig":                        schema_pkg_apis_core_v1beta1_KubeProxyConfig(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeSchedulerConfig":                    schema_pkg_apis_core_v1beta1_KubeSchedulerConfig(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeconfigInfo":                         schema_pkg_apis_core_v1beta1_KubeconfigInfo(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeletConfig":                          schema_pkg_apis_core_v1beta1_KubeletConfig(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeletConfigEviction":                  schema_pkg_apis_core_v1beta1_KubeletConfigEviction(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeletConfigEvictionMinimumReclaim":    schema_pkg_apis_core_v1beta1_KubeletConfigEvictionMinimumReclaim(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeletConfigEvictionSoftGracePeriod":   schema_pkg_apis_core_v1beta1_KubeletConfigEvictionSoftGracePeriod(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeletConfigFieldDrift":                schema_pkg_apis_core_v1beta1_KubeletConfigFieldDrift(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Kubernetes":                             schema_pkg_apis_core_v1beta1_Kubernetes(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.KubernetesConfig":                       schema_pkg_apis_core_v1beta1_KubernetesConfig(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.KubernetesDashboard":                    schema_pkg_apis_core_v1beta1_KubernetesDashboard(ref),
@@ -215,8 +277,13 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.MaintenanceAutoUpdate":                  schema_pkg_apis_core_v1beta1_MaintenanceAutoUpdate(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.MaintenanceTimeWindow":                  schema_pkg_apis_core_v1beta1_MaintenanceTimeWindow(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Monitoring":                             schema_pkg_apis_core_v1beta1_Monitoring(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.NetworkPolicyRule":                      schema_pkg_apis_core_v1beta1_NetworkPolicyRule(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.NetworkPolicyRulePort":                  schema_pkg_apis_core_v1beta1_NetworkPolicyRulePort(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.NetworkPool":                            schema_pkg_apis_core_v1beta1_NetworkPool(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Networking":                             schema_pkg_apis_core_v1beta1_Networking(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.NginxIngress":                           schema_pkg_apis_core_v1beta1_NginxIngress(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.NodeKubeletConfigDrift":                 schema_pkg_apis_core_v1beta1_NodeKubeletConfigDrift(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.NodesInfo":                              schema_pkg_apis_core_v1beta1_NodesInfo(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.OIDCConfig":                             schema_pkg_apis_core_v1beta1_OIDCConfig(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.OpenIDConnectClientAuthentication":      schema_pkg_apis_core_v1beta1_OpenIDConnectClientAuthentication(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Plant":                                  schema_pkg_apis_core_v1beta1_Plant(ref),
@@ -234,6 +301,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.QuotaList":                              schema_pkg_apis_core_v1beta1_QuotaList(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.QuotaSpec":                              schema_pkg_apis_core_v1beta1_QuotaSpec(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Region":                                 schema_pkg_apis_core_v1beta1_Region(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SecondaryBackupLocation":                schema_pkg_apis_core_v1beta1_SecondaryBackupLocation(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SecretBinding":                          schema_pkg_apis_core_v1beta1_SecretBinding(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SecretBindingList":                      schema_pkg_apis_core_v1beta1_SecretBindingList(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Seed":                                   schema_pkg_apis_core_v1beta1_Seed(ref),
@@ -249,15 +317,25 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedVolumeProvider":                     schema_pkg_apis_core_v1beta1_SeedVolumeProvider(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ServiceAccountConfig":                   schema_pkg_apis_core_v1beta1_ServiceAccountConfig(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Shoot":                                  schema_pkg_apis_core_v1beta1_Shoot(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootAvailability":                      schema_pkg_apis_core_v1beta1_ShootAvailability(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootBackup":                            schema_pkg_apis_core_v1beta1_ShootBackup(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootCredentials":                       schema_pkg_apis_core_v1beta1_ShootCredentials(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootCredentialsRotation":               schema_pkg_apis_core_v1beta1_ShootCredentialsRotation(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootETCDBackup":                        schema_pkg_apis_core_v1beta1_ShootETCDBackup(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootList":                              schema_pkg_apis_core_v1beta1_ShootList(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootMachineImage":                      schema_pkg_apis_core_v1beta1_ShootMachineImage(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootNetworkPools":                      schema_pkg_apis_core_v1beta1_ShootNetworkPools(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootNetworks":                          schema_pkg_apis_core_v1beta1_ShootNetworks(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootSpec":                              schema_pkg_apis_core_v1beta1_ShootSpec(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootStatus":                            schema_pkg_apis_core_v1beta1_ShootStatus(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.StaticCredentialsRotation":              schema_pkg_apis_core_v1beta1_StaticCredentialsRotation(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.StaticCredentialsRotationConfig":        schema_pkg_apis_core_v1beta1_StaticCredentialsRotationConfig(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Volume":                                 schema_pkg_apis_core_v1beta1_Volume(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.VolumeType":                             schema_pkg_apis_core_v1beta1_VolumeType(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Worker":                                 schema_pkg_apis_core_v1beta1_Worker(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkerKubernetes":                       schema_pkg_apis_core_v1beta1_WorkerKubernetes(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkloadHealthInfo":                     schema_pkg_apis_core_v1beta1_WorkloadHealthInfo(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkloadsInfo":                          schema_pkg_apis_core_v1beta1_WorkloadsInfo(ref),
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AWSCloud":                             schema_pkg_apis_garden_v1beta1_AWSCloud(ref),
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AWSConstraints":                       schema_pkg_apis_garden_v1beta1_AWSConstraints(ref),
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AWSNetworks":                          schema_pkg_apis_garden_v1beta1_AWSNetworks(ref),
@@ -268,6 +346,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AddonClusterAutoscaler":               schema_pkg_apis_garden_v1beta1_AddonClusterAutoscaler(ref),
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Addons":                               schema_pkg_apis_garden_v1beta1_Addons(ref),
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AdmissionPlugin":                      schema_pkg_apis_garden_v1beta1_AdmissionPlugin(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AdmissionWebhookConfig":               schema_pkg_apis_garden_v1beta1_AdmissionWebhookConfig(ref),
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Alerting":                             schema_pkg_apis_garden_v1beta1_Alerting(ref),
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Alicloud":                             schema_pkg_apis_garden_v1beta1_Alicloud(ref),
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AlicloudConstraints":                  schema_pkg_apis_garden_v1beta1_AlicloudConstraints(ref),
@@ -277,8 +356,15 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AlicloudVPC":                          schema_pkg_apis_garden_v1beta1_AlicloudVPC(ref),
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AlicloudVolumeType":                   schema_pkg_apis_garden_v1beta1_AlicloudVolumeType(ref),
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AlicloudWorker":                       schema_pkg_apis_garden_v1beta1_AlicloudWorker(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AuditBackend":                         schema_pkg_apis_garden_v1beta1_AuditBackend(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AuditBuffering":                       schema_pkg_apis_garden_v1beta1_AuditBuffering(ref),
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AuditConfig":                          schema_pkg_apis_garden_v1beta1_AuditConfig(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AuditLogBackend":                      schema_pkg_apis_garden_v1beta1_AuditLogBackend(ref),
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AuditPolicy":                          schema_pkg_apis_garden_v1beta1_AuditPolicy(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AuditWebhookBackend":                  schema_pkg_apis_garden_v1beta1_AuditWebhookBackend(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AuthenticationWebhook":                schema_pkg_apis_garden_v1beta1_AuthenticationWebhook(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AuthorizationWebhook":                 schema_pkg_apis_garden_v1beta1_AuthorizationWebhook(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AvailabilityRatio":                    schema_pkg_apis_garden_v1beta1_AvailabilityRatio(ref),
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AzureCloud":                           schema_pkg_apis_garden_v1beta1_AzureCloud(ref),
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AzureConstraints":                     schema_pkg_apis_garden_v1beta1_AzureConstraints(ref),
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AzureDomainCount":                     schema_pkg_apis_garden_v1beta1_AzureDomainCount(ref),
@@ -288,15 +374,23 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AzureVNet":                            schema_pkg_apis_garden_v1beta1_AzureVNet(ref),
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AzureWorker":                          schema_pkg_apis_garden_v1beta1_AzureWorker(ref),
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.BackupProfile":                        schema_pkg_apis_garden_v1beta1_BackupProfile(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.BackupRetentionPolicy":                schema_pkg_apis_garden_v1beta1_BackupRetentionPolicy(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.CARotation":                           schema_pkg_apis_garden_v1beta1_CARotation(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.CRI":                                  schema_pkg_apis_garden_v1beta1_CRI(ref),
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Cloud":                                schema_pkg_apis_garden_v1beta1_Cloud(ref),
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.CloudControllerManagerConfig":         schema_pkg_apis_garden_v1beta1_CloudControllerManagerConfig(ref),
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.CloudProfile":                         schema_pkg_apis_garden_v1beta1_CloudProfile(ref),
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.CloudProfileList":                     schema_pkg_apis_garden_v1beta1_CloudProfileList(ref),
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.CloudProfileSpec":                     schema_pkg_apis_garden_v1beta1_CloudProfileSpec(ref),
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ClusterAutoscaler":                    schema_pkg_apis_garden_v1beta1_ClusterAutoscaler(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ControlPlane":                         schema_pkg_apis_garden_v1beta1_ControlPlane(ref),
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.DNS":                                  schema_pkg_apis_garden_v1beta1_DNS(ref),
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.DNSProviderConstraint":                schema_pkg_apis_garden_v1beta1_DNSProviderConstraint(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ETCDBackupOperation":                  schema_pkg_apis_garden_v1beta1_ETCDBackupOperation(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ETCDEncryptionKeyRotation":            schema_pkg_apis_garden_v1beta1_ETCDEncryptionKeyRotation(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.EncryptionConfig":                     schema_pkg_apis_garden_v1beta1_EncryptionConfig(ref),
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Extension":                            schema_pkg_apis_garden_v1beta1_Extension(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.FailureTolerance":                     schema_pkg_apis_garden_v1beta1_FailureTolerance(ref),
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.GCPCloud":                             schema_pkg_apis_garden_v1beta1_GCPCloud(ref),
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.GCPConstraints":                       schema_pkg_apis_garden_v1beta1_GCPConstraints(ref),
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.GCPNetworks":                          schema_pkg_apis_garden_v1beta1_GCPNetworks(ref),
@@ -309,11 +403,14 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.HelmTiller":                           schema_pkg_apis_garden_v1beta1_HelmTiller(ref),
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Hibernation":                          schema_pkg_apis_garden_v1beta1_Hibernation(ref),
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.HibernationSchedule":                  schema_pkg_apis_garden_v1beta1_HibernationSchedule(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.HighAvailability":                     schema_pkg_apis_garden_v1beta1_HighAvailability(ref),
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.HorizontalPodAutoscalerConfig":        schema_pkg_apis_garden_v1beta1_HorizontalPodAutoscalerConfig(ref),
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.K8SNetworks":                          schema_pkg_apis_garden_v1beta1_K8SNetworks(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.KMSProvider":                          schema_pkg_apis_garden_v1beta1_KMSProvider(ref),
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Kube2IAM":                             schema_pkg_apis_garden_v1beta1_Kube2IAM(ref),
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Kube2IAMRole":                         schema_pkg_apis_garden_v1beta1_Kube2IAMRole(ref),
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeAPIServerConfig":                  schema_pkg_apis_garden_v1beta1_KubeAPIServerConfig(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeControllerManagerConcurrentSyncs": schema_pkg_apis_garden_v1beta1_KubeControllerManagerConcurrentSyncs(ref),
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeControllerManagerConfig":          schema_pkg_apis_garden_v1beta1_KubeControllerManagerConfig(ref),
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeLego":                             schema_pkg_apis_garden_v1beta1_KubeLego(ref),
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeProxyConfig":                      schema_pkg_apis_garden_v1beta1_KubeProxyConfig(ref),
@@ -322,6 +419,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeletConfigEviction":                schema_pkg_apis_garden_v1beta1_KubeletConfigEviction(ref),
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeletConfigEvictionMinimumReclaim":  schema_pkg_apis_garden_v1beta1_KubeletConfigEvictionMinimumReclaim(ref),
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeletConfigEvictionSoftGracePeriod": schema_pkg_apis_garden_v1beta1_KubeletConfigEvictionSoftGracePeriod(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeletConfigFieldDrift":              schema_pkg_apis_garden_v1beta1_KubeletConfigFieldDrift(ref),
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Kubernetes":                           schema_pkg_apis_garden_v1beta1_Kubernetes(ref),
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubernetesConfig":                     schema_pkg_apis_garden_v1beta1_KubernetesConfig(ref),
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubernetesConstraints":                schema_pkg_apis_garden_v1beta1_KubernetesConstraints(ref),
@@ -336,8 +434,12 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.MaintenanceTimeWindow":                schema_pkg_apis_garden_v1beta1_MaintenanceTimeWindow(ref),
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Monitoring":                           schema_pkg_apis_garden_v1beta1_Monitoring(ref),
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Monocular":                            schema_pkg_apis_garden_v1beta1_Monocular(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.NetworkPolicyRule":                    schema_pkg_apis_garden_v1beta1_NetworkPolicyRule(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.NetworkPolicyRulePort":                schema_pkg_apis_garden_v1beta1_NetworkPolicyRulePort(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.NetworkPool":                          schema_pkg_apis_garden_v1beta1_NetworkPool(ref),
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Networking":                           schema_pkg_apis_garden_v1beta1_Networking(ref),
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.NginxIngress":                         schema_pkg_apis_garden_v1beta1_NginxIngress(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.NodeKubeletConfigDrift":               schema_pkg_apis_garden_v1beta1_NodeKubeletConfigDrift(ref),
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.OIDCConfig":                           schema_pkg_apis_garden_v1beta1_OIDCConfig(ref),
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenIDConnectClientAuthentication":    schema_pkg_apis_garden_v1beta1_OpenIDConnectClientAuthentication(ref),
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackCloud":                       schema_pkg_apis_garden_v1beta1_OpenStackCloud(ref),
@@ -362,6 +464,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Quota":                                schema_pkg_apis_garden_v1beta1_Quota(ref),
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.QuotaList":                            schema_pkg_apis_garden_v1beta1_QuotaList(ref),
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.QuotaSpec":                            schema_pkg_apis_garden_v1beta1_QuotaSpec(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SecondaryBackupLocation":              schema_pkg_apis_garden_v1beta1_SecondaryBackupLocation(ref),
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SecretBinding":                        schema_pkg_apis_garden_v1beta1_SecretBinding(ref),
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SecretBindingList":                    schema_pkg_apis_garden_v1beta1_SecretBindingList(ref),
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Seed":                                 schema_pkg_apis_garden_v1beta1_Seed(ref),
@@ -372,11 +475,19 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedStatus":                           schema_pkg_apis_garden_v1beta1_SeedStatus(ref),
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ServiceAccountConfig":                 schema_pkg_apis_garden_v1beta1_ServiceAccountConfig(ref),
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Shoot":                                schema_pkg_apis_garden_v1beta1_Shoot(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootAvailability":                    schema_pkg_apis_garden_v1beta1_ShootAvailability(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootBackup":                          schema_pkg_apis_garden_v1beta1_ShootBackup(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootCredentials":                     schema_pkg_apis_garden_v1beta1_ShootCredentials(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootCredentialsRotation":             schema_pkg_apis_garden_v1beta1_ShootCredentialsRotation(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootETCDBackup":                      schema_pkg_apis_garden_v1beta1_ShootETCDBackup(ref),
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootList":                            schema_pkg_apis_garden_v1beta1_ShootList(ref),
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootMachineImage":                    schema_pkg_apis_garden_v1beta1_ShootMachineImage(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootNetworkPools":                    schema_pkg_apis_garden_v1beta1_ShootNetworkPools(ref),
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootNetworks":                        schema_pkg_apis_garden_v1beta1_ShootNetworks(ref),
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootSpec":                            schema_pkg_apis_garden_v1beta1_ShootSpec(ref),
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootStatus":                          schema_pkg_apis_garden_v1beta1_ShootStatus(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.StaticCredentialsRotation":            schema_pkg_apis_garden_v1beta1_StaticCredentialsRotation(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.StaticCredentialsRotationConfig":      schema_pkg_apis_garden_v1beta1_StaticCredentialsRotationConfig(ref),
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.VolumeType":                           schema_pkg_apis_garden_v1beta1_VolumeType(ref),
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Worker":                               schema_pkg_apis_garden_v1beta1_Worker(ref),
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Zone":                                 schema_pkg_apis_garden_v1beta1_Zone(ref),
@@ -501,150 +612,150 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"k8s.io/api/core/v1.PersistentVolumeSpec":                                                   schema_k8sio_api_core_v1_PersistentVolumeSpec(ref),
 		"k8s.io/api/core/v1.PersistentVolumeStatus":                                                 schema_k8sio_api_core_v1_PersistentVolumeStatus(ref),
 		"k8s.io/api/core/v1.PhotonPersistentDiskVolumeSource":                                       schema_k8sio_api_core_v1_PhotonPersistentDiskVolumeSource(ref),
-		"k8s.io/api/core/v1.Pod":                                                                    schema_k8sio_api_core_v1_Pod(ref),
-		"k8s.io/api/core/v1.PodAffinity":                                                            schema_k8sio_api_core_v1_PodAffinity(ref),
-		"k8s.io/api/core/v1.PodAffinityTerm":                                                        schema_k8sio_api_core_v1_PodAffinityTerm(ref),
-		"k8s.io/api/core/v1.PodAntiAffinity":                                                        schema_k8sio_api_core_v1_PodAntiAffinity(ref),
-		"k8s.io/api/core/v1.PodAttachOptions":                                                       schema_k8sio_api_core_v1_PodAttachOptions(ref),
-		"k8s.io/api/core/v1.PodCondition":                                                           schema_k8sio_api_core_v1_PodCondition(ref),
-		"k8s.io/api/core/v1.PodDNSConfig":                                                           schema_k8sio_api_core_v1_PodDNSConfig(ref),
-		"k8s.io/api/core/v1.PodDNSConfigOption":                                                     schema_k8sio_api_core_v1_PodDNSConfigOption(ref),
-		"k8s.io/api/core/v1.PodExecOptions":                                                         schema_k8sio_api_core_v1_PodExecOptions(ref),
-		"k8s.io/api/core/v1.PodList":                                                                schema_k8sio_api_core_v1_PodList(ref),
-		"k8s.io/api/core/v1.PodLogOptions":                                                          schema_k8sio_api_core_v1_PodLogOptions(ref),
-		"k8s.io/api/core/v1.PodPortForwardOptions":                                                  schema_k8sio_api_core_v1_PodPortForwardOptions(ref),
-		"k8s.io/api/core/v1.PodProxyOptions":                                                        schema_k8sio_api_core_v1_PodProxyOptions(ref),
-		"k8s.io/api/core/v1.PodReadinessGate":                                                       schema_k8sio_api_core_v1_PodReadinessGate(ref),
-		"k8s.io/api/core/v1.PodSecurityContext":                                                     schema_k8sio_api_core_v1_PodSecurityContext(ref),
-		"k8s.io/api/core/v1.PodSignature":                                                           schema_k8sio_api_core_v1_PodSignature(ref),
-		"k8s.io/api/core/v1.PodSpec":                                                                schema_k8sio_api_core_v1_PodSpec(ref),
-		"k8s.io/api/core/v1.PodStatus":                                                              schema_k8sio_api_core_v1_PodStatus(ref),
-		"k8s.io/api/core/v1.PodStatusResult":                                                        schema_k8sio_api_core_v1_PodStatusResult(ref),
-		"k8s.io/api/core/v1.PodTemplate":                                                            schema_k8sio_api_core_v1_PodTemplate(ref),
-		"k8s.io/api/core/v1.PodTemplateList":                                                        schema_k8sio_api_core_v1_PodTemplateList(ref),
-		"k8s.io/api/core/v1.PodTemplateSpec":                                                        schema_k8sio_api_core_v1_PodTemplateSpec(ref),
-		"k8s.io/api/core/v1.PortworxVolumeSource":                                                   schema_k8sio_api_core_v1_PortworxVolumeSource(ref),
-		"k8s.io/api/core/v1.PreferAvoidPodsEntry":                                                   schema_k8sio_api_core_v1_PreferAvoidPodsEntry(ref),
-		"k8s.io/api/core/v1.PreferredSchedulingTerm":                                                schema_k8sio_api_core_v1_PreferredSchedulingTerm(ref),
-		"k8s.io/api/core/v1.Probe":                                                                  schema_k8sio_api_core_v1_Probe(ref),
-		"k8s.io/api/core/v1.ProjectedVolumeSource":                                                  schema_k8sio_api_core_v1_ProjectedVolumeSource(ref),
-		"k8s.io/api/core/v1.QuobyteVolumeSource":                                                    schema_k8sio_api_core_v1_QuobyteVolumeSource(ref),
-		"k8s.io/api/core/v1.RBDPersistentVolumeSource":                                              schema_k8sio_api_core_v1_RBDPersistentVolumeSource(ref),
-		"k8s.io/api/core/v1.RBDVolumeSource":                                                        schema_k8sio_api_core_v1_RBDVolumeSource(ref),
-		"k8s.io/api/core/v1.RangeAllocation":                                                        schema_k8sio_api_core_v1_RangeAllocation(ref),
-		"k8s.io/api/core/v1.ReplicationController":                                                  schema_k8sio_api_core_v1_ReplicationController(ref),
-		"k8s.io/api/core/v1.ReplicationControllerCondition":                                         schema_k8sio_api_core_v1_ReplicationControllerCondition(ref),
-		"k8s.io/api/core/v1.ReplicationControllerList":                                              schema_k8sio_api_core_v1_ReplicationControllerList(ref),
-		"k8s.io/api/core/v1.ReplicationControllerSpec":                                              schema_k8sio_api_core_v1_ReplicationControllerSpec(ref),
-		"k8s.io/api/core/v1.ReplicationControllerStatus":                                            schema_k8sio_api_core_v1_ReplicationControllerStatus(ref),
-		"k8s.io/api/core/v1.ResourceFieldSelector":                                                  schema_k8sio_api_core_v1_ResourceFieldSelector(ref),
-		"k8s.io/api/core/v1.ResourceQuota":                                                          schema_k8sio_api_core_v1_ResourceQuota(ref),
-		"k8s.io/api/core/v1.ResourceQuotaList":                                                      schema_k8sio_api_core_v1_ResourceQuotaList(ref),
-		"k8s.io/api/core/v1.ResourceQuotaSpec":                                                      schema_k8sio_api_core_v1_ResourceQuotaSpec(ref),
-		"k8s.io/api/core/v1.ResourceQuotaStatus":                                                    schema_k8sio_api_core_v1_ResourceQuotaStatus(ref),
-		"k8s.io/api/core/v1.ResourceRequirements":                                                   schema_k8sio_api_core_v1_ResourceRequirements(ref),
-		"k8s.io/api/core/v1.SELinuxOptions":                                                         schema_k8sio_api_core_v1_SELinuxOptions(ref),
-		"k8s.io/api/core/v1.ScaleIOPersistentVolumeSource":                                          schema_k8sio_api_core_v1_ScaleIOPersistentVolumeSource(ref),
-		"k8s.io/api/core/v1.ScaleIOVolumeSource":                                                    schema_k8sio_api_core_v1_ScaleIOVolumeSource(ref),
-		"k8s.io/api/core/v1.ScopeSelector":                                                          schema_k8sio_api_core_v1_ScopeSelector(ref),
-		"k8s.io/api/core/v1.ScopedResourceSelectorRequirement":                                      schema_k8sio_api_core_v1_ScopedResourceSelectorRequirement(ref),
-		"k8s.io/api/core/v1.Secret":                                                                 schema_k8sio_api_core_v1_Secret(ref),
-		"k8s.io/api/core/v1.SecretEnvSource":                                                        schema_k8sio_api_core_v1_SecretEnvSource(ref),
-		"k8s.io/api/core/v1.SecretKeySelector":                                                      schema_k8sio_api_core_v1_SecretKeySelector(ref),
-		"k8s.io/api/core/v1.SecretList":                                                             schema_k8sio_api_core_v1_SecretList(ref),
-		"k8s.io/api/core/v1.SecretProjection":                                                       schema_k8sio_api_core_v1_SecretProjection(ref),
-		"k8s.io/api/core/v1.SecretReference":                                                        schema_k8sio_api_core_v1_SecretReference(ref),
-		"k8s.io/api/core/v1.SecretVolumeSource":                                                     schema_k8sio_api_core_v1_SecretVolumeSource(ref),
-		"k8s.io/api/core/v1.SecurityContext":                                                        schema_k8sio_api_core_v1_SecurityContext(ref),
-		"k8s.io/api/core/v1.SerializedReference":                                                    schema_k8sio_api_core_v1_SerializedReference(ref),
-		"k8s.io/api/core/v1.Service":                                                                schema_k8sio_api_core_v1_Service(ref),
-		"k8s.io/api/core/v1.ServiceAccount":                                                         schema_k8sio_api_core_v1_ServiceAccount(ref),
-		"k8s.io/api/core/v1.ServiceAccountList":                                                     schema_k8sio_api_core_v1_ServiceAccountList(ref),
-		"k8s.io/api/core/v1.ServiceAccountTokenProjection":                                          schema_k8sio_api_core_v1_ServiceAccountTokenProjection(ref),
-		"k8s.io/api/core/v1.ServiceList":                                                            schema_k8sio_api_core_v1_ServiceList(ref),
-		"k8s.io/api/core/v1.ServicePort":                                                            schema_k8sio_api_core_v1_ServicePort(ref),
-		"k8s.io/api/core/v1.ServiceProxyOptions":                                                    schema_k8sio_api_core_v1_ServiceProxyOptions(ref),
-		"k8s.io/api/core/v1.ServiceSpec":                                                            schema_k8sio_api_core_v1_ServiceSpec(ref),
-		"k8s.io/api/core/v1.ServiceStatus":                                                          schema_k8sio_api_core_v1_ServiceStatus(ref),
-		"k8s.io/api/core/v1.SessionAffinityConfig":                                                  schema_k8sio_api_core_v1_SessionAffinityConfig(ref),
-		"k8s.io/api/core/v1.StorageOSPersistentVolumeSource":                                        schema_k8sio_api_core_v1_StorageOSPersistentVolumeSource(ref),
-		"k8s.io/api/core/v1.StorageOSVolumeSource":                                                  schema_k8sio_api_core_v1_StorageOSVolumeSource(ref),
-		"k8s.io/api/core/v1.Sysctl":                                                                 schema_k8sio_api_core_v1_Sysctl(ref),
-		"k8s.io/api/core/v1.TCPSocketAction":                                                        schema_k8sio_api_core_v1_TCPSocketAction(ref),
-		"k8s.io/api/core/v1.Taint":                                                                  schema_k8sio_api_core_v1_Taint(ref),
-		"k8s.io/api/core/v1.Toleration":                                                             schema_k8sio_api_core_v1_Toleration(ref),
-		"k8s.io/api/core/v1.TopologySelectorLabelRequirement":                                       schema_k8sio_api_core_v1_TopologySelectorLabelRequirement(ref),
-		"k8s.io/api/core/v1.TopologySelectorTerm":                                                   schema_k8sio_api_core_v1_TopologySelectorTerm(ref),
-		"k8s.io/api/core/v1.TypedLocalObjectReference":                                              schema_k8sio_api_core_v1_TypedLocalObjectReference(ref),
-		"k8s.io/api/core/v1.Volume":                                                                 schema_k8sio_api_core_v1_Volume(ref),
-		"k8s.io/api/core/v1.VolumeDevice":                                                           schema_k8sio_api_core_v1_VolumeDevice(ref),
-		"k8s.io/api/core/v1.VolumeMount":                                                            schema_k8sio_api_core_v1_VolumeMount(ref),
-		"k8s.io/api/core/v1.VolumeNodeAffinity":                                                     schema_k8sio_api_core_v1_VolumeNodeAffinity(ref),
-		"k8s.io/api/core/v1.VolumeProjection":                                                       schema_k8sio_api_core_v1_VolumeProjection(ref),
-		"k8s.io/api/core/v1.VolumeSource":                                                           schema_k8sio_api_core_v1_VolumeSource(ref),
-		"k8s.io/api/core/v1.VsphereVirtualDiskVolumeSource":                                         schema_k8sio_api_core_v1_VsphereVirtualDiskVolumeSource(ref),
-		"k8s.io/api/core/v1.WeightedPodAffinityTerm":                                                schema_k8sio_api_core_v1_WeightedPodAffinityTerm(ref),
-		"k8s.io/api/rbac/v1.AggregationRule":                                                        schema_k8sio_api_rbac_v1_AggregationRule(ref),
-		"k8s.io/api/rbac/v1.ClusterRole":                                                            schema_k8sio_api_rbac_v1_ClusterRole(ref),
-		"k8s.io/api/rbac/v1.ClusterRoleBinding":                                                     schema_k8sio_api_rbac_v1_ClusterRoleBinding(ref),
-		"k8s.io/api/rbac/v1.ClusterRoleBindingList":                                                 schema_k8sio_api_rbac_v1_ClusterRoleBindingList(ref),
-		"k8s.io/api/rbac/v1.ClusterRoleList":                                                        schema_k8sio_api_rbac_v1_ClusterRoleList(ref),
-		"k8s.io/api/rbac/v1.PolicyRule":                                                             schema_k8sio_api_rbac_v1_PolicyRule(ref),
-		"k8s.io/api/rbac/v1.Role":                                                                   schema_k8sio_api_rbac_v1_Role(ref),
-		"k8s.io/api/rbac/v1.RoleBinding":                                                            schema_k8sio_api_rbac_v1_RoleBinding(ref),
-		"k8s.io/api/rbac/v1.RoleBindingList":                                                        schema_k8sio_api_rbac_v1_RoleBindingList(ref),
-		"k8s.io/api/rbac/v1.RoleList":                                                               schema_k8sio_api_rbac_v1_RoleList(ref),
-		"k8s.io/api/rbac/v1.RoleRef":                                                                schema_k8sio_api_rbac_v1_RoleRef(ref),
-		"k8s.io/api/rbac/v1.Subject":                                                                schema_k8sio_api_rbac_v1_Subject(ref),
-		"k8s.io/apimachinery/pkg/api/resource.Quantity":                                             schema_apimachinery_pkg_api_resource_Quantity(ref),
-		"k8s.io/apimachinery/pkg/api/resource.int64Amount":                                          schema_apimachinery_pkg_api_resource_int64Amount(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.APIGroup":                                             schema_pkg_apis_meta_v1_APIGroup(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.APIGroupList":                                         schema_pkg_apis_meta_v1_APIGroupList(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.APIResource":                                          schema_pkg_apis_meta_v1_APIResource(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.APIResourceList":                                      schema_pkg_apis_meta_v1_APIResourceList(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.APIVersions":                                          schema_pkg_apis_meta_v1_APIVersions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.CreateOptions":                                        schema_pkg_apis_meta_v1_CreateOptions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.DeleteOptions":                                        schema_pkg_apis_meta_v1_DeleteOptions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Duration":                                             schema_pkg_apis_meta_v1_Duration(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.ExportOptions":                                        schema_pkg_apis_meta_v1_ExportOptions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Fields":                                               schema_pkg_apis_meta_v1_Fields(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.GetOptions":                                           schema_pkg_apis_meta_v1_GetOptions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupKind":                                            schema_pkg_apis_meta_v1_GroupKind(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupResource":                                        schema_pkg_apis_meta_v1_GroupResource(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersion":                                         schema_pkg_apis_meta_v1_GroupVersion(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersionForDiscovery":                             schema_pkg_apis_meta_v1_GroupVersionForDiscovery(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersionKind":                                     schema_pkg_apis_meta_v1_GroupVersionKind(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersionResource":                                 schema_pkg_apis_meta_v1_GroupVersionResource(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Initializer":                                          schema_pkg_apis_meta_v1_Initializer(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Initializers":                                         schema_pkg_apis_meta_v1_Initializers(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.InternalEvent":                                        schema_pkg_apis_meta_v1_InternalEvent(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector":                                        schema_pkg_apis_meta_v1_LabelSelector(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelectorRequirement":                             schema_pkg_apis_meta_v1_LabelSelectorRequirement(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.List":                                                 schema_pkg_apis_meta_v1_List(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta":                                             schema_pkg_apis_meta_v1_ListMeta(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.ListOptions":                                          schema_pkg_apis_meta_v1_ListOptions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.ManagedFieldsEntry":                                   schema_pkg_apis_meta_v1_ManagedFieldsEntry(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.MicroTime":                                            schema_pkg_apis_meta_v1_MicroTime(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta":                                           schema_pkg_apis_meta_v1_ObjectMeta(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.OwnerReference":                                       schema_pkg_apis_meta_v1_OwnerReference(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Patch":                                                schema_pkg_apis_meta_v1_Patch(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.PatchOptions":                                         schema_pkg_apis_meta_v1_PatchOptions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Preconditions":                                        schema_pkg_apis_meta_v1_Preconditions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.RootPaths":                                            schema_pkg_apis_meta_v1_RootPaths(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.ServerAddressByClientCIDR":                            schema_pkg_apis_meta_v1_ServerAddressByClientCIDR(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Status":                                               schema_pkg_apis_meta_v1_Status(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.StatusCause":                                          schema_pkg_apis_meta_v1_StatusCause(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.StatusDetails":                                        schema_pkg_apis_meta_v1_StatusDetails(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Time":                                                 schema_pkg_apis_meta_v1_Time(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Timestamp":                                            schema_pkg_apis_meta_v1_Timestamp(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.TypeMeta":                                             schema_pkg_apis_meta_v1_TypeMeta(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.UpdateOptions":                                        schema_pkg_apis_meta_v1_UpdateOptions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.WatchEvent":                                           schema_pkg_apis_meta_v1_WatchEvent(ref),
-		"k8s.io/apimachinery/pkg/runtime.RawExtension":                                              schema_k8sio_apimachinery_pkg_runtime_RawExtension(ref),
-		"k8s.io/apimachinery/pkg/runtime.TypeMeta":                                                  schema_k8sio_apimachinery_pkg_runtime_TypeMeta(ref),
-		"k8s.io/apimachinery/pkg/runtime.Unknown":                                                   schema_k8sio_apimachinery_pkg_runtime_Unknown(ref),
-		"k8s.io/apimachinery/pkg/util/intstr.IntOrString":                                           schema_apimachinery_pkg_util_intstr_IntOrString(ref),
-		"k8s.io/apimachinery/pkg/version.Info":                                                      schema_k8sio_apimachinery_pkg_version_Info(ref),
+		"k8s.io/api/core/v1.Pod":                                         schema_k8sio_api_core_v1_Pod(ref),
+		"k8s.io/api/core/v1.PodAffinity":                                 schema_k8sio_api_core_v1_PodAffinity(ref),
+		"k8s.io/api/core/v1.PodAffinityTerm":                             schema_k8sio_api_core_v1_PodAffinityTerm(ref),
+		"k8s.io/api/core/v1.PodAntiAffinity":                             schema_k8sio_api_core_v1_PodAntiAffinity(ref),
+		"k8s.io/api/core/v1.PodAttachOptions":                            schema_k8sio_api_core_v1_PodAttachOptions(ref),
+		"k8s.io/api/core/v1.PodCondition":                                schema_k8sio_api_core_v1_PodCondition(ref),
+		"k8s.io/api/core/v1.PodDNSConfig":                                schema_k8sio_api_core_v1_PodDNSConfig(ref),
+		"k8s.io/api/core/v1.PodDNSConfigOption":                          schema_k8sio_api_core_v1_PodDNSConfigOption(ref),
+		"k8s.io/api/core/v1.PodExecOptions":                              schema_k8sio_api_core_v1_PodExecOptions(ref),
+		"k8s.io/api/core/v1.PodList":                                     schema_k8sio_api_core_v1_PodList(ref),
+		"k8s.io/api/core/v1.PodLogOptions":                               schema_k8sio_api_core_v1_PodLogOptions(ref),
+		"k8s.io/api/core/v1.PodPortForwardOptions":                       schema_k8sio_api_core_v1_PodPortForwardOptions(ref),
+		"k8s.io/api/core/v1.PodProxyOptions":                             schema_k8sio_api_core_v1_PodProxyOptions(ref),
+		"k8s.io/api/core/v1.PodReadinessGate":                            schema_k8sio_api_core_v1_PodReadinessGate(ref),
+		"k8s.io/api/core/v1.PodSecurityContext":                          schema_k8sio_api_core_v1_PodSecurityContext(ref),
+		"k8s.io/api/core/v1.PodSignature":                                schema_k8sio_api_core_v1_PodSignature(ref),
+		"k8s.io/api/core/v1.PodSpec":                                     schema_k8sio_api_core_v1_PodSpec(ref),
+		"k8s.io/api/core/v1.PodStatus":                                   schema_k8sio_api_core_v1_PodStatus(ref),
+		"k8s.io/api/core/v1.PodStatusResult":                             schema_k8sio_api_core_v1_PodStatusResult(ref),
+		"k8s.io/api/core/v1.PodTemplate":                                 schema_k8sio_api_core_v1_PodTemplate(ref),
+		"k8s.io/api/core/v1.PodTemplateList":                             schema_k8sio_api_core_v1_PodTemplateList(ref),
+		"k8s.io/api/core/v1.PodTemplateSpec":                             schema_k8sio_api_core_v1_PodTemplateSpec(ref),
+		"k8s.io/api/core/v1.PortworxVolumeSource":                        schema_k8sio_api_core_v1_PortworxVolumeSource(ref),
+		"k8s.io/api/core/v1.PreferAvoidPodsEntry":                        schema_k8sio_api_core_v1_PreferAvoidPodsEntry(ref),
+		"k8s.io/api/core/v1.PreferredSchedulingTerm":                     schema_k8sio_api_core_v1_PreferredSchedulingTerm(ref),
+		"k8s.io/api/core/v1.Probe":                                       schema_k8sio_api_core_v1_Probe(ref),
+		"k8s.io/api/core/v1.ProjectedVolumeSource":                       schema_k8sio_api_core_v1_ProjectedVolumeSource(ref),
+		"k8s.io/api/core/v1.QuobyteVolumeSource":                         schema_k8sio_api_core_v1_QuobyteVolumeSource(ref),
+		"k8s.io/api/core/v1.RBDPersistentVolumeSource":                   schema_k8sio_api_core_v1_RBDPersistentVolumeSource(ref),
+		"k8s.io/api/core/v1.RBDVolumeSource":                             schema_k8sio_api_core_v1_RBDVolumeSource(ref),
+		"k8s.io/api/core/v1.RangeAllocation":                             schema_k8sio_api_core_v1_RangeAllocation(ref),
+		"k8s.io/api/core/v1.ReplicationController":                       schema_k8sio_api_core_v1_ReplicationController(ref),
+		"k8s.io/api/core/v1.ReplicationControllerCondition":              schema_k8sio_api_core_v1_ReplicationControllerCondition(ref),
+		"k8s.io/api/core/v1.ReplicationControllerList":                   schema_k8sio_api_core_v1_ReplicationControllerList(ref),
+		"k8s.io/api/core/v1.ReplicationControllerSpec":                   schema_k8sio_api_core_v1_ReplicationControllerSpec(ref),
+		"k8s.io/api/core/v1.ReplicationControllerStatus":                 schema_k8sio_api_core_v1_ReplicationControllerStatus(ref),
+		"k8s.io/api/core/v1.ResourceFieldSelector":                       schema_k8sio_api_core_v1_ResourceFieldSelector(ref),
+		"k8s.io/api/core/v1.ResourceQuota":                               schema_k8sio_api_core_v1_ResourceQuota(ref),
+		"k8s.io/api/core/v1.ResourceQuotaList":                           schema_k8sio_api_core_v1_ResourceQuotaList(ref),
+		"k8s.io/api/core/v1.ResourceQuotaSpec":                           schema_k8sio_api_core_v1_ResourceQuotaSpec(ref),
+		"k8s.io/api/core/v1.ResourceQuotaStatus":                         schema_k8sio_api_core_v1_ResourceQuotaStatus(ref),
+		"k8s.io/api/core/v1.ResourceRequirements":                        schema_k8sio_api_core_v1_ResourceRequirements(ref),
+		"k8s.io/api/core/v1.SELinuxOptions":                              schema_k8sio_api_core_v1_SELinuxOptions(ref),
+		"k8s.io/api/core/v1.ScaleIOPersistentVolumeSource":               schema_k8sio_api_core_v1_ScaleIOPersistentVolumeSource(ref),
+		"k8s.io/api/core/v1.ScaleIOVolumeSource":                         schema_k8sio_api_core_v1_ScaleIOVolumeSource(ref),
+		"k8s.io/api/core/v1.ScopeSelector":                               schema_k8sio_api_core_v1_ScopeSelector(ref),
+		"k8s.io/api/core/v1.ScopedResourceSelectorRequirement":           schema_k8sio_api_core_v1_ScopedResourceSelectorRequirement(ref),
+		"k8s.io/api/core/v1.Secret":                                      schema_k8sio_api_core_v1_Secret(ref),
+		"k8s.io/api/core/v1.SecretEnvSource":                             schema_k8sio_api_core_v1_SecretEnvSource(ref),
+		"k8s.io/api/core/v1.SecretKeySelector":                           schema_k8sio_api_core_v1_SecretKeySelector(ref),
+		"k8s.io/api/core/v1.SecretList":                                  schema_k8sio_api_core_v1_SecretList(ref),
+		"k8s.io/api/core/v1.SecretProjection":                            schema_k8sio_api_core_v1_SecretProjection(ref),
+		"k8s.io/api/core/v1.SecretReference":                             schema_k8sio_api_core_v1_SecretReference(ref),
+		"k8s.io/api/core/v1.SecretVolumeSource":                          schema_k8sio_api_core_v1_SecretVolumeSource(ref),
+		"k8s.io/api/core/v1.SecurityContext":                             schema_k8sio_api_core_v1_SecurityContext(ref),
+		"k8s.io/api/core/v1.SerializedReference":                         schema_k8sio_api_core_v1_SerializedReference(ref),
+		"k8s.io/api/core/v1.Service":                                     schema_k8sio_api_core_v1_Service(ref),
+		"k8s.io/api/core/v1.ServiceAccount":                              schema_k8sio_api_core_v1_ServiceAccount(ref),
+		"k8s.io/api/core/v1.ServiceAccountList":                          schema_k8sio_api_core_v1_ServiceAccountList(ref),
+		"k8s.io/api/core/v1.ServiceAccountTokenProjection":               schema_k8sio_api_core_v1_ServiceAccountTokenProjection(ref),
+		"k8s.io/api/core/v1.ServiceList":                                 schema_k8sio_api_core_v1_ServiceList(ref),
+		"k8s.io/api/core/v1.ServicePort":                                 schema_k8sio_api_core_v1_ServicePort(ref),
+		"k8s.io/api/core/v1.ServiceProxyOptions":                         schema_k8sio_api_core_v1_ServiceProxyOptions(ref),
+		"k8s.io/api/core/v1.ServiceSpec":                                 schema_k8sio_api_core_v1_ServiceSpec(ref),
+		"k8s.io/api/core/v1.ServiceStatus":                               schema_k8sio_api_core_v1_ServiceStatus(ref),
+		"k8s.io/api/core/v1.SessionAffinityConfig":                       schema_k8sio_api_core_v1_SessionAffinityConfig(ref),
+		"k8s.io/api/core/v1.StorageOSPersistentVolumeSource":             schema_k8sio_api_core_v1_StorageOSPersistentVolumeSource(ref),
+		"k8s.io/api/core/v1.StorageOSVolumeSource":                       schema_k8sio_api_core_v1_StorageOSVolumeSource(ref),
+		"k8s.io/api/core/v1.Sysctl":                                      schema_k8sio_api_core_v1_Sysctl(ref),
+		"k8s.io/api/core/v1.TCPSocketAction":                             schema_k8sio_api_core_v1_TCPSocketAction(ref),
+		"k8s.io/api/core/v1.Taint":                                       schema_k8sio_api_core_v1_Taint(ref),
+		"k8s.io/api/core/v1.Toleration":                                  schema_k8sio_api_core_v1_Toleration(ref),
+		"k8s.io/api/core/v1.TopologySelectorLabelRequirement":            schema_k8sio_api_core_v1_TopologySelectorLabelRequirement(ref),
+		"k8s.io/api/core/v1.TopologySelectorTerm":                        schema_k8sio_api_core_v1_TopologySelectorTerm(ref),
+		"k8s.io/api/core/v1.TypedLocalObjectReference":                   schema_k8sio_api_core_v1_TypedLocalObjectReference(ref),
+		"k8s.io/api/core/v1.Volume":                                      schema_k8sio_api_core_v1_Volume(ref),
+		"k8s.io/api/core/v1.VolumeDevice":                                schema_k8sio_api_core_v1_VolumeDevice(ref),
+		"k8s.io/api/core/v1.VolumeMount":                                 schema_k8sio_api_core_v1_VolumeMount(ref),
+		"k8s.io/api/core/v1.VolumeNodeAffinity":                          schema_k8sio_api_core_v1_VolumeNodeAffinity(ref),
+		"k8s.io/api/core/v1.VolumeProjection":                            schema_k8sio_api_core_v1_VolumeProjection(ref),
+		"k8s.io/api/core/v1.VolumeSource":                                schema_k8sio_api_core_v1_VolumeSource(ref),
+		"k8s.io/api/core/v1.VsphereVirtualDiskVolumeSource":              schema_k8sio_api_core_v1_VsphereVirtualDiskVolumeSource(ref),
+		"k8s.io/api/core/v1.WeightedPodAffinityTerm":                     schema_k8sio_api_core_v1_WeightedPodAffinityTerm(ref),
+		"k8s.io/api/rbac/v1.AggregationRule":                             schema_k8sio_api_rbac_v1_AggregationRule(ref),
+		"k8s.io/api/rbac/v1.ClusterRole":                                 schema_k8sio_api_rbac_v1_ClusterRole(ref),
+		"k8s.io/api/rbac/v1.ClusterRoleBinding":                          schema_k8sio_api_rbac_v1_ClusterRoleBinding(ref),
+		"k8s.io/api/rbac/v1.ClusterRoleBindingList":                      schema_k8sio_api_rbac_v1_ClusterRoleBindingList(ref),
+		"k8s.io/api/rbac/v1.ClusterRoleList":                             schema_k8sio_api_rbac_v1_ClusterRoleList(ref),
+		"k8s.io/api/rbac/v1.PolicyRule":                                  schema_k8sio_api_rbac_v1_PolicyRule(ref),
+		"k8s.io/api/rbac/v1.Role":                                        schema_k8sio_api_rbac_v1_Role(ref),
+		"k8s.io/api/rbac/v1.RoleBinding":                                 schema_k8sio_api_rbac_v1_RoleBinding(ref),
+		"k8s.io/api/rbac/v1.RoleBindingList":                             schema_k8sio_api_rbac_v1_RoleBindingList(ref),
+		"k8s.io/api/rbac/v1.RoleList":                                    schema_k8sio_api_rbac_v1_RoleList(ref),
+		"k8s.io/api/rbac/v1.RoleRef":                                     schema_k8sio_api_rbac_v1_RoleRef(ref),
+		"k8s.io/api/rbac/v1.Subject":                                     schema_k8sio_api_rbac_v1_Subject(ref),
+		"k8s.io/apimachinery/pkg/api/resource.Quantity":                  schema_apimachinery_pkg_api_resource_Quantity(ref),
+		"k8s.io/apimachinery/pkg/api/resource.int64Amount":               schema_apimachinery_pkg_api_resource_int64Amount(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.APIGroup":                  schema_pkg_apis_meta_v1_APIGroup(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.APIGroupList":              schema_pkg_apis_meta_v1_APIGroupList(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.APIResource":               schema_pkg_apis_meta_v1_APIResource(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.APIResourceList":           schema_pkg_apis_meta_v1_APIResourceList(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.APIVersions":               schema_pkg_apis_meta_v1_APIVersions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.CreateOptions":             schema_pkg_apis_meta_v1_CreateOptions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.DeleteOptions":             schema_pkg_apis_meta_v1_DeleteOptions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Duration":                  schema_pkg_apis_meta_v1_Duration(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.ExportOptions":             schema_pkg_apis_meta_v1_ExportOptions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Fields":                    schema_pkg_apis_meta_v1_Fields(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.GetOptions":                schema_pkg_apis_meta_v1_GetOptions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupKind":                 schema_pkg_apis_meta_v1_GroupKind(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupResource":             schema_pkg_apis_meta_v1_GroupResource(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersion":              schema_pkg_apis_meta_v1_GroupVersion(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersionForDiscovery":  schema_pkg_apis_meta_v1_GroupVersionForDiscovery(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersionKind":          schema_pkg_apis_meta_v1_GroupVersionKind(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersionResource":      schema_pkg_apis_meta_v1_GroupVersionResource(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Initializer":               schema_pkg_apis_meta_v1_Initializer(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Initializers":              schema_pkg_apis_meta_v1_Initializers(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.InternalEvent":             schema_pkg_apis_meta_v1_InternalEvent(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector":             schema_pkg_apis_meta_v1_LabelSelector(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelectorRequirement":  schema_pkg_apis_meta_v1_LabelSelectorRequirement(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.List":                      schema_pkg_apis_meta_v1_List(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta":                  schema_pkg_apis_meta_v1_ListMeta(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.ListOptions":               schema_pkg_apis_meta_v1_ListOptions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.ManagedFieldsEntry":        schema_pkg_apis_meta_v1_ManagedFieldsEntry(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.MicroTime":                 schema_pkg_apis_meta_v1_MicroTime(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta":                schema_pkg_apis_meta_v1_ObjectMeta(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.OwnerReference":            schema_pkg_apis_meta_v1_OwnerReference(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Patch":                     schema_pkg_apis_meta_v1_Patch(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.PatchOptions":              schema_pkg_apis_meta_v1_PatchOptions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Preconditions":             schema_pkg_apis_meta_v1_Preconditions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.RootPaths":                 schema_pkg_apis_meta_v1_RootPaths(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.ServerAddressByClientCIDR": schema_pkg_apis_meta_v1_ServerAddressByClientCIDR(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Status":                    schema_pkg_apis_meta_v1_Status(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.StatusCause":               schema_pkg_apis_meta_v1_StatusCause(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.StatusDetails":             schema_pkg_apis_meta_v1_StatusDetails(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Time":                      schema_pkg_apis_meta_v1_Time(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Timestamp":                 schema_pkg_apis_meta_v1_Timestamp(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.TypeMeta":                  schema_pkg_apis_meta_v1_TypeMeta(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.UpdateOptions":             schema_pkg_apis_meta_v1_UpdateOptions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.WatchEvent":                schema_pkg_apis_meta_v1_WatchEvent(ref),
+		"k8s.io/apimachinery/pkg/runtime.RawExtension":                   schema_k8sio_apimachinery_pkg_runtime_RawExtension(ref),
+		"k8s.io/apimachinery/pkg/runtime.TypeMeta":                       schema_k8sio_apimachinery_pkg_runtime_TypeMeta(ref),
+		"k8s.io/apimachinery/pkg/runtime.Unknown":                        schema_k8sio_apimachinery_pkg_runtime_Unknown(ref),
+		"k8s.io/apimachinery/pkg/util/intstr.IntOrString":                schema_apimachinery_pkg_util_intstr_IntOrString(ref),
+		"k8s.io/apimachinery/pkg/version.Info":                           schema_k8sio_apimachinery_pkg_version_Info(ref),
 	}
 }
 
@@ -725,6 +836,28 @@ func schema_pkg_apis_core_v1alpha1_AdmissionPlugin(ref common.ReferenceCallback)
 	}
 }
 
+func schema_pkg_apis_core_v1alpha1_AdmissionWebhookConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AdmissionWebhookConfig contains configuration settings for the MutatingAdmissionWebhook and ValidatingAdmissionWebhook admission plugins.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"secretRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SecretRef is a reference to a secret in the same namespace as the Shoot which contains a kubeconfig (data key `kubeconfig`) with the credentials the kube-apiserver uses to authenticate to the servers of admission webhooks. The users of the kubeconfig are matched with the webhook servers by their names, i.e., the host name and optional port of the server (`*` wildcards are supported). All certificates and credentials must be contained inline.",
+							Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
+						},
+					},
+				},
+				Required: []string{"secretRef"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.LocalObjectReference"},
+	}
+}
+
 func schema_pkg_apis_core_v1alpha1_Alerting(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -752,6 +885,90 @@ func schema_pkg_apis_core_v1alpha1_Alerting(ref common.ReferenceCallback) common
 	}
 }
 
+func schema_pkg_apis_core_v1alpha1_AuditBackend(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AuditBackend contains settings for the backend to which the kube-apiserver sends the audit events. Exactly one of Log or Webhook must be set.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"log": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Log contains configuration settings for writing the audit events to the log file of the kube-apiserver.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.AuditLogBackend"),
+						},
+					},
+					"webhook": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Webhook contains configuration settings for sending the audit events to an external webhook, e.g. a SIEM system. The log file of the kube-apiserver is not written if the webhook is configured.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.AuditWebhookBackend"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.AuditLogBackend", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.AuditWebhookBackend"},
+	}
+}
+
+func schema_pkg_apis_core_v1alpha1_AuditBuffering(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AuditBuffering contains settings for buffering and batching audit events before they are sent to a backend.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"mode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Mode is the strategy for sending the audit events. In `batch` mode, the events are buffered and sent asynchronously. In `blocking` mode, the kube-apiserver blocks its responses until the events have been sent, and in `blocking-strict` mode, requests fail if the audit events cannot be sent.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"bufferSize": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BufferSize is the size of the buffer to store the audit events before batching and sending them. Only used in `batch` mode.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"maxBatchSize": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxBatchSize is the maximum number of audit events in one batch. Only used in `batch` mode.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"maxBatchWait": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxBatchWait is the maximum time to wait before a batch is sent even if it is not full. Only used in `batch` mode.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"throttleQPS": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ThrottleQPS is the maximum average number of batches per second. If set, the batches are throttled. Only used in `batch` mode.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"throttleBurst": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ThrottleBurst is the maximum number of batches sent at the same moment if ThrottleQPS was not utilized before. Only used in `batch` mode.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"mode"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
 func schema_pkg_apis_core_v1alpha1_AuditConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -765,11 +982,59 @@ func schema_pkg_apis_core_v1alpha1_AuditConfig(ref common.ReferenceCallback) com
 							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.AuditPolicy"),
 						},
 					},
+					"backend": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Backend contains configuration settings for the backend to which the kube-apiserver sends the audit events. If not set, the audit events are written to the log file of the kube-apiserver.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.AuditBackend"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.AuditBackend", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.AuditPolicy"},
+	}
+}
+
+func schema_pkg_apis_core_v1alpha1_AuditLogBackend(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AuditLogBackend contains settings for writing the audit events to the log file of the kube-apiserver.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"maxAge": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxAge is the maximum number of days to retain old audit log files.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"maxBackups": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxBackups is the maximum number of old audit log files to retain. Defaults to 5.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"maxSize": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxSize is the maximum size in megabytes of the audit log file before it gets rotated. Defaults to 100.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"buffering": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Buffering contains configuration settings for buffering and batching the audit events.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.AuditBuffering"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.AuditPolicy"},
+			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.AuditBuffering"},
 	}
 }
 
@@ -794,6 +1059,138 @@ func schema_pkg_apis_core_v1alpha1_AuditPolicy(ref common.ReferenceCallback) com
 	}
 }
 
+func schema_pkg_apis_core_v1alpha1_AuditWebhookBackend(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AuditWebhookBackend contains settings for sending the audit events to an external webhook.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"secretRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SecretRef is a reference to a secret in the same namespace as the Shoot which contains a kubeconfig (data key `kubeconfig`) that defines the address of and the credentials for the webhook. All certificates and credentials must be contained inline, references to files or credential plugins are not supported.",
+							Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
+						},
+					},
+					"initialBackoff": {
+						SchemaProps: spec.SchemaProps{
+							Description: "InitialBackoff is the time to wait before retrying the first failed request to the webhook. Defaults to 10s.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"buffering": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Buffering contains configuration settings for buffering and batching the audit events.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.AuditBuffering"),
+						},
+					},
+				},
+				Required: []string{"secretRef"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.AuditBuffering", "k8s.io/api/core/v1.LocalObjectReference", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_pkg_apis_core_v1alpha1_AuthenticationWebhook(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AuthenticationWebhook contains configuration settings for a webhook which authenticates bearer tokens.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"secretRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SecretRef is a reference to a secret in the same namespace as the Shoot which contains a kubeconfig (data key `kubeconfig`) that defines the address of and the credentials for the webhook. All certificates and credentials must be contained inline, references to files or credential plugins are not supported.",
+							Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
+						},
+					},
+					"cacheTTL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CacheTTL is the duration to cache the responses of the webhook. Defaults to 2m.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+				},
+				Required: []string{"secretRef"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.LocalObjectReference", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_pkg_apis_core_v1alpha1_AuthorizationWebhook(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AuthorizationWebhook contains configuration settings for a webhook which authorizes requests.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"secretRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SecretRef is a reference to a secret in the same namespace as the Shoot which contains a kubeconfig (data key `kubeconfig`) that defines the address of and the credentials for the webhook. All certificates and credentials must be contained inline, references to files or credential plugins are not supported.",
+							Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
+						},
+					},
+					"cacheAuthorizedTTL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CacheAuthorizedTTL is the duration to cache authorized responses of the webhook. Defaults to 5m.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"cacheUnauthorizedTTL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CacheUnauthorizedTTL is the duration to cache unauthorized responses of the webhook. Defaults to 30s.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+				},
+				Required: []string{"secretRef"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.LocalObjectReference", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_pkg_apis_core_v1alpha1_AvailabilityRatio(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AvailabilityRatio is the ratio of successful availability probes within a period.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"period": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Period is the duration the ratio has been computed for.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"ratio": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Ratio is the percentage of successful probes within the period, e.g. \"99.950\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"probes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Probes is the number of probes which have been performed within the period.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+				Required: []string{"period", "ratio", "probes"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
 func schema_pkg_apis_core_v1alpha1_AvailabilityZone(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -994,12 +1391,18 @@ func schema_pkg_apis_core_v1alpha1_BackupBucketSpec(ref common.ReferenceCallback
 							Format:      "",
 						},
 					},
+					"secondary": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Secondary is a secondary location into which the objects of the bucket are replicated.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.SecondaryBackupLocation"),
+						},
+					},
 				},
 				Required: []string{"provider", "secretRef"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.BackupBucketProvider", "k8s.io/api/core/v1.SecretReference"},
+			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.BackupBucketProvider", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.SecondaryBackupLocation", "k8s.io/api/core/v1.SecretReference"},
 	}
 }
 
@@ -1161,10 +1564,18 @@ func schema_pkg_apis_core_v1alpha1_BackupEntrySpec(ref common.ReferenceCallback)
 							Format:      "",
 						},
 					},
+					"retentionPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RetentionPolicy defines how long the snapshots in this Backup Entry are kept.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.BackupRetentionPolicy"),
+						},
+					},
 				},
 				Required: []string{"bucketName"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.BackupRetentionPolicy"},
 	}
 }
 
@@ -1194,6 +1605,13 @@ func schema_pkg_apis_core_v1alpha1_BackupEntryStatus(ref common.ReferenceCallbac
 							Format:      "int64",
 						},
 					},
+					"replicaBucketName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ReplicaBucketName is the name of the bucket into which the objects of this Backup Entry are replicated. It is only set if the Backup Bucket has a secondary location.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -1202,63 +1620,146 @@ func schema_pkg_apis_core_v1alpha1_BackupEntryStatus(ref common.ReferenceCallbac
 	}
 }
 
-func schema_pkg_apis_core_v1alpha1_CloudInfo(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1alpha1_BackupRetentionPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "CloudInfo contains information about the cloud",
+				Description: "BackupRetentionPolicy defines how long the snapshots of a backup are kept.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"type": {
+					"dailySnapshots": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Type is the cloud type",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "DailySnapshots is the number of days for which the latest snapshot of each day is kept.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"region": {
+					"weeklySnapshots": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Region is the cloud region",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "WeeklySnapshots is the number of weeks for which the latest snapshot of each week is kept.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
 				},
-				Required: []string{"type", "region"},
 			},
 		},
 	}
 }
 
-func schema_pkg_apis_core_v1alpha1_CloudProfile(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1alpha1_CARotation(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "CloudProfile represents certain properties about a provider environment.",
+				Description: "CARotation contains information about the rotation of the certificate authorities of the Shoot cluster.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"phase": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
+							Description: "Phase describes the phase of the certificate authority rotation.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"lastInitiationTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "LastInitiationTime is the most recent time when the certificate authority rotation was initiated.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"metadata": {
+					"lastCompletionTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Standard object metadata.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+							Description: "LastCompletionTime is the most recent time when the certificate authority rotation was successfully completed.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"spec": {
-						SchemaProps: spec.SchemaProps{
+				},
+				Required: []string{"phase"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_core_v1alpha1_CRI(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CRI contains information about the Container Runtimes.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the CRI library.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_core_v1alpha1_CloudInfo(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CloudInfo contains information about the cloud",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type is the cloud type",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"region": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Region is the cloud region",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"type", "region"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_core_v1alpha1_CloudProfile(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CloudProfile represents certain properties about a provider environment.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Standard object metadata.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
 							Description: "Spec defines the provider environment properties.",
 							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.CloudProfileSpec"),
 						},
@@ -1515,12 +2016,30 @@ func schema_pkg_apis_core_v1alpha1_ClusterInfo(ref common.ReferenceCallback) com
 							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.KubernetesInfo"),
 						},
 					},
+					"nodes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Nodes describes the nodes of the Plant cluster",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.NodesInfo"),
+						},
+					},
+					"workloads": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Workloads describes the health of the deployments and statefulsets of the Plant cluster",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.WorkloadsInfo"),
+						},
+					},
+					"kubeconfig": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kubeconfig describes the kubeconfig used to access the Plant cluster",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.KubeconfigInfo"),
+						},
+					},
 				},
 				Required: []string{"cloud", "kubernetes"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.CloudInfo", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.KubernetesInfo"},
+			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.CloudInfo", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.KubeconfigInfo", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.KubernetesInfo", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.NodesInfo", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.WorkloadsInfo"},
 	}
 }
 
@@ -1580,6 +2099,105 @@ func schema_pkg_apis_core_v1alpha1_Condition(ref common.ReferenceCallback) commo
 	}
 }
 
+func schema_pkg_apis_core_v1alpha1_ConditionHistory(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ConditionHistory contains the most recent status transitions of a condition.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type is the type of the condition.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"transitions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Transitions are the most recent status transitions of the condition, ordered from oldest to newest.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.ConditionTransition"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"type"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ConditionTransition"},
+	}
+}
+
+func schema_pkg_apis_core_v1alpha1_ConditionTransition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ConditionTransition is a single status transition of a condition.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status is the status the condition transitioned to.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"time": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Time is the time of the transition.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"status", "time"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_core_v1alpha1_ControlPlane(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ControlPlane contains configuration settings for the control plane of the Shoot.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"highAvailability": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HighAvailability configures the control plane of the Shoot to be highly available. It can only be set when the Shoot is created.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.HighAvailability"),
+						},
+					},
+					"networkPolicyRules": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NetworkPolicyRules is a list of additional rules allowing egress traffic from pods of the control plane of the Shoot in the seed cluster. The destinations must not overlap with the blocked CIDRs or the node, pod and service networks of the seed.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.NetworkPolicyRule"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.HighAvailability", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.NetworkPolicyRule"},
+	}
+}
+
 func schema_pkg_apis_core_v1alpha1_ControllerDeployment(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -2063,63 +2681,84 @@ func schema_pkg_apis_core_v1alpha1_DNSProvider(ref common.ReferenceCallback) com
 	}
 }
 
-func schema_pkg_apis_core_v1alpha1_Endpoint(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1alpha1_ETCDBackupOperation(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Endpoint is an endpoint for monitoring, logging and other services around the plant.",
+				Description: "ETCDBackupOperation contains information about an on-demand operation on the backup of the Shoot's etcd.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"state": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the endpoint",
+							Description: "State is the current state of the operation.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"url": {
+					"description": {
 						SchemaProps: spec.SchemaProps{
-							Description: "URL is the url of the endpoint",
+							Description: "Description is a human-readable message describing the progress of the operation.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"purpose": {
+					"progress": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Purpose is the purpose of the endpoint",
+							Description: "Progress is the progress of the operation in percent.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"lastUpdateTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastUpdateTime is the time at which the operation was updated the last time.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"snapshot": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Snapshot is the name of the full snapshot which has been taken. For restorations, it is the final snapshot of the replaced etcd.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 				},
-				Required: []string{"name", "url", "purpose"},
+				Required: []string{"state", "description", "progress", "lastUpdateTime"},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_pkg_apis_core_v1alpha1_ExpirableVersion(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1alpha1_ETCDEncryptionKeyRotation(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ExpirableVersion contains a version and an expiration date.",
+				Description: "ETCDEncryptionKeyRotation contains information about the rotation of the key which is used by the kube-apiserver to encrypt resources in etcd.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"version": {
+					"phase": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Version is the version identifier.",
+							Description: "Phase describes the phase of the etcd encryption key rotation.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"expirationDate": {
+					"lastInitiationTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ExpirationDate defines the time at which this version expires.",
+							Description: "LastInitiationTime is the most recent time when the etcd encryption key rotation was initiated.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"lastCompletionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastCompletionTime is the most recent time when the etcd encryption key rotation was successfully completed.",
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
 				},
-				Required: []string{"version"},
+				Required: []string{"phase"},
 			},
 		},
 		Dependencies: []string{
@@ -2127,79 +2766,199 @@ func schema_pkg_apis_core_v1alpha1_ExpirableVersion(ref common.ReferenceCallback
 	}
 }
 
-func schema_pkg_apis_core_v1alpha1_Extension(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1alpha1_EncryptionConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Extension contains type and provider information for Shoot extensions.",
+				Description: "EncryptionConfig contains customizable encryption configuration of the kube-apiserver.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"type": {
+					"resources": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Type is the type of the extension resource.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Resources contains the list of resources that shall be encrypted in etcd in addition to secrets. Each item is a Kubernetes resource name in plural (resource or resource.group), e.g., configmaps or flunders.example.com. Removed resources are decrypted again.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
 						},
 					},
-					"providerConfig": {
+					"kms": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ProviderConfig is the configuration passed to extension resource.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.ProviderConfig"),
+							Description: "KMS configures a KMS provider which is used to encrypt the resources instead of the aescbc provider. The KMS plugin is run as sidecar of the kube-apiserver by the extension which is responsible for the given type.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.KMSProvider"),
 						},
 					},
 				},
-				Required: []string{"type"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ProviderConfig"},
+			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.KMSProvider"},
 	}
 }
 
-func schema_pkg_apis_core_v1alpha1_ExtensionResourceState(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1alpha1_Endpoint(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ExtensionResourceState contains the kind of the extension custom resource and its last observed state in the Shoot's namespace on the Seed cluster.",
+				Description: "Endpoint is an endpoint for monitoring, logging and other services around the plant.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind (type) of the extension custom resource",
+							Description: "Name is the name of the endpoint",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"purpose": {
+					"url": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Purpose of the extension custom resource",
+							Description: "URL is the url of the endpoint",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"state": {
+					"purpose": {
 						SchemaProps: spec.SchemaProps{
-							Description: "State of the extension resource",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.ProviderConfig"),
+							Description: "Purpose is the purpose of the endpoint",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"kind", "state"},
+				Required: []string{"name", "url", "purpose"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ProviderConfig"},
 	}
 }
 
-func schema_pkg_apis_core_v1alpha1_Gardener(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1alpha1_ExpirableVersion(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Gardener holds the information about the Gardener version that operated a resource.",
+				Description: "ExpirableVersion contains a version and an expiration date.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"id": {
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Version is the version identifier.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"expirationDate": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExpirationDate defines the time at which this version expires.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"version"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_core_v1alpha1_Extension(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Extension contains type and provider information for Shoot extensions.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type is the type of the extension resource.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"providerConfig": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ProviderConfig is the configuration passed to extension resource.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.ProviderConfig"),
+						},
+					},
+				},
+				Required: []string{"type"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ProviderConfig"},
+	}
+}
+
+func schema_pkg_apis_core_v1alpha1_ExtensionResourceState(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ExtensionResourceState contains the kind of the extension custom resource and its last observed state in the Shoot's namespace on the Seed cluster.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind (type) of the extension custom resource",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"purpose": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Purpose of the extension custom resource",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"state": {
+						SchemaProps: spec.SchemaProps{
+							Description: "State of the extension resource",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.ProviderConfig"),
+						},
+					},
+				},
+				Required: []string{"kind", "state"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ProviderConfig"},
+	}
+}
+
+func schema_pkg_apis_core_v1alpha1_FailureTolerance(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "FailureTolerance describes the failure tolerance of a highly available control plane.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type specifies the failure domain of the seed across which the replicas of the control plane components are spread, i.e. whether the control plane tolerates the loss of a node or of an entire zone.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"type"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_core_v1alpha1_Gardener(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Gardener holds the information about the Gardener version that operated a resource.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"id": {
 						SchemaProps: spec.SchemaProps{
 							Description: "ID is the Docker container id of the Gardener which last acted on a resource.",
 							Type:        []string{"string"},
@@ -2344,6 +3103,28 @@ func schema_pkg_apis_core_v1alpha1_HibernationSchedule(ref common.ReferenceCallb
 	}
 }
 
+func schema_pkg_apis_core_v1alpha1_HighAvailability(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HighAvailability specifies the configuration settings for a highly available control plane.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"failureTolerance": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FailureTolerance holds information about the failure tolerance of the control plane.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.FailureTolerance"),
+						},
+					},
+				},
+				Required: []string{"failureTolerance"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.FailureTolerance"},
+	}
+}
+
 func schema_pkg_apis_core_v1alpha1_HorizontalPodAutoscalerConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -2402,6 +3183,42 @@ func schema_pkg_apis_core_v1alpha1_HorizontalPodAutoscalerConfig(ref common.Refe
 	}
 }
 
+func schema_pkg_apis_core_v1alpha1_KMSProvider(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "KMSProvider contains the configuration of a KMS provider for the encryption of resources in etcd.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type is the type of the KMS plugin. An extension must be registered for it which runs the plugin as sidecar of the kube-apiserver.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"cacheSize": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CacheSize is the maximum number of data encryption keys which are cached in memory by the kube-apiserver. Defaults to 1000.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"timeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Timeout is the timeout for calls of the kube-apiserver to the KMS plugin. Defaults to 3s.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+				},
+				Required: []string{"type"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
 func schema_pkg_apis_core_v1alpha1_KubeAPIServerConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -2470,6 +3287,12 @@ func schema_pkg_apis_core_v1alpha1_KubeAPIServerConfig(ref common.ReferenceCallb
 							Format:      "",
 						},
 					},
+					"encryptionConfig": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EncryptionConfig contains customizable encryption configuration of the kube-apiserver.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.EncryptionConfig"),
+						},
+					},
 					"oidcConfig": {
 						SchemaProps: spec.SchemaProps{
 							Description: "OIDCConfig contains configuration settings for the OIDC provider.",
@@ -2497,11 +3320,97 @@ func schema_pkg_apis_core_v1alpha1_KubeAPIServerConfig(ref common.ReferenceCallb
 							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.ServiceAccountConfig"),
 						},
 					},
+					"staticCredentialsRotation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StaticCredentialsRotation contains configuration settings for the scheduled rotation of the static tokens and the basic authentication password of the kube-apiserver.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.StaticCredentialsRotationConfig"),
+						},
+					},
+					"authenticationWebhook": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AuthenticationWebhook contains configuration settings for a webhook which authenticates bearer tokens in addition to the built-in authenticators of the kube-apiserver.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.AuthenticationWebhook"),
+						},
+					},
+					"authorizationWebhook": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AuthorizationWebhook contains configuration settings for a webhook which authorizes requests after the Node and RBAC authorizers of the kube-apiserver.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.AuthorizationWebhook"),
+						},
+					},
+					"admissionWebhookConfig": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AdmissionWebhookConfig contains configuration settings for the admission plugins which call the validating and mutating admission webhooks. It is written to the admission control config file of the kube-apiserver.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.AdmissionWebhookConfig"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.AdmissionPlugin", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.AuditConfig", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.OIDCConfig", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.ServiceAccountConfig"},
+			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.AdmissionPlugin", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.AdmissionWebhookConfig", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.AuditConfig", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.AuthenticationWebhook", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.AuthorizationWebhook", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.EncryptionConfig", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.OIDCConfig", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.ServiceAccountConfig", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.StaticCredentialsRotationConfig"},
+	}
+}
+
+func schema_pkg_apis_core_v1alpha1_KubeControllerManagerConcurrentSyncs(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "KubeControllerManagerConcurrentSyncs contains the number of objects which are allowed to be synced concurrently by the controllers of the kube-controller-manager. Larger numbers result in more responsive controllers but cause more load on the kube-apiserver.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"deployments": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Deployments is the number of deployments that are allowed to sync concurrently (default: 10).",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"endpoints": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Endpoints is the number of endpoints that are allowed to sync concurrently (default: 5).",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"garbageCollector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "GarbageCollector is the number of garbage collector workers that are allowed to sync concurrently (default: 20).",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"namespaces": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespaces is the number of namespaces that are allowed to sync concurrently (default: 10).",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"replicaSets": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ReplicaSets is the number of replica sets that are allowed to sync concurrently (default: 10).",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"resourceQuotas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ResourceQuotas is the number of resource quotas that are allowed to sync concurrently (default: 5).",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"serviceAccountTokens": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ServiceAccountTokens is the number of service account token objects that are allowed to sync concurrently (default: 5).",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -2540,11 +3449,29 @@ func schema_pkg_apis_core_v1alpha1_KubeControllerManagerConfig(ref common.Refere
 							Format:      "int32",
 						},
 					},
+					"nodeMonitorGracePeriod": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NodeMonitorGracePeriod is the amount of time which a running node is allowed to be unresponsive before it is marked unhealthy (default: 80s).",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"podEvictionTimeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PodEvictionTimeout is the grace period for deleting pods on failed nodes (default: 2m).",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"concurrentSyncs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConcurrentSyncs contains the number of objects which are allowed to be synced concurrently by the controllers of the kube-controller-manager.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.KubeControllerManagerConcurrentSyncs"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.HorizontalPodAutoscalerConfig"},
+			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.HorizontalPodAutoscalerConfig", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.KubeControllerManagerConcurrentSyncs", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
 	}
 }
 
@@ -2605,39 +3532,73 @@ func schema_pkg_apis_core_v1alpha1_KubeSchedulerConfig(ref common.ReferenceCallb
 							},
 						},
 					},
+					"profile": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Profile configures the scoring strategy of the kube-scheduler (default: balanced).",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
 	}
 }
 
-func schema_pkg_apis_core_v1alpha1_KubeletConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1alpha1_KubeconfigInfo(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "KubeletConfig contains configuration settings for the kubelet.",
+				Description: "KubeconfigInfo contains information about the kubeconfig used to access the Plant cluster",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"featureGates": {
+					"certificateExpirationTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "FeatureGates contains information about enabled feature gates.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Type:   []string{"boolean"},
-										Format: "",
-									},
-								},
-							},
+							Description: "CertificateExpirationTime is the time when the client certificate of the kubeconfig expires",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"cpuCFSQuota": {
+					"tokenExpirationTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "CPUCFSQuota allows you to disable/enable CPU throttling for Pods.",
-							Type:        []string{"boolean"},
-							Format:      "",
+							Description: "TokenExpirationTime is the time when the bearer token of the kubeconfig expires",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_core_v1alpha1_KubeletConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "KubeletConfig contains configuration settings for the kubelet.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"featureGates": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FeatureGates contains information about enabled feature gates.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"boolean"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"cpuCFSQuota": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CPUCFSQuota allows you to disable/enable CPU throttling for Pods.",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
 					"cpuManagerPolicy": {
@@ -2844,6 +3805,41 @@ func schema_pkg_apis_core_v1alpha1_KubeletConfigEvictionSoftGracePeriod(ref comm
 	}
 }
 
+func schema_pkg_apis_core_v1alpha1_KubeletConfigFieldDrift(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "KubeletConfigFieldDrift contains the desired and the effective value of a field of the kubelet configuration.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the field in the kubelet configuration file, e.g. `maxPods` or `evictionHard[memory.available]`.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"desired": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Desired is the desired value of the field.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"actual": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Actual is the effective value of the field on the node. It is empty if the field is not set.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name", "desired"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_core_v1alpha1_Kubernetes(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -2980,6 +3976,27 @@ func schema_pkg_apis_core_v1alpha1_KubernetesInfo(ref common.ReferenceCallback)
 							Format:      "",
 						},
 					},
+					"nodeVersions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NodeVersions are the distinct kubelet versions of the nodes of the Plant cluster.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"nodeVersionSkew": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NodeVersionSkew is the maximum number of minor versions the kubelets of the Plant cluster are behind its control plane.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
 				},
 				Required: []string{"version"},
 			},
@@ -3386,6 +4403,118 @@ func schema_pkg_apis_core_v1alpha1_Monitoring(ref common.ReferenceCallback) comm
 	}
 }
 
+func schema_pkg_apis_core_v1alpha1_NetworkPolicyRule(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NetworkPolicyRule allows egress traffic from selected pods of the control plane of a Shoot to the given networks.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the rule. It must be unique among the rules of the Shoot and is part of the name of the generated NetworkPolicy.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"podSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PodSelector selects the pods of the control plane in the seed cluster to which the rule applies. It must not be empty, and the etcd pods are never selected.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+						},
+					},
+					"cidrs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CIDRs is a list of destination networks to which egress traffic is allowed.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"ports": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Ports is a list of destination ports to which egress traffic is allowed. If empty, all ports are allowed.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.NetworkPolicyRulePort"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"name", "podSelector", "cidrs"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.NetworkPolicyRulePort", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+	}
+}
+
+func schema_pkg_apis_core_v1alpha1_NetworkPolicyRulePort(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NetworkPolicyRulePort is a destination port of a network policy rule.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"protocol": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Protocol is the protocol of the port (TCP, UDP or SCTP). Defaults to TCP.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"port": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Port is the number of the port.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"port"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_core_v1alpha1_NetworkPool(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NetworkPool is a network from which equally sized networks are allocated.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"cidr": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CIDR is the network from which the networks are allocated.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"prefixLength": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PrefixLength is the prefix length of the allocated networks. It must not be smaller than the prefix length of the pool CIDR.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"cidr", "prefixLength"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_core_v1alpha1_Networking(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -3427,6 +4556,20 @@ func schema_pkg_apis_core_v1alpha1_Networking(ref common.ReferenceCallback) comm
 							Format:      "",
 						},
 					},
+					"ipFamilies": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IPFamilies specifies the IP protocol versions used by the networks of the Shoot. The first family is the primary one. Dual-stack networking is configured by specifying both IPv4 and IPv6, in this case the pod, node and service networks contain one CIDR per IP family, separated by a comma and in the same order. Defaults to IPv4 if empty.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"type", "nodes"},
 			},
@@ -3493,6 +4636,86 @@ func schema_pkg_apis_core_v1alpha1_NginxIngress(ref common.ReferenceCallback) co
 	}
 }
 
+func schema_pkg_apis_core_v1alpha1_NodeKubeletConfigDrift(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NodeKubeletConfigDrift contains the differences between the desired and the effective kubelet configuration of a node.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"node": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Node is the name of the node.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"workerPool": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WorkerPool is the name of the worker pool the node belongs to.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"fields": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Fields contains the fields of the kubelet configuration whose effective values differ from the desired ones.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.KubeletConfigFieldDrift"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"node", "fields"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.KubeletConfigFieldDrift"},
+	}
+}
+
+func schema_pkg_apis_core_v1alpha1_NodesInfo(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NodesInfo contains information about the nodes of the Plant cluster",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"count": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Count is the number of nodes registered to the cluster",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"capacity": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Capacity is the total capacity of all nodes registered to the cluster",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"count"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
+	}
+}
+
 func schema_pkg_apis_core_v1alpha1_OIDCConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -3948,12 +5171,26 @@ func schema_pkg_apis_core_v1alpha1_ProjectMember(ref common.ReferenceCallback) c
 							Format:      "",
 						},
 					},
-				},
-				Required: []string{"kind", "name", "role"},
-			},
-		},
-	}
-}
+					"roles": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Roles represents the list of additional roles of this member.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"kind", "name", "role"},
+			},
+		},
+	}
+}
 
 func schema_pkg_apis_core_v1alpha1_ProjectSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
@@ -4008,11 +5245,17 @@ func schema_pkg_apis_core_v1alpha1_ProjectSpec(ref common.ReferenceCallback) com
 							Format:      "",
 						},
 					},
+					"backupRetentionPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BackupRetentionPolicy is the default retention policy for the backups of the Shoots in the project.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.BackupRetentionPolicy"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ProjectMember", "k8s.io/api/rbac/v1.Subject"},
+			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.BackupRetentionPolicy", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.ProjectMember", "k8s.io/api/rbac/v1.Subject"},
 	}
 }
 
@@ -4283,6 +5526,27 @@ func schema_pkg_apis_core_v1alpha1_Region(ref common.ReferenceCallback) common.O
 	}
 }
 
+func schema_pkg_apis_core_v1alpha1_SecondaryBackupLocation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SecondaryBackupLocation contains information about a secondary location into which the backups are replicated.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"region": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Region is the region of the secondary location. It must differ from the region of the primary location.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"region"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_core_v1alpha1_SecretBinding(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -4460,12 +5724,18 @@ func schema_pkg_apis_core_v1alpha1_SeedBackup(ref common.ReferenceCallback) comm
 							Ref:         ref("k8s.io/api/core/v1.SecretReference"),
 						},
 					},
+					"secondary": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Secondary is a secondary location into which the backups are replicated. It uses the same provider and credentials as the primary location.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.SecondaryBackupLocation"),
+						},
+					},
 				},
 				Required: []string{"provider", "secretRef"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/api/core/v1.SecretReference"},
+			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.SecondaryBackupLocation", "k8s.io/api/core/v1.SecretReference"},
 	}
 }
 
@@ -4573,12 +5843,32 @@ func schema_pkg_apis_core_v1alpha1_SeedNetworks(ref common.ReferenceCallback) co
 							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.ShootNetworks"),
 						},
 					},
+					"shootPools": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ShootPools contains network pools from which non-overlapping networks are allocated to shoots which do not specify their node or pod network.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.ShootNetworkPools"),
+						},
+					},
+					"ipFamilies": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IPFamilies specifies the IP protocol versions used by the networks of the Seed. The first family is the primary one. Dual-stack networking is configured by specifying both IPv4 and IPv6, in this case the pod, node and service networks contain one CIDR per IP family, separated by a comma and in the same order. Defaults to IPv4 if empty.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"nodes", "pods", "services"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ShootNetworks"},
+			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ShootNetworkPools", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.ShootNetworks"},
 	}
 }
 
@@ -4913,6 +6203,136 @@ func schema_pkg_apis_core_v1alpha1_Shoot(ref common.ReferenceCallback) common.Op
 	}
 }
 
+func schema_pkg_apis_core_v1alpha1_ShootAvailability(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ShootAvailability contains the availability of the Shoot's components over several periods.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"apiServer": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIServer contains the availability ratios of the Shoot's API server as derived from the health checks.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.AvailabilityRatio"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.AvailabilityRatio"},
+	}
+}
+
+func schema_pkg_apis_core_v1alpha1_ShootBackup(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ShootBackup contains configuration settings for the backup of the Shoot's etcd.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"retentionPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RetentionPolicy defines how long the snapshots of the Shoot's etcd are kept. It overrides the default retention policy of the Project.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.BackupRetentionPolicy"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.BackupRetentionPolicy"},
+	}
+}
+
+func schema_pkg_apis_core_v1alpha1_ShootCredentials(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ShootCredentials contains information about the credentials of the Shoot cluster.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"rotation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Rotation contains information about the rotation of credentials.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.ShootCredentialsRotation"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ShootCredentialsRotation"},
+	}
+}
+
+func schema_pkg_apis_core_v1alpha1_ShootCredentialsRotation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ShootCredentialsRotation contains information about the rotation of credentials.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"certificateAuthorities": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CertificateAuthorities contains information about the rotation of the certificate authorities.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.CARotation"),
+						},
+					},
+					"staticCredentials": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StaticCredentials contains information about the rotation of the static tokens and the basic authentication password of the kube-apiserver.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.StaticCredentialsRotation"),
+						},
+					},
+					"etcdEncryptionKey": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ETCDEncryptionKey contains information about the rotation of the key which is used to encrypt resources in etcd.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.ETCDEncryptionKeyRotation"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.CARotation", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.ETCDEncryptionKeyRotation", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.StaticCredentialsRotation"},
+	}
+}
+
+func schema_pkg_apis_core_v1alpha1_ShootETCDBackup(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ShootETCDBackup contains information about the on-demand operations on the backup of the Shoot's etcd.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"lastSnapshot": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastSnapshot contains information about the last on-demand full snapshot of the etcd.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.ETCDBackupOperation"),
+						},
+					},
+					"lastRestore": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastRestore contains information about the last restoration of the etcd from its backup.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.ETCDBackupOperation"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ETCDBackupOperation"},
+	}
+}
+
 func schema_pkg_apis_core_v1alpha1_ShootList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -4998,6 +6418,33 @@ func schema_pkg_apis_core_v1alpha1_ShootMachineImage(ref common.ReferenceCallbac
 	}
 }
 
+func schema_pkg_apis_core_v1alpha1_ShootNetworkPools(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ShootNetworkPools contains network pools from which networks are allocated to shoots.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"nodes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Nodes is the pool from which the node networks of shoots are allocated.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.NetworkPool"),
+						},
+					},
+					"pods": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Pods is the pool from which the pod networks of shoots are allocated.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.NetworkPool"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.NetworkPool"},
+	}
+}
+
 func schema_pkg_apis_core_v1alpha1_ShootNetworks(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -5038,6 +6485,12 @@ func schema_pkg_apis_core_v1alpha1_ShootSpec(ref common.ReferenceCallback) commo
 							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.Addons"),
 						},
 					},
+					"backup": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Backup contains configuration settings for the backup of the Shoot's etcd.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.ShootBackup"),
+						},
+					},
 					"cloudProfileName": {
 						SchemaProps: spec.SchemaProps{
 							Description: "CloudProfileName is a name of a CloudProfile object.",
@@ -5045,6 +6498,12 @@ func schema_pkg_apis_core_v1alpha1_ShootSpec(ref common.ReferenceCallback) commo
 							Format:      "",
 						},
 					},
+					"controlPlane": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ControlPlane contains configuration settings for the control plane of the Shoot.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.ControlPlane"),
+						},
+					},
 					"dns": {
 						SchemaProps: spec.SchemaProps{
 							Description: "DNS contains information about the DNS settings of the Shoot.",
@@ -5126,7 +6585,7 @@ func schema_pkg_apis_core_v1alpha1_ShootSpec(ref common.ReferenceCallback) commo
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.Addons", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.DNS", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.Extension", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.Hibernation", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.Kubernetes", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.Maintenance", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.Monitoring", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.Networking", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.Provider"},
+			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.Addons", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.ControlPlane", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.DNS", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.Extension", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.Hibernation", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.Kubernetes", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.Maintenance", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.Monitoring", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.Networking", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.Provider", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.ShootBackup"},
 	}
 }
 
@@ -5274,6 +6733,12 @@ func schema_pkg_apis_core_v1alpha1_ShootStatus(ref common.ReferenceCallback) com
 				Description: "ShootStatus holds the most recently observed status of the Shoot cluster.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
+					"availability": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Availability contains the availability of the Shoot's components over several periods as derived from the health checks of the care controller.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.ShootAvailability"),
+						},
+					},
 					"conditions": {
 						VendorExtensible: spec.VendorExtensible{
 							Extensions: spec.Extensions{
@@ -5312,6 +6777,37 @@ func schema_pkg_apis_core_v1alpha1_ShootStatus(ref common.ReferenceCallback) com
 							},
 						},
 					},
+					"conditionHistories": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-patch-merge-key": "type",
+								"x-kubernetes-patch-strategy":  "merge",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "ConditionHistories contains a bounded history of the status transitions of the Shoot's conditions.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.ConditionHistory"),
+									},
+								},
+							},
+						},
+					},
+					"credentials": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Credentials contains information about the credentials of the Shoot cluster, e.g. the status of their rotation.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.ShootCredentials"),
+						},
+					},
+					"etcdBackup": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ETCDBackup contains information about the on-demand operations on the backup of the Shoot's etcd, i.e., taking snapshots and restoring the etcd from its backup.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.ShootETCDBackup"),
+						},
+					},
 					"gardener": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Gardener holds information about the Gardener which last acted on the Shoot.",
@@ -5325,6 +6821,19 @@ func schema_pkg_apis_core_v1alpha1_ShootStatus(ref common.ReferenceCallback) com
 							Format:      "",
 						},
 					},
+					"kubeletConfigDrifts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KubeletConfigDrifts contains the nodes of the Shoot whose effective kubelet configuration differs from the desired one as detected by the care controller. At most 10 nodes are listed.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.NodeKubeletConfigDrift"),
+									},
+								},
+							},
+						},
+					},
 					"lastOperation": {
 						SchemaProps: spec.SchemaProps{
 							Description: "LastOperation holds information about the last operation on the Shoot.",
@@ -5389,7 +6898,56 @@ func schema_pkg_apis_core_v1alpha1_ShootStatus(ref common.ReferenceCallback) com
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.Condition", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.Gardener", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.LastError", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.LastOperation", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.Condition", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.ConditionHistory", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.Gardener", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.LastError", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.LastOperation", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.NodeKubeletConfigDrift", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.ShootAvailability", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.ShootCredentials", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.ShootETCDBackup", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_core_v1alpha1_StaticCredentialsRotation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "StaticCredentialsRotation contains information about the rotation of the static tokens and the basic authentication password of the kube-apiserver of the Shoot cluster.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"lastRotationTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastRotationTime is the most recent time when the static credentials were rotated.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_core_v1alpha1_StaticCredentialsRotationConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "StaticCredentialsRotationConfig contains configuration settings for the scheduled rotation of the static tokens and the basic authentication password of the kube-apiserver.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"period": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Period is the interval after which the static tokens and the basic authentication password are rotated.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"transitionPeriod": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TransitionPeriod is the duration for which the previous static tokens remain valid after a rotation. Defaults to 24h.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+				},
+				Required: []string{"period"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
 	}
 }
 
@@ -5485,6 +7043,12 @@ func schema_pkg_apis_core_v1alpha1_Worker(ref common.ReferenceCallback) common.O
 							Format:      "",
 						},
 					},
+					"cri": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CRI contains the configuration of the container runtime of every machine of this worker pool. Defaults to Docker.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.CRI"),
+						},
+					},
 					"kubernetes": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Kubernetes contains configuration for Kubernetes components related to this worker pool.",
@@ -5589,7 +7153,7 @@ func schema_pkg_apis_core_v1alpha1_Worker(ref common.ReferenceCallback) common.O
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.Machine", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.ProviderConfig", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.Volume", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.WorkerKubernetes", "k8s.io/api/core/v1.Taint", "k8s.io/apimachinery/pkg/util/intstr.IntOrString"},
+			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.CRI", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.Machine", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.ProviderConfig", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.Volume", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.WorkerKubernetes", "k8s.io/api/core/v1.Taint", "k8s.io/apimachinery/pkg/util/intstr.IntOrString"},
 	}
 }
 
@@ -5614,6 +7178,62 @@ func schema_pkg_apis_core_v1alpha1_WorkerKubernetes(ref common.ReferenceCallback
 	}
 }
 
+func schema_pkg_apis_core_v1alpha1_WorkloadHealthInfo(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "WorkloadHealthInfo contains the number of total and healthy workloads of a kind",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"total": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Total is the total number of workloads",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"healthy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Healthy is the number of healthy workloads",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"total", "healthy"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_core_v1alpha1_WorkloadsInfo(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "WorkloadsInfo contains a health summary of the workloads of the Plant cluster",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"deployments": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Deployments is the health summary of the deployments",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.WorkloadHealthInfo"),
+						},
+					},
+					"statefulSets": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StatefulSets is the health summary of the statefulsets",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.WorkloadHealthInfo"),
+						},
+					},
+				},
+				Required: []string{"deployments", "statefulSets"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.WorkloadHealthInfo"},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_Addon(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -5642,121 +7262,407 @@ func schema_pkg_apis_core_v1beta1_Addons(ref common.ReferenceCallback) common.Op
 				Description: "Addons is a collection of configuration for specific addons which are managed by the Gardener.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kubernetesDashboard": {
-						SchemaProps: spec.SchemaProps{
-							Description: "KubernetesDashboard holds configuration settings for the kubernetes dashboard addon.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.KubernetesDashboard"),
-						},
-					},
-					"nginxIngress": {
+					"kubernetesDashboard": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KubernetesDashboard holds configuration settings for the kubernetes dashboard addon.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.KubernetesDashboard"),
+						},
+					},
+					"nginxIngress": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NginxIngress holds configuration settings for the nginx-ingress addon.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.NginxIngress"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.KubernetesDashboard", "github.com/gardener/gardener/pkg/apis/core/v1beta1.NginxIngress"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_AdmissionPlugin(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AdmissionPlugin contains information about a specific admission plugin and its corresponding configuration.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the plugin.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"config": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Config is the configuration of the plugin.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ProviderConfig"),
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.ProviderConfig"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_AdmissionWebhookConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AdmissionWebhookConfig contains configuration settings for the MutatingAdmissionWebhook and ValidatingAdmissionWebhook admission plugins.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"secretRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SecretRef is a reference to a secret in the same namespace as the Shoot which contains a kubeconfig (data key `kubeconfig`) with the credentials the kube-apiserver uses to authenticate to the servers of admission webhooks. The users of the kubeconfig are matched with the webhook servers by their names, i.e., the host name and optional port of the server (`*` wildcards are supported). All certificates and credentials must be contained inline.",
+							Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
+						},
+					},
+				},
+				Required: []string{"secretRef"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.LocalObjectReference"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_Alerting(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Alerting contains information about how alerting will be done (i.e. who will receive alerts and how).",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"emailReceivers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MonitoringEmailReceivers is a list of recipients for alerts",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_AuditBackend(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AuditBackend contains settings for the backend to which the kube-apiserver sends the audit events. Exactly one of Log or Webhook must be set.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"log": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Log contains configuration settings for writing the audit events to the log file of the kube-apiserver.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.AuditLogBackend"),
+						},
+					},
+					"webhook": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Webhook contains configuration settings for sending the audit events to an external webhook, e.g. a SIEM system. The log file of the kube-apiserver is not written if the webhook is configured.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.AuditWebhookBackend"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.AuditLogBackend", "github.com/gardener/gardener/pkg/apis/core/v1beta1.AuditWebhookBackend"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_AuditBuffering(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AuditBuffering contains settings for buffering and batching audit events before they are sent to a backend.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"mode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Mode is the strategy for sending the audit events. In `batch` mode, the events are buffered and sent asynchronously. In `blocking` mode, the kube-apiserver blocks its responses until the events have been sent, and in `blocking-strict` mode, requests fail if the audit events cannot be sent.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"bufferSize": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BufferSize is the size of the buffer to store the audit events before batching and sending them. Only used in `batch` mode.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"maxBatchSize": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxBatchSize is the maximum number of audit events in one batch. Only used in `batch` mode.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"maxBatchWait": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxBatchWait is the maximum time to wait before a batch is sent even if it is not full. Only used in `batch` mode.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"throttleQPS": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ThrottleQPS is the maximum average number of batches per second. If set, the batches are throttled. Only used in `batch` mode.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"throttleBurst": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ThrottleBurst is the maximum number of batches sent at the same moment if ThrottleQPS was not utilized before. Only used in `batch` mode.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"mode"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_AuditConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AuditConfig contains settings for audit of the api server",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"auditPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AuditPolicy contains configuration settings for audit policy of the kube-apiserver.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.AuditPolicy"),
+						},
+					},
+					"backend": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Backend contains configuration settings for the backend to which the kube-apiserver sends the audit events. If not set, the audit events are written to the log file of the kube-apiserver.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.AuditBackend"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.AuditBackend", "github.com/gardener/gardener/pkg/apis/core/v1beta1.AuditPolicy"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_AuditLogBackend(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AuditLogBackend contains settings for writing the audit events to the log file of the kube-apiserver.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"maxAge": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxAge is the maximum number of days to retain old audit log files.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"maxBackups": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxBackups is the maximum number of old audit log files to retain. Defaults to 5.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"maxSize": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxSize is the maximum size in megabytes of the audit log file before it gets rotated. Defaults to 100.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"buffering": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Buffering contains configuration settings for buffering and batching the audit events.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.AuditBuffering"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.AuditBuffering"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_AuditPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AuditPolicy contains audit policy for kube-apiserver",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"configMapRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "NginxIngress holds configuration settings for the nginx-ingress addon.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.NginxIngress"),
+							Description: "ConfigMapRef is a reference to a ConfigMap object in the same namespace, which contains the audit policy for the kube-apiserver.",
+							Ref:         ref("k8s.io/api/core/v1.ObjectReference"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.KubernetesDashboard", "github.com/gardener/gardener/pkg/apis/core/v1beta1.NginxIngress"},
+			"k8s.io/api/core/v1.ObjectReference"},
 	}
 }
 
-func schema_pkg_apis_core_v1beta1_AdmissionPlugin(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1beta1_AuditWebhookBackend(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "AdmissionPlugin contains information about a specific admission plugin and its corresponding configuration.",
+				Description: "AuditWebhookBackend contains settings for sending the audit events to an external webhook.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"secretRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the plugin.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "SecretRef is a reference to a secret in the same namespace as the Shoot which contains a kubeconfig (data key `kubeconfig`) that defines the address of and the credentials for the webhook. All certificates and credentials must be contained inline, references to files or credential plugins are not supported.",
+							Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
 						},
 					},
-					"config": {
+					"initialBackoff": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Config is the configuration of the plugin.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ProviderConfig"),
+							Description: "InitialBackoff is the time to wait before retrying the first failed request to the webhook. Defaults to 10s.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"buffering": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Buffering contains configuration settings for buffering and batching the audit events.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.AuditBuffering"),
 						},
 					},
 				},
-				Required: []string{"name"},
+				Required: []string{"secretRef"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.ProviderConfig"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.AuditBuffering", "k8s.io/api/core/v1.LocalObjectReference", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
 	}
 }
 
-func schema_pkg_apis_core_v1beta1_Alerting(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1beta1_AuthenticationWebhook(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Alerting contains information about how alerting will be done (i.e. who will receive alerts and how).",
+				Description: "AuthenticationWebhook contains configuration settings for a webhook which authenticates bearer tokens.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"emailReceivers": {
+					"secretRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "MonitoringEmailReceivers is a list of recipients for alerts",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Type:   []string{"string"},
-										Format: "",
-									},
-								},
-							},
+							Description: "SecretRef is a reference to a secret in the same namespace as the Shoot which contains a kubeconfig (data key `kubeconfig`) that defines the address of and the credentials for the webhook. All certificates and credentials must be contained inline, references to files or credential plugins are not supported.",
+							Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
+						},
+					},
+					"cacheTTL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CacheTTL is the duration to cache the responses of the webhook. Defaults to 2m.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
 						},
 					},
 				},
+				Required: []string{"secretRef"},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.LocalObjectReference", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
 	}
 }
 
-func schema_pkg_apis_core_v1beta1_AuditConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1beta1_AuthorizationWebhook(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "AuditConfig contains settings for audit of the api server",
+				Description: "AuthorizationWebhook contains configuration settings for a webhook which authorizes requests.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"auditPolicy": {
+					"secretRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "AuditPolicy contains configuration settings for audit policy of the kube-apiserver.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.AuditPolicy"),
+							Description: "SecretRef is a reference to a secret in the same namespace as the Shoot which contains a kubeconfig (data key `kubeconfig`) that defines the address of and the credentials for the webhook. All certificates and credentials must be contained inline, references to files or credential plugins are not supported.",
+							Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
+						},
+					},
+					"cacheAuthorizedTTL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CacheAuthorizedTTL is the duration to cache authorized responses of the webhook. Defaults to 5m.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"cacheUnauthorizedTTL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CacheUnauthorizedTTL is the duration to cache unauthorized responses of the webhook. Defaults to 30s.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
 						},
 					},
 				},
+				Required: []string{"secretRef"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.AuditPolicy"},
+			"k8s.io/api/core/v1.LocalObjectReference", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
 	}
 }
 
-func schema_pkg_apis_core_v1beta1_AuditPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1beta1_AvailabilityRatio(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "AuditPolicy contains audit policy for kube-apiserver",
+				Description: "AvailabilityRatio is the ratio of successful availability probes within a period.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"configMapRef": {
+					"period": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ConfigMapRef is a reference to a ConfigMap object in the same namespace, which contains the audit policy for the kube-apiserver.",
-							Ref:         ref("k8s.io/api/core/v1.ObjectReference"),
+							Description: "Period is the duration the ratio has been computed for.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"ratio": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Ratio is the percentage of successful probes within the period, e.g. \"99.950\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"probes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Probes is the number of probes which have been performed within the period.",
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
 				},
+				Required: []string{"period", "ratio", "probes"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/api/core/v1.ObjectReference"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
 	}
 }
 
@@ -5960,12 +7866,18 @@ func schema_pkg_apis_core_v1beta1_BackupBucketSpec(ref common.ReferenceCallback)
 							Format:      "",
 						},
 					},
+					"secondary": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Secondary is a secondary location into which the objects of the bucket are replicated.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.SecondaryBackupLocation"),
+						},
+					},
 				},
 				Required: []string{"provider", "secretRef"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.BackupBucketProvider", "k8s.io/api/core/v1.SecretReference"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.BackupBucketProvider", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SecondaryBackupLocation", "k8s.io/api/core/v1.SecretReference"},
 	}
 }
 
@@ -6127,10 +8039,18 @@ func schema_pkg_apis_core_v1beta1_BackupEntrySpec(ref common.ReferenceCallback)
 							Format:      "",
 						},
 					},
+					"retentionPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RetentionPolicy defines how long the snapshots in this Backup Entry are kept.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.BackupRetentionPolicy"),
+						},
+					},
 				},
 				Required: []string{"bucketName"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.BackupRetentionPolicy"},
 	}
 }
 
@@ -6160,6 +8080,13 @@ func schema_pkg_apis_core_v1beta1_BackupEntryStatus(ref common.ReferenceCallback
 							Format:      "int64",
 						},
 					},
+					"replicaBucketName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ReplicaBucketName is the name of the bucket into which the objects of this Backup Entry are replicated. It is only set if the Backup Bucket has a secondary location.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -6168,6 +8095,89 @@ func schema_pkg_apis_core_v1beta1_BackupEntryStatus(ref common.ReferenceCallback
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_BackupRetentionPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "BackupRetentionPolicy defines how long the snapshots of a backup are kept.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"dailySnapshots": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DailySnapshots is the number of days for which the latest snapshot of each day is kept.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"weeklySnapshots": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WeeklySnapshots is the number of weeks for which the latest snapshot of each week is kept.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_CARotation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CARotation contains information about the rotation of the certificate authorities of the Shoot cluster.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Phase describes the phase of the certificate authority rotation.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"lastInitiationTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastInitiationTime is the most recent time when the certificate authority rotation was initiated.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"lastCompletionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastCompletionTime is the most recent time when the certificate authority rotation was successfully completed.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"phase"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_CRI(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CRI contains information about the Container Runtimes.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the CRI library.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_CloudInfo(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -6481,12 +8491,30 @@ func schema_pkg_apis_core_v1beta1_ClusterInfo(ref common.ReferenceCallback) comm
 							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.KubernetesInfo"),
 						},
 					},
+					"nodes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Nodes describes the nodes of the Plant cluster",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.NodesInfo"),
+						},
+					},
+					"workloads": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Workloads describes the health of the deployments and statefulsets of the Plant cluster",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkloadsInfo"),
+						},
+					},
+					"kubeconfig": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kubeconfig describes the kubeconfig used to access the Plant cluster",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeconfigInfo"),
+						},
+					},
 				},
 				Required: []string{"cloud", "kubernetes"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.CloudInfo", "github.com/gardener/gardener/pkg/apis/core/v1beta1.KubernetesInfo"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.CloudInfo", "github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeconfigInfo", "github.com/gardener/gardener/pkg/apis/core/v1beta1.KubernetesInfo", "github.com/gardener/gardener/pkg/apis/core/v1beta1.NodesInfo", "github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkloadsInfo"},
 	}
 }
 
@@ -6538,11 +8566,110 @@ func schema_pkg_apis_core_v1beta1_Condition(ref common.ReferenceCallback) common
 						},
 					},
 				},
-				Required: []string{"type", "status", "lastTransitionTime", "lastUpdateTime", "reason", "message"},
+				Required: []string{"type", "status", "lastTransitionTime", "lastUpdateTime", "reason", "message"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_ConditionHistory(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ConditionHistory contains the most recent status transitions of a condition.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type is the type of the condition.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"transitions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Transitions are the most recent status transitions of the condition, ordered from oldest to newest.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ConditionTransition"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"type"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.ConditionTransition"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_ConditionTransition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ConditionTransition is a single status transition of a condition.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status is the status the condition transitioned to.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"time": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Time is the time of the transition.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"status", "time"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_ControlPlane(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ControlPlane contains configuration settings for the control plane of the Shoot.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"highAvailability": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HighAvailability configures the control plane of the Shoot to be highly available. It can only be set when the Shoot is created.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.HighAvailability"),
+						},
+					},
+					"networkPolicyRules": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NetworkPolicyRules is a list of additional rules allowing egress traffic from pods of the control plane of the Shoot in the seed cluster. The destinations must not overlap with the blocked CIDRs or the node, pod and service networks of the seed.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.NetworkPolicyRule"),
+									},
+								},
+							},
+						},
+					},
+				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.HighAvailability", "github.com/gardener/gardener/pkg/apis/core/v1beta1.NetworkPolicyRule"},
 	}
 }
 
@@ -7029,6 +9156,126 @@ func schema_pkg_apis_core_v1beta1_DNSProvider(ref common.ReferenceCallback) comm
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_ETCDBackupOperation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ETCDBackupOperation contains information about an on-demand operation on the backup of the Shoot's etcd.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"state": {
+						SchemaProps: spec.SchemaProps{
+							Description: "State is the current state of the operation.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"description": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Description is a human-readable message describing the progress of the operation.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"progress": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Progress is the progress of the operation in percent.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"lastUpdateTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastUpdateTime is the time at which the operation was updated the last time.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"snapshot": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Snapshot is the name of the full snapshot which has been taken. For restorations, it is the final snapshot of the replaced etcd.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"state", "description", "progress", "lastUpdateTime"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_ETCDEncryptionKeyRotation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ETCDEncryptionKeyRotation contains information about the rotation of the key which is used by the kube-apiserver to encrypt resources in etcd.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Phase describes the phase of the etcd encryption key rotation.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"lastInitiationTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastInitiationTime is the most recent time when the etcd encryption key rotation was initiated.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"lastCompletionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastCompletionTime is the most recent time when the etcd encryption key rotation was successfully completed.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"phase"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_EncryptionConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "EncryptionConfig contains customizable encryption configuration of the kube-apiserver.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"resources": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Resources contains the list of resources that shall be encrypted in etcd in addition to secrets. Each item is a Kubernetes resource name in plural (resource or resource.group), e.g., configmaps or flunders.example.com. Removed resources are decrypted again.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"kms": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KMS configures a KMS provider which is used to encrypt the resources instead of the aescbc provider. The KMS plugin is run as sidecar of the kube-apiserver by the extension which is responsible for the given type.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.KMSProvider"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.KMSProvider"},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_Endpoint(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -7122,6 +9369,27 @@ func schema_pkg_apis_core_v1beta1_Extension(ref common.ReferenceCallback) common
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_FailureTolerance(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "FailureTolerance describes the failure tolerance of a highly available control plane.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type specifies the failure domain of the seed across which the replicas of the control plane components are spread, i.e. whether the control plane tolerates the loss of a node or of an entire zone.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"type"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_Gardener(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -7238,6 +9506,28 @@ func schema_pkg_apis_core_v1beta1_HibernationSchedule(ref common.ReferenceCallba
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_HighAvailability(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HighAvailability specifies the configuration settings for a highly available control plane.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"failureTolerance": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FailureTolerance holds information about the failure tolerance of the control plane.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.FailureTolerance"),
+						},
+					},
+				},
+				Required: []string{"failureTolerance"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.FailureTolerance"},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_HorizontalPodAutoscalerConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -7296,6 +9586,42 @@ func schema_pkg_apis_core_v1beta1_HorizontalPodAutoscalerConfig(ref common.Refer
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_KMSProvider(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "KMSProvider contains the configuration of a KMS provider for the encryption of resources in etcd.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type is the type of the KMS plugin. An extension must be registered for it which runs the plugin as sidecar of the kube-apiserver.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"cacheSize": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CacheSize is the maximum number of data encryption keys which are cached in memory by the kube-apiserver. Defaults to 1000.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"timeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Timeout is the timeout for calls of the kube-apiserver to the KMS plugin. Defaults to 3s.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+				},
+				Required: []string{"type"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_KubeAPIServerConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -7364,6 +9690,12 @@ func schema_pkg_apis_core_v1beta1_KubeAPIServerConfig(ref common.ReferenceCallba
 							Format:      "",
 						},
 					},
+					"encryptionConfig": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EncryptionConfig contains customizable encryption configuration of the kube-apiserver.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.EncryptionConfig"),
+						},
+					},
 					"oidcConfig": {
 						SchemaProps: spec.SchemaProps{
 							Description: "OIDCConfig contains configuration settings for the OIDC provider.",
@@ -7391,11 +9723,97 @@ func schema_pkg_apis_core_v1beta1_KubeAPIServerConfig(ref common.ReferenceCallba
 							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ServiceAccountConfig"),
 						},
 					},
+					"staticCredentialsRotation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StaticCredentialsRotation contains configuration settings for the scheduled rotation of the static tokens and the basic authentication password of the kube-apiserver.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.StaticCredentialsRotationConfig"),
+						},
+					},
+					"authenticationWebhook": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AuthenticationWebhook contains configuration settings for a webhook which authenticates bearer tokens in addition to the built-in authenticators of the kube-apiserver.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.AuthenticationWebhook"),
+						},
+					},
+					"authorizationWebhook": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AuthorizationWebhook contains configuration settings for a webhook which authorizes requests after the Node and RBAC authorizers of the kube-apiserver.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.AuthorizationWebhook"),
+						},
+					},
+					"admissionWebhookConfig": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AdmissionWebhookConfig contains configuration settings for the admission plugins which call the validating and mutating admission webhooks. It is written to the admission control config file of the kube-apiserver.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.AdmissionWebhookConfig"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.AdmissionPlugin", "github.com/gardener/gardener/pkg/apis/core/v1beta1.AuditConfig", "github.com/gardener/gardener/pkg/apis/core/v1beta1.OIDCConfig", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ServiceAccountConfig"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.AdmissionPlugin", "github.com/gardener/gardener/pkg/apis/core/v1beta1.AdmissionWebhookConfig", "github.com/gardener/gardener/pkg/apis/core/v1beta1.AuditConfig", "github.com/gardener/gardener/pkg/apis/core/v1beta1.AuthenticationWebhook", "github.com/gardener/gardener/pkg/apis/core/v1beta1.AuthorizationWebhook", "github.com/gardener/gardener/pkg/apis/core/v1beta1.EncryptionConfig", "github.com/gardener/gardener/pkg/apis/core/v1beta1.OIDCConfig", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ServiceAccountConfig", "github.com/gardener/gardener/pkg/apis/core/v1beta1.StaticCredentialsRotationConfig"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_KubeControllerManagerConcurrentSyncs(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "KubeControllerManagerConcurrentSyncs contains the number of objects which are allowed to be synced concurrently by the controllers of the kube-controller-manager. Larger numbers result in more responsive controllers but cause more load on the kube-apiserver.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"deployments": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Deployments is the number of deployments that are allowed to sync concurrently (default: 10).",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"endpoints": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Endpoints is the number of endpoints that are allowed to sync concurrently (default: 5).",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"garbageCollector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "GarbageCollector is the number of garbage collector workers that are allowed to sync concurrently (default: 20).",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"namespaces": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespaces is the number of namespaces that are allowed to sync concurrently (default: 10).",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"replicaSets": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ReplicaSets is the number of replica sets that are allowed to sync concurrently (default: 10).",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"resourceQuotas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ResourceQuotas is the number of resource quotas that are allowed to sync concurrently (default: 5).",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"serviceAccountTokens": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ServiceAccountTokens is the number of service account token objects that are allowed to sync concurrently (default: 5).",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -7434,11 +9852,29 @@ func schema_pkg_apis_core_v1beta1_KubeControllerManagerConfig(ref common.Referen
 							Format:      "int32",
 						},
 					},
+					"nodeMonitorGracePeriod": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NodeMonitorGracePeriod is the amount of time which a running node is allowed to be unresponsive before it is marked unhealthy (default: 80s).",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"podEvictionTimeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PodEvictionTimeout is the grace period for deleting pods on failed nodes (default: 2m).",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"concurrentSyncs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConcurrentSyncs contains the number of objects which are allowed to be synced concurrently by the controllers of the kube-controller-manager.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeControllerManagerConcurrentSyncs"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.HorizontalPodAutoscalerConfig"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.HorizontalPodAutoscalerConfig", "github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeControllerManagerConcurrentSyncs", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
 	}
 }
 
@@ -7499,9 +9935,43 @@ func schema_pkg_apis_core_v1beta1_KubeSchedulerConfig(ref common.ReferenceCallba
 							},
 						},
 					},
+					"profile": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Profile configures the scoring strategy of the kube-scheduler (default: balanced).",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_KubeconfigInfo(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "KubeconfigInfo contains information about the kubeconfig used to access the Plant cluster",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"certificateExpirationTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CertificateExpirationTime is the time when the client certificate of the kubeconfig expires",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"tokenExpirationTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TokenExpirationTime is the time when the bearer token of the kubeconfig expires",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -7738,6 +10208,41 @@ func schema_pkg_apis_core_v1beta1_KubeletConfigEvictionSoftGracePeriod(ref commo
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_KubeletConfigFieldDrift(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "KubeletConfigFieldDrift contains the desired and the effective value of a field of the kubelet configuration.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the field in the kubelet configuration file, e.g. `maxPods` or `evictionHard[memory.available]`.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"desired": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Desired is the desired value of the field.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"actual": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Actual is the effective value of the field on the node. It is empty if the field is not set.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name", "desired"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_Kubernetes(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -7874,6 +10379,27 @@ func schema_pkg_apis_core_v1beta1_KubernetesInfo(ref common.ReferenceCallback) c
 							Format:      "",
 						},
 					},
+					"nodeVersions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NodeVersions are the distinct kubelet versions of the nodes of the Plant cluster.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"nodeVersionSkew": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NodeVersionSkew is the maximum number of minor versions the kubelets of the Plant cluster are behind its control plane.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
 				},
 				Required: []string{"version"},
 			},
@@ -8280,6 +10806,118 @@ func schema_pkg_apis_core_v1beta1_Monitoring(ref common.ReferenceCallback) commo
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_NetworkPolicyRule(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NetworkPolicyRule allows egress traffic from selected pods of the control plane of a Shoot to the given networks.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the rule. It must be unique among the rules of the Shoot and is part of the name of the generated NetworkPolicy.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"podSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PodSelector selects the pods of the control plane in the seed cluster to which the rule applies. It must not be empty, and the etcd pods are never selected.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+						},
+					},
+					"cidrs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CIDRs is a list of destination networks to which egress traffic is allowed.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"ports": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Ports is a list of destination ports to which egress traffic is allowed. If empty, all ports are allowed.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.NetworkPolicyRulePort"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"name", "podSelector", "cidrs"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.NetworkPolicyRulePort", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_NetworkPolicyRulePort(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NetworkPolicyRulePort is a destination port of a network policy rule.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"protocol": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Protocol is the protocol of the port (TCP, UDP or SCTP). Defaults to TCP.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"port": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Port is the number of the port.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"port"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_NetworkPool(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NetworkPool is a network from which equally sized networks are allocated.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"cidr": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CIDR is the network from which the networks are allocated.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"prefixLength": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PrefixLength is the prefix length of the allocated networks. It must not be smaller than the prefix length of the pool CIDR.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"cidr", "prefixLength"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_Networking(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -8321,6 +10959,20 @@ func schema_pkg_apis_core_v1beta1_Networking(ref common.ReferenceCallback) commo
 							Format:      "",
 						},
 					},
+					"ipFamilies": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IPFamilies specifies the IP protocol versions used by the networks of the Shoot. The first family is the primary one. Dual-stack networking is configured by specifying both IPv4 and IPv6, in this case the pod, node and service networks contain one CIDR per IP family, separated by a comma and in the same order. Defaults to IPv4 if empty.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"type", "nodes"},
 			},
@@ -8387,6 +11039,86 @@ func schema_pkg_apis_core_v1beta1_NginxIngress(ref common.ReferenceCallback) com
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_NodeKubeletConfigDrift(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NodeKubeletConfigDrift contains the differences between the desired and the effective kubelet configuration of a node.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"node": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Node is the name of the node.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"workerPool": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WorkerPool is the name of the worker pool the node belongs to.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"fields": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Fields contains the fields of the kubelet configuration whose effective values differ from the desired ones.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeletConfigFieldDrift"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"node", "fields"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeletConfigFieldDrift"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_NodesInfo(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NodesInfo contains information about the nodes of the Plant cluster",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"count": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Count is the number of nodes registered to the cluster",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"capacity": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Capacity is the total capacity of all nodes registered to the cluster",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"count"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_OIDCConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -8842,6 +11574,20 @@ func schema_pkg_apis_core_v1beta1_ProjectMember(ref common.ReferenceCallback) co
 							Format:      "",
 						},
 					},
+					"roles": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Roles represents the list of additional roles of this member.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"kind", "name", "role"},
 			},
@@ -8902,11 +11648,17 @@ func schema_pkg_apis_core_v1beta1_ProjectSpec(ref common.ReferenceCallback) comm
 							Format:      "",
 						},
 					},
+					"backupRetentionPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BackupRetentionPolicy is the default retention policy for the backups of the Shoots in the project.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.BackupRetentionPolicy"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.ProjectMember", "k8s.io/api/rbac/v1.Subject"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.BackupRetentionPolicy", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ProjectMember", "k8s.io/api/rbac/v1.Subject"},
 	}
 }
 
@@ -9177,6 +11929,27 @@ func schema_pkg_apis_core_v1beta1_Region(ref common.ReferenceCallback) common.Op
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_SecondaryBackupLocation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SecondaryBackupLocation contains information about a secondary location into which the backups are replicated.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"region": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Region is the region of the secondary location. It must differ from the region of the primary location.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"region"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_SecretBinding(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -9350,8 +12123,14 @@ func schema_pkg_apis_core_v1beta1_SeedBackup(ref common.ReferenceCallback) commo
 					},
 					"secretRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SecretRef is a reference to a Secret object containing the cloud provider credentials for the object store where backups should be stored. It should have enough privileges to manipulate the objects as well as buckets.",
-							Ref:         ref("k8s.io/api/core/v1.SecretReference"),
+							Description: "SecretRef is a reference to a Secret object containing the cloud provider credentials for the object store where backups should be stored. It should have enough privileges to manipulate the objects as well as buckets.",
+							Ref:         ref("k8s.io/api/core/v1.SecretReference"),
+						},
+					},
+					"secondary": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Secondary is a secondary location into which the backups are replicated. It uses the same provider and credentials as the primary location.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.SecondaryBackupLocation"),
 						},
 					},
 				},
@@ -9359,7 +12138,7 @@ func schema_pkg_apis_core_v1beta1_SeedBackup(ref common.ReferenceCallback) commo
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/api/core/v1.SecretReference"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.SecondaryBackupLocation", "k8s.io/api/core/v1.SecretReference"},
 	}
 }
 
@@ -9467,6 +12246,26 @@ func schema_pkg_apis_core_v1beta1_SeedNetworks(ref common.ReferenceCallback) com
 							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootNetworks"),
 						},
 					},
+					"shootPools": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ShootPools contains network pools from which non-overlapping networks are allocated to shoots which do not specify their node or pod network.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootNetworkPools"),
+						},
+					},
+					"ipFamilies": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IPFamilies specifies the IP protocol versions used by the networks of the Seed. The first family is the primary one. Dual-stack networking is configured by specifying both IPv4 and IPv6, in this case the pod, node and service networks contain one CIDR per IP family, separated by a comma and in the same order. Defaults to IPv4 if empty.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
 					"blockCIDRs": {
 						SchemaProps: spec.SchemaProps{
 							Description: "BlockCIDRs is a list of network addresses that should be blocked for shoot control plane components running in the seed cluster.",
@@ -9486,7 +12285,7 @@ func schema_pkg_apis_core_v1beta1_SeedNetworks(ref common.ReferenceCallback) com
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootNetworks"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootNetworkPools", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootNetworks"},
 	}
 }
 
@@ -9807,6 +12606,136 @@ func schema_pkg_apis_core_v1beta1_Shoot(ref common.ReferenceCallback) common.Ope
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_ShootAvailability(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ShootAvailability contains the availability of the Shoot's components over several periods.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"apiServer": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIServer contains the availability ratios of the Shoot's API server as derived from the health checks.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.AvailabilityRatio"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.AvailabilityRatio"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_ShootBackup(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ShootBackup contains configuration settings for the backup of the Shoot's etcd.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"retentionPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RetentionPolicy defines how long the snapshots of the Shoot's etcd are kept. It overrides the default retention policy of the Project.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.BackupRetentionPolicy"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.BackupRetentionPolicy"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_ShootCredentials(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ShootCredentials contains information about the credentials of the Shoot cluster.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"rotation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Rotation contains information about the rotation of credentials.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootCredentialsRotation"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootCredentialsRotation"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_ShootCredentialsRotation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ShootCredentialsRotation contains information about the rotation of credentials.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"certificateAuthorities": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CertificateAuthorities contains information about the rotation of the certificate authorities.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.CARotation"),
+						},
+					},
+					"staticCredentials": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StaticCredentials contains information about the rotation of the static tokens and the basic authentication password of the kube-apiserver.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.StaticCredentialsRotation"),
+						},
+					},
+					"etcdEncryptionKey": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ETCDEncryptionKey contains information about the rotation of the key which is used to encrypt resources in etcd.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ETCDEncryptionKeyRotation"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.CARotation", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ETCDEncryptionKeyRotation", "github.com/gardener/gardener/pkg/apis/core/v1beta1.StaticCredentialsRotation"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_ShootETCDBackup(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ShootETCDBackup contains information about the on-demand operations on the backup of the Shoot's etcd.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"lastSnapshot": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastSnapshot contains information about the last on-demand full snapshot of the etcd.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ETCDBackupOperation"),
+						},
+					},
+					"lastRestore": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastRestore contains information about the last restoration of the etcd from its backup.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ETCDBackupOperation"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.ETCDBackupOperation"},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_ShootList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -9892,6 +12821,33 @@ func schema_pkg_apis_core_v1beta1_ShootMachineImage(ref common.ReferenceCallback
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_ShootNetworkPools(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ShootNetworkPools contains network pools from which networks are allocated to shoots.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"nodes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Nodes is the pool from which the node networks of shoots are allocated.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.NetworkPool"),
+						},
+					},
+					"pods": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Pods is the pool from which the pod networks of shoots are allocated.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.NetworkPool"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.NetworkPool"},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_ShootNetworks(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -9932,6 +12888,12 @@ func schema_pkg_apis_core_v1beta1_ShootSpec(ref common.ReferenceCallback) common
 							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.Addons"),
 						},
 					},
+					"backup": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Backup contains configuration settings for the backup of the Shoot's etcd.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootBackup"),
+						},
+					},
 					"cloudProfileName": {
 						SchemaProps: spec.SchemaProps{
 							Description: "CloudProfileName is a name of a CloudProfile object.",
@@ -9939,6 +12901,12 @@ func schema_pkg_apis_core_v1beta1_ShootSpec(ref common.ReferenceCallback) common
 							Format:      "",
 						},
 					},
+					"controlPlane": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ControlPlane contains configuration settings for the control plane of the Shoot.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ControlPlane"),
+						},
+					},
 					"dns": {
 						SchemaProps: spec.SchemaProps{
 							Description: "DNS contains information about the DNS settings of the Shoot.",
@@ -10020,7 +12988,7 @@ func schema_pkg_apis_core_v1beta1_ShootSpec(ref common.ReferenceCallback) common
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.Addons", "github.com/gardener/gardener/pkg/apis/core/v1beta1.DNS", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Extension", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Hibernation", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Kubernetes", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Maintenance", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Monitoring", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Networking", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Provider"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.Addons", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ControlPlane", "github.com/gardener/gardener/pkg/apis/core/v1beta1.DNS", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Extension", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Hibernation", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Kubernetes", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Maintenance", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Monitoring", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Networking", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Provider", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootBackup"},
 	}
 }
 
@@ -10031,6 +12999,12 @@ func schema_pkg_apis_core_v1beta1_ShootStatus(ref common.ReferenceCallback) comm
 				Description: "ShootStatus holds the most recently observed status of the Shoot cluster.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
+					"availability": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Availability contains the availability of the Shoot's components over several periods as derived from the health checks of the care controller.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootAvailability"),
+						},
+					},
 					"conditions": {
 						VendorExtensible: spec.VendorExtensible{
 							Extensions: spec.Extensions{
@@ -10069,6 +13043,37 @@ func schema_pkg_apis_core_v1beta1_ShootStatus(ref common.ReferenceCallback) comm
 							},
 						},
 					},
+					"conditionHistories": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-patch-merge-key": "type",
+								"x-kubernetes-patch-strategy":  "merge",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "ConditionHistories contains a bounded history of the status transitions of the Shoot's conditions.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ConditionHistory"),
+									},
+								},
+							},
+						},
+					},
+					"credentials": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Credentials contains information about the credentials of the Shoot cluster, e.g. the status of their rotation.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootCredentials"),
+						},
+					},
+					"etcdBackup": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ETCDBackup contains information about the on-demand operations on the backup of the Shoot's etcd, i.e., taking snapshots and restoring the etcd from its backup.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootETCDBackup"),
+						},
+					},
 					"gardener": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Gardener holds information about the Gardener which last acted on the Shoot.",
@@ -10082,6 +13087,19 @@ func schema_pkg_apis_core_v1beta1_ShootStatus(ref common.ReferenceCallback) comm
 							Format:      "",
 						},
 					},
+					"kubeletConfigDrifts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KubeletConfigDrifts contains the nodes of the Shoot whose effective kubelet configuration differs from the desired one as detected by the care controller. At most 10 nodes are listed.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.NodeKubeletConfigDrift"),
+									},
+								},
+							},
+						},
+					},
 					"lastOperation": {
 						SchemaProps: spec.SchemaProps{
 							Description: "LastOperation holds information about the last operation on the Shoot.",
@@ -10140,7 +13158,56 @@ func schema_pkg_apis_core_v1beta1_ShootStatus(ref common.ReferenceCallback) comm
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.Condition", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Gardener", "github.com/gardener/gardener/pkg/apis/core/v1beta1.LastError", "github.com/gardener/gardener/pkg/apis/core/v1beta1.LastOperation", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.Condition", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ConditionHistory", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Gardener", "github.com/gardener/gardener/pkg/apis/core/v1beta1.LastError", "github.com/gardener/gardener/pkg/apis/core/v1beta1.LastOperation", "github.com/gardener/gardener/pkg/apis/core/v1beta1.NodeKubeletConfigDrift", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootAvailability", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootCredentials", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootETCDBackup", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_StaticCredentialsRotation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "StaticCredentialsRotation contains information about the rotation of the static tokens and the basic authentication password of the kube-apiserver of the Shoot cluster.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"lastRotationTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastRotationTime is the most recent time when the static credentials were rotated.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_StaticCredentialsRotationConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "StaticCredentialsRotationConfig contains configuration settings for the scheduled rotation of the static tokens and the basic authentication password of the kube-apiserver.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"period": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Period is the interval after which the static tokens and the basic authentication password are rotated.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"transitionPeriod": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TransitionPeriod is the duration for which the previous static tokens remain valid after a rotation. Defaults to 24h.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+				},
+				Required: []string{"period"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
 	}
 }
 
@@ -10236,6 +13303,12 @@ func schema_pkg_apis_core_v1beta1_Worker(ref common.ReferenceCallback) common.Op
 							Format:      "",
 						},
 					},
+					"cri": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CRI contains the configuration of the container runtime of every machine of this worker pool. Defaults to Docker.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.CRI"),
+						},
+					},
 					"kubernetes": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Kubernetes contains configuration for Kubernetes components related to this worker pool.",
@@ -10317,51 +13390,107 @@ func schema_pkg_apis_core_v1beta1_Worker(ref common.ReferenceCallback) common.Op
 					},
 					"volume": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Volume contains information about the volume type and size.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.Volume"),
+							Description: "Volume contains information about the volume type and size.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.Volume"),
+						},
+					},
+					"zones": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Zones is a list of availability zones that are used to evenly distribute this worker pool. Optional as not every provider may support availability zones.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"name", "machine", "maximum", "minimum"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.CRI", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Machine", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ProviderConfig", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Volume", "github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkerKubernetes", "k8s.io/api/core/v1.Taint", "k8s.io/apimachinery/pkg/util/intstr.IntOrString"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_WorkerKubernetes(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "WorkerKubernetes contains configuration for Kubernetes components related to this worker pool.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kubelet": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kubelet contains configuration settings for all kubelets of this worker pool.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeletConfig"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeletConfig"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_WorkloadHealthInfo(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "WorkloadHealthInfo contains the number of total and healthy workloads of a kind",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"total": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Total is the total number of workloads",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"zones": {
+					"healthy": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Zones is a list of availability zones that are used to evenly distribute this worker pool. Optional as not every provider may support availability zones.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Type:   []string{"string"},
-										Format: "",
-									},
-								},
-							},
+							Description: "Healthy is the number of healthy workloads",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
 				},
-				Required: []string{"name", "machine", "maximum", "minimum"},
+				Required: []string{"total", "healthy"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.Machine", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ProviderConfig", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Volume", "github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkerKubernetes", "k8s.io/api/core/v1.Taint", "k8s.io/apimachinery/pkg/util/intstr.IntOrString"},
 	}
 }
 
-func schema_pkg_apis_core_v1beta1_WorkerKubernetes(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1beta1_WorkloadsInfo(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "WorkerKubernetes contains configuration for Kubernetes components related to this worker pool.",
+				Description: "WorkloadsInfo contains a health summary of the workloads of the Plant cluster",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kubelet": {
+					"deployments": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kubelet contains configuration settings for all kubelets of this worker pool.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeletConfig"),
+							Description: "Deployments is the health summary of the deployments",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkloadHealthInfo"),
+						},
+					},
+					"statefulSets": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StatefulSets is the health summary of the statefulsets",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkloadHealthInfo"),
 						},
 					},
 				},
+				Required: []string{"deployments", "statefulSets"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeletConfig"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkloadHealthInfo"},
 	}
 }
 
@@ -10786,6 +13915,12 @@ func schema_pkg_apis_garden_v1beta1_AWSWorker(ref common.ReferenceCallback) comm
 							Format:      "",
 						},
 					},
+					"cri": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CRI contains the configuration of the container runtime of every machine of this worker pool. Defaults to Docker.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.CRI"),
+						},
+					},
 					"volumeType": {
 						SchemaProps: spec.SchemaProps{
 							Description: "VolumeType is the type of the root volumes.",
@@ -10805,7 +13940,7 @@ func schema_pkg_apis_garden_v1beta1_AWSWorker(ref common.ReferenceCallback) comm
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeletConfig", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootMachineImage", "k8s.io/api/core/v1.Taint", "k8s.io/apimachinery/pkg/util/intstr.IntOrString"},
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.CRI", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeletConfig", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootMachineImage", "k8s.io/api/core/v1.Taint", "k8s.io/apimachinery/pkg/util/intstr.IntOrString"},
 	}
 }
 
@@ -10937,6 +14072,28 @@ func schema_pkg_apis_garden_v1beta1_AdmissionPlugin(ref common.ReferenceCallback
 	}
 }
 
+func schema_pkg_apis_garden_v1beta1_AdmissionWebhookConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AdmissionWebhookConfig contains configuration settings for the MutatingAdmissionWebhook and ValidatingAdmissionWebhook admission plugins.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"secretRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SecretRef is a reference to a secret in the same namespace as the Shoot which contains a kubeconfig (data key `kubeconfig`) with the credentials the kube-apiserver uses to authenticate to the servers of admission webhooks. The users of the kubeconfig are matched with the webhook servers by their names, i.e., the host name and optional port of the server (`*` wildcards are supported). All certificates and credentials must be contained inline.",
+							Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
+						},
+					},
+				},
+				Required: []string{"secretRef"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.LocalObjectReference"},
+	}
+}
+
 func schema_pkg_apis_garden_v1beta1_Alerting(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -11472,6 +14629,12 @@ func schema_pkg_apis_garden_v1beta1_AlicloudWorker(ref common.ReferenceCallback)
 							Format:      "",
 						},
 					},
+					"cri": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CRI contains the configuration of the container runtime of every machine of this worker pool. Defaults to Docker.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.CRI"),
+						},
+					},
 					"volumeType": {
 						SchemaProps: spec.SchemaProps{
 							Description: "VolumeType is the type of the root volumes.",
@@ -11491,7 +14654,91 @@ func schema_pkg_apis_garden_v1beta1_AlicloudWorker(ref common.ReferenceCallback)
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeletConfig", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootMachineImage", "k8s.io/api/core/v1.Taint", "k8s.io/apimachinery/pkg/util/intstr.IntOrString"},
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.CRI", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeletConfig", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootMachineImage", "k8s.io/api/core/v1.Taint", "k8s.io/apimachinery/pkg/util/intstr.IntOrString"},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_AuditBackend(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AuditBackend contains settings for the backend to which the kube-apiserver sends the audit events. Exactly one of Log or Webhook must be set.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"log": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Log contains configuration settings for writing the audit events to the log file of the kube-apiserver.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.AuditLogBackend"),
+						},
+					},
+					"webhook": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Webhook contains configuration settings for sending the audit events to an external webhook, e.g. a SIEM system. The log file of the kube-apiserver is not written if the webhook is configured.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.AuditWebhookBackend"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AuditLogBackend", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.AuditWebhookBackend"},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_AuditBuffering(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AuditBuffering contains settings for buffering and batching audit events before they are sent to a backend.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"mode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Mode is the strategy for sending the audit events. In `batch` mode, the events are buffered and sent asynchronously. In `blocking` mode, the kube-apiserver blocks its responses until the events have been sent, and in `blocking-strict` mode, requests fail if the audit events cannot be sent.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"bufferSize": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BufferSize is the size of the buffer to store the audit events before batching and sending them. Only used in `batch` mode.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"maxBatchSize": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxBatchSize is the maximum number of audit events in one batch. Only used in `batch` mode.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"maxBatchWait": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxBatchWait is the maximum time to wait before a batch is sent even if it is not full. Only used in `batch` mode.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"throttleQPS": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ThrottleQPS is the maximum average number of batches per second. If set, the batches are throttled. Only used in `batch` mode.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"throttleBurst": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ThrottleBurst is the maximum number of batches sent at the same moment if ThrottleQPS was not utilized before. Only used in `batch` mode.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"mode"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
 	}
 }
 
@@ -11508,11 +14755,59 @@ func schema_pkg_apis_garden_v1beta1_AuditConfig(ref common.ReferenceCallback) co
 							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.AuditPolicy"),
 						},
 					},
+					"backend": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Backend contains configuration settings for the backend to which the kube-apiserver sends the audit events. If not set, the audit events are written to the log file of the kube-apiserver.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.AuditBackend"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AuditBackend", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.AuditPolicy"},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_AuditLogBackend(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AuditLogBackend contains settings for writing the audit events to the log file of the kube-apiserver.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"maxAge": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxAge is the maximum number of days to retain old audit log files.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"maxBackups": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxBackups is the maximum number of old audit log files to retain. Defaults to 5.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"maxSize": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxSize is the maximum size in megabytes of the audit log file before it gets rotated. Defaults to 100.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"buffering": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Buffering contains configuration settings for buffering and batching the audit events.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.AuditBuffering"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AuditPolicy"},
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AuditBuffering"},
 	}
 }
 
@@ -11537,6 +14832,138 @@ func schema_pkg_apis_garden_v1beta1_AuditPolicy(ref common.ReferenceCallback) co
 	}
 }
 
+func schema_pkg_apis_garden_v1beta1_AuditWebhookBackend(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AuditWebhookBackend contains settings for sending the audit events to an external webhook.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"secretRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SecretRef is a reference to a secret in the same namespace as the Shoot which contains a kubeconfig (data key `kubeconfig`) that defines the address of and the credentials for the webhook. All certificates and credentials must be contained inline, references to files or credential plugins are not supported.",
+							Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
+						},
+					},
+					"initialBackoff": {
+						SchemaProps: spec.SchemaProps{
+							Description: "InitialBackoff is the time to wait before retrying the first failed request to the webhook. Defaults to 10s.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"buffering": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Buffering contains configuration settings for buffering and batching the audit events.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.AuditBuffering"),
+						},
+					},
+				},
+				Required: []string{"secretRef"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AuditBuffering", "k8s.io/api/core/v1.LocalObjectReference", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_AuthenticationWebhook(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AuthenticationWebhook contains configuration settings for a webhook which authenticates bearer tokens.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"secretRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SecretRef is a reference to a secret in the same namespace as the Shoot which contains a kubeconfig (data key `kubeconfig`) that defines the address of and the credentials for the webhook. All certificates and credentials must be contained inline, references to files or credential plugins are not supported.",
+							Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
+						},
+					},
+					"cacheTTL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CacheTTL is the duration to cache the responses of the webhook. Defaults to 2m.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+				},
+				Required: []string{"secretRef"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.LocalObjectReference", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_AuthorizationWebhook(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AuthorizationWebhook contains configuration settings for a webhook which authorizes requests.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"secretRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SecretRef is a reference to a secret in the same namespace as the Shoot which contains a kubeconfig (data key `kubeconfig`) that defines the address of and the credentials for the webhook. All certificates and credentials must be contained inline, references to files or credential plugins are not supported.",
+							Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
+						},
+					},
+					"cacheAuthorizedTTL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CacheAuthorizedTTL is the duration to cache authorized responses of the webhook. Defaults to 5m.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"cacheUnauthorizedTTL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CacheUnauthorizedTTL is the duration to cache unauthorized responses of the webhook. Defaults to 30s.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+				},
+				Required: []string{"secretRef"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.LocalObjectReference", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_AvailabilityRatio(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AvailabilityRatio is the ratio of successful availability probes within a period.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"period": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Period is the duration the ratio has been computed for.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"ratio": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Ratio is the percentage of successful probes within the period, e.g. \"99.950\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"probes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Probes is the number of probes which have been performed within the period.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+				Required: []string{"period", "ratio", "probes"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
 func schema_pkg_apis_garden_v1beta1_AzureCloud(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -12037,6 +15464,12 @@ func schema_pkg_apis_garden_v1beta1_AzureWorker(ref common.ReferenceCallback) co
 							Format:      "",
 						},
 					},
+					"cri": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CRI contains the configuration of the container runtime of every machine of this worker pool. Defaults to Docker.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.CRI"),
+						},
+					},
 					"volumeType": {
 						SchemaProps: spec.SchemaProps{
 							Description: "VolumeType is the type of the root volumes.",
@@ -12056,7 +15489,7 @@ func schema_pkg_apis_garden_v1beta1_AzureWorker(ref common.ReferenceCallback) co
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeletConfig", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootMachineImage", "k8s.io/api/core/v1.Taint", "k8s.io/apimachinery/pkg/util/intstr.IntOrString"},
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.CRI", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeletConfig", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootMachineImage", "k8s.io/api/core/v1.Taint", "k8s.io/apimachinery/pkg/util/intstr.IntOrString"},
 	}
 }
 
@@ -12087,12 +15520,101 @@ func schema_pkg_apis_garden_v1beta1_BackupProfile(ref common.ReferenceCallback)
 							Ref:         ref("k8s.io/api/core/v1.SecretReference"),
 						},
 					},
+					"secondary": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Secondary is a secondary location into which the backups are replicated. It uses the same provider and credentials as the primary location.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.SecondaryBackupLocation"),
+						},
+					},
 				},
 				Required: []string{"provider", "secretRef"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/api/core/v1.SecretReference"},
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SecondaryBackupLocation", "k8s.io/api/core/v1.SecretReference"},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_BackupRetentionPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "BackupRetentionPolicy defines how long the snapshots of a backup are kept.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"dailySnapshots": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DailySnapshots is the number of days for which the latest snapshot of each day is kept.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"weeklySnapshots": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WeeklySnapshots is the number of weeks for which the latest snapshot of each week is kept.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_CARotation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CARotation contains information about the rotation of the certificate authorities of the Shoot cluster.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Phase describes the phase of the certificate authority rotation.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"lastInitiationTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastInitiationTime is the most recent time when the certificate authority rotation was initiated.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"lastCompletionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastCompletionTime is the most recent time when the certificate authority rotation was successfully completed.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"phase"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_CRI(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CRI contains information about the Container Runtimes.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the CRI library.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
 	}
 }
 
@@ -12403,6 +15925,40 @@ func schema_pkg_apis_garden_v1beta1_ClusterAutoscaler(ref common.ReferenceCallba
 	}
 }
 
+func schema_pkg_apis_garden_v1beta1_ControlPlane(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ControlPlane contains configuration settings for the control plane of the Shoot.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"highAvailability": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HighAvailability configures the control plane of the Shoot to be highly available. It can only be set when the Shoot is created.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.HighAvailability"),
+						},
+					},
+					"networkPolicyRules": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NetworkPolicyRules is a list of additional rules allowing egress traffic from pods of the control plane of the Shoot in the seed cluster. The destinations must not overlap with the blocked CIDRs or the node, pod and service networks of the seed.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.NetworkPolicyRule"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.HighAvailability", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.NetworkPolicyRule"},
+	}
+}
+
 func schema_pkg_apis_garden_v1beta1_DNS(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -12514,6 +16070,126 @@ func schema_pkg_apis_garden_v1beta1_DNSProviderConstraint(ref common.ReferenceCa
 	}
 }
 
+func schema_pkg_apis_garden_v1beta1_ETCDBackupOperation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ETCDBackupOperation contains information about an on-demand operation on the backup of the Shoot's etcd.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"state": {
+						SchemaProps: spec.SchemaProps{
+							Description: "State is the current state of the operation.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"description": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Description is a human-readable message describing the progress of the operation.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"progress": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Progress is the progress of the operation in percent.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"lastUpdateTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastUpdateTime is the time at which the operation was updated the last time.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"snapshot": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Snapshot is the name of the full snapshot which has been taken. For restorations, it is the final snapshot of the replaced etcd.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"state", "description", "progress", "lastUpdateTime"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_ETCDEncryptionKeyRotation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ETCDEncryptionKeyRotation contains information about the rotation of the key which is used by the kube-apiserver to encrypt resources in etcd.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Phase describes the phase of the etcd encryption key rotation.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"lastInitiationTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastInitiationTime is the most recent time when the etcd encryption key rotation was initiated.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"lastCompletionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastCompletionTime is the most recent time when the etcd encryption key rotation was successfully completed.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"phase"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_EncryptionConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "EncryptionConfig contains customizable encryption configuration of the kube-apiserver.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"resources": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Resources contains the list of resources that shall be encrypted in etcd in addition to secrets. Each item is a Kubernetes resource name in plural (resource or resource.group), e.g., configmaps or flunders.example.com. Removed resources are decrypted again.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"kms": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KMS configures a KMS provider which is used to encrypt the resources instead of the aescbc provider. The KMS plugin is run as sidecar of the kube-apiserver by the extension which is responsible for the given type.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.KMSProvider"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.KMSProvider"},
+	}
+}
+
 func schema_pkg_apis_garden_v1beta1_Extension(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -12543,6 +16219,27 @@ func schema_pkg_apis_garden_v1beta1_Extension(ref common.ReferenceCallback) comm
 	}
 }
 
+func schema_pkg_apis_garden_v1beta1_FailureTolerance(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "FailureTolerance describes the failure tolerance of a highly available control plane.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type specifies the failure domain of the seed across which the replicas of the control plane components are spread, i.e. whether the control plane tolerates the loss of a node or of an entire zone.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"type"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_garden_v1beta1_GCPCloud(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -12937,6 +16634,12 @@ func schema_pkg_apis_garden_v1beta1_GCPWorker(ref common.ReferenceCallback) comm
 							Format:      "",
 						},
 					},
+					"cri": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CRI contains the configuration of the container runtime of every machine of this worker pool. Defaults to Docker.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.CRI"),
+						},
+					},
 					"volumeType": {
 						SchemaProps: spec.SchemaProps{
 							Description: "VolumeType is the type of the root volumes.",
@@ -12956,7 +16659,7 @@ func schema_pkg_apis_garden_v1beta1_GCPWorker(ref common.ReferenceCallback) comm
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeletConfig", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootMachineImage", "k8s.io/api/core/v1.Taint", "k8s.io/apimachinery/pkg/util/intstr.IntOrString"},
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.CRI", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeletConfig", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootMachineImage", "k8s.io/api/core/v1.Taint", "k8s.io/apimachinery/pkg/util/intstr.IntOrString"},
 	}
 }
 
@@ -13118,6 +16821,28 @@ func schema_pkg_apis_garden_v1beta1_HibernationSchedule(ref common.ReferenceCall
 	}
 }
 
+func schema_pkg_apis_garden_v1beta1_HighAvailability(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HighAvailability specifies the configuration settings for a highly available control plane.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"failureTolerance": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FailureTolerance holds information about the failure tolerance of the control plane.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.FailureTolerance"),
+						},
+					},
+				},
+				Required: []string{"failureTolerance"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.FailureTolerance"},
+	}
+}
+
 func schema_pkg_apis_garden_v1beta1_HorizontalPodAutoscalerConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -13210,6 +16935,42 @@ func schema_pkg_apis_garden_v1beta1_K8SNetworks(ref common.ReferenceCallback) co
 	}
 }
 
+func schema_pkg_apis_garden_v1beta1_KMSProvider(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "KMSProvider contains the configuration of a KMS provider for the encryption of resources in etcd.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type is the type of the KMS plugin. An extension must be registered for it which runs the plugin as sidecar of the kube-apiserver.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"cacheSize": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CacheSize is the maximum number of data encryption keys which are cached in memory by the kube-apiserver. Defaults to 1000.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"timeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Timeout is the timeout for calls of the kube-apiserver to the KMS plugin. Defaults to 3s.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+				},
+				Required: []string{"type"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
 func schema_pkg_apis_garden_v1beta1_Kube2IAM(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -13355,6 +17116,12 @@ func schema_pkg_apis_garden_v1beta1_KubeAPIServerConfig(ref common.ReferenceCall
 							Format:      "",
 						},
 					},
+					"encryptionConfig": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EncryptionConfig contains customizable encryption configuration of the kube-apiserver.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.EncryptionConfig"),
+						},
+					},
 					"oidcConfig": {
 						SchemaProps: spec.SchemaProps{
 							Description: "OIDCConfig contains configuration settings for the OIDC provider.",
@@ -13382,11 +17149,97 @@ func schema_pkg_apis_garden_v1beta1_KubeAPIServerConfig(ref common.ReferenceCall
 							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.ServiceAccountConfig"),
 						},
 					},
+					"staticCredentialsRotation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StaticCredentialsRotation contains configuration settings for the scheduled rotation of the static tokens and the basic authentication password of the kube-apiserver.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.StaticCredentialsRotationConfig"),
+						},
+					},
+					"authenticationWebhook": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AuthenticationWebhook contains configuration settings for a webhook which authenticates bearer tokens in addition to the built-in authenticators of the kube-apiserver.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.AuthenticationWebhook"),
+						},
+					},
+					"authorizationWebhook": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AuthorizationWebhook contains configuration settings for a webhook which authorizes requests after the Node and RBAC authorizers of the kube-apiserver.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.AuthorizationWebhook"),
+						},
+					},
+					"admissionWebhookConfig": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AdmissionWebhookConfig contains configuration settings for the admission plugins which call the validating and mutating admission webhooks. It is written to the admission control config file of the kube-apiserver.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.AdmissionWebhookConfig"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AdmissionPlugin", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.AuditConfig", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.OIDCConfig", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.ServiceAccountConfig"},
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AdmissionPlugin", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.AdmissionWebhookConfig", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.AuditConfig", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.AuthenticationWebhook", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.AuthorizationWebhook", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.EncryptionConfig", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.OIDCConfig", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.ServiceAccountConfig", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.StaticCredentialsRotationConfig"},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_KubeControllerManagerConcurrentSyncs(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "KubeControllerManagerConcurrentSyncs contains the number of objects which are allowed to be synced concurrently by the controllers of the kube-controller-manager. Larger numbers result in more responsive controllers but cause more load on the kube-apiserver.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"deployments": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Deployments is the number of deployments that are allowed to sync concurrently (default: 10).",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"endpoints": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Endpoints is the number of endpoints that are allowed to sync concurrently (default: 5).",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"garbageCollector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "GarbageCollector is the number of garbage collector workers that are allowed to sync concurrently (default: 20).",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"namespaces": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespaces is the number of namespaces that are allowed to sync concurrently (default: 10).",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"replicaSets": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ReplicaSets is the number of replica sets that are allowed to sync concurrently (default: 10).",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"resourceQuotas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ResourceQuotas is the number of resource quotas that are allowed to sync concurrently (default: 5).",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"serviceAccountTokens": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ServiceAccountTokens is the number of service account token objects that are allowed to sync concurrently (default: 5).",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -13425,11 +17278,29 @@ func schema_pkg_apis_garden_v1beta1_KubeControllerManagerConfig(ref common.Refer
 							Format:      "int32",
 						},
 					},
+					"nodeMonitorGracePeriod": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NodeMonitorGracePeriod is the amount of time which a running node is allowed to be unresponsive before it is marked unhealthy (default: 80s).",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"podEvictionTimeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PodEvictionTimeout is the grace period for deleting pods on failed nodes (default: 2m).",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"concurrentSyncs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConcurrentSyncs contains the number of objects which are allowed to be synced concurrently by the controllers of the kube-controller-manager.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeControllerManagerConcurrentSyncs"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.HorizontalPodAutoscalerConfig"},
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.HorizontalPodAutoscalerConfig", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeControllerManagerConcurrentSyncs", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
 	}
 }
 
@@ -13518,6 +17389,13 @@ func schema_pkg_apis_garden_v1beta1_KubeSchedulerConfig(ref common.ReferenceCall
 							},
 						},
 					},
+					"profile": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Profile configures the scoring strategy of the kube-scheduler (default: balanced).",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -13757,6 +17635,41 @@ func schema_pkg_apis_garden_v1beta1_KubeletConfigEvictionSoftGracePeriod(ref com
 	}
 }
 
+func schema_pkg_apis_garden_v1beta1_KubeletConfigFieldDrift(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "KubeletConfigFieldDrift contains the desired and the effective value of a field of the kubelet configuration.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the field in the kubelet configuration file, e.g. `maxPods` or `evictionHard[memory.available]`.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"desired": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Desired is the desired value of the field.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"actual": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Actual is the effective value of the field on the node. It is empty if the field is not set.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name", "desired"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_garden_v1beta1_Kubernetes(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -14255,6 +18168,118 @@ func schema_pkg_apis_garden_v1beta1_Monocular(ref common.ReferenceCallback) comm
 	}
 }
 
+func schema_pkg_apis_garden_v1beta1_NetworkPolicyRule(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NetworkPolicyRule allows egress traffic from selected pods of the control plane of a Shoot to the given networks.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the rule. It must be unique among the rules of the Shoot and is part of the name of the generated NetworkPolicy.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"podSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PodSelector selects the pods of the control plane in the seed cluster to which the rule applies. It must not be empty, and the etcd pods are never selected.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+						},
+					},
+					"cidrs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CIDRs is a list of destination networks to which egress traffic is allowed.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"ports": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Ports is a list of destination ports to which egress traffic is allowed. If empty, all ports are allowed.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.NetworkPolicyRulePort"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"name", "podSelector", "cidrs"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.NetworkPolicyRulePort", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_NetworkPolicyRulePort(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NetworkPolicyRulePort is a destination port of a network policy rule.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"protocol": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Protocol is the protocol of the port (TCP, UDP or SCTP). Defaults to TCP.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"port": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Port is the number of the port.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"port"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_NetworkPool(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NetworkPool is a network from which equally sized networks are allocated.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"cidr": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CIDR is the network from which the networks are allocated.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"prefixLength": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PrefixLength is the prefix length of the allocated networks. It must not be smaller than the prefix length of the pool CIDR.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"cidr", "prefixLength"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_garden_v1beta1_Networking(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -14296,6 +18321,20 @@ func schema_pkg_apis_garden_v1beta1_Networking(ref common.ReferenceCallback) com
 							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.ProviderConfig"),
 						},
 					},
+					"ipFamilies": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IPFamilies specifies the IP protocol versions used by the networks of the Shoot. The first family is the primary one. Dual-stack networking is configured by specifying both IPv4 and IPv6, in this case the pod, node and service networks contain one CIDR per IP family, separated by a comma and in the same order. Defaults to IPv4 if empty.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"type"},
 			},
@@ -14333,32 +18372,75 @@ func schema_pkg_apis_garden_v1beta1_NginxIngress(ref common.ReferenceCallback) c
 							},
 						},
 					},
-					"config": {
+					"config": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Config contains custom configuration for the nginx-ingress-controller configuration. See https://github.com/kubernetes/ingress-nginx/blob/master/docs/user-guide/nginx-configuration/configmap.md#configuration-options",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"externalTrafficPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExternalTrafficPolicy controls the `.spec.externalTrafficPolicy` value of the load balancer `Service` exposing the nginx-ingress. Defaults to `Cluster`.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"enabled"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_NodeKubeletConfigDrift(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NodeKubeletConfigDrift contains the differences between the desired and the effective kubelet configuration of a node.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"node": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Node is the name of the node.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"workerPool": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WorkerPool is the name of the worker pool the node belongs to.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"fields": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Config contains custom configuration for the nginx-ingress-controller configuration. See https://github.com/kubernetes/ingress-nginx/blob/master/docs/user-guide/nginx-configuration/configmap.md#configuration-options",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Description: "Fields contains the fields of the kubelet configuration whose effective values differ from the desired ones.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Type:   []string{"string"},
-										Format: "",
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeletConfigFieldDrift"),
 									},
 								},
 							},
 						},
 					},
-					"externalTrafficPolicy": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ExternalTrafficPolicy controls the `.spec.externalTrafficPolicy` value of the load balancer `Service` exposing the nginx-ingress. Defaults to `Cluster`.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
 				},
-				Required: []string{"enabled"},
+				Required: []string{"node", "fields"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeletConfigFieldDrift"},
 	}
 }
 
@@ -15142,12 +19224,18 @@ func schema_pkg_apis_garden_v1beta1_OpenStackWorker(ref common.ReferenceCallback
 							Format:      "",
 						},
 					},
+					"cri": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CRI contains the configuration of the container runtime of every machine of this worker pool. Defaults to Docker.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.CRI"),
+						},
+					},
 				},
 				Required: []string{"name", "machineType", "autoScalerMin", "autoScalerMax"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeletConfig", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootMachineImage", "k8s.io/api/core/v1.Taint", "k8s.io/apimachinery/pkg/util/intstr.IntOrString"},
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.CRI", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeletConfig", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootMachineImage", "k8s.io/api/core/v1.Taint", "k8s.io/apimachinery/pkg/util/intstr.IntOrString"},
 	}
 }
 
@@ -15488,6 +19576,12 @@ func schema_pkg_apis_garden_v1beta1_PacketWorker(ref common.ReferenceCallback) c
 							Format:      "",
 						},
 					},
+					"cri": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CRI contains the configuration of the container runtime of every machine of this worker pool. Defaults to Docker.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.CRI"),
+						},
+					},
 					"volumeType": {
 						SchemaProps: spec.SchemaProps{
 							Description: "VolumeType is the type of the root volumes.",
@@ -15507,7 +19601,7 @@ func schema_pkg_apis_garden_v1beta1_PacketWorker(ref common.ReferenceCallback) c
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeletConfig", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootMachineImage", "k8s.io/api/core/v1.Taint", "k8s.io/apimachinery/pkg/util/intstr.IntOrString"},
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.CRI", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeletConfig", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootMachineImage", "k8s.io/api/core/v1.Taint", "k8s.io/apimachinery/pkg/util/intstr.IntOrString"},
 	}
 }
 
@@ -15673,11 +19767,17 @@ func schema_pkg_apis_garden_v1beta1_ProjectSpec(ref common.ReferenceCallback) co
 							},
 						},
 					},
+					"backupRetentionPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BackupRetentionPolicy is the default retention policy for the backups of the Shoots in the project.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.BackupRetentionPolicy"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/api/rbac/v1.Subject"},
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.BackupRetentionPolicy", "k8s.io/api/rbac/v1.Subject"},
 	}
 }
 
@@ -15841,6 +19941,27 @@ func schema_pkg_apis_garden_v1beta1_QuotaSpec(ref common.ReferenceCallback) comm
 	}
 }
 
+func schema_pkg_apis_garden_v1beta1_SecondaryBackupLocation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SecondaryBackupLocation contains information about a secondary location into which the backups are replicated.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"region": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Region is the region of the secondary location. It must differ from the region of the primary location.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"region"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_garden_v1beta1_SecretBinding(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -16102,12 +20223,32 @@ func schema_pkg_apis_garden_v1beta1_SeedNetworks(ref common.ReferenceCallback) c
 							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootNetworks"),
 						},
 					},
+					"shootPools": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ShootPools contains network pools from which non-overlapping networks are allocated to shoots which do not specify their node or pod network.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootNetworkPools"),
+						},
+					},
+					"ipFamilies": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IPFamilies specifies the IP protocol versions used by the networks of the Seed. The first family is the primary one. Dual-stack networking is configured by specifying both IPv4 and IPv6, in this case the pod, node and service networks contain one CIDR per IP family, separated by a comma and in the same order. Defaults to IPv4 if empty.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"nodes", "pods", "services"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootNetworks"},
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootNetworkPools", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootNetworks"},
 	}
 }
 
@@ -16314,6 +20455,136 @@ func schema_pkg_apis_garden_v1beta1_Shoot(ref common.ReferenceCallback) common.O
 	}
 }
 
+func schema_pkg_apis_garden_v1beta1_ShootAvailability(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ShootAvailability contains the availability of the Shoot's components over several periods.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"apiServer": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIServer contains the availability ratios of the Shoot's API server as derived from the health checks.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.AvailabilityRatio"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AvailabilityRatio"},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_ShootBackup(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ShootBackup contains configuration settings for the backup of the Shoot's etcd.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"retentionPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RetentionPolicy defines how long the snapshots of the Shoot's etcd are kept. It overrides the default retention policy of the Project.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.BackupRetentionPolicy"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.BackupRetentionPolicy"},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_ShootCredentials(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ShootCredentials contains information about the credentials of the Shoot cluster.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"rotation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Rotation contains information about the rotation of credentials.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootCredentialsRotation"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootCredentialsRotation"},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_ShootCredentialsRotation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ShootCredentialsRotation contains information about the rotation of credentials.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"certificateAuthorities": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CertificateAuthorities contains information about the rotation of the certificate authorities.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.CARotation"),
+						},
+					},
+					"staticCredentials": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StaticCredentials contains information about the rotation of the static tokens and the basic authentication password of the kube-apiserver.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.StaticCredentialsRotation"),
+						},
+					},
+					"etcdEncryptionKey": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ETCDEncryptionKey contains information about the rotation of the key which is used to encrypt resources in etcd.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.ETCDEncryptionKeyRotation"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.CARotation", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.ETCDEncryptionKeyRotation", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.StaticCredentialsRotation"},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_ShootETCDBackup(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ShootETCDBackup contains information about the on-demand operations on the backup of the Shoot's etcd.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"lastSnapshot": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastSnapshot contains information about the last on-demand full snapshot of the etcd.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.ETCDBackupOperation"),
+						},
+					},
+					"lastRestore": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastRestore contains information about the last restoration of the etcd from its backup.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.ETCDBackupOperation"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ETCDBackupOperation"},
+	}
+}
+
 func schema_pkg_apis_garden_v1beta1_ShootList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -16399,6 +20670,33 @@ func schema_pkg_apis_garden_v1beta1_ShootMachineImage(ref common.ReferenceCallba
 	}
 }
 
+func schema_pkg_apis_garden_v1beta1_ShootNetworkPools(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ShootNetworkPools contains network pools from which networks are allocated to shoots.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"nodes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Nodes is the pool from which the node networks of shoots are allocated.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.NetworkPool"),
+						},
+					},
+					"pods": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Pods is the pool from which the pod networks of shoots are allocated.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.NetworkPool"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.NetworkPool"},
+	}
+}
+
 func schema_pkg_apis_garden_v1beta1_ShootNetworks(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -16439,12 +20737,24 @@ func schema_pkg_apis_garden_v1beta1_ShootSpec(ref common.ReferenceCallback) comm
 							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.Addons"),
 						},
 					},
+					"backup": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Backup contains configuration settings for the backup of the Shoot's etcd.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootBackup"),
+						},
+					},
 					"cloud": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Cloud contains information about the cloud environment and their specific settings.",
 							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.Cloud"),
 						},
 					},
+					"controlPlane": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ControlPlane contains configuration settings for the control plane of the Shoot.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.ControlPlane"),
+						},
+					},
 					"dns": {
 						SchemaProps: spec.SchemaProps{
 							Description: "DNS contains information about the DNS settings of the Shoot.",
@@ -16499,7 +20809,7 @@ func schema_pkg_apis_garden_v1beta1_ShootSpec(ref common.ReferenceCallback) comm
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Addons", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Cloud", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.DNS", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Extension", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Hibernation", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Kubernetes", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Maintenance", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Monitoring", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Networking"},
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Addons", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Cloud", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.ControlPlane", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.DNS", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Extension", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Hibernation", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Kubernetes", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Maintenance", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Monitoring", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Networking", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootBackup"},
 	}
 }
 
@@ -16510,6 +20820,12 @@ func schema_pkg_apis_garden_v1beta1_ShootStatus(ref common.ReferenceCallback) co
 				Description: "ShootStatus holds the most recently observed status of the Shoot cluster.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
+					"availability": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Availability contains the availability of the Shoot's components over several periods as derived from the health checks of the care controller.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootAvailability"),
+						},
+					},
 					"conditions": {
 						VendorExtensible: spec.VendorExtensible{
 							Extensions: spec.Extensions{
@@ -16548,12 +20864,56 @@ func schema_pkg_apis_garden_v1beta1_ShootStatus(ref common.ReferenceCallback) co
 							},
 						},
 					},
+					"conditionHistories": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-patch-merge-key": "type",
+								"x-kubernetes-patch-strategy":  "merge",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "ConditionHistories contains a bounded history of the status transitions of the Shoot's conditions.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.ConditionHistory"),
+									},
+								},
+							},
+						},
+					},
+					"credentials": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Credentials contains information about the credentials of the Shoot cluster, e.g. the status of their rotation.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootCredentials"),
+						},
+					},
+					"etcdBackup": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ETCDBackup contains information about the on-demand operations on the backup of the Shoot's etcd, i.e., taking snapshots and restoring the etcd from its backup.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootETCDBackup"),
+						},
+					},
 					"gardener": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Gardener holds information about the Gardener which last acted on the Shoot.",
 							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.Gardener"),
 						},
 					},
+					"kubeletConfigDrifts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KubeletConfigDrifts contains the nodes of the Shoot whose effective kubelet configuration differs from the desired one as detected by the care controller. At most 10 nodes are listed.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.NodeKubeletConfigDrift"),
+									},
+								},
+							},
+						},
+					},
 					"lastOperation": {
 						SchemaProps: spec.SchemaProps{
 							Description: "LastOperation holds information about the last operation on the Shoot.",
@@ -16625,7 +20985,56 @@ func schema_pkg_apis_garden_v1beta1_ShootStatus(ref common.ReferenceCallback) co
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.Condition", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.LastError", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.LastOperation", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Gardener", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.Condition", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.ConditionHistory", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.LastError", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.LastOperation", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Gardener", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.NodeKubeletConfigDrift", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootAvailability", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootCredentials", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootETCDBackup", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_StaticCredentialsRotation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "StaticCredentialsRotation contains information about the rotation of the static tokens and the basic authentication password of the kube-apiserver of the Shoot cluster.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"lastRotationTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastRotationTime is the most recent time when the static credentials were rotated.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_StaticCredentialsRotationConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "StaticCredentialsRotationConfig contains configuration settings for the scheduled rotation of the static tokens and the basic authentication password of the kube-apiserver.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"period": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Period is the interval after which the static tokens and the basic authentication password are rotated.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"transitionPeriod": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TransitionPeriod is the duration for which the previous static tokens remain valid after a rotation. Defaults to 24h.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+				},
+				Required: []string{"period"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
 	}
 }
 
@@ -16773,12 +21182,18 @@ func schema_pkg_apis_garden_v1beta1_Worker(ref common.ReferenceCallback) common.
 							Format:      "",
 						},
 					},
+					"cri": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CRI contains the configuration of the container runtime of every machine of this worker pool. Defaults to Docker.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.CRI"),
+						},
+					},
 				},
 				Required: []string{"name", "machineType", "autoScalerMin", "autoScalerMax"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeletConfig", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootMachineImage", "k8s.io/api/core/v1.Taint", "k8s.io/apimachinery/pkg/util/intstr.IntOrString"},
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.CRI", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeletConfig", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootMachineImage", "k8s.io/api/core/v1.Taint", "k8s.io/apimachinery/pkg/util/intstr.IntOrString"},
 	}
 }
 
@@ -241,10 +241,11 @@ func (b *Botanist) generateCoreAddonsChart() (*chartrenderer.RenderedChart, erro
 		networkPolicyConfig       = map[string]interface{}{}
 	)
 
-	proxyConfig := b.Shoot.Info.Spec.Kubernetes.KubeProxy
-	if proxyConfig != nil {
-		kubeProxyConfig["featureGates"] = proxyConfig.FeatureGates
+	var proxyFeatureGates map[string]bool
+	if proxyConfig := b.Shoot.Info.Spec.Kubernetes.KubeProxy; proxyConfig != nil {
+		proxyFeatureGates = proxyConfig.FeatureGates
 	}
+	kubeProxyConfig["featureGates"] = ComputeIPFamiliesFeatureGates(b.Shoot.Info.Spec.Networking.IPFamilies, proxyFeatureGates)
 
 	if openvpnDiffieHellmanSecret, ok := b.Secrets[common.GardenRoleOpenVPNDiffieHellman]; ok {
 		vpnShootConfig["diffieHellmanKey"] = openvpnDiffieHellmanSecret.Data["dh2048.pem"]
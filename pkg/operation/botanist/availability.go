@@ -0,0 +1,258 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package botanist
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	configMapSuffixAvailability = "availability"
+	availabilityLedgerDayLayout = "2006-01-02"
+	// availabilityLedgerPersistInterval is the interval after which a cached availability ledger is persisted.
+	availabilityLedgerPersistInterval = 10 * time.Minute
+	day                               = 24 * time.Hour
+)
+
+// AvailabilityPeriods are the periods for which the availability ratios of a Shoot's API server are computed.
+var AvailabilityPeriods = []time.Duration{7 * day, 30 * day, 90 * day}
+
+// AvailabilityLedger contains the number of performed and successful availability probes per day.
+type AvailabilityLedger map[string]AvailabilityLedgerEntry
+
+// AvailabilityLedgerEntry contains the number of performed and successful availability probes of a single day.
+type AvailabilityLedgerEntry struct {
+	// Probes is the number of performed probes.
+	Probes int64
+	// Successful is the number of successful probes.
+	Successful int64
+}
+
+// ParseAvailabilityLedger parses the availability ledger from the given config map data. Every key is a day in the
+// format YYYY-MM-DD, every value contains the number of performed and successful probes separated by a slash.
+func ParseAvailabilityLedger(data map[string]string) (AvailabilityLedger, error) {
+	ledger := make(AvailabilityLedger, len(data))
+
+	for key, value := range data {
+		if _, err := time.Parse(availabilityLedgerDayLayout, key); err != nil {
+			return nil, fmt.Errorf("invalid day %q in availability ledger: %v", key, err)
+		}
+
+		split := strings.Split(value, "/")
+		if len(split) != 2 {
+			return nil, fmt.Errorf("invalid entry %q for day %q in availability ledger", value, key)
+		}
+
+		probes, err := strconv.ParseInt(split[0], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid number of probes for day %q in availability ledger: %v", key, err)
+		}
+		successful, err := strconv.ParseInt(split[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid number of successful probes for day %q in availability ledger: %v", key, err)
+		}
+
+		ledger[key] = AvailabilityLedgerEntry{Probes: probes, Successful: successful}
+	}
+
+	return ledger, nil
+}
+
+// Data returns the config map data representation of the availability ledger.
+func (l AvailabilityLedger) Data() map[string]string {
+	data := make(map[string]string, len(l))
+	for key, entry := range l {
+		data[key] = fmt.Sprintf("%d/%d", entry.Probes, entry.Successful)
+	}
+	return data
+}
+
+// Record records the result of a probe performed at the given time and drops all entries which are older than the
+// longest availability period.
+func (l AvailabilityLedger) Record(now time.Time, successful bool) {
+	key := now.UTC().Format(availabilityLedgerDayLayout)
+
+	entry := l[key]
+	entry.Probes++
+	if successful {
+		entry.Successful++
+	}
+	l[key] = entry
+
+	oldest := now.UTC().Add(-AvailabilityPeriods[len(AvailabilityPeriods)-1]).Format(availabilityLedgerDayLayout)
+	for key := range l {
+		if key <= oldest {
+			delete(l, key)
+		}
+	}
+}
+
+// Ratios computes the availability ratios for all availability periods ending at the given time. The current day
+// is counted as the last day of every period.
+func (l AvailabilityLedger) Ratios(now time.Time) []gardencorev1alpha1.AvailabilityRatio {
+	ratios := make([]gardencorev1alpha1.AvailabilityRatio, 0, len(AvailabilityPeriods))
+
+	for _, period := range AvailabilityPeriods {
+		var (
+			oldest          = now.UTC().Add(-period).Format(availabilityLedgerDayLayout)
+			probes, success int64
+			ratio           = 100.0
+		)
+
+		for key, entry := range l {
+			if key > oldest {
+				probes += entry.Probes
+				success += entry.Successful
+			}
+		}
+		if probes > 0 {
+			ratio = 100 * float64(success) / float64(probes)
+		}
+
+		ratios = append(ratios, gardencorev1alpha1.AvailabilityRatio{
+			Period: metav1.Duration{Duration: period},
+			Ratio:  strconv.FormatFloat(ratio, 'f', 3, 64),
+			Probes: probes,
+		})
+	}
+
+	return ratios
+}
+
+// AvailabilityLedgers caches the availability ledgers of Shoots in memory. Probes are recorded in memory only and a
+// ledger is persisted at most every availabilityLedgerPersistInterval or when a new day has begun, so that not every
+// care operation has to write to the garden cluster. Probes which have not been persisted yet are lost when the
+// gardenlet restarts.
+type AvailabilityLedgers struct {
+	lock    sync.Mutex
+	ledgers map[string]*cachedAvailabilityLedger
+}
+
+type cachedAvailabilityLedger struct {
+	ledger        AvailabilityLedger
+	lastPersisted time.Time
+}
+
+// NewAvailabilityLedgers returns a new, empty in-memory cache for availability ledgers.
+func NewAvailabilityLedgers() *AvailabilityLedgers {
+	return &AvailabilityLedgers{ledgers: make(map[string]*cachedAvailabilityLedger)}
+}
+
+// Delete removes the availability ledger of the Shoot with the given key from the cache.
+func (a *AvailabilityLedgers) Delete(key string) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	delete(a.ledgers, key)
+}
+
+func (a *AvailabilityLedgers) get(key string) (*cachedAvailabilityLedger, bool) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	cached, ok := a.ledgers[key]
+	return cached, ok
+}
+
+func (a *AvailabilityLedgers) set(key string, cached *cachedAvailabilityLedger) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	a.ledgers[key] = cached
+}
+
+// RecordAPIServerAvailability records the result of the API server availability check in the availability ledger of
+// the Shoot and returns the resulting availability. The ledger is cached in the given <ledgers> and persisted in a
+// config map in the project namespace next to the Shoot. A ledger which cannot be decoded is reset. Probes are not
+// recorded while the Shoot is hibernated.
+func (b *Botanist) RecordAPIServerAvailability(ctx context.Context, ledgers *AvailabilityLedgers, apiServerAvailable gardencorev1alpha1.Condition) (*gardencorev1alpha1.ShootAvailability, error) {
+	var (
+		now = Now()
+		key = fmt.Sprintf("%s/%s", b.Shoot.Info.Namespace, b.Shoot.Info.Name)
+	)
+
+	cached, ok := ledgers.get(key)
+	if !ok {
+		ledger, err := b.readAvailabilityLedger(ctx)
+		if err != nil {
+			return nil, err
+		}
+		cached = &cachedAvailabilityLedger{ledger: ledger}
+		ledgers.set(key, cached)
+	}
+
+	if !b.Shoot.HibernationEnabled && !b.Shoot.Info.Status.IsHibernated {
+		cached.ledger.Record(now, apiServerAvailable.Status == gardencorev1alpha1.ConditionTrue)
+	}
+
+	if now.Sub(cached.lastPersisted) >= availabilityLedgerPersistInterval ||
+		now.UTC().Format(availabilityLedgerDayLayout) != cached.lastPersisted.UTC().Format(availabilityLedgerDayLayout) {
+		if err := b.persistAvailabilityLedger(ctx, cached.ledger); err != nil {
+			return nil, err
+		}
+		cached.lastPersisted = now
+	}
+
+	return &gardencorev1alpha1.ShootAvailability{APIServer: cached.ledger.Ratios(now)}, nil
+}
+
+func (b *Botanist) availabilityLedgerConfigMap() *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      computeProjectSecretName(b.Shoot.Info.Name, configMapSuffixAvailability),
+			Namespace: b.Shoot.Info.Namespace,
+		},
+	}
+}
+
+func (b *Botanist) readAvailabilityLedger(ctx context.Context) (AvailabilityLedger, error) {
+	configMap := b.availabilityLedgerConfigMap()
+	if err := b.K8sGardenClient.Client().Get(ctx, kutil.Key(configMap.Namespace, configMap.Name), configMap); err != nil {
+		if apierrors.IsNotFound(err) {
+			return AvailabilityLedger{}, nil
+		}
+		return nil, err
+	}
+
+	ledger, err := ParseAvailabilityLedger(configMap.Data)
+	if err != nil {
+		b.Logger.Warnf("Resetting the availability ledger as it cannot be decoded: %v", err)
+		return AvailabilityLedger{}, nil
+	}
+	return ledger, nil
+}
+
+func (b *Botanist) persistAvailabilityLedger(ctx context.Context, ledger AvailabilityLedger) error {
+	configMap := b.availabilityLedgerConfigMap()
+	return kutil.CreateOrUpdate(ctx, b.K8sGardenClient.Client(), configMap, func() error {
+		configMap.OwnerReferences = []metav1.OwnerReference{
+			*metav1.NewControllerRef(b.Shoot.Info, gardencorev1alpha1.SchemeGroupVersion.WithKind("Shoot")),
+		}
+		configMap.Data = ledger.Data()
+		return nil
+	})
+}
@@ -0,0 +1,176 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package botanist_test
+
+import (
+	"context"
+	"time"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	"github.com/gardener/gardener/pkg/logger"
+	mockclient "github.com/gardener/gardener/pkg/mock/controller-runtime/client"
+	mock "github.com/gardener/gardener/pkg/mock/gardener/kubernetes"
+	"github.com/gardener/gardener/pkg/operation"
+	. "github.com/gardener/gardener/pkg/operation/botanist"
+	"github.com/gardener/gardener/pkg/operation/shoot"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("availability", func() {
+	var now = time.Date(2019, 12, 31, 12, 0, 0, 0, time.UTC)
+
+	Describe("#ParseAvailabilityLedger", func() {
+		It("should parse a valid ledger", func() {
+			ledger, err := ParseAvailabilityLedger(map[string]string{"2019-12-30": "10/9"})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ledger).To(Equal(AvailabilityLedger{"2019-12-30": {Probes: 10, Successful: 9}}))
+		})
+
+		It("should fail for an invalid day", func() {
+			_, err := ParseAvailabilityLedger(map[string]string{"foo": "10/9"})
+
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should fail for an invalid entry", func() {
+			_, err := ParseAvailabilityLedger(map[string]string{"2019-12-30": "10"})
+
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("#Record", func() {
+		It("should record the probe and drop outdated entries", func() {
+			ledger := AvailabilityLedger{
+				"2019-10-01": {Probes: 1, Successful: 1},
+				"2019-12-31": {Probes: 1, Successful: 1},
+			}
+
+			ledger.Record(now, false)
+
+			Expect(ledger.Data()).To(Equal(map[string]string{"2019-12-31": "2/1"}))
+		})
+	})
+
+	Describe("#Ratios", func() {
+		It("should compute the ratios for all periods", func() {
+			ledger := AvailabilityLedger{
+				"2019-10-10": {Probes: 100, Successful: 50},
+				"2019-12-20": {Probes: 100, Successful: 100},
+				"2019-12-31": {Probes: 100, Successful: 99},
+			}
+
+			Expect(ledger.Ratios(now)).To(Equal([]gardencorev1alpha1.AvailabilityRatio{
+				{Period: metav1.Duration{Duration: 7 * 24 * time.Hour}, Ratio: "99.000", Probes: 100},
+				{Period: metav1.Duration{Duration: 30 * 24 * time.Hour}, Ratio: "99.500", Probes: 200},
+				{Period: metav1.Duration{Duration: 90 * 24 * time.Hour}, Ratio: "83.000", Probes: 300},
+			}))
+		})
+
+		It("should report full availability if no probes have been recorded", func() {
+			Expect(AvailabilityLedger{}.Ratios(now)[0].Ratio).To(Equal("100.000"))
+		})
+	})
+
+	Describe("#RecordAPIServerAvailability", func() {
+		var (
+			ctx                    = context.TODO()
+			ctrl                   *gomock.Controller
+			k8sGardenClient        *mock.MockInterface
+			k8sGardenRuntimeClient *mockclient.MockClient
+			botanist               *Botanist
+			ledgers                *AvailabilityLedgers
+			available              = gardencorev1alpha1.Condition{Status: gardencorev1alpha1.ConditionTrue}
+			key                    = client.ObjectKey{Namespace: "garden-foo", Name: "bar.availability"}
+			notFound               = apierrors.NewNotFound(schema.GroupResource{Resource: "configmaps"}, key.Name)
+			tmpNow                 func() time.Time
+		)
+
+		BeforeEach(func() {
+			ctrl = gomock.NewController(GinkgoT())
+			k8sGardenClient = mock.NewMockInterface(ctrl)
+			k8sGardenRuntimeClient = mockclient.NewMockClient(ctrl)
+			k8sGardenClient.EXPECT().Client().Return(k8sGardenRuntimeClient).AnyTimes()
+
+			botanist = &Botanist{Operation: &operation.Operation{
+				K8sGardenClient: k8sGardenClient,
+				Logger:          logger.NewFieldLogger(logger.NewLogger("info"), "shoot", "bar"),
+				Shoot: &shoot.Shoot{
+					Info: &gardencorev1alpha1.Shoot{ObjectMeta: metav1.ObjectMeta{Namespace: "garden-foo", Name: "bar"}},
+				},
+			}}
+			ledgers = NewAvailabilityLedgers()
+
+			tmpNow = Now
+			Now = func() time.Time { return now }
+		})
+
+		AfterEach(func() {
+			Now = tmpNow
+			ctrl.Finish()
+		})
+
+		It("should persist the ledger only from time to time", func() {
+			k8sGardenRuntimeClient.EXPECT().Get(ctx, key, gomock.AssignableToTypeOf(&corev1.ConfigMap{})).Return(notFound).Times(2)
+			k8sGardenRuntimeClient.EXPECT().Create(ctx, gomock.AssignableToTypeOf(&corev1.ConfigMap{})).DoAndReturn(func(_ context.Context, configMap *corev1.ConfigMap) error {
+				Expect(configMap.Data).To(Equal(map[string]string{"2019-12-31": "1/1"}))
+				return nil
+			})
+
+			availability, err := botanist.RecordAPIServerAvailability(ctx, ledgers, available)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(availability.APIServer[0].Probes).To(Equal(int64(1)))
+
+			Now = func() time.Time { return now.Add(time.Minute) }
+			availability, err = botanist.RecordAPIServerAvailability(ctx, ledgers, available)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(availability.APIServer[0].Probes).To(Equal(int64(2)))
+
+			Now = func() time.Time { return now.Add(11 * time.Minute) }
+			k8sGardenRuntimeClient.EXPECT().Get(ctx, key, gomock.AssignableToTypeOf(&corev1.ConfigMap{}))
+			k8sGardenRuntimeClient.EXPECT().Update(ctx, gomock.AssignableToTypeOf(&corev1.ConfigMap{})).DoAndReturn(func(_ context.Context, configMap *corev1.ConfigMap) error {
+				Expect(configMap.Data).To(Equal(map[string]string{"2019-12-31": "3/3"}))
+				return nil
+			})
+
+			_, err = botanist.RecordAPIServerAvailability(ctx, ledgers, available)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should reset a ledger which cannot be decoded", func() {
+			k8sGardenRuntimeClient.EXPECT().Get(ctx, key, gomock.AssignableToTypeOf(&corev1.ConfigMap{})).DoAndReturn(func(_ context.Context, _ client.ObjectKey, configMap *corev1.ConfigMap) error {
+				configMap.Data = map[string]string{"2019-12-30": "invalid"}
+				return nil
+			}).Times(2)
+			k8sGardenRuntimeClient.EXPECT().Update(ctx, gomock.AssignableToTypeOf(&corev1.ConfigMap{})).DoAndReturn(func(_ context.Context, configMap *corev1.ConfigMap) error {
+				Expect(configMap.Data).To(Equal(map[string]string{"2019-12-31": "1/1"}))
+				return nil
+			})
+
+			availability, err := botanist.RecordAPIServerAvailability(ctx, ledgers, available)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(availability.APIServer[0].Probes).To(Equal(int64(1)))
+		})
+	})
+})
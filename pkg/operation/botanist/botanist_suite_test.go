@@ -101,6 +101,12 @@ func newDeployment(namespace, name, role string, healthy bool) *appsv1.Deploymen
 	return deployment
 }
 
+func withReplicas(deployment *appsv1.Deployment, availableReplicas int32) *appsv1.Deployment {
+	deployment = deployment.DeepCopy()
+	deployment.Status.AvailableReplicas = availableReplicas
+	return deployment
+}
+
 func newStatefulSet(namespace, name, role string, healthy bool) *appsv1.StatefulSet {
 	statefulSet := &appsv1.StatefulSet{
 		ObjectMeta: metav1.ObjectMeta{
@@ -184,7 +190,16 @@ var _ = Describe("health check", func() {
 		condition = gardencorev1alpha1.Condition{
 			Type: gardencorev1alpha1.ConditionType("test"),
 		}
-		gcpShoot               = &gardencorev1alpha1.Shoot{}
+		gcpShoot             = &gardencorev1alpha1.Shoot{}
+		highlyAvailableShoot = &gardencorev1alpha1.Shoot{
+			Spec: gardencorev1alpha1.ShootSpec{
+				ControlPlane: &gardencorev1alpha1.ControlPlane{
+					HighAvailability: &gardencorev1alpha1.HighAvailability{
+						FailureTolerance: gardencorev1alpha1.FailureTolerance{Type: gardencorev1alpha1.FailureToleranceTypeZone},
+					},
+				},
+			},
+		}
 		gcpShootWithAutoscaler = &gardencorev1alpha1.Shoot{
 			Spec: gardencorev1alpha1.ShootSpec{
 				Provider: gardencorev1alpha1.Provider{
@@ -228,6 +243,17 @@ var _ = Describe("health check", func() {
 			etcdEventsStatefulSet,
 		}
 
+		// highly available control plane
+		highlyAvailableControlPlaneDeployments = []*appsv1.Deployment{
+			gardenerResourceManagerDeployment,
+			withReplicas(kubeAPIServerDeployment, 3),
+			withReplicas(kubeControllerManagerDeployment, 2),
+			withReplicas(kubeSchedulerDeployment, 2),
+			machineControllerManagerDeployment,
+		}
+		highlyAvailableETCDMainStatefulSet   = &appsv1.StatefulSet{ObjectMeta: etcdMainStatefulSet.ObjectMeta, Status: appsv1.StatefulSetStatus{ReadyReplicas: 3}}
+		highlyAvailableETCDEventsStatefulSet = &appsv1.StatefulSet{ObjectMeta: etcdEventsStatefulSet.ObjectMeta, Status: appsv1.StatefulSetStatus{ReadyReplicas: 3}}
+
 		// system component deployments
 		calicoKubeControllersDeployment = newDeployment(shootNamespace, common.CalicoKubeControllersDeploymentName, v1alpha1constants.GardenRoleSystemComponent, true)
 		coreDNSDeployment               = newDeployment(shootNamespace, common.CoreDNSDeploymentName, v1alpha1constants.GardenRoleSystemComponent, true)
@@ -397,6 +423,42 @@ var _ = Describe("health check", func() {
 				{Status: machinev1alpha1.MachineDeploymentStatus{Replicas: 2, UpdatedReplicas: 1}},
 			},
 			BeNil()),
+		Entry("all healthy (highly available)",
+			highlyAvailableShoot,
+			"gcp",
+			highlyAvailableControlPlaneDeployments,
+			[]*appsv1.StatefulSet{
+				highlyAvailableETCDMainStatefulSet,
+				highlyAvailableETCDEventsStatefulSet,
+			},
+			nil,
+			BeNil()),
+		Entry("too few available deployment replicas (highly available)",
+			highlyAvailableShoot,
+			"gcp",
+			[]*appsv1.Deployment{
+				gardenerResourceManagerDeployment,
+				withReplicas(kubeAPIServerDeployment, 3),
+				withReplicas(kubeControllerManagerDeployment, 1),
+				withReplicas(kubeSchedulerDeployment, 2),
+				machineControllerManagerDeployment,
+			},
+			[]*appsv1.StatefulSet{
+				highlyAvailableETCDMainStatefulSet,
+				highlyAvailableETCDEventsStatefulSet,
+			},
+			nil,
+			beConditionWithStatus(gardencorev1alpha1.ConditionFalse)),
+		Entry("too few ready etcd members (highly available)",
+			highlyAvailableShoot,
+			"gcp",
+			highlyAvailableControlPlaneDeployments,
+			[]*appsv1.StatefulSet{
+				etcdMainStatefulSet,
+				highlyAvailableETCDEventsStatefulSet,
+			},
+			nil,
+			beConditionWithStatus(gardencorev1alpha1.ConditionFalse)),
 	)
 
 	DescribeTable("#CheckSystemComponents",
@@ -0,0 +1,281 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package botanist
+
+import (
+	"context"
+	"crypto/x509"
+	"time"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
+	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
+	"github.com/gardener/gardener/pkg/operation/common"
+	"github.com/gardener/gardener/pkg/utils"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+	"github.com/gardener/gardener/pkg/utils/secrets"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// NextCARotationPhase computes the phase of the certificate authority rotation for the current reconciliation based
+// on the current rotation status, the operation annotation of the Shoot, the current certificate authorities and the
+// automatic rotation configuration. A rotation is started if it is requested by the operation annotation or if one of
+// the certificate authorities expires within the expiration threshold or is older than the maximum age. A prepared
+// rotation is completed if it is requested by the operation annotation or if the completion delay has passed.
+func NextCARotationPhase(rotation *gardencorev1alpha1.CARotation, operation string, certificateAuthorities []*x509.Certificate, cfg *config.CertificateAuthorityRotationConfiguration, now time.Time) gardencorev1alpha1.CredentialsRotationPhase {
+	var phase gardencorev1alpha1.CredentialsRotationPhase
+	if rotation != nil {
+		phase = rotation.Phase
+	}
+
+	switch phase {
+	case gardencorev1alpha1.RotationPreparing, gardencorev1alpha1.RotationCompleting:
+		return phase
+
+	case gardencorev1alpha1.RotationPrepared:
+		if operation == common.ShootOperationRotateCAComplete {
+			return gardencorev1alpha1.RotationCompleting
+		}
+		if cfg != nil && cfg.CompletionDelay != nil && rotation.LastInitiationTime != nil && !now.Before(rotation.LastInitiationTime.Add(cfg.CompletionDelay.Duration)) {
+			return gardencorev1alpha1.RotationCompleting
+		}
+		return phase
+
+	default:
+		if operation == common.ShootOperationRotateCAStart {
+			return gardencorev1alpha1.RotationPreparing
+		}
+		if cfg == nil {
+			return phase
+		}
+		for _, certificate := range certificateAuthorities {
+			if cfg.ExpirationThreshold != nil && !now.Add(cfg.ExpirationThreshold.Duration).Before(certificate.NotAfter) {
+				return gardencorev1alpha1.RotationPreparing
+			}
+			if cfg.MaxAge != nil && !now.Before(certificate.NotBefore.Add(cfg.MaxAge.Duration)) {
+				return gardencorev1alpha1.RotationPreparing
+			}
+		}
+		return phase
+	}
+}
+
+// reconcileCARotationPhase computes the phase of the certificate authority rotation for the current reconciliation,
+// removes a CA rotation operation annotation from the Shoot, and persists a phase change in the Shoot status.
+func (b *Botanist) reconcileCARotationPhase(ctx context.Context, certificateAuthorityData map[string]map[string][]byte) (gardencorev1alpha1.CredentialsRotationPhase, error) {
+	var (
+		now          = Now()
+		operation    = b.Shoot.Info.Annotations[common.ShootOperation]
+		currentPhase = gardencorev1alpha1helper.GetShootCARotationPhase(b.Shoot.Info.Status.Credentials)
+
+		rotation               *gardencorev1alpha1.CARotation
+		cfg                    *config.CertificateAuthorityRotationConfiguration
+		certificateAuthorities []*x509.Certificate
+	)
+
+	if credentials := b.Shoot.Info.Status.Credentials; credentials != nil && credentials.Rotation != nil {
+		rotation = credentials.Rotation.CertificateAuthorities
+	}
+	if b.Config != nil && b.Config.Controllers != nil && b.Config.Controllers.Shoot != nil {
+		cfg = b.Config.Controllers.Shoot.CertificateAuthorityRotation
+	}
+	for _, data := range certificateAuthorityData {
+		certificate, err := utils.DecodeCertificate(data[secrets.DataKeyCertificateCA])
+		if err != nil {
+			return "", err
+		}
+		certificateAuthorities = append(certificateAuthorities, certificate)
+	}
+
+	phase := NextCARotationPhase(rotation, operation, certificateAuthorities, cfg, now)
+
+	if operation == common.ShootOperationRotateCAStart || operation == common.ShootOperationRotateCAComplete {
+		if phase == currentPhase {
+			b.Logger.Infof("Ignoring operation %q as the certificate authority rotation is in phase %q", operation, currentPhase)
+		}
+
+		if _, err := kutil.TryUpdateShootAnnotations(b.K8sGardenClient.GardenCore(), retry.DefaultRetry, b.Shoot.Info.ObjectMeta, func(shoot *gardencorev1alpha1.Shoot) (*gardencorev1alpha1.Shoot, error) {
+			delete(shoot.Annotations, common.ShootOperation)
+			return shoot, nil
+		}); err != nil {
+			return "", err
+		}
+	}
+
+	if phase == currentPhase {
+		return phase, nil
+	}
+
+	b.Logger.Infof("Certificate authority rotation enters phase %q", phase)
+	if _, err := kutil.TryUpdateShootStatus(b.K8sGardenClient.GardenCore(), retry.DefaultRetry, b.Shoot.Info.ObjectMeta, func(shoot *gardencorev1alpha1.Shoot) (*gardencorev1alpha1.Shoot, error) {
+		gardencorev1alpha1helper.MutateShootCARotation(shoot, func(rotation *gardencorev1alpha1.CARotation) {
+			rotation.Phase = phase
+			if phase == gardencorev1alpha1.RotationPreparing {
+				rotation.LastInitiationTime = &metav1.Time{Time: now}
+			}
+		})
+		return shoot, nil
+	}); err != nil {
+		return "", err
+	}
+
+	return phase, nil
+}
+
+// rotateCertificateAuthorities updates the data of the certificate authorities according to the given phase of the
+// certificate authority rotation and returns the current and the previous certificate authorities. While the rotation
+// is being prepared, a new CA is introduced alongside the old one. Once the rotation is being completed, the old CA is
+// dropped. Changed data is persisted with the given update function, i.e. in the CA secrets or in the secret store.
+func (b *Botanist) rotateCertificateAuthorities(phase gardencorev1alpha1.CredentialsRotationPhase, certificateAuthorityData map[string]map[string][]byte, updateData func(name string, data map[string][]byte) error) (map[string]*secrets.Certificate, map[string]*secrets.Certificate, error) {
+	var (
+		current  = make(map[string]*secrets.Certificate, len(certificateAuthorityData))
+		previous = make(map[string]*secrets.Certificate, len(certificateAuthorityData))
+	)
+
+	for name, config := range wantedCertificateAuthorities {
+		existingData, ok := certificateAuthorityData[name]
+		if !ok {
+			continue
+		}
+
+		currentCA, previousCA, err := secrets.LoadCertificateAuthorities(name, existingData)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		var data map[string][]byte
+		switch phase {
+		case gardencorev1alpha1.RotationPreparing, gardencorev1alpha1.RotationPrepared:
+			if previousCA == nil && phase == gardencorev1alpha1.RotationPreparing {
+				b.Logger.Infof("Introducing new certificate authority %q", name)
+				if data, err = secrets.RotateCertificateAuthority(config, currentCA); err != nil {
+					return nil, nil, err
+				}
+			}
+		default:
+			if previousCA != nil {
+				b.Logger.Infof("Dropping previous certificate authority %q", name)
+			}
+			if previousCA != nil || len(existingData[secrets.DataKeyCertificateCASigning]) == 0 {
+				data = secrets.CompleteCertificateAuthorityRotation(currentCA)
+			}
+		}
+
+		if data != nil {
+			if err := updateData(name, data); err != nil {
+				return nil, nil, err
+			}
+			certificateAuthorityData[name] = data
+
+			if currentCA, previousCA, err = secrets.LoadCertificateAuthorities(name, data); err != nil {
+				return nil, nil, err
+			}
+		}
+
+		current[name] = currentCA
+		if previousCA != nil {
+			previous[name] = previousCA
+		}
+	}
+
+	return current, previous, nil
+}
+
+// signServerCertificatesWithPreviousCAs lets the previous certificate authorities sign the server certificates while
+// a certificate authority rotation is prepared, so that clients which do not trust the new CAs yet can still connect.
+// Client certificates are already signed by the new CAs as all servers trust both the old and the new CAs.
+func signServerCertificatesWithPreviousCAs(wantedSecretsList []secrets.ConfigInterface, previousCertificateAuthorities map[string]*secrets.Certificate) {
+	for _, config := range wantedSecretsList {
+		certificateConfig := getCertificateSecretConfig(config)
+		if certificateConfig == nil || certificateConfig.SigningCA == nil {
+			continue
+		}
+		if certificateConfig.CertType != secrets.ServerCert && certificateConfig.CertType != secrets.ServerClientCert {
+			continue
+		}
+		if previousCA, ok := previousCertificateAuthorities[certificateConfig.SigningCA.Name]; ok {
+			certificateConfig.SigningCA = previousCA
+		}
+	}
+}
+
+// deleteOutdatedCertificateSecrets deletes all existing certificate secrets which were not generated for the
+// certificate authorities currently used for signing, so that they are regenerated.
+func (b *Botanist) deleteOutdatedCertificateSecrets(ctx context.Context, existingSecretsMap map[string]*corev1.Secret, wantedSecretsList []secrets.ConfigInterface) error {
+	for _, config := range wantedSecretsList {
+		certificateConfig := getCertificateSecretConfig(config)
+		if certificateConfig == nil {
+			continue
+		}
+
+		secret, ok := existingSecretsMap[certificateConfig.Name]
+		if !ok || !certificateConfig.IsOutdated(secret.Data) {
+			continue
+		}
+
+		b.Logger.Infof("Renewing certificate secret %q as its certificate authority has been rotated", secret.Name)
+		if err := b.K8sSeedClient.Client().Delete(ctx, secret); client.IgnoreNotFound(err) != nil {
+			return err
+		}
+		delete(existingSecretsMap, certificateConfig.Name)
+	}
+
+	return nil
+}
+
+// secretStore returns the store in which the certificate authorities of the Shoot are kept according to the Gardenlet
+// configuration, or nil if they are kept in the CA secrets themselves.
+func (b *Botanist) secretStore() secrets.Store {
+	if b.Config == nil || b.Config.Controllers == nil || b.Config.Controllers.Shoot == nil || b.Config.Controllers.Shoot.SecretStore == nil {
+		return nil
+	}
+
+	cfg := b.Config.Controllers.Shoot.SecretStore
+	switch cfg.Type {
+	case config.SecretStoreTypeKubernetes:
+		return secrets.NewKubernetesStore(b.K8sSeedClient.Client())
+	case config.SecretStoreTypeVault:
+		if cfg.Vault == nil {
+			return nil
+		}
+		vaultConfig := secrets.VaultStoreConfig{
+			Address:   cfg.Vault.Address,
+			TokenFile: cfg.Vault.TokenFile,
+		}
+		if cfg.Vault.MountPath != nil {
+			vaultConfig.MountPath = *cfg.Vault.MountPath
+		}
+		if cfg.Vault.PathPrefix != nil {
+			vaultConfig.PathPrefix = *cfg.Vault.PathPrefix
+		}
+		return secrets.NewVaultStore(vaultConfig)
+	}
+
+	return nil
+}
+
+func getCertificateSecretConfig(config secrets.ConfigInterface) *secrets.CertificateSecretConfig {
+	switch c := config.(type) {
+	case *secrets.CertificateSecretConfig:
+		return c
+	case *secrets.ControlPlaneSecretConfig:
+		return c.CertificateSecretConfig
+	}
+	return nil
+}
@@ -0,0 +1,103 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package botanist_test
+
+import (
+	"crypto/x509"
+	"time"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
+	. "github.com/gardener/gardener/pkg/operation/botanist"
+	"github.com/gardener/gardener/pkg/operation/common"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("certificate authority rotation", func() {
+	Describe("#NextCARotationPhase", func() {
+		var (
+			now = time.Date(2019, 12, 31, 12, 0, 0, 0, time.UTC)
+
+			certificateAuthorities = []*x509.Certificate{
+				{NotBefore: now.Add(-2 * 365 * 24 * time.Hour), NotAfter: now.Add(8 * 365 * 24 * time.Hour)},
+			}
+
+			cfg *config.CertificateAuthorityRotationConfiguration
+		)
+
+		BeforeEach(func() {
+			cfg = &config.CertificateAuthorityRotationConfiguration{
+				ExpirationThreshold: &metav1.Duration{Duration: 30 * 24 * time.Hour},
+			}
+		})
+
+		rotationInPhase := func(phase gardencorev1alpha1.CredentialsRotationPhase) *gardencorev1alpha1.CARotation {
+			return &gardencorev1alpha1.CARotation{
+				Phase:              phase,
+				LastInitiationTime: &metav1.Time{Time: now.Add(-24 * time.Hour)},
+			}
+		}
+
+		It("should not start a rotation if nothing requires it", func() {
+			Expect(NextCARotationPhase(nil, "", certificateAuthorities, cfg, now)).To(BeEmpty())
+			Expect(NextCARotationPhase(rotationInPhase(gardencorev1alpha1.RotationCompleted), "", certificateAuthorities, cfg, now)).To(Equal(gardencorev1alpha1.RotationCompleted))
+		})
+
+		It("should start a rotation if requested by the operation annotation", func() {
+			Expect(NextCARotationPhase(nil, common.ShootOperationRotateCAStart, certificateAuthorities, cfg, now)).To(Equal(gardencorev1alpha1.RotationPreparing))
+			Expect(NextCARotationPhase(rotationInPhase(gardencorev1alpha1.RotationCompleted), common.ShootOperationRotateCAStart, certificateAuthorities, cfg, now)).To(Equal(gardencorev1alpha1.RotationPreparing))
+		})
+
+		It("should start a rotation if a CA expires within the threshold", func() {
+			expiring := []*x509.Certificate{{NotBefore: now.Add(-10 * 365 * 24 * time.Hour), NotAfter: now.Add(24 * time.Hour)}}
+
+			Expect(NextCARotationPhase(nil, "", expiring, cfg, now)).To(Equal(gardencorev1alpha1.RotationPreparing))
+		})
+
+		It("should start a rotation if a CA is older than the maximum age", func() {
+			cfg.MaxAge = &metav1.Duration{Duration: 365 * 24 * time.Hour}
+
+			Expect(NextCARotationPhase(nil, "", certificateAuthorities, cfg, now)).To(Equal(gardencorev1alpha1.RotationPreparing))
+		})
+
+		It("should not start a rotation automatically without configuration", func() {
+			expiring := []*x509.Certificate{{NotBefore: now.Add(-10 * 365 * 24 * time.Hour), NotAfter: now.Add(24 * time.Hour)}}
+
+			Expect(NextCARotationPhase(nil, "", expiring, nil, now)).To(BeEmpty())
+		})
+
+		It("should continue rotations which are in progress", func() {
+			Expect(NextCARotationPhase(rotationInPhase(gardencorev1alpha1.RotationPreparing), common.ShootOperationRotateCAComplete, certificateAuthorities, cfg, now)).To(Equal(gardencorev1alpha1.RotationPreparing))
+			Expect(NextCARotationPhase(rotationInPhase(gardencorev1alpha1.RotationCompleting), common.ShootOperationRotateCAStart, certificateAuthorities, cfg, now)).To(Equal(gardencorev1alpha1.RotationCompleting))
+		})
+
+		It("should only complete prepared rotations if requested by the operation annotation", func() {
+			Expect(NextCARotationPhase(rotationInPhase(gardencorev1alpha1.RotationPrepared), "", certificateAuthorities, cfg, now)).To(Equal(gardencorev1alpha1.RotationPrepared))
+			Expect(NextCARotationPhase(rotationInPhase(gardencorev1alpha1.RotationPrepared), common.ShootOperationRotateCAStart, certificateAuthorities, cfg, now)).To(Equal(gardencorev1alpha1.RotationPrepared))
+			Expect(NextCARotationPhase(rotationInPhase(gardencorev1alpha1.RotationPrepared), common.ShootOperationRotateCAComplete, certificateAuthorities, cfg, now)).To(Equal(gardencorev1alpha1.RotationCompleting))
+		})
+
+		It("should complete prepared rotations after the completion delay", func() {
+			cfg.CompletionDelay = &metav1.Duration{Duration: 48 * time.Hour}
+			Expect(NextCARotationPhase(rotationInPhase(gardencorev1alpha1.RotationPrepared), "", certificateAuthorities, cfg, now)).To(Equal(gardencorev1alpha1.RotationPrepared))
+
+			cfg.CompletionDelay = &metav1.Duration{Duration: 24 * time.Hour}
+			Expect(NextCARotationPhase(rotationInPhase(gardencorev1alpha1.RotationPrepared), "", certificateAuthorities, cfg, now)).To(Equal(gardencorev1alpha1.RotationCompleting))
+		})
+	})
+})
@@ -0,0 +1,61 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package botanist
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
+	"github.com/gardener/gardener/pkg/utils/secrets"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// GetEarliestCertificateExpiration returns the expiration of the certificate which expires first among the
+// certificates generated for the Shoot, i.e., among its certificate authorities and the certificates signed by them.
+// Other secrets in the Shoot's namespace in the Seed cluster are not considered as they are not managed by Gardener.
+func (b *Botanist) GetEarliestCertificateExpiration(ctx context.Context) (*secrets.CertificateExpiration, error) {
+	secretList := &corev1.SecretList{}
+	if err := b.K8sSeedClient.Client().List(ctx, secretList, client.InNamespace(b.Shoot.SeedNamespace)); err != nil {
+		return nil, err
+	}
+
+	return secrets.GetEarliestCertificateExpiration(secrets.FilterGeneratedCertificateSecrets(secretList.Items, wantedCertificateAuthorities)), nil
+}
+
+// CheckCertificateExpiration checks whether the certificate which expires first is still valid for at least the given
+// <threshold>. The condition is failed if the certificate has already expired or expires within the threshold.
+func (b *HealthChecker) CheckCertificateExpiration(condition gardencorev1alpha1.Condition, expiration *secrets.CertificateExpiration, threshold time.Duration, now time.Time) gardencorev1alpha1.Condition {
+	if expiration == nil {
+		return gardencorev1alpha1helper.UpdatedCondition(condition, gardencorev1alpha1.ConditionTrue, "CertificatesValid", "No certificates have been generated yet.")
+	}
+
+	var (
+		certificate = fmt.Sprintf("Certificate %q (key %q of secret %q)", expiration.CommonName, expiration.DataKey, expiration.SecretName)
+		notAfter    = expiration.NotAfter.UTC().Format(time.RFC3339)
+	)
+
+	if !now.Before(expiration.NotAfter) {
+		return b.FailedCondition(condition, "CertificateExpired", fmt.Sprintf("%s expired at %s.", certificate, notAfter))
+	}
+	if !now.Add(threshold).Before(expiration.NotAfter) {
+		return b.FailedCondition(condition, "CertificateExpiresSoon", fmt.Sprintf("%s expires at %s, i.e., within less than %s.", certificate, notAfter, threshold))
+	}
+	return gardencorev1alpha1helper.UpdatedCondition(condition, gardencorev1alpha1.ConditionTrue, "CertificatesValid", fmt.Sprintf("All certificates are valid until at least %s.", notAfter))
+}
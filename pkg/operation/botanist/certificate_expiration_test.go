@@ -0,0 +1,86 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package botanist_test
+
+import (
+	"time"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	. "github.com/gardener/gardener/pkg/operation/botanist"
+	"github.com/gardener/gardener/pkg/utils/secrets"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+)
+
+var _ = Describe("certificate expiration", func() {
+	Describe("#CheckCertificateExpiration", func() {
+		var (
+			now       = time.Date(2019, 12, 31, 12, 0, 0, 0, time.UTC)
+			threshold = 30 * 24 * time.Hour
+
+			checker   *HealthChecker
+			condition gardencorev1alpha1.Condition
+		)
+
+		BeforeEach(func() {
+			checker = NewHealthChecker(nil)
+			condition = gardencorev1alpha1.Condition{
+				Type:   gardencorev1alpha1.ShootCertificatesValid,
+				Status: gardencorev1alpha1.ConditionTrue,
+			}
+		})
+
+		expiringAt := func(notAfter time.Time) *secrets.CertificateExpiration {
+			return &secrets.CertificateExpiration{
+				SecretName: "kube-apiserver",
+				DataKey:    secrets.DataKeyCertificate,
+				CommonName: "kube-apiserver",
+				NotAfter:   notAfter,
+			}
+		}
+
+		It("should succeed if there are no certificates", func() {
+			Expect(checker.CheckCertificateExpiration(condition, nil, threshold, now)).To(MatchFields(IgnoreExtras, Fields{
+				"Status": Equal(gardencorev1alpha1.ConditionTrue),
+				"Reason": Equal("CertificatesValid"),
+			}))
+		})
+
+		It("should succeed if the certificates are valid for longer than the threshold", func() {
+			Expect(checker.CheckCertificateExpiration(condition, expiringAt(now.Add(2*threshold)), threshold, now)).To(MatchFields(IgnoreExtras, Fields{
+				"Status":  Equal(gardencorev1alpha1.ConditionTrue),
+				"Reason":  Equal("CertificatesValid"),
+				"Message": ContainSubstring("2020-02-29T12:00:00Z"),
+			}))
+		})
+
+		It("should fail if a certificate expires within the threshold", func() {
+			Expect(checker.CheckCertificateExpiration(condition, expiringAt(now.Add(threshold)), threshold, now)).To(MatchFields(IgnoreExtras, Fields{
+				"Status":  Equal(gardencorev1alpha1.ConditionFalse),
+				"Reason":  Equal("CertificateExpiresSoon"),
+				"Message": ContainSubstring(`secret "kube-apiserver"`),
+			}))
+		})
+
+		It("should fail if a certificate has expired", func() {
+			Expect(checker.CheckCertificateExpiration(condition, expiringAt(now.Add(-time.Hour)), threshold, now)).To(MatchFields(IgnoreExtras, Fields{
+				"Status": Equal(gardencorev1alpha1.ConditionFalse),
+				"Reason": Equal("CertificateExpired"),
+			}))
+		})
+	})
+})
@@ -34,17 +34,21 @@ import (
 	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
 	"github.com/gardener/gardener/pkg/utils/kubernetes/health"
 	"github.com/gardener/gardener/pkg/utils/retry"
+	"github.com/gardener/gardener/pkg/utils/secrets"
 
 	hvpav1alpha1 "github.com/gardener/hvpa-controller/api/v1alpha1"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metaerrors "k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/selection"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
 	audit_internal "k8s.io/apiserver/pkg/apis/audit"
@@ -52,11 +56,53 @@ import (
 	auditv1alpha1 "k8s.io/apiserver/pkg/apis/audit/v1alpha1"
 	auditv1beta1 "k8s.io/apiserver/pkg/apis/audit/v1beta1"
 	auditvalidation "k8s.io/apiserver/pkg/apis/audit/validation"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 var chartPathControlPlane = filepath.Join(common.ChartPath, "seed-controlplane", "charts")
 
+const (
+	// highAvailabilityETCDReplicas is the number of members of the etcd clusters of a highly available control plane.
+	highAvailabilityETCDReplicas = 3
+	// highAvailabilityKubeAPIServerMinReplicas is the minimum number of kube-apiserver replicas of a highly available
+	// control plane.
+	highAvailabilityKubeAPIServerMinReplicas = 3
+	// highAvailabilityControllerReplicas is the number of replicas of the leader-elected kube-controller-manager and
+	// kube-scheduler of a highly available control plane.
+	highAvailabilityControllerReplicas = 2
+	// etcdAppLabelValue is the value of the 'app' label of the etcd pods.
+	etcdAppLabelValue = "etcd-statefulset"
+)
+
+// highAvailabilityValues returns the chart values which spread the replicas of the control plane components across
+// the failure domains of the seed if the control plane of the Shoot is highly available.
+func (b *Botanist) highAvailabilityValues() map[string]interface{} {
+	failureToleranceType := gardencorev1alpha1helper.GetShootFailureToleranceType(b.Shoot.Info)
+	if failureToleranceType == nil {
+		return map[string]interface{}{"enabled": false}
+	}
+
+	topologyKey := corev1.LabelHostname
+	if *failureToleranceType == gardencorev1alpha1.FailureToleranceTypeZone {
+		topologyKey = corev1.LabelZoneFailureDomain
+	}
+
+	return map[string]interface{}{
+		"enabled":     true,
+		"topologyKey": topologyKey,
+	}
+}
+
+// etcdReplicas returns the number of replicas of the etcd statefulsets of an awake control plane.
+func (b *Botanist) etcdReplicas() int32 {
+	if gardencorev1alpha1helper.IsShootHighlyAvailable(b.Shoot.Info) {
+		return highAvailabilityETCDReplicas
+	}
+	return 1
+}
+
 // DeployNamespace creates a namespace in the Seed cluster which is used to deploy all the control plane
 // components for the Shoot cluster. Moreover, the cloud provider configuration and all the secrets will be
 // stored as ConfigMaps/Secrets.
@@ -106,8 +152,15 @@ func getShootAnnotations(annotations map[string]string, uid types.UID) map[strin
 
 // DeleteNamespace deletes the namespace in the Seed cluster which holds the control plane components. The built-in
 // garbage collection in Kubernetes will automatically delete all resources which belong to this namespace. This
-// comprises volumes and load balancers as well.
+// comprises volumes and load balancers as well. The certificate authorities kept in a secret store are deleted, too.
 func (b *Botanist) DeleteNamespace(ctx context.Context) error {
+	if store := b.secretStore(); store != nil {
+		for name := range wantedCertificateAuthorities {
+			if err := store.Delete(ctx, b.Shoot.SeedNamespace, name); err != nil {
+				return err
+			}
+		}
+	}
 	return b.deleteNamespace(ctx, b.Shoot.SeedNamespace)
 }
 
@@ -212,11 +265,13 @@ func (b *Botanist) DeleteClusterAutoscaler(ctx context.Context) error {
 // * etcd-main
 // * kube-apiserver
 // * kube-controller-manager
+// The etcd statefulsets of a highly available control plane are scaled to all of their members as they cannot reach
+// quorum otherwise.
 func (b *Botanist) WakeUpControlPlane(ctx context.Context) error {
 	client := b.K8sSeedClient.Client()
 
 	for _, statefulset := range []string{v1alpha1constants.StatefulSetNameETCDEvents, v1alpha1constants.StatefulSetNameETCDMain} {
-		if err := kubernetes.ScaleStatefulSet(ctx, client, kutil.Key(b.Shoot.SeedNamespace, statefulset), 1); err != nil {
+		if err := kubernetes.ScaleStatefulSet(ctx, client, kutil.Key(b.Shoot.SeedNamespace, statefulset), b.etcdReplicas()); err != nil {
 			return err
 		}
 	}
@@ -525,12 +580,14 @@ func (b *Botanist) DeployBackupEntryInGarden(ctx context.Context) error {
 		backupEntry.ObjectMeta.OwnerReferences = []metav1.OwnerReference{*ownerRef}
 		backupEntry.Spec.BucketName = bucketName
 		backupEntry.Spec.Seed = seedName
+		backupEntry.Spec.RetentionPolicy = gardencorev1alpha1helper.GetBackupRetentionPolicy(b.Garden.Project, b.Shoot.Info)
 		return nil
 	})
 }
 
 const (
-	auditPolicyConfigMapDataKey = "policy"
+	auditPolicyConfigMapDataKey    = "policy"
+	webhookKubeconfigSecretDataKey = "kubeconfig"
 )
 
 var (
@@ -612,13 +669,7 @@ func (b *Botanist) deployNetworkPolicies(ctx context.Context, denyAll bool) erro
 		excludeNets = append(excludeNets, addr)
 	}
 
-	shootCIDRNetworks := []string{b.Shoot.Info.Spec.Networking.Nodes}
-	if b.Shoot.Info.Spec.Networking.Pods != nil {
-		shootCIDRNetworks = append(shootCIDRNetworks, *b.Shoot.Info.Spec.Networking.Pods)
-	}
-	if b.Shoot.Info.Spec.Networking.Services != nil {
-		shootCIDRNetworks = append(shootCIDRNetworks, *b.Shoot.Info.Spec.Networking.Services)
-	}
+	shootCIDRNetworks := utils.SplitCIDRs(b.Shoot.Info.Spec.Networking.Nodes, b.Shoot.GetPodNetwork(), b.Shoot.GetServiceNetwork())
 	shootNetworkValues, err := common.ExceptNetworks(shootCIDRNetworks, excludeNets...)
 	if err != nil {
 		return err
@@ -626,7 +677,8 @@ func (b *Botanist) deployNetworkPolicies(ctx context.Context, denyAll bool) erro
 	values["clusterNetworks"] = shootNetworkValues
 
 	seedNetworks := b.Seed.Info.Spec.Networks
-	allCIDRNetworks := append([]string{seedNetworks.Nodes, seedNetworks.Pods, seedNetworks.Services}, shootCIDRNetworks...)
+	seedCIDRNetworks := utils.SplitCIDRs(seedNetworks.Nodes, seedNetworks.Pods, seedNetworks.Services)
+	allCIDRNetworks := append(append([]string{}, seedCIDRNetworks...), shootCIDRNetworks...)
 	allCIDRNetworks = append(allCIDRNetworks, excludeNets...)
 
 	privateNetworks, err := common.ToExceptNetworks(common.AllPrivateNetworkBlocks(), allCIDRNetworks...)
@@ -636,7 +688,102 @@ func (b *Botanist) deployNetworkPolicies(ctx context.Context, denyAll bool) erro
 	globalNetworkPoliciesValues["privateNetworks"] = privateNetworks
 	values["global-network-policies"] = globalNetworkPoliciesValues
 
-	return b.ApplyChartSeed(filepath.Join(chartPathControlPlane, "network-policies"), b.Shoot.SeedNamespace, "network-policies", values, nil)
+	var (
+		networkPolicyRules     = []interface{}{}
+		networkPolicyRuleNames = sets.NewString()
+		// The network policy rules must neither allow traffic to blocked addresses nor to other components in the seed.
+		ruleExcludeNets = append(append([]string{}, excludeNets...), seedCIDRNetworks...)
+	)
+	if controlPlane := b.Shoot.Info.Spec.ControlPlane; controlPlane != nil {
+		for _, rule := range controlPlane.NetworkPolicyRules {
+			ruleValues, err := computeNetworkPolicyRuleValues(rule, ruleExcludeNets)
+			if err != nil {
+				return err
+			}
+			networkPolicyRules = append(networkPolicyRules, ruleValues)
+			networkPolicyRuleNames.Insert(rule.Name)
+		}
+	}
+	values["networkPolicyRules"] = networkPolicyRules
+
+	if err := b.ApplyChartSeed(filepath.Join(chartPathControlPlane, "network-policies"), b.Shoot.SeedNamespace, "network-policies", values, nil); err != nil {
+		return err
+	}
+
+	return b.deleteStaleNetworkPolicyRules(ctx, networkPolicyRuleNames)
+}
+
+// computeNetworkPolicyRuleValues computes the chart values for the given network policy rule of the Shoot. The given
+// excluded CIDRs, i.e., the blocked CIDRs and the node, pod and service networks of the Seed, are excluded from the
+// destination networks of the rule. An error is returned if a destination network lies entirely within an excluded
+// CIDR as the rule would otherwise allow traffic to excluded addresses, e.g., because the networks of the Seed have
+// been changed after the rule has been admitted. The etcd pods are never selected by the rule.
+func computeNetworkPolicyRuleValues(rule gardencorev1alpha1.NetworkPolicyRule, excludedCIDRs []string) (map[string]interface{}, error) {
+	networks, err := common.ExceptBlockedNetworks(rule.CIDRs, excludedCIDRs...)
+	if err != nil {
+		return nil, fmt.Errorf("network policy rule %q cannot be applied: %v", rule.Name, err)
+	}
+
+	ports := make([]interface{}, 0, len(rule.Ports))
+	for _, port := range rule.Ports {
+		portValues := map[string]interface{}{
+			"port": port.Port,
+		}
+		if port.Protocol != nil {
+			portValues["protocol"] = string(*port.Protocol)
+		}
+		ports = append(ports, portValues)
+	}
+
+	values := map[string]interface{}{
+		"name":     rule.Name,
+		"networks": networks,
+		"ports":    ports,
+	}
+
+	if len(rule.PodSelector.MatchLabels) > 0 {
+		values["matchLabels"] = rule.PodSelector.MatchLabels
+	}
+
+	matchExpressions := make([]interface{}, 0, len(rule.PodSelector.MatchExpressions)+1)
+	for _, expression := range rule.PodSelector.MatchExpressions {
+		matchExpressions = append(matchExpressions, map[string]interface{}{
+			"key":      expression.Key,
+			"operator": string(expression.Operator),
+			"values":   expression.Values,
+		})
+	}
+	// The etcd pods hold the data of the Shoot and must not be granted additional egress traffic.
+	matchExpressions = append(matchExpressions, map[string]interface{}{
+		"key":      "app",
+		"operator": string(metav1.LabelSelectorOpNotIn),
+		"values":   []string{etcdAppLabelValue},
+	})
+	values["matchExpressions"] = matchExpressions
+
+	return values, nil
+}
+
+// deleteStaleNetworkPolicyRules deletes the NetworkPolicies in the Shoot namespace which have been generated for network
+// policy rules that are no longer part of the Shoot specification.
+func (b *Botanist) deleteStaleNetworkPolicyRules(ctx context.Context, wantedRuleNames sets.String) error {
+	networkPolicyList := &networkingv1.NetworkPolicyList{}
+	if err := b.K8sSeedClient.Client().List(ctx, networkPolicyList, client.InNamespace(b.Shoot.SeedNamespace), client.MatchingLabelsSelector{
+		Selector: labels.NewSelector().Add(MustNewRequirement(v1alpha1constants.LabelNetworkPolicyShootRule, selection.Exists)),
+	}); err != nil {
+		return err
+	}
+
+	for _, networkPolicy := range networkPolicyList.Items {
+		if wantedRuleNames.Has(networkPolicy.Labels[v1alpha1constants.LabelNetworkPolicyShootRule]) {
+			continue
+		}
+		if err := b.K8sSeedClient.Client().Delete(ctx, networkPolicy.DeepCopy(), kubernetes.DefaultDeleteOptions...); client.IgnoreNotFound(err) != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 // DeployNetworkPolicies creates a network policies in a Shoot cluster's namespace that
@@ -701,6 +848,7 @@ func (b *Botanist) DeployKubeAPIServer() error {
 			"hvpa": map[string]interface{}{
 				"enabled": hvpaEnabled,
 			},
+			"highAvailability": b.highAvailabilityValues(),
 		}
 	)
 
@@ -711,6 +859,10 @@ func (b *Botanist) DeployKubeAPIServer() error {
 	if enableEtcdEncryption {
 		defaultValues["enableEtcdEncryption"] = true
 		defaultValues["podAnnotations"].(map[string]interface{})["checksum/secret-etcd-encryption"] = b.CheckSums[common.EtcdEncryptionSecretName]
+
+		if gardencorev1alpha1helper.GetShootKMSProvider(b.Shoot.Info) != nil {
+			defaultValues["enableKMSPlugin"] = true
+		}
 	}
 
 	if gardencorev1alpha1helper.ShootWantsBasicAuthentication(b.Shoot.Info) {
@@ -785,6 +937,18 @@ func (b *Botanist) DeployKubeAPIServer() error {
 		}
 	}
 
+	if gardencorev1alpha1helper.IsShootHighlyAvailable(b.Shoot.Info) {
+		if minReplicas < highAvailabilityKubeAPIServerMinReplicas {
+			minReplicas = highAvailabilityKubeAPIServerMinReplicas
+		}
+		if maxReplicas < minReplicas {
+			maxReplicas = minReplicas
+		}
+		if replicas := deployment.Spec.Replicas; !b.Shoot.HibernationEnabled && (replicas == nil || *replicas < minReplicas) {
+			defaultValues["replicas"] = minReplicas
+		}
+	}
+
 	// APIserver will be horizontally scaled until last but one replicas,
 	// after which there will be vertical scaling
 	if maxReplicas > minReplicas {
@@ -801,8 +965,13 @@ func (b *Botanist) DeployKubeAPIServer() error {
 		admissionPlugins = kubernetes.GetAdmissionPluginsForVersion(b.Shoot.Info.Spec.Kubernetes.Version)
 	)
 
+	var apiServerFeatureGates map[string]bool
+	if apiServerConfig != nil {
+		apiServerFeatureGates = apiServerConfig.FeatureGates
+	}
+	defaultValues["featureGates"] = ComputeIPFamiliesFeatureGates(b.Shoot.Info.Spec.Networking.IPFamilies, apiServerFeatureGates)
+
 	if apiServerConfig != nil {
-		defaultValues["featureGates"] = apiServerConfig.FeatureGates
 		defaultValues["runtimeConfig"] = apiServerConfig.RuntimeConfig
 
 		if apiServerConfig.OIDCConfig != nil {
@@ -848,16 +1017,28 @@ func (b *Botanist) DeployKubeAPIServer() error {
 			}
 		}
 
-		if apiServerConfig.AuditConfig != nil &&
-			apiServerConfig.AuditConfig.AuditPolicy != nil &&
-			apiServerConfig.AuditConfig.AuditPolicy.ConfigMapRef != nil {
-			auditPolicy, err := b.getAuditPolicy(apiServerConfig.AuditConfig.AuditPolicy.ConfigMapRef.Name, b.Shoot.Info.Namespace)
-			if err != nil {
-				return fmt.Errorf("Retrieving audit policy from the ConfigMap '%v' failed with reason '%v'", apiServerConfig.AuditConfig.AuditPolicy.ConfigMapRef.Name, err)
+		if auditConfig := apiServerConfig.AuditConfig; auditConfig != nil {
+			auditConfigValues := map[string]interface{}{}
+
+			if auditConfig.AuditPolicy != nil && auditConfig.AuditPolicy.ConfigMapRef != nil {
+				auditPolicy, err := b.getAuditPolicy(auditConfig.AuditPolicy.ConfigMapRef.Name, b.Shoot.Info.Namespace)
+				if err != nil {
+					return fmt.Errorf("Retrieving audit policy from the ConfigMap '%v' failed with reason '%v'", auditConfig.AuditPolicy.ConfigMapRef.Name, err)
+				}
+				auditConfigValues["auditPolicy"] = auditPolicy
 			}
-			defaultValues["auditConfig"] = map[string]interface{}{
-				"auditPolicy": auditPolicy,
+
+			if auditConfig.Backend != nil {
+				if err := b.injectAuditBackendValues(auditConfigValues, auditConfig.Backend); err != nil {
+					return err
+				}
 			}
+
+			defaultValues["auditConfig"] = auditConfigValues
+		}
+
+		if err := b.injectWebhookValues(defaultValues, apiServerConfig); err != nil {
+			return err
 		}
 	}
 	defaultValues["admissionPlugins"] = admissionPlugins
@@ -950,6 +1131,200 @@ func (b *Botanist) getAuditPolicy(name, namespace string) (string, error) {
 	return auditPolicy, nil
 }
 
+// injectAuditBackendValues adds the chart values for the given audit backend to the given audit config values. The
+// kubeconfig of a webhook backend is read from the referenced secret in the namespace of the Shoot.
+func (b *Botanist) injectAuditBackendValues(values map[string]interface{}, backend *gardencorev1alpha1.AuditBackend) error {
+	if log := backend.Log; log != nil {
+		logValues := map[string]interface{}{}
+		if log.MaxAge != nil {
+			logValues["maxAge"] = *log.MaxAge
+		}
+		if log.MaxBackups != nil {
+			logValues["maxBackups"] = *log.MaxBackups
+		}
+		if log.MaxSize != nil {
+			logValues["maxSize"] = *log.MaxSize
+		}
+		if log.Buffering != nil {
+			logValues["buffering"] = auditBufferingValues(*log.Buffering)
+		}
+		values["log"] = logValues
+	}
+
+	if webhook := backend.Webhook; webhook != nil {
+		kubeconfig, err := b.getWebhookKubeconfig(webhook.SecretRef.Name, b.Shoot.Info.Namespace, ValidateWebhookKubeconfig)
+		if err != nil {
+			return fmt.Errorf("Retrieving audit webhook kubeconfig from the Secret '%v' failed with reason '%v'", webhook.SecretRef.Name, err)
+		}
+
+		webhookValues := map[string]interface{}{
+			"kubeconfig": string(kubeconfig),
+		}
+		if webhook.InitialBackoff != nil {
+			webhookValues["initialBackoff"] = webhook.InitialBackoff.Duration.String()
+		}
+		if webhook.Buffering != nil {
+			webhookValues["buffering"] = auditBufferingValues(*webhook.Buffering)
+		}
+		values["webhook"] = webhookValues
+	}
+
+	return nil
+}
+
+func auditBufferingValues(buffering gardencorev1alpha1.AuditBuffering) map[string]interface{} {
+	values := map[string]interface{}{
+		"mode": buffering.Mode,
+	}
+	if buffering.BufferSize != nil {
+		values["bufferSize"] = *buffering.BufferSize
+	}
+	if buffering.MaxBatchSize != nil {
+		values["maxBatchSize"] = *buffering.MaxBatchSize
+	}
+	if buffering.MaxBatchWait != nil {
+		values["maxBatchWait"] = buffering.MaxBatchWait.Duration.String()
+	}
+	if buffering.ThrottleQPS != nil {
+		values["throttleQPS"] = *buffering.ThrottleQPS
+	}
+	if buffering.ThrottleBurst != nil {
+		values["throttleBurst"] = *buffering.ThrottleBurst
+	}
+	return values
+}
+
+// injectWebhookValues adds the chart values for the authentication, authorization and admission webhooks of the given
+// kube-apiserver config to the given values. The kubeconfigs of the webhooks are read from the referenced secrets in
+// the namespace of the Shoot.
+func (b *Botanist) injectWebhookValues(values map[string]interface{}, apiServerConfig *gardencorev1alpha1.KubeAPIServerConfig) error {
+	if webhook := apiServerConfig.AuthenticationWebhook; webhook != nil {
+		kubeconfig, err := b.getWebhookKubeconfig(webhook.SecretRef.Name, b.Shoot.Info.Namespace, ValidateWebhookKubeconfig)
+		if err != nil {
+			return fmt.Errorf("Retrieving authentication webhook kubeconfig from the Secret '%v' failed with reason '%v'", webhook.SecretRef.Name, err)
+		}
+
+		webhookValues := map[string]interface{}{
+			"kubeconfig": string(kubeconfig),
+		}
+		if webhook.CacheTTL != nil {
+			webhookValues["cacheTTL"] = webhook.CacheTTL.Duration.String()
+		}
+		values["authenticationWebhook"] = webhookValues
+	}
+
+	if webhook := apiServerConfig.AuthorizationWebhook; webhook != nil {
+		kubeconfig, err := b.getWebhookKubeconfig(webhook.SecretRef.Name, b.Shoot.Info.Namespace, ValidateWebhookKubeconfig)
+		if err != nil {
+			return fmt.Errorf("Retrieving authorization webhook kubeconfig from the Secret '%v' failed with reason '%v'", webhook.SecretRef.Name, err)
+		}
+
+		webhookValues := map[string]interface{}{
+			"kubeconfig": string(kubeconfig),
+		}
+		if webhook.CacheAuthorizedTTL != nil {
+			webhookValues["cacheAuthorizedTTL"] = webhook.CacheAuthorizedTTL.Duration.String()
+		}
+		if webhook.CacheUnauthorizedTTL != nil {
+			webhookValues["cacheUnauthorizedTTL"] = webhook.CacheUnauthorizedTTL.Duration.String()
+		}
+		values["authorizationWebhook"] = webhookValues
+	}
+
+	if admissionWebhookConfig := apiServerConfig.AdmissionWebhookConfig; admissionWebhookConfig != nil {
+		kubeconfig, err := b.getWebhookKubeconfig(admissionWebhookConfig.SecretRef.Name, b.Shoot.Info.Namespace, ValidateAdmissionWebhookKubeconfig)
+		if err != nil {
+			return fmt.Errorf("Retrieving admission webhook kubeconfig from the Secret '%v' failed with reason '%v'", admissionWebhookConfig.SecretRef.Name, err)
+		}
+
+		values["admissionWebhookConfig"] = map[string]interface{}{
+			"kubeconfig": string(kubeconfig),
+		}
+	}
+
+	return nil
+}
+
+func (b *Botanist) getWebhookKubeconfig(name, namespace string, validate func([]byte) error) ([]byte, error) {
+	secret := &corev1.Secret{}
+	if err := b.K8sGardenClient.Client().Get(context.TODO(), kutil.Key(namespace, name), secret); err != nil {
+		return nil, err
+	}
+	kubeconfig, ok := secret.Data[webhookKubeconfigSecretDataKey]
+	if !ok {
+		return nil, fmt.Errorf("Missing '.data.%s' in webhook secret %v/%v", webhookKubeconfigSecretDataKey, namespace, name)
+	}
+	if err := validate(kubeconfig); err != nil {
+		return nil, fmt.Errorf("Provided invalid webhook kubeconfig err=%v", err)
+	}
+	return kubeconfig, nil
+}
+
+// ValidateWebhookKubeconfig checks whether the given kubeconfig can be used by the kube-apiserver to call a webhook,
+// e.g. for audit events, authentication or authorization. Its current context must be complete, and it must not refer
+// to files or credential plugins as they are not available in the pod of the kube-apiserver.
+func ValidateWebhookKubeconfig(kubeconfig []byte) error {
+	config, err := clientcmd.Load(kubeconfig)
+	if err != nil {
+		return err
+	}
+
+	kubeContext, ok := config.Contexts[config.CurrentContext]
+	if !ok {
+		return fmt.Errorf("current context %q is not defined", config.CurrentContext)
+	}
+	cluster, ok := config.Clusters[kubeContext.Cluster]
+	if !ok {
+		return fmt.Errorf("cluster %q of the current context is not defined", kubeContext.Cluster)
+	}
+	authInfo, ok := config.AuthInfos[kubeContext.AuthInfo]
+	if !ok {
+		return fmt.Errorf("user %q of the current context is not defined", kubeContext.AuthInfo)
+	}
+
+	if err := clientcmd.ConfirmUsable(*config, ""); err != nil {
+		return err
+	}
+
+	if len(cluster.CertificateAuthority) > 0 {
+		return fmt.Errorf("cluster %q must contain the certificate authority data inline", kubeContext.Cluster)
+	}
+	return validateInlineAuthInfo(kubeContext.AuthInfo, authInfo)
+}
+
+// ValidateAdmissionWebhookKubeconfig checks whether the given kubeconfig can be used by the kube-apiserver to
+// authenticate to the servers of admission webhooks. Only its users are considered, which must not refer to files or
+// credential plugins as they are not available in the pod of the kube-apiserver.
+func ValidateAdmissionWebhookKubeconfig(kubeconfig []byte) error {
+	config, err := clientcmd.Load(kubeconfig)
+	if err != nil {
+		return err
+	}
+
+	if len(config.AuthInfos) == 0 {
+		return fmt.Errorf("no users are defined")
+	}
+	for name, authInfo := range config.AuthInfos {
+		if err := validateInlineAuthInfo(name, authInfo); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validateInlineAuthInfo(name string, authInfo *clientcmdapi.AuthInfo) error {
+	switch {
+	case len(authInfo.ClientCertificate) > 0 || len(authInfo.ClientKey) > 0:
+		return fmt.Errorf("user %q must contain the client certificate and key data inline", name)
+	case len(authInfo.TokenFile) > 0:
+		return fmt.Errorf("user %q must contain the token inline", name)
+	case authInfo.AuthProvider != nil || authInfo.Exec != nil:
+		return fmt.Errorf("user %q must not use an auth provider or exec plugin", name)
+	}
+	return nil
+}
+
 // IsValidAuditPolicyVersion checks whether the api server support the provided audit policy apiVersion
 func IsValidAuditPolicyVersion(shootVersion string, schemaVersion *schema.GroupVersionKind) (bool, error) {
 	auditGroupVersion := schemaVersion.GroupVersion().String()
@@ -962,13 +1337,21 @@ func IsValidAuditPolicyVersion(shootVersion string, schemaVersion *schema.GroupV
 
 // DeployKubeControllerManager deploys kube-controller-manager deployment.
 func (b *Botanist) DeployKubeControllerManager() error {
+	// The private key of the cluster CA is only contained in the CA secret if the CAs are not kept in a secret store.
+	clusterSigningSecretName := v1alpha1constants.SecretNameCACluster
+	if b.secretStore() != nil {
+		clusterSigningSecretName = v1alpha1constants.SecretNameCACluster + secrets.SigningSecretNameSuffix
+	}
+
 	defaultValues := map[string]interface{}{
-		"clusterName":       b.Shoot.SeedNamespace,
-		"kubernetesVersion": b.Shoot.Info.Spec.Kubernetes.Version,
-		"podNetwork":        b.Shoot.GetPodNetwork(),
-		"serviceNetwork":    b.Shoot.GetServiceNetwork(),
+		"clusterName":              b.Shoot.SeedNamespace,
+		"clusterSigningSecretName": clusterSigningSecretName,
+		"kubernetesVersion":        b.Shoot.Info.Spec.Kubernetes.Version,
+		"podNetwork":               b.Shoot.GetPodNetwork(),
+		"serviceNetwork":           b.Shoot.GetServiceNetwork(),
 		"podAnnotations": map[string]interface{}{
 			"checksum/secret-ca":                             b.CheckSums[v1alpha1constants.SecretNameCACluster],
+			"checksum/secret-ca-cluster-signing":             b.CheckSums[clusterSigningSecretName],
 			"checksum/secret-kube-controller-manager":        b.CheckSums[v1alpha1constants.DeploymentNameKubeControllerManager],
 			"checksum/secret-kube-controller-manager-server": b.CheckSums[common.KubeControllerManagerServerName],
 			"checksum/secret-service-account-key":            b.CheckSums["service-account-key"],
@@ -979,19 +1362,65 @@ func (b *Botanist) DeployKubeControllerManager() error {
 	if err != nil {
 		return err
 	}
+	if replicaCount > 0 && gardencorev1alpha1helper.IsShootHighlyAvailable(b.Shoot.Info) {
+		replicaCount = highAvailabilityControllerReplicas
+	}
 	defaultValues["replicas"] = replicaCount
+	defaultValues["highAvailability"] = b.highAvailabilityValues()
+
+	var (
+		controllerManagerConfig = b.Shoot.Info.Spec.Kubernetes.KubeControllerManager
+		ipFamilies              = b.Shoot.Info.Spec.Networking.IPFamilies
+		featureGates            map[string]bool
+		nodeCIDRMaskSize        *int32
+	)
 
-	controllerManagerConfig := b.Shoot.Info.Spec.Kubernetes.KubeControllerManager
 	if controllerManagerConfig != nil {
-		defaultValues["featureGates"] = controllerManagerConfig.FeatureGates
+		featureGates = controllerManagerConfig.FeatureGates
+		nodeCIDRMaskSize = controllerManagerConfig.NodeCIDRMaskSize
 
 		if controllerManagerConfig.HorizontalPodAutoscalerConfig != nil {
 			defaultValues["horizontalPodAutoscaler"] = controllerManagerConfig.HorizontalPodAutoscalerConfig
 		}
 
-		if controllerManagerConfig.NodeCIDRMaskSize != nil {
-			defaultValues["nodeCIDRMaskSize"] = *controllerManagerConfig.NodeCIDRMaskSize
+		if controllerManagerConfig.NodeMonitorGracePeriod != nil {
+			defaultValues["nodeMonitorGracePeriod"] = controllerManagerConfig.NodeMonitorGracePeriod.Duration.String()
+		}
+
+		if controllerManagerConfig.PodEvictionTimeout != nil {
+			defaultValues["podEvictionTimeout"] = controllerManagerConfig.PodEvictionTimeout.Duration.String()
 		}
+
+		if concurrentSyncs := controllerManagerConfig.ConcurrentSyncs; concurrentSyncs != nil {
+			concurrentSyncsValues := map[string]interface{}{}
+			for name, value := range map[string]*int32{
+				"deployment":          concurrentSyncs.Deployments,
+				"endpoint":            concurrentSyncs.Endpoints,
+				"gc":                  concurrentSyncs.GarbageCollector,
+				"namespace":           concurrentSyncs.Namespaces,
+				"replicaset":          concurrentSyncs.ReplicaSets,
+				"resourceQuota":       concurrentSyncs.ResourceQuotas,
+				"serviceaccountToken": concurrentSyncs.ServiceAccountTokens,
+			} {
+				if value != nil {
+					concurrentSyncsValues[name] = *value
+				}
+			}
+			defaultValues["concurrentSyncs"] = concurrentSyncsValues
+		}
+	}
+
+	defaultValues["featureGates"] = ComputeIPFamiliesFeatureGates(ipFamilies, featureGates)
+
+	nodeCIDRMaskSizes := ComputeNodeCIDRMaskSizes(ipFamilies, nodeCIDRMaskSize)
+	if gardencorev1alpha1helper.IsDualStack(ipFamilies) {
+		nodeCIDRMaskSizesValues := map[string]interface{}{}
+		for ipFamily, size := range nodeCIDRMaskSizes {
+			nodeCIDRMaskSizesValues[strings.ToLower(string(ipFamily))] = size
+		}
+		defaultValues["nodeCIDRMaskSizes"] = nodeCIDRMaskSizesValues
+	} else if size, ok := nodeCIDRMaskSizes[gardencorev1alpha1helper.GetIPFamilies(ipFamilies)[0]]; ok {
+		defaultValues["nodeCIDRMaskSize"] = size
 	}
 
 	values, err := b.InjectSeedShootImages(defaultValues, common.HyperkubeImageName)
@@ -1004,13 +1433,19 @@ func (b *Botanist) DeployKubeControllerManager() error {
 
 // DeployKubeScheduler deploys kube-scheduler deployment.
 func (b *Botanist) DeployKubeScheduler() error {
+	replicas := 1
+	if gardencorev1alpha1helper.IsShootHighlyAvailable(b.Shoot.Info) {
+		replicas = highAvailabilityControllerReplicas
+	}
+
 	defaultValues := map[string]interface{}{
-		"replicas":          b.Shoot.GetReplicas(1),
+		"replicas":          b.Shoot.GetReplicas(replicas),
 		"kubernetesVersion": b.Shoot.Info.Spec.Kubernetes.Version,
 		"podAnnotations": map[string]interface{}{
 			"checksum/secret-kube-scheduler":        b.CheckSums[v1alpha1constants.DeploymentNameKubeScheduler],
 			"checksum/secret-kube-scheduler-server": b.CheckSums[common.KubeSchedulerServerName],
 		},
+		"highAvailability": b.highAvailabilityValues(),
 	}
 
 	if b.ShootedSeed != nil {
@@ -1025,6 +1460,10 @@ func (b *Botanist) DeployKubeScheduler() error {
 	schedulerConfig := b.Shoot.Info.Spec.Kubernetes.KubeScheduler
 	if schedulerConfig != nil {
 		defaultValues["featureGates"] = schedulerConfig.FeatureGates
+
+		if schedulerConfig.Profile != nil {
+			defaultValues["profile"] = *schedulerConfig.Profile
+		}
 	}
 
 	values, err := b.InjectSeedShootImages(defaultValues, common.HyperkubeImageName)
@@ -1056,7 +1495,14 @@ func (b *Botanist) DeployETCD(ctx context.Context) error {
 			"enabled": hvpaEnabled,
 		},
 		"storageCapacity": b.Seed.GetValidVolumeSize("10Gi"),
+		"replicas":        b.etcdReplicas(),
+	}
+
+	highAvailability := b.highAvailabilityValues()
+	if gardencorev1alpha1helper.IsShootHighlyAvailable(b.Shoot.Info) {
+		highAvailability["members"] = highAvailabilityETCDReplicas
 	}
+	etcdConfig["highAvailability"] = highAvailability
 
 	etcd, err := b.InjectSeedShootImages(etcdConfig, common.ETCDImageName)
 	if err != nil {
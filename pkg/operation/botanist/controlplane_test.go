@@ -15,6 +15,14 @@
 package botanist_test
 
 import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
 	. "github.com/gardener/gardener/pkg/operation/botanist"
 
 	. "github.com/onsi/ginkgo"
@@ -23,6 +31,8 @@ import (
 	auditv1 "k8s.io/apiserver/pkg/apis/audit/v1"
 	auditv1alpha1 "k8s.io/apiserver/pkg/apis/audit/v1alpha1"
 	auditv1beta1 "k8s.io/apiserver/pkg/apis/audit/v1beta1"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
 )
 
 var _ = Describe("controlplane", func() {
@@ -102,5 +112,134 @@ var _ = Describe("controlplane", func() {
 				Expect(ok).To(BeFalse())
 			})
 		})
+
+		Describe("#ValidateWebhookKubeconfig", func() {
+			var (
+				receiver *httptest.Server
+				events   chan auditv1.EventList
+
+				kubeconfig = func(cluster, user string) []byte {
+					return []byte(`apiVersion: v1
+kind: Config
+current-context: audit
+clusters:
+- name: audit
+  cluster:
+` + cluster + `
+contexts:
+- name: audit
+  context:
+    cluster: audit
+    user: audit
+users:
+- name: audit
+  user:
+` + user)
+				}
+				inlineCluster = func() string {
+					caData := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: receiver.Certificate().Raw})
+					return fmt.Sprintf("    server: %s\n    certificate-authority-data: %s", receiver.URL, base64.StdEncoding.EncodeToString(caData))
+				}
+				inlineUser = "    token: audit-token"
+			)
+
+			BeforeEach(func() {
+				events = make(chan auditv1.EventList, 1)
+				receiver = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					if r.Header.Get("Authorization") != "Bearer audit-token" {
+						w.WriteHeader(http.StatusUnauthorized)
+						return
+					}
+
+					eventList := auditv1.EventList{}
+					if err := json.NewDecoder(r.Body).Decode(&eventList); err != nil {
+						w.WriteHeader(http.StatusBadRequest)
+						return
+					}
+					events <- eventList
+				}))
+			})
+
+			AfterEach(func() {
+				receiver.Close()
+			})
+
+			It("should accept a kubeconfig which can be used to send audit events to the webhook", func() {
+				config := kubeconfig(inlineCluster(), inlineUser)
+				Expect(ValidateWebhookKubeconfig(config)).To(Succeed())
+
+				restConfig, err := clientcmd.RESTConfigFromKubeConfig(config)
+				Expect(err).NotTo(HaveOccurred())
+				transport, err := rest.TransportFor(restConfig)
+				Expect(err).NotTo(HaveOccurred())
+
+				body, err := json.Marshal(auditv1.EventList{Items: []auditv1.Event{{AuditID: "foo", Level: auditv1.LevelMetadata}}})
+				Expect(err).NotTo(HaveOccurred())
+				resp, err := (&http.Client{Transport: transport}).Post(restConfig.Host, "application/json", bytes.NewReader(body))
+				Expect(err).NotTo(HaveOccurred())
+				defer resp.Body.Close()
+				Expect(resp.StatusCode).To(Equal(http.StatusOK))
+
+				var eventList auditv1.EventList
+				Eventually(events).Should(Receive(&eventList))
+				Expect(eventList.Items).To(HaveLen(1))
+				Expect(eventList.Items[0].AuditID).To(BeEquivalentTo("foo"))
+			})
+
+			It("should reject a kubeconfig whose current context is not defined", func() {
+				config := bytes.Replace(kubeconfig(inlineCluster(), inlineUser), []byte("current-context: audit"), []byte("current-context: other"), 1)
+
+				Expect(ValidateWebhookKubeconfig(config)).NotTo(Succeed())
+			})
+
+			It("should reject a kubeconfig which refers to files", func() {
+				config := kubeconfig(fmt.Sprintf("    server: %s\n    certificate-authority: /etc/ssl/ca.crt", receiver.URL), inlineUser)
+
+				Expect(ValidateWebhookKubeconfig(config)).NotTo(Succeed())
+			})
+
+			It("should reject a kubeconfig which uses a credential plugin", func() {
+				config := kubeconfig(inlineCluster(), "    exec:\n      apiVersion: client.authentication.k8s.io/v1beta1\n      command: get-token")
+
+				Expect(ValidateWebhookKubeconfig(config)).NotTo(Succeed())
+			})
+
+			It("should reject an invalid kubeconfig", func() {
+				Expect(ValidateWebhookKubeconfig([]byte("{"))).NotTo(Succeed())
+			})
+		})
+
+		Describe("#ValidateAdmissionWebhookKubeconfig", func() {
+			It("should accept a kubeconfig whose users contain the credentials inline", func() {
+				Expect(ValidateAdmissionWebhookKubeconfig([]byte(`apiVersion: v1
+kind: Config
+users:
+- name: "*.webhooks.svc"
+  user:
+    token: admission-token
+- name: validator.example.com:8443
+  user:
+    client-certificate-data: Zm9v
+    client-key-data: YmFy
+`))).To(Succeed())
+			})
+
+			It("should reject a kubeconfig without users", func() {
+				Expect(ValidateAdmissionWebhookKubeconfig([]byte(`apiVersion: v1
+kind: Config
+users: []
+`))).NotTo(Succeed())
+			})
+
+			It("should reject a kubeconfig whose users refer to files", func() {
+				Expect(ValidateAdmissionWebhookKubeconfig([]byte(`apiVersion: v1
+kind: Config
+users:
+- name: "*.webhooks.svc"
+  user:
+    tokenFile: /var/run/secrets/token
+`))).NotTo(Succeed())
+			})
+		})
 	})
 })
@@ -0,0 +1,203 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package botanist
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	v1alpha1constants "github.com/gardener/gardener/pkg/apis/core/v1alpha1/constants"
+	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/operation/common"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// etcdMainPodName is the name of the pod of the etcd-main statefulset. For highly available control planes, it is the
+	// member which takes the backups (see the `etcd.gardener.cloud/backup-member` annotation).
+	etcdMainPodName = v1alpha1constants.StatefulSetNameETCDMain + "-0"
+	// etcdMainVolumeClaimName is the name of the persistent volume claim which contains the data of etcd-main.
+	etcdMainVolumeClaimName = "main-etcd-" + etcdMainPodName
+	// etcdContainerName is the name of the etcd container in the etcd pods.
+	etcdContainerName = "etcd"
+	// etcdBackupRestoreURL is the URL at which the backup-restore sidecar of etcd serves its API within the pod.
+	etcdBackupRestoreURL = "http://localhost:8080"
+)
+
+// TakeETCDMainSnapshot triggers an immediate full snapshot of etcd-main via its backup-restore sidecar and returns the
+// name of the snapshot.
+func (b *Botanist) TakeETCDMainSnapshot(ctx context.Context) (string, error) {
+	executor := kubernetes.NewPodExecutor(b.K8sSeedClient.RESTConfig())
+
+	b.Logger.Info("Taking full snapshot of etcd-main")
+	out, err := executor.Execute(ctx, b.Shoot.SeedNamespace, etcdMainPodName, etcdContainerName, fmt.Sprintf("wget -q -O - %s/snapshot/full", etcdBackupRestoreURL))
+	if err != nil {
+		return "", fmt.Errorf("could not take full snapshot of etcd-main: %v", err)
+	}
+
+	snapshot, err := ParseETCDSnapshotName(out)
+	if err != nil {
+		return "", err
+	}
+
+	b.Logger.Infof("Successfully took full snapshot %q of etcd-main", snapshot)
+	return snapshot, nil
+}
+
+// VerifyETCDMainSnapshot verifies that the given full snapshot of etcd-main has been uploaded to the backup bucket,
+// i.e., that it is the latest full snapshot known to the backup-restore sidecar.
+func (b *Botanist) VerifyETCDMainSnapshot(ctx context.Context, snapshot string) error {
+	executor := kubernetes.NewPodExecutor(b.K8sSeedClient.RESTConfig())
+
+	out, err := executor.Execute(ctx, b.Shoot.SeedNamespace, etcdMainPodName, etcdContainerName, fmt.Sprintf("wget -q -O - %s/snapshot/latest", etcdBackupRestoreURL))
+	if err != nil {
+		return fmt.Errorf("could not get latest snapshots of etcd-main: %v", err)
+	}
+
+	latest, err := ParseETCDLatestFullSnapshotName(out)
+	if err != nil {
+		return err
+	}
+	if latest != snapshot {
+		return fmt.Errorf("the latest full snapshot of etcd-main in the backup is %q instead of %q", latest, snapshot)
+	}
+
+	b.Logger.Infof("Successfully verified full snapshot %q of etcd-main", snapshot)
+	return nil
+}
+
+// ParseETCDLatestFullSnapshotName parses the response of the backup-restore sidecar of etcd to a request for the latest
+// snapshots and returns the name of the latest full snapshot.
+func ParseETCDLatestFullSnapshotName(r io.Reader) (string, error) {
+	latest := struct {
+		FullSnapshot *struct {
+			SnapName string `json:"snapName"`
+		} `json:"fullSnapshot"`
+	}{}
+
+	if err := json.NewDecoder(r).Decode(&latest); err != nil {
+		return "", fmt.Errorf("could not decode latest snapshots response of etcd backup-restore sidecar: %v", err)
+	}
+	if latest.FullSnapshot == nil || len(latest.FullSnapshot.SnapName) == 0 {
+		return "", fmt.Errorf("latest snapshots response of etcd backup-restore sidecar does not contain a full snapshot")
+	}
+	return latest.FullSnapshot.SnapName, nil
+}
+
+// ParseETCDSnapshotName parses the response of the backup-restore sidecar of etcd to a snapshot request and returns the
+// name of the snapshot.
+func ParseETCDSnapshotName(r io.Reader) (string, error) {
+	snapshot := struct {
+		SnapName string `json:"snapName"`
+	}{}
+
+	if err := json.NewDecoder(r).Decode(&snapshot); err != nil {
+		return "", fmt.Errorf("could not decode snapshot response of etcd backup-restore sidecar: %v", err)
+	}
+	if len(snapshot.SnapName) == 0 {
+		return "", fmt.Errorf("snapshot response of etcd backup-restore sidecar does not contain a snapshot name")
+	}
+	return snapshot.SnapName, nil
+}
+
+// ScaleDownControlPlane scales the deployments of the control plane which access the etcd to zero so that nothing
+// writes to it anymore.
+func (b *Botanist) ScaleDownControlPlane(ctx context.Context) error {
+	for _, deployment := range []string{
+		v1alpha1constants.DeploymentNameGardenerResourceManager,
+		v1alpha1constants.DeploymentNameKubeControllerManager,
+		v1alpha1constants.DeploymentNameKubeAPIServer,
+	} {
+		if err := kubernetes.ScaleDeployment(ctx, b.K8sSeedClient.Client(), kutil.Key(b.Shoot.SeedNamespace, deployment), 0); client.IgnoreNotFound(err) != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// DetachETCDMainVolume scales etcd-main to zero and detaches the persistent volume which contains its data. The
+// volume is not deleted but retained and annotated with the time of the restoration, so that the data of the replaced
+// etcd stays available until an operator deletes the volume. When etcd-main is scaled up again, its statefulset
+// creates a fresh volume and the backup-restore sidecar restores the data from the backup into it.
+func (b *Botanist) DetachETCDMainVolume(ctx context.Context) error {
+	c := b.K8sSeedClient.Client()
+
+	if err := kubernetes.ScaleStatefulSet(ctx, c, kutil.Key(b.Shoot.SeedNamespace, v1alpha1constants.StatefulSetNameETCDMain), 0); err != nil {
+		return err
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, 5*time.Minute)
+	defer cancel()
+
+	pod := &corev1.Pod{}
+	pod.SetName(etcdMainPodName)
+	pod.SetNamespace(b.Shoot.SeedNamespace)
+	if err := kutil.WaitUntilResourceDeleted(timeoutCtx, c, pod, 5*time.Second); err != nil {
+		return err
+	}
+
+	pvc := &corev1.PersistentVolumeClaim{}
+	if err := c.Get(ctx, kutil.Key(b.Shoot.SeedNamespace, etcdMainVolumeClaimName), pvc); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+
+	if len(pvc.Spec.VolumeName) > 0 {
+		pv := &corev1.PersistentVolume{}
+		if err := c.Get(ctx, kutil.Key(pvc.Spec.VolumeName), pv); err != nil {
+			return err
+		}
+
+		b.Logger.Infof("Retaining the data volume %q of etcd-main", pv.Name)
+		pv.Spec.PersistentVolumeReclaimPolicy = corev1.PersistentVolumeReclaimRetain
+		kutil.SetMetaDataAnnotation(pv, common.ETCDMainRestoredAt, time.Now().UTC().Format(time.RFC3339))
+		if err := c.Update(ctx, pv); err != nil {
+			return err
+		}
+	}
+
+	b.Logger.Info("Detaching the data volume of etcd-main")
+	if err := c.Delete(ctx, pvc, kubernetes.DefaultDeleteOptions...); client.IgnoreNotFound(err) != nil {
+		return err
+	}
+
+	return kutil.WaitUntilResourceDeleted(timeoutCtx, c, pvc, 5*time.Second)
+}
+
+// ScaleUpETCDMain scales etcd-main to one replica.
+func (b *Botanist) ScaleUpETCDMain(ctx context.Context) error {
+	return kubernetes.ScaleStatefulSet(ctx, b.K8sSeedClient.Client(), kutil.Key(b.Shoot.SeedNamespace, v1alpha1constants.StatefulSetNameETCDMain), 1)
+}
+
+// ETCDBackupOperationAllowed returns an error if on-demand operations on the etcd backup of the Shoot are not possible.
+func (b *Botanist) ETCDBackupOperationAllowed(restore bool) error {
+	if b.Seed.Info.Spec.Backup == nil {
+		return fmt.Errorf("the seed %q of the shoot does not have a backup configuration", b.Seed.Info.Name)
+	}
+	if b.Shoot.HibernationEnabled || b.Shoot.Info.Status.IsHibernated {
+		return fmt.Errorf("the etcd of a hibernated shoot cannot be operated on")
+	}
+	if restore && gardencorev1alpha1helper.IsShootHighlyAvailable(b.Shoot.Info) {
+		return fmt.Errorf("the etcd of a shoot with a highly available control plane cannot be restored")
+	}
+	return nil
+}
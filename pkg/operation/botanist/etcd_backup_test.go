@@ -0,0 +1,62 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package botanist_test
+
+import (
+	"strings"
+
+	. "github.com/gardener/gardener/pkg/operation/botanist"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("etcd backup", func() {
+	Describe("#ParseETCDSnapshotName", func() {
+		It("should return the name of the snapshot", func() {
+			snapshot, err := ParseETCDSnapshotName(strings.NewReader(`{"kind":"Full","startRevision":0,"lastRevision":42,"snapName":"Full-00000000-00000042-1571990400"}`))
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(snapshot).To(Equal("Full-00000000-00000042-1571990400"))
+		})
+
+		It("should fail if the response does not contain a snapshot name", func() {
+			_, err := ParseETCDSnapshotName(strings.NewReader(`{"kind":"Full"}`))
+
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should fail if the response cannot be decoded", func() {
+			_, err := ParseETCDSnapshotName(strings.NewReader(`Internal Server Error`))
+
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("#ParseETCDLatestFullSnapshotName", func() {
+		It("should return the name of the latest full snapshot", func() {
+			snapshot, err := ParseETCDLatestFullSnapshotName(strings.NewReader(`{"fullSnapshot":{"kind":"Full","snapName":"Full-00000000-00000042-1571990400"},"deltaSnapshots":[]}`))
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(snapshot).To(Equal("Full-00000000-00000042-1571990400"))
+		})
+
+		It("should fail if the response does not contain a full snapshot", func() {
+			_, err := ParseETCDLatestFullSnapshotName(strings.NewReader(`{"deltaSnapshots":[]}`))
+
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
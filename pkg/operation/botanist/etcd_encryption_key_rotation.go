@@ -0,0 +1,171 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package botanist
+
+import (
+	"io"
+	"time"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
+	"github.com/gardener/gardener/pkg/operation/common"
+	encryptionconfiguration "github.com/gardener/gardener/pkg/operation/etcdencryption"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apiserverconfigv1 "k8s.io/apiserver/pkg/apis/config/v1"
+	"k8s.io/client-go/util/retry"
+)
+
+// NextETCDEncryptionKeyRotationPhase computes the phase of the etcd encryption key rotation for the current
+// reconciliation based on the current phase and the operation annotation of the Shoot. A rotation is started if it is
+// requested by the operation annotation. Once the rotation is prepared, i.e., all kube-apiservers know the new key, it
+// is completed with the next reconciliation.
+func NextETCDEncryptionKeyRotationPhase(phase gardencorev1alpha1.CredentialsRotationPhase, operation string) gardencorev1alpha1.CredentialsRotationPhase {
+	switch phase {
+	case gardencorev1alpha1.RotationPreparing, gardencorev1alpha1.RotationCompleting:
+		return phase
+	case gardencorev1alpha1.RotationPrepared:
+		return gardencorev1alpha1.RotationCompleting
+	default:
+		if operation == common.ShootOperationRotateETCDEncryptionKey {
+			return gardencorev1alpha1.RotationPreparing
+		}
+		return phase
+	}
+}
+
+// RotateETCDEncryptionKey updates the given encryption configuration according to the given phase of the etcd
+// encryption key rotation and returns whether the rotation has been completed:
+// 1. While the rotation is being prepared, a new key is added as non-primary key so that all kube-apiservers can
+//    decrypt resources with it after they have been rolled.
+// 2. While the rotation is being completed, the new key is promoted to the primary key so that the resources are
+//    encrypted with it when they are rewritten.
+// 3. Once all resources have been rewritten, i.e., the checksum annotation of the given secret matches the encryption
+//    configuration, the old key is retired. The checksum annotation is updated accordingly as the resources do not
+//    need to be rewritten again.
+func RotateETCDEncryptionKey(secret *corev1.Secret, conf *apiserverconfigv1.EncryptionConfiguration, phase gardencorev1alpha1.CredentialsRotationPhase, now time.Time, r io.Reader) (bool, error) {
+	keys, err := encryptionconfiguration.GetEncryptionKeys(conf, common.EtcdEncryptionEncryptedResourceSecrets)
+	if err != nil {
+		return false, err
+	}
+
+	switch phase {
+	case gardencorev1alpha1.RotationPreparing:
+		if len(keys) == 1 {
+			key, err := encryptionconfiguration.NewEncryptionKey(now, r)
+			if err != nil {
+				return false, err
+			}
+			encryptionconfiguration.AddEncryptionKey(conf, *key)
+		}
+
+	case gardencorev1alpha1.RotationCompleting:
+		if len(keys) < 2 {
+			return true, nil
+		}
+
+		if newestKeyName := newestEncryptionKeyName(keys); keys[0].Name != newestKeyName {
+			return false, encryptionconfiguration.PromoteEncryptionKey(conf, newestKeyName)
+		}
+
+		checksum, err := confChecksum(conf)
+		if err != nil {
+			return false, err
+		}
+		if secret.Annotations[common.EtcdEncryptionChecksumAnnotationName] != checksum {
+			return false, nil
+		}
+
+		encryptionconfiguration.RetireEncryptionKeys(conf)
+		if checksum, err = confChecksum(conf); err != nil {
+			return false, err
+		}
+		kutil.SetMetaDataAnnotation(secret, common.EtcdEncryptionChecksumAnnotationName, checksum)
+		return true, nil
+	}
+
+	return false, nil
+}
+
+func newestEncryptionKeyName(keys []apiserverconfigv1.Key) string {
+	var (
+		newestKeyName string
+		newestTime    time.Time
+	)
+
+	for _, key := range keys {
+		t, err := encryptionconfiguration.ParseEncryptionKeyName(key.Name)
+		if err != nil {
+			continue
+		}
+		if newestKeyName == "" || t.After(newestTime) {
+			newestKeyName, newestTime = key.Name, t
+		}
+	}
+	return newestKeyName
+}
+
+// reconcileETCDEncryptionKeyRotationPhase computes the phase of the etcd encryption key rotation for the current
+// reconciliation, removes the rotation operation annotation from the Shoot, and persists a phase change in the Shoot
+// status.
+func (b *Botanist) reconcileETCDEncryptionKeyRotationPhase() (gardencorev1alpha1.CredentialsRotationPhase, error) {
+	var (
+		operation    = b.Shoot.Info.Annotations[common.ShootOperation]
+		currentPhase = gardencorev1alpha1helper.GetShootETCDEncryptionKeyRotationPhase(b.Shoot.Info.Status.Credentials)
+		phase        = NextETCDEncryptionKeyRotationPhase(currentPhase, operation)
+	)
+
+	if operation == common.ShootOperationRotateETCDEncryptionKey {
+		if phase == currentPhase {
+			b.Logger.Infof("Ignoring operation %q as the etcd encryption key rotation is in phase %q", operation, currentPhase)
+		}
+
+		if _, err := kutil.TryUpdateShootAnnotations(b.K8sGardenClient.GardenCore(), retry.DefaultRetry, b.Shoot.Info.ObjectMeta, func(shoot *gardencorev1alpha1.Shoot) (*gardencorev1alpha1.Shoot, error) {
+			delete(shoot.Annotations, common.ShootOperation)
+			return shoot, nil
+		}); err != nil {
+			return "", err
+		}
+	}
+
+	if phase == currentPhase {
+		return phase, nil
+	}
+
+	if err := b.updateETCDEncryptionKeyRotationPhase(phase); err != nil {
+		return "", err
+	}
+	return phase, nil
+}
+
+func (b *Botanist) updateETCDEncryptionKeyRotationPhase(phase gardencorev1alpha1.CredentialsRotationPhase) error {
+	b.Logger.Infof("Etcd encryption key rotation enters phase %q", phase)
+	_, err := kutil.TryUpdateShootStatus(b.K8sGardenClient.GardenCore(), retry.DefaultRetry, b.Shoot.Info.ObjectMeta, func(shoot *gardencorev1alpha1.Shoot) (*gardencorev1alpha1.Shoot, error) {
+		gardencorev1alpha1helper.MutateShootETCDEncryptionKeyRotation(shoot, func(rotation *gardencorev1alpha1.ETCDEncryptionKeyRotation) {
+			now := metav1.Now()
+			rotation.Phase = phase
+			switch phase {
+			case gardencorev1alpha1.RotationPreparing:
+				rotation.LastInitiationTime = &now
+			case gardencorev1alpha1.RotationCompleted:
+				rotation.LastCompletionTime = &now
+			}
+		})
+		return shoot, nil
+	})
+	return err
+}
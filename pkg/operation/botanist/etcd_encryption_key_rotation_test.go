@@ -0,0 +1,127 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package botanist_test
+
+import (
+	"bytes"
+	"time"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	. "github.com/gardener/gardener/pkg/operation/botanist"
+	"github.com/gardener/gardener/pkg/operation/common"
+	encryptionconfiguration "github.com/gardener/gardener/pkg/operation/etcdencryption"
+	"github.com/gardener/gardener/pkg/utils"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apiserverconfigv1 "k8s.io/apiserver/pkg/apis/config/v1"
+)
+
+var _ = Describe("etcd encryption key rotation", func() {
+	Describe("#NextETCDEncryptionKeyRotationPhase", func() {
+		It("should start a rotation if it is requested", func() {
+			Expect(NextETCDEncryptionKeyRotationPhase("", "")).To(BeEmpty())
+			Expect(NextETCDEncryptionKeyRotationPhase("", common.ShootOperationRotateETCDEncryptionKey)).To(Equal(gardencorev1alpha1.RotationPreparing))
+			Expect(NextETCDEncryptionKeyRotationPhase(gardencorev1alpha1.RotationCompleted, common.ShootOperationRotateETCDEncryptionKey)).To(Equal(gardencorev1alpha1.RotationPreparing))
+		})
+
+		It("should complete a prepared rotation", func() {
+			Expect(NextETCDEncryptionKeyRotationPhase(gardencorev1alpha1.RotationPrepared, "")).To(Equal(gardencorev1alpha1.RotationCompleting))
+		})
+
+		It("should not change the phase of a rotation in progress", func() {
+			Expect(NextETCDEncryptionKeyRotationPhase(gardencorev1alpha1.RotationPreparing, common.ShootOperationRotateETCDEncryptionKey)).To(Equal(gardencorev1alpha1.RotationPreparing))
+			Expect(NextETCDEncryptionKeyRotationPhase(gardencorev1alpha1.RotationCompleting, common.ShootOperationRotateETCDEncryptionKey)).To(Equal(gardencorev1alpha1.RotationCompleting))
+		})
+	})
+
+	Describe("#RotateETCDEncryptionKey", func() {
+		var (
+			now    = time.Unix(1000, 0)
+			secret *corev1.Secret
+			conf   *apiserverconfigv1.EncryptionConfiguration
+		)
+
+		BeforeEach(func() {
+			var err error
+			conf, err = encryptionconfiguration.NewPassiveConfiguration(now.Add(-time.Hour), bytes.NewReader(bytes.Repeat([]byte{1}, common.EtcdEncryptionKeySecretLen)))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(encryptionconfiguration.SetResourceEncryption(conf, common.EtcdEncryptionEncryptedResourceSecrets, true)).To(Succeed())
+
+			secret = &corev1.Secret{}
+		})
+
+		keyNames := func() []string {
+			keys, err := encryptionconfiguration.GetEncryptionKeys(conf, common.EtcdEncryptionEncryptedResourceSecrets)
+			Expect(err).NotTo(HaveOccurred())
+
+			var names []string
+			for _, key := range keys {
+				names = append(names, key.Name)
+			}
+			return names
+		}
+
+		setRewrittenChecksum := func() {
+			data, err := encryptionconfiguration.Write(conf)
+			Expect(err).NotTo(HaveOccurred())
+			secret.Annotations = map[string]string{common.EtcdEncryptionChecksumAnnotationName: utils.ComputeSHA256Hex(data)}
+		}
+
+		It("should add, promote and retire the keys in the respective phases", func() {
+			var (
+				oldKeyName = encryptionconfiguration.NewEncryptionKeyName(now.Add(-time.Hour))
+				newKeyName = encryptionconfiguration.NewEncryptionKeyName(now)
+				r          = bytes.NewReader(bytes.Repeat([]byte{2}, common.EtcdEncryptionKeySecretLen))
+			)
+
+			completed, err := RotateETCDEncryptionKey(secret, conf, gardencorev1alpha1.RotationPreparing, now, r)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(completed).To(BeFalse())
+			Expect(keyNames()).To(Equal([]string{oldKeyName, newKeyName}))
+
+			By("promoting the new key")
+			completed, err = RotateETCDEncryptionKey(secret, conf, gardencorev1alpha1.RotationCompleting, now, r)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(completed).To(BeFalse())
+			Expect(keyNames()).To(Equal([]string{newKeyName, oldKeyName}))
+
+			By("waiting until the secrets have been rewritten")
+			completed, err = RotateETCDEncryptionKey(secret, conf, gardencorev1alpha1.RotationCompleting, now, r)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(completed).To(BeFalse())
+			Expect(keyNames()).To(Equal([]string{newKeyName, oldKeyName}))
+
+			By("retiring the old key")
+			setRewrittenChecksum()
+			completed, err = RotateETCDEncryptionKey(secret, conf, gardencorev1alpha1.RotationCompleting, now, r)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(completed).To(BeTrue())
+			Expect(keyNames()).To(Equal([]string{newKeyName}))
+
+			checksum := secret.Annotations[common.EtcdEncryptionChecksumAnnotationName]
+			setRewrittenChecksum()
+			Expect(secret.Annotations[common.EtcdEncryptionChecksumAnnotationName]).To(Equal(checksum))
+		})
+
+		It("should not change the keys if no rotation is in progress", func() {
+			completed, err := RotateETCDEncryptionKey(secret, conf, gardencorev1alpha1.RotationCompleted, now, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(completed).To(BeFalse())
+			Expect(keyNames()).To(HaveLen(1))
+		})
+	})
+})
@@ -21,14 +21,19 @@ import (
 	"time"
 
 	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	v1alpha1constants "github.com/gardener/gardener/pkg/apis/core/v1alpha1/constants"
+	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
 	"github.com/gardener/gardener/pkg/operation/common"
 	encryptionconfiguration "github.com/gardener/gardener/pkg/operation/etcdencryption"
 	"github.com/gardener/gardener/pkg/utils"
 	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/selection"
 	apiserverconfigv1 "k8s.io/apiserver/pkg/apis/config/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -51,11 +56,17 @@ func (b *Botanist) ApplyEncryptionConfiguration(ctx context.Context) error {
 
 func (b *Botanist) createOrUpdateEncryptionConfiguration(ctx context.Context) (*apiserverconfigv1.EncryptionConfiguration, error) {
 	var (
-		secret = &corev1.Secret{ObjectMeta: kutil.ObjectMeta(b.Shoot.SeedNamespace, common.EtcdEncryptionSecretName)}
-		conf   *apiserverconfigv1.EncryptionConfiguration
+		secret            = &corev1.Secret{ObjectMeta: kutil.ObjectMeta(b.Shoot.SeedNamespace, common.EtcdEncryptionSecretName)}
+		conf              *apiserverconfigv1.EncryptionConfiguration
+		rotationCompleted bool
 	)
 
-	_, err := controllerutil.CreateOrUpdate(ctx, b.K8sSeedClient.Client(), secret, func() error {
+	rotationPhase, err := b.reconcileETCDEncryptionKeyRotationPhase()
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = controllerutil.CreateOrUpdate(ctx, b.K8sSeedClient.Client(), secret, func() error {
 		var err error
 		conf, err = encryptionconfiguration.ReadSecret(secret)
 		if err != nil {
@@ -70,6 +81,13 @@ func (b *Botanist) createOrUpdateEncryptionConfiguration(ctx context.Context) (*
 			}
 		}
 
+		if kms := gardencorev1alpha1helper.GetShootKMSProvider(b.Shoot.Info); kms != nil {
+			b.Logger.Infof("Setting KMS provider of type %q for etcd encryption", kms.Type)
+			if err := encryptionconfiguration.SetKMSProvider(conf, encryptionconfiguration.NewKMSConfiguration(kms.Type, v1alpha1constants.KMSPluginEndpoint, kms.CacheSize, kms.Timeout)); err != nil {
+				return err
+			}
+		}
+
 		// When firstly created, the encryption configuration secret does not have a checksum annotation yet. This annotation will
 		// only be added after all shoot secrets have been rewritten. In order to allow a smooth transition from un-encrypted to encrypted
 		// etcd data we first make the configuration inactive, i.e., put the `identity` provider as first list in the entry. In the next
@@ -89,6 +107,14 @@ func (b *Botanist) createOrUpdateEncryptionConfiguration(ctx context.Context) (*
 			return err
 		}
 
+		if rotationCompleted, err = RotateETCDEncryptionKey(secret, conf, rotationPhase, time.Now(), rand.Reader); err != nil {
+			return err
+		}
+
+		if err := b.setEncryptedResources(secret, conf); err != nil {
+			return err
+		}
+
 		checksum, err := confChecksum(conf)
 		if err != nil {
 			return err
@@ -106,9 +132,44 @@ func (b *Botanist) createOrUpdateEncryptionConfiguration(ctx context.Context) (*
 		return nil, err
 	}
 
+	if rotationCompleted {
+		if err := b.updateETCDEncryptionKeyRotationPhase(gardencorev1alpha1.RotationCompleted); err != nil {
+			return nil, err
+		}
+	}
+
 	return conf, err
 }
 
+// setEncryptedResources adds the resources which shall be encrypted in addition to secrets according to the Shoot
+// specification to the encryption configuration. Resources which shall not be encrypted anymore remain decryptable
+// until they have been rewritten in plaintext. They are removed from the configuration afterwards, and the checksum
+// annotation is updated accordingly as the resources do not need to be rewritten again.
+func (b *Botanist) setEncryptedResources(secret *corev1.Secret, conf *apiserverconfigv1.EncryptionConfiguration) error {
+	var resources []string
+	if kubeAPIServer := b.Shoot.Info.Spec.Kubernetes.KubeAPIServer; kubeAPIServer != nil && kubeAPIServer.EncryptionConfig != nil {
+		resources = kubeAPIServer.EncryptionConfig.Resources
+	}
+
+	checksum, err := confChecksum(conf)
+	if err != nil {
+		return err
+	}
+	rewritten := secret.Annotations[common.EtcdEncryptionChecksumAnnotationName] == checksum
+
+	removed, err := encryptionconfiguration.SetEncryptedResources(conf, resources, rewritten)
+	if err != nil || !removed {
+		return err
+	}
+
+	b.Logger.Info("Removing decrypted resources from etcd encryption configuration")
+	if checksum, err = confChecksum(conf); err != nil {
+		return err
+	}
+	kutil.SetMetaDataAnnotation(secret, common.EtcdEncryptionChecksumAnnotationName, checksum)
+	return nil
+}
+
 func (b *Botanist) syncEncryptionConfigurationToGarden(ctx context.Context, conf *apiserverconfigv1.EncryptionConfiguration) error {
 	secret := &corev1.Secret{ObjectMeta: kutil.ObjectMetaFromKey(common.GardenEtcdEncryptionSecretKey(b.Shoot.Info.Namespace, b.Shoot.Info.Name))}
 	_, err := controllerutil.CreateOrUpdate(ctx, b.K8sGardenClient.Client(), secret, func() error {
@@ -129,9 +190,9 @@ func confChecksum(conf *apiserverconfigv1.EncryptionConfiguration) (string, erro
 	return utils.ComputeSHA256Hex(data), nil
 }
 
-// RewriteShootSecretsIfEncryptionConfigurationChanged rewrites the secrets in the Shoot if the etcd
-// encryption configuration changed. Rewriting here means that a patch request is sent that forces
-// the etcd to encrypt them with the new configuration.
+// RewriteShootSecretsIfEncryptionConfigurationChanged rewrites the secrets and all other resources contained in the
+// etcd encryption configuration in the Shoot if the configuration changed. Rewriting here means that a patch request
+// is sent that forces the etcd to encrypt (or decrypt) them with the new configuration.
 func (b *Botanist) RewriteShootSecretsIfEncryptionConfigurationChanged(ctx context.Context) error {
 	checksum := func() string {
 		b.mutex.RLock()
@@ -150,6 +211,11 @@ func (b *Botanist) RewriteShootSecretsIfEncryptionConfigurationChanged(ctx conte
 		return nil
 	}
 
+	conf, err := encryptionconfiguration.ReadSecret(secret)
+	if err != nil {
+		return err
+	}
+
 	shortChecksum := kutil.TruncateLabelValue(checksum)
 
 	// Add checksum label to all secrets in shoot so that they get rewritten now, and also so that we don't rewrite them again in
@@ -158,7 +224,7 @@ func (b *Botanist) RewriteShootSecretsIfEncryptionConfigurationChanged(ctx conte
 	if err != nil {
 		return err
 	}
-	if errorList := b.updateShootLabelsForEtcdEncryption(ctx, notCurrentChecksum, func(m metav1.Object) {
+	if errorList := b.updateShootLabelsForEtcdEncryption(ctx, conf, notCurrentChecksum, func(m metav1.Object) {
 		kutil.SetMetaDataLabel(m, common.EtcdEncryptionChecksumLabelName, shortChecksum)
 	}); len(errorList) > 0 {
 		return fmt.Errorf("could not add checksum label for all shoot secrets: %+v", errorList)
@@ -170,7 +236,7 @@ func (b *Botanist) RewriteShootSecretsIfEncryptionConfigurationChanged(ctx conte
 	if err != nil {
 		return err
 	}
-	if errorList := b.updateShootLabelsForEtcdEncryption(ctx, hasChecksumLabelKey, func(m metav1.Object) {
+	if errorList := b.updateShootLabelsForEtcdEncryption(ctx, conf, hasChecksumLabelKey, func(m metav1.Object) {
 		delete(m.GetLabels(), common.EtcdEncryptionChecksumLabelName)
 	}); len(errorList) > 0 {
 		return fmt.Errorf("could not remove checksum label from all shoot secrets: %+v", errorList)
@@ -183,20 +249,36 @@ func (b *Botanist) RewriteShootSecretsIfEncryptionConfigurationChanged(ctx conte
 	return b.K8sSeedClient.Client().Patch(ctx, secret, client.MergeFrom(oldSecret))
 }
 
-func (b *Botanist) updateShootLabelsForEtcdEncryption(ctx context.Context, labelRequirement *labels.Requirement, mutateLabelsFunc func(m metav1.Object)) []error {
-	secretList := &corev1.SecretList{}
-	if err := b.K8sShootClient.Client().List(ctx, secretList, client.MatchingLabelsSelector{Selector: labels.NewSelector().Add(*labelRequirement)}); err != nil {
-		return []error{err}
-	}
-
+func (b *Botanist) updateShootLabelsForEtcdEncryption(ctx context.Context, conf *apiserverconfigv1.EncryptionConfiguration, labelRequirement *labels.Requirement, mutateLabelsFunc func(m metav1.Object)) []error {
 	var errorList []error
-	for _, s := range secretList.Items {
-		secretCopy := s.DeepCopy()
-		mutateLabelsFunc(&s)
-		patch := client.MergeFrom(secretCopy)
 
-		if err := b.K8sShootClient.Client().Patch(ctx, &s, patch); err != nil {
+	for _, resource := range encryptionconfiguration.GetResources(conf) {
+		gvk, err := b.K8sShootClient.RESTMapper().KindFor(schema.ParseGroupResource(resource).WithVersion(""))
+		if err != nil {
+			if meta.IsNoMatchError(err) {
+				// The resource is not served (yet), e.g., because the respective custom resource definition does not exist.
+				b.Logger.Infof("Skipping rewrite of resource %q as it is not served by the API server", resource)
+				continue
+			}
 			errorList = append(errorList, err)
+			continue
+		}
+
+		list := &unstructured.UnstructuredList{}
+		list.SetGroupVersionKind(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+		if err := b.K8sShootClient.Client().List(ctx, list, client.MatchingLabelsSelector{Selector: labels.NewSelector().Add(*labelRequirement)}); err != nil {
+			errorList = append(errorList, err)
+			continue
+		}
+
+		for _, obj := range list.Items {
+			objCopy := obj.DeepCopy()
+			mutateLabelsFunc(&obj)
+			patch := client.MergeFrom(objCopy)
+
+			if err := b.K8sShootClient.Client().Patch(ctx, &obj, patch); err != nil {
+				errorList = append(errorList, err)
+			}
 		}
 	}
 
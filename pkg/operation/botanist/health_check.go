@@ -127,6 +127,24 @@ func (b *HealthChecker) checkStatefulSets(condition gardencorev1alpha1.Condition
 	return nil
 }
 
+func (b *HealthChecker) checkRequiredReplicas(condition gardencorev1alpha1.Condition, requiredReplicas map[string]int32, deployments []*appsv1.Deployment, statefulSets []*appsv1.StatefulSet) *gardencorev1alpha1.Condition {
+	for _, deployment := range deployments {
+		if required, ok := requiredReplicas[deployment.Name]; ok && deployment.Status.AvailableReplicas < required {
+			c := b.FailedCondition(condition, "ControlPlaneNotHighlyAvailable", fmt.Sprintf("Deployment %s has %d available replicas but requires %d to be highly available", deployment.Name, deployment.Status.AvailableReplicas, required))
+			return &c
+		}
+	}
+
+	for _, statefulSet := range statefulSets {
+		if required, ok := requiredReplicas[statefulSet.Name]; ok && statefulSet.Status.ReadyReplicas < required {
+			c := b.FailedCondition(condition, "ControlPlaneNotHighlyAvailable", fmt.Sprintf("Stateful set %s has %d ready replicas but requires %d to be highly available", statefulSet.Name, statefulSet.Status.ReadyReplicas, required))
+			return &c
+		}
+	}
+
+	return nil
+}
+
 func (b *HealthChecker) checkNodes(condition gardencorev1alpha1.Condition, objects []*corev1.Node) *gardencorev1alpha1.Condition {
 	for _, object := range objects {
 		if err := health.CheckNode(object); err != nil {
@@ -216,6 +234,23 @@ func computeRequiredControlPlaneDeployments(
 	return requiredControlPlaneDeployments, nil
 }
 
+// computeRequiredControlPlaneReplicas determines the number of replicas of the control plane deployments and stateful
+// sets which must be ready for the control plane of the given Shoot to be highly available. It returns nil if the
+// control plane is not highly available.
+func computeRequiredControlPlaneReplicas(shoot *gardencorev1alpha1.Shoot) map[string]int32 {
+	if !gardencorev1alpha1helper.IsShootHighlyAvailable(shoot) {
+		return nil
+	}
+
+	return map[string]int32{
+		v1alpha1constants.DeploymentNameKubeAPIServer:         highAvailabilityKubeAPIServerMinReplicas,
+		v1alpha1constants.DeploymentNameKubeControllerManager: highAvailabilityControllerReplicas,
+		v1alpha1constants.DeploymentNameKubeScheduler:         highAvailabilityControllerReplicas,
+		v1alpha1constants.StatefulSetNameETCDMain:             highAvailabilityETCDReplicas,
+		v1alpha1constants.StatefulSetNameETCDEvents:           highAvailabilityETCDReplicas,
+	}
+}
+
 // computeRequiredMonitoringStatefulSets determine the required monitoring statefulsets
 // which should exist next to the control plane.
 func computeRequiredMonitoringStatefulSets(wantsAlertmanager bool) sets.String {
@@ -264,6 +299,9 @@ func (b *HealthChecker) CheckControlPlane(
 	if exitCondition := b.checkStatefulSets(condition, statefulSets); exitCondition != nil {
 		return exitCondition, nil
 	}
+	if exitCondition := b.checkRequiredReplicas(condition, computeRequiredControlPlaneReplicas(shoot), deployments, statefulSets); exitCondition != nil {
+		return exitCondition, nil
+	}
 	return nil, nil
 }
 
@@ -582,6 +620,22 @@ func (b *HealthChecker) CheckExtensionCondition(condition gardencorev1alpha1.Con
 	return nil
 }
 
+// CheckConditionsStable checks whether any of the given condition histories contains at least <threshold> status
+// transitions within the given <window>, i.e., whether the respective condition is flapping.
+func (b *HealthChecker) CheckConditionsStable(condition gardencorev1alpha1.Condition, histories []gardencorev1alpha1.ConditionHistory, window time.Duration, threshold int) gardencorev1alpha1.Condition {
+	var flappingConditions []string
+	for _, history := range histories {
+		if transitions := gardencorev1alpha1helper.CountConditionTransitions(history, window); transitions >= threshold {
+			flappingConditions = append(flappingConditions, fmt.Sprintf("%s (%d transitions)", history.Type, transitions))
+		}
+	}
+
+	if len(flappingConditions) > 0 {
+		return b.FailedCondition(condition, "ConditionsFlapping", fmt.Sprintf("The following conditions changed their status too often within the last %s: %s", window, strings.Join(flappingConditions, ", ")))
+	}
+	return gardencorev1alpha1helper.UpdatedCondition(condition, gardencorev1alpha1.ConditionTrue, "ConditionsStable", fmt.Sprintf("No condition changed its status more than %d times within the last %s.", threshold-1, window))
+}
+
 // checkControlPlane checks whether the control plane of the Shoot cluster is healthy.
 func (b *Botanist) checkControlPlane(
 	checker *HealthChecker,
@@ -74,7 +74,7 @@ func (b *Botanist) DeployInfrastructure(ctx context.Context) error {
 				Type: b.Shoot.Info.Spec.Provider.Type,
 			},
 			Region:       b.Shoot.Info.Spec.Region,
-			SSHPublicKey: b.Secrets[v1alpha1constants.SecretNameSSHKeyPair].Data[secrets.DataKeySSHAuthorizedKeys],
+			SSHPublicKey: secrets.SSHAuthorizedKeys(b.Secrets[v1alpha1constants.SecretNameSSHKeyPair].Data),
 			SecretRef: corev1.SecretReference{
 				Name:      v1alpha1constants.SecretNameCloudProvider,
 				Namespace: infrastructure.Namespace,
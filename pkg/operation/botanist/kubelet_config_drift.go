@@ -0,0 +1,363 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package botanist
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	v1alpha1constants "github.com/gardener/gardener/pkg/apis/core/v1alpha1/constants"
+	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// kubeletConfigzParallelism is the maximum number of nodes whose configz endpoint is queried in parallel.
+	kubeletConfigzParallelism = 10
+	// kubeletConfigzTimeout is the timeout for querying the configz endpoint of a single node.
+	kubeletConfigzTimeout = 10 * time.Second
+	// kubeletConfigCheckInterval is the minimum duration between two queries of the configz endpoints of the nodes.
+	kubeletConfigCheckInterval = 10 * time.Minute
+	// maxReportedKubeletConfigDrifts is the maximum number of nodes which are listed in the Shoot status and in the
+	// message of the condition.
+	maxReportedKubeletConfigDrifts = 10
+)
+
+// ComputeDesiredKubeletConfigFields returns the fields of the kubelet configuration file which are explicitly
+// configured by the given <kubeletConfig> together with their desired values. The fields are named like in the
+// kubelet configuration file, entries of maps are denoted by `<field>[<key>]`.
+func ComputeDesiredKubeletConfigFields(kubeletConfig *gardencorev1alpha1.KubeletConfig) map[string]string {
+	fields := map[string]string{}
+	if kubeletConfig == nil {
+		return fields
+	}
+
+	for name, enabled := range kubeletConfig.FeatureGates {
+		fields[fmt.Sprintf("featureGates[%s]", name)] = strconv.FormatBool(enabled)
+	}
+	if v := kubeletConfig.CPUCFSQuota; v != nil {
+		fields["cpuCFSQuota"] = strconv.FormatBool(*v)
+	}
+	if v := kubeletConfig.CPUManagerPolicy; v != nil {
+		fields["cpuManagerPolicy"] = *v
+	}
+	if v := kubeletConfig.MaxPods; v != nil {
+		fields["maxPods"] = strconv.Itoa(int(*v))
+	}
+	if v := kubeletConfig.PodPIDsLimit; v != nil {
+		fields["podPidsLimit"] = strconv.FormatInt(*v, 10)
+	}
+	if v := kubeletConfig.EvictionPressureTransitionPeriod; v != nil {
+		fields["evictionPressureTransitionPeriod"] = v.Duration.String()
+	}
+	if v := kubeletConfig.EvictionMaxPodGracePeriod; v != nil {
+		fields["evictionMaxPodGracePeriod"] = strconv.Itoa(int(*v))
+	}
+
+	addEviction := func(field string, eviction *gardencorev1alpha1.KubeletConfigEviction) {
+		if eviction == nil {
+			return
+		}
+		for signal, value := range map[string]*string{
+			"memory.available":   eviction.MemoryAvailable,
+			"imagefs.available":  eviction.ImageFSAvailable,
+			"imagefs.inodesFree": eviction.ImageFSInodesFree,
+			"nodefs.available":   eviction.NodeFSAvailable,
+			"nodefs.inodesFree":  eviction.NodeFSInodesFree,
+		} {
+			if value != nil {
+				fields[fmt.Sprintf("%s[%s]", field, signal)] = *value
+			}
+		}
+	}
+	addEviction("evictionHard", kubeletConfig.EvictionHard)
+	addEviction("evictionSoft", kubeletConfig.EvictionSoft)
+
+	if eviction := kubeletConfig.EvictionSoftGracePeriod; eviction != nil {
+		for signal, value := range map[string]*metav1.Duration{
+			"memory.available":   eviction.MemoryAvailable,
+			"imagefs.available":  eviction.ImageFSAvailable,
+			"imagefs.inodesFree": eviction.ImageFSInodesFree,
+			"nodefs.available":   eviction.NodeFSAvailable,
+			"nodefs.inodesFree":  eviction.NodeFSInodesFree,
+		} {
+			if value != nil {
+				fields[fmt.Sprintf("evictionSoftGracePeriod[%s]", signal)] = value.Duration.String()
+			}
+		}
+	}
+
+	if eviction := kubeletConfig.EvictionMinimumReclaim; eviction != nil {
+		for signal, value := range map[string]*resource.Quantity{
+			"memory.available":   eviction.MemoryAvailable,
+			"imagefs.available":  eviction.ImageFSAvailable,
+			"imagefs.inodesFree": eviction.ImageFSInodesFree,
+			"nodefs.available":   eviction.NodeFSAvailable,
+			"nodefs.inodesFree":  eviction.NodeFSInodesFree,
+		} {
+			if value != nil {
+				fields[fmt.Sprintf("evictionMinimumReclaim[%s]", signal)] = value.String()
+			}
+		}
+	}
+
+	return fields
+}
+
+// ParseKubeletConfigz parses the response of the configz endpoint of a kubelet and returns the fields of its effective
+// configuration. Only scalar fields and the entries of maps are returned, they are named like in the result of
+// ComputeDesiredKubeletConfigFields.
+func ParseKubeletConfigz(data []byte) (map[string]string, error) {
+	var configz struct {
+		KubeletConfig map[string]interface{} `json:"kubeletconfig"`
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(&configz); err != nil {
+		return nil, err
+	}
+	if configz.KubeletConfig == nil {
+		return nil, fmt.Errorf("configz response does not contain the kubelet configuration")
+	}
+
+	fields := map[string]string{}
+	for name, value := range configz.KubeletConfig {
+		if entries, ok := value.(map[string]interface{}); ok {
+			for key, entry := range entries {
+				if s, ok := kubeletConfigValueToString(entry); ok {
+					fields[fmt.Sprintf("%s[%s]", name, key)] = s
+				}
+			}
+			continue
+		}
+		if s, ok := kubeletConfigValueToString(value); ok {
+			fields[name] = s
+		}
+	}
+
+	return fields, nil
+}
+
+func kubeletConfigValueToString(value interface{}) (string, bool) {
+	switch v := value.(type) {
+	case string:
+		return v, true
+	case bool:
+		return strconv.FormatBool(v), true
+	case json.Number:
+		return v.String(), true
+	}
+	return "", false
+}
+
+// CompareKubeletConfigFields returns the fields whose <actual> values differ from the <desired> ones, sorted by name.
+// Fields which are not desired are not compared.
+func CompareKubeletConfigFields(desired, actual map[string]string) []gardencorev1alpha1.KubeletConfigFieldDrift {
+	var drifts []gardencorev1alpha1.KubeletConfigFieldDrift
+
+	for name, desiredValue := range desired {
+		if actualValue := actual[name]; actualValue != desiredValue {
+			drifts = append(drifts, gardencorev1alpha1.KubeletConfigFieldDrift{
+				Name:    name,
+				Desired: desiredValue,
+				Actual:  actualValue,
+			})
+		}
+	}
+
+	sort.Slice(drifts, func(i, j int) bool { return drifts[i].Name < drifts[j].Name })
+	return drifts
+}
+
+// computeDesiredKubeletConfigFieldsPerWorkerPool returns the desired kubelet configuration fields for every worker pool
+// of the Shoot. Like for the generation of the operating system configs, the kubelet configuration of a worker pool
+// replaces the one of the Shoot.
+func (b *Botanist) computeDesiredKubeletConfigFieldsPerWorkerPool() map[string]map[string]string {
+	out := make(map[string]map[string]string, len(b.Shoot.Info.Spec.Provider.Workers))
+
+	for _, worker := range b.Shoot.Info.Spec.Provider.Workers {
+		kubeletConfig := b.Shoot.Info.Spec.Kubernetes.Kubelet
+		if worker.Kubernetes != nil && worker.Kubernetes.Kubelet != nil {
+			kubeletConfig = worker.Kubernetes.Kubelet
+		}
+		out[worker.Name] = ComputeDesiredKubeletConfigFields(kubeletConfig)
+	}
+
+	return out
+}
+
+// ComputeKubeletConfigDrifts reads the effective kubelet configuration of every node of the Shoot from the configz
+// endpoint of the kubelet (proxied by the API server) and compares it with the desired configuration of the node's
+// worker pool. It returns the detected drifts and the names of the nodes whose configuration could not be read (e.g.,
+// because they are not ready), both sorted by node name.
+func (b *Botanist) ComputeKubeletConfigDrifts(ctx context.Context) ([]gardencorev1alpha1.NodeKubeletConfigDrift, []string, error) {
+	nodeList := &corev1.NodeList{}
+	if err := b.K8sShootClient.Client().List(ctx, nodeList); err != nil {
+		return nil, nil, err
+	}
+
+	var (
+		desiredPerWorkerPool = b.computeDesiredKubeletConfigFieldsPerWorkerPool()
+		drifts               []gardencorev1alpha1.NodeKubeletConfigDrift
+		uncheckedNodes       []string
+
+		wg        sync.WaitGroup
+		lock      sync.Mutex
+		semaphore = make(chan struct{}, kubeletConfigzParallelism)
+	)
+
+	for _, node := range nodeList.Items {
+		workerPool := node.Labels[v1alpha1constants.LabelWorkerPool]
+		desired := desiredPerWorkerPool[workerPool]
+		if len(desired) == 0 {
+			continue
+		}
+
+		wg.Add(1)
+		go func(nodeName, workerPool string, desired map[string]string) {
+			defer wg.Done()
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+
+			data, err := b.K8sShootClient.Kubernetes().CoreV1().RESTClient().Get().
+				Resource("nodes").
+				Name(nodeName).
+				SubResource("proxy").
+				Suffix("configz").
+				Context(ctx).
+				Timeout(kubeletConfigzTimeout).
+				DoRaw()
+			if err != nil {
+				b.Logger.Infof("Could not read kubelet configuration of node %q: %v", nodeName, err)
+				lock.Lock()
+				defer lock.Unlock()
+				uncheckedNodes = append(uncheckedNodes, nodeName)
+				return
+			}
+
+			actual, err := ParseKubeletConfigz(data)
+			if err != nil {
+				b.Logger.Infof("Could not parse kubelet configuration of node %q: %v", nodeName, err)
+				lock.Lock()
+				defer lock.Unlock()
+				uncheckedNodes = append(uncheckedNodes, nodeName)
+				return
+			}
+
+			if fields := CompareKubeletConfigFields(desired, actual); len(fields) > 0 {
+				lock.Lock()
+				defer lock.Unlock()
+				drifts = append(drifts, gardencorev1alpha1.NodeKubeletConfigDrift{
+					Node:       nodeName,
+					WorkerPool: &workerPool,
+					Fields:     fields,
+				})
+			}
+		}(node.Name, workerPool, desired)
+	}
+	wg.Wait()
+
+	sort.Slice(drifts, func(i, j int) bool { return drifts[i].Node < drifts[j].Node })
+	sort.Strings(uncheckedNodes)
+	return drifts, uncheckedNodes, nil
+}
+
+// CheckKubeletConfigDrift checks whether the effective kubelet configuration of any node differs from the desired one.
+// If no drift was detected but the configuration of some nodes could not be checked, the condition is Unknown.
+func (b *HealthChecker) CheckKubeletConfigDrift(condition gardencorev1alpha1.Condition, drifts []gardencorev1alpha1.NodeKubeletConfigDrift, uncheckedNodes []string) gardencorev1alpha1.Condition {
+	var uncheckedMessage string
+	if len(uncheckedNodes) > 0 {
+		uncheckedMessage = fmt.Sprintf("The kubelet configuration of %d node(s) could not be checked: %s.", len(uncheckedNodes), joinNodeNames(uncheckedNodes))
+	}
+
+	if len(drifts) == 0 {
+		if len(uncheckedNodes) > 0 {
+			return gardencorev1alpha1helper.UpdatedCondition(condition, gardencorev1alpha1.ConditionUnknown, "KubeletConfigNotChecked", uncheckedMessage)
+		}
+		return gardencorev1alpha1helper.UpdatedCondition(condition, gardencorev1alpha1.ConditionTrue, "KubeletConfigInSync", "The effective kubelet configuration of all nodes matches the desired one.")
+	}
+
+	nodes := make([]string, 0, len(drifts))
+	for _, drift := range drifts {
+		nodes = append(nodes, drift.Node)
+	}
+
+	message := fmt.Sprintf("The effective kubelet configuration of %d node(s) differs from the desired one: %s.", len(drifts), joinNodeNames(nodes))
+	if len(uncheckedMessage) > 0 {
+		message = fmt.Sprintf("%s %s", message, uncheckedMessage)
+	}
+	return b.FailedCondition(condition, "KubeletConfigDrift", message)
+}
+
+// joinNodeNames joins the given node names, at most maxReportedKubeletConfigDrifts of them are listed.
+func joinNodeNames(nodes []string) string {
+	if len(nodes) <= maxReportedKubeletConfigDrifts {
+		return strings.Join(nodes, ", ")
+	}
+	return fmt.Sprintf("%s and %d more", strings.Join(nodes[:maxReportedKubeletConfigDrifts], ", "), len(nodes)-maxReportedKubeletConfigDrifts)
+}
+
+// KubeletConfigCheckDue returns true if the configz endpoints of the nodes shall be queried again, i.e., if the last
+// check did not produce a result (including checks skipped for hibernated Shoots) or if it is older than
+// kubeletConfigCheckInterval.
+func KubeletConfigCheckDue(condition gardencorev1alpha1.Condition, now time.Time) bool {
+	if condition.Status == gardencorev1alpha1.ConditionUnknown || condition.Reason == "ConditionNotChecked" {
+		return true
+	}
+	return now.Sub(condition.LastUpdateTime.Time) >= kubeletConfigCheckInterval
+}
+
+// KubeletConfigChecks detects drifts between the desired and the effective kubelet configuration of the Shoot's nodes
+// and returns the updated condition together with the detected drifts. At most maxReportedKubeletConfigDrifts drifts
+// are returned. As every check queries all nodes, the nodes are only checked again after kubeletConfigCheckInterval
+// has passed, the previous result is kept in the meantime.
+func (b *Botanist) KubeletConfigChecks(ctx context.Context, initializeShootClients func() error, thresholdMappings map[gardencorev1alpha1.ConditionType]time.Duration, condition gardencorev1alpha1.Condition) (gardencorev1alpha1.Condition, []gardencorev1alpha1.NodeKubeletConfigDrift) {
+	if b.Shoot.HibernationEnabled || b.Shoot.Info.Status.IsHibernated {
+		return shootHibernatedCondition(condition), nil
+	}
+
+	if err := initializeShootClients(); err != nil {
+		message := fmt.Sprintf("Could not initialize Shoot client for kubelet configuration check: %+v", err)
+		b.Logger.Error(message)
+		return gardencorev1alpha1helper.UpdatedConditionUnknownErrorMessage(condition, message), b.Shoot.Info.Status.KubeletConfigDrifts
+	}
+
+	if !KubeletConfigCheckDue(condition, Now()) {
+		return condition, b.Shoot.Info.Status.KubeletConfigDrifts
+	}
+
+	drifts, uncheckedNodes, err := b.ComputeKubeletConfigDrifts(ctx)
+	if err != nil {
+		return gardencorev1alpha1helper.UpdatedConditionUnknownError(condition, err), b.Shoot.Info.Status.KubeletConfigDrifts
+	}
+
+	condition = b.pardonCondition(NewHealthChecker(thresholdMappings).CheckKubeletConfigDrift(condition, drifts, uncheckedNodes))
+	if len(drifts) > maxReportedKubeletConfigDrifts {
+		drifts = drifts[:maxReportedKubeletConfigDrifts]
+	}
+	return condition, drifts
+}
@@ -0,0 +1,196 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package botanist_test
+
+import (
+	"fmt"
+	"time"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	. "github.com/gardener/gardener/pkg/operation/botanist"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+)
+
+var _ = Describe("kubelet configuration drift", func() {
+	Describe("#ComputeDesiredKubeletConfigFields", func() {
+		It("should return no fields if no kubelet configuration is given", func() {
+			Expect(ComputeDesiredKubeletConfigFields(nil)).To(BeEmpty())
+		})
+
+		It("should return the explicitly configured fields", func() {
+			var (
+				cpuCFSQuota                      = false
+				cpuManagerPolicy                 = "static"
+				maxPods                          = int32(200)
+				podPIDsLimit                     = int64(1000)
+				evictionMaxPodGracePeriod        = int32(90)
+				memoryAvailable                  = "200Mi"
+				minimumReclaim                   = resource.MustParse("1Gi")
+				evictionPressureTransitionPeriod = metav1.Duration{Duration: 4 * time.Minute}
+				softGracePeriod                  = metav1.Duration{Duration: 90 * time.Second}
+			)
+
+			Expect(ComputeDesiredKubeletConfigFields(&gardencorev1alpha1.KubeletConfig{
+				KubernetesConfig: gardencorev1alpha1.KubernetesConfig{
+					FeatureGates: map[string]bool{"SomeFeature": true},
+				},
+				CPUCFSQuota:                      &cpuCFSQuota,
+				CPUManagerPolicy:                 &cpuManagerPolicy,
+				MaxPods:                          &maxPods,
+				PodPIDsLimit:                     &podPIDsLimit,
+				EvictionMaxPodGracePeriod:        &evictionMaxPodGracePeriod,
+				EvictionPressureTransitionPeriod: &evictionPressureTransitionPeriod,
+				EvictionHard:                     &gardencorev1alpha1.KubeletConfigEviction{MemoryAvailable: &memoryAvailable},
+				EvictionSoftGracePeriod:          &gardencorev1alpha1.KubeletConfigEvictionSoftGracePeriod{NodeFSAvailable: &softGracePeriod},
+				EvictionMinimumReclaim:           &gardencorev1alpha1.KubeletConfigEvictionMinimumReclaim{ImageFSAvailable: &minimumReclaim},
+			})).To(Equal(map[string]string{
+				"featureGates[SomeFeature]":                 "true",
+				"cpuCFSQuota":                               "false",
+				"cpuManagerPolicy":                          "static",
+				"maxPods":                                   "200",
+				"podPidsLimit":                              "1000",
+				"evictionMaxPodGracePeriod":                 "90",
+				"evictionPressureTransitionPeriod":          "4m0s",
+				"evictionHard[memory.available]":            "200Mi",
+				"evictionSoftGracePeriod[nodefs.available]": "1m30s",
+				"evictionMinimumReclaim[imagefs.available]": "1Gi",
+			}))
+		})
+	})
+
+	Describe("#ParseKubeletConfigz", func() {
+		It("should return the scalar fields and the entries of maps", func() {
+			fields, err := ParseKubeletConfigz([]byte(`{"kubeletconfig":{"maxPods":110,"cpuCFSQuota":true,"cpuManagerPolicy":"none","evictionHard":{"memory.available":"100Mi"},"featureGates":{"SomeFeature":false},"clusterDNS":["100.64.0.10"]}}`))
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fields).To(Equal(map[string]string{
+				"maxPods":                        "110",
+				"cpuCFSQuota":                    "true",
+				"cpuManagerPolicy":               "none",
+				"evictionHard[memory.available]": "100Mi",
+				"featureGates[SomeFeature]":      "false",
+			}))
+		})
+
+		It("should fail if the response does not contain the kubelet configuration", func() {
+			_, err := ParseKubeletConfigz([]byte(`{}`))
+
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("#CompareKubeletConfigFields", func() {
+		It("should return the differing fields sorted by name", func() {
+			Expect(CompareKubeletConfigFields(
+				map[string]string{"maxPods": "200", "cpuManagerPolicy": "static", "podPidsLimit": "1000"},
+				map[string]string{"maxPods": "110", "cpuManagerPolicy": "static", "readOnlyPort": "0"},
+			)).To(Equal([]gardencorev1alpha1.KubeletConfigFieldDrift{
+				{Name: "maxPods", Desired: "200", Actual: "110"},
+				{Name: "podPidsLimit", Desired: "1000"},
+			}))
+		})
+	})
+
+	Describe("#CheckKubeletConfigDrift", func() {
+		var (
+			checker   *HealthChecker
+			condition gardencorev1alpha1.Condition
+		)
+
+		BeforeEach(func() {
+			checker = NewHealthChecker(nil)
+			condition = gardencorev1alpha1.Condition{
+				Type:   gardencorev1alpha1.ShootKubeletConfigInSync,
+				Status: gardencorev1alpha1.ConditionTrue,
+			}
+		})
+
+		It("should succeed if there are no drifts", func() {
+			Expect(checker.CheckKubeletConfigDrift(condition, nil, nil)).To(MatchFields(IgnoreExtras, Fields{
+				"Status": Equal(gardencorev1alpha1.ConditionTrue),
+				"Reason": Equal("KubeletConfigInSync"),
+			}))
+		})
+
+		It("should fail if the configuration of nodes drifted", func() {
+			Expect(checker.CheckKubeletConfigDrift(condition, []gardencorev1alpha1.NodeKubeletConfigDrift{
+				{Node: "node-1", Fields: []gardencorev1alpha1.KubeletConfigFieldDrift{{Name: "maxPods", Desired: "200", Actual: "110"}}},
+				{Node: "node-2", Fields: []gardencorev1alpha1.KubeletConfigFieldDrift{{Name: "maxPods", Desired: "200", Actual: "110"}}},
+			}, nil)).To(MatchFields(IgnoreExtras, Fields{
+				"Status":  Equal(gardencorev1alpha1.ConditionFalse),
+				"Reason":  Equal("KubeletConfigDrift"),
+				"Message": ContainSubstring("2 node(s) differs from the desired one: node-1, node-2"),
+			}))
+		})
+
+		It("should report the nodes which could not be checked together with the drifts", func() {
+			Expect(checker.CheckKubeletConfigDrift(condition, []gardencorev1alpha1.NodeKubeletConfigDrift{
+				{Node: "node-1", Fields: []gardencorev1alpha1.KubeletConfigFieldDrift{{Name: "maxPods", Desired: "200", Actual: "110"}}},
+			}, []string{"node-2"})).To(MatchFields(IgnoreExtras, Fields{
+				"Status":  Equal(gardencorev1alpha1.ConditionFalse),
+				"Reason":  Equal("KubeletConfigDrift"),
+				"Message": ContainSubstring("The kubelet configuration of 1 node(s) could not be checked: node-2."),
+			}))
+		})
+
+		It("should be unknown if no drift was detected but nodes could not be checked", func() {
+			Expect(checker.CheckKubeletConfigDrift(condition, nil, []string{"node-1", "node-2"})).To(MatchFields(IgnoreExtras, Fields{
+				"Status":  Equal(gardencorev1alpha1.ConditionUnknown),
+				"Reason":  Equal("KubeletConfigNotChecked"),
+				"Message": Equal("The kubelet configuration of 2 node(s) could not be checked: node-1, node-2."),
+			}))
+		})
+
+		It("should list at most 10 nodes in the message", func() {
+			var drifts []gardencorev1alpha1.NodeKubeletConfigDrift
+			for i := 0; i < 12; i++ {
+				drifts = append(drifts, gardencorev1alpha1.NodeKubeletConfigDrift{Node: fmt.Sprintf("node-%02d", i)})
+			}
+
+			Expect(checker.CheckKubeletConfigDrift(condition, drifts, nil)).To(MatchFields(IgnoreExtras, Fields{
+				"Status":  Equal(gardencorev1alpha1.ConditionFalse),
+				"Message": HaveSuffix("12 node(s) differs from the desired one: node-00, node-01, node-02, node-03, node-04, node-05, node-06, node-07, node-08, node-09 and 2 more."),
+			}))
+		})
+	})
+
+	Describe("#KubeletConfigCheckDue", func() {
+		var now = time.Date(2019, 10, 1, 12, 0, 0, 0, time.UTC)
+
+		DescribeTable("should return the expected result",
+			func(status gardencorev1alpha1.ConditionStatus, reason string, lastUpdate time.Duration, due bool) {
+				condition := gardencorev1alpha1.Condition{
+					Type:           gardencorev1alpha1.ShootKubeletConfigInSync,
+					Status:         status,
+					Reason:         reason,
+					LastUpdateTime: metav1.NewTime(now.Add(-lastUpdate)),
+				}
+				Expect(KubeletConfigCheckDue(condition, now)).To(Equal(due))
+			},
+			Entry("recent result", gardencorev1alpha1.ConditionTrue, "KubeletConfigInSync", time.Minute, false),
+			Entry("recent drift", gardencorev1alpha1.ConditionFalse, "KubeletConfigDrift", time.Minute, false),
+			Entry("outdated result", gardencorev1alpha1.ConditionTrue, "KubeletConfigInSync", 10*time.Minute, true),
+			Entry("unknown result", gardencorev1alpha1.ConditionUnknown, "KubeletConfigNotChecked", time.Minute, true),
+			Entry("hibernated shoot", gardencorev1alpha1.ConditionTrue, "ConditionNotChecked", time.Minute, true),
+		)
+	})
+})
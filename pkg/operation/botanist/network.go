@@ -57,6 +57,10 @@ func (b *Botanist) DeployNetwork(ctx context.Context) error {
 			ServiceCIDR: string(b.Shoot.GetServiceNetwork()),
 		}
 
+		for _, ipFamily := range b.Shoot.Info.Spec.Networking.IPFamilies {
+			network.Spec.IPFamilies = append(network.Spec.IPFamilies, extensionsv1alpha1.IPFamily(ipFamily))
+		}
+
 		if b.Shoot.Info.Spec.Networking.ProviderConfig != nil {
 			network.Spec.ProviderConfig = &b.Shoot.Info.Spec.Networking.ProviderConfig.RawExtension
 		}
@@ -119,3 +123,45 @@ func (b *Botanist) WaitUntilNetworkIsDeleted(ctx context.Context) error {
 
 	return nil
 }
+
+const (
+	// featureGateIPv6DualStack is the name of the feature gate which enables dual-stack networking in the Kubernetes
+	// components.
+	featureGateIPv6DualStack = "IPv6DualStack"
+	// nodeCIDRMaskSizeIPv6 is the mask size of the IPv6 pod networks of the nodes.
+	nodeCIDRMaskSizeIPv6 = 64
+)
+
+// ComputeIPFamiliesFeatureGates returns the given feature gates of a Kubernetes component together with the feature
+// gates which are required by the given IP families, i.e., IPv6DualStack for dual-stack networking. The given map is
+// not modified.
+func ComputeIPFamiliesFeatureGates(ipFamilies []gardencorev1alpha1.IPFamily, featureGates map[string]bool) map[string]bool {
+	if !gardencorev1alpha1helper.IsDualStack(ipFamilies) {
+		return featureGates
+	}
+
+	out := make(map[string]bool, len(featureGates)+1)
+	for name, enabled := range featureGates {
+		out[name] = enabled
+	}
+	out[featureGateIPv6DualStack] = true
+	return out
+}
+
+// ComputeNodeCIDRMaskSizes returns the mask sizes of the pod networks of the nodes per IP family. The given
+// <nodeCIDRMaskSize> configured for the kube-controller-manager applies to IPv4, IPv6 pod networks always have a mask
+// size of 64. If the mask size for IPv4 is not configured, it is omitted.
+func ComputeNodeCIDRMaskSizes(ipFamilies []gardencorev1alpha1.IPFamily, nodeCIDRMaskSize *int32) map[gardencorev1alpha1.IPFamily]int32 {
+	maskSizes := map[gardencorev1alpha1.IPFamily]int32{}
+	for _, ipFamily := range gardencorev1alpha1helper.GetIPFamilies(ipFamilies) {
+		switch ipFamily {
+		case gardencorev1alpha1.IPFamilyIPv4:
+			if nodeCIDRMaskSize != nil {
+				maskSizes[ipFamily] = *nodeCIDRMaskSize
+			}
+		case gardencorev1alpha1.IPFamilyIPv6:
+			maskSizes[ipFamily] = nodeCIDRMaskSizeIPv6
+		}
+	}
+	return maskSizes
+}
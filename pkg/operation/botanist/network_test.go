@@ -0,0 +1,67 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package botanist_test
+
+import (
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	. "github.com/gardener/gardener/pkg/operation/botanist"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("network", func() {
+	var (
+		ipv4      = []gardencorev1alpha1.IPFamily{gardencorev1alpha1.IPFamilyIPv4}
+		ipv6      = []gardencorev1alpha1.IPFamily{gardencorev1alpha1.IPFamilyIPv6}
+		dualStack = []gardencorev1alpha1.IPFamily{gardencorev1alpha1.IPFamilyIPv4, gardencorev1alpha1.IPFamilyIPv6}
+	)
+
+	Describe("#ComputeIPFamiliesFeatureGates", func() {
+		It("should not add feature gates for single-stack networks", func() {
+			featureGates := map[string]bool{"Foo": true}
+
+			Expect(ComputeIPFamiliesFeatureGates(nil, featureGates)).To(Equal(featureGates))
+			Expect(ComputeIPFamiliesFeatureGates(ipv6, nil)).To(BeNil())
+		})
+
+		It("should enable the IPv6DualStack feature gate for dual-stack networks without modifying the given feature gates", func() {
+			featureGates := map[string]bool{"Foo": true, "IPv6DualStack": false}
+
+			Expect(ComputeIPFamiliesFeatureGates(dualStack, featureGates)).To(Equal(map[string]bool{"Foo": true, "IPv6DualStack": true}))
+			Expect(featureGates).To(Equal(map[string]bool{"Foo": true, "IPv6DualStack": false}))
+		})
+	})
+
+	Describe("#ComputeNodeCIDRMaskSizes", func() {
+		var nodeCIDRMaskSize = int32(25)
+
+		It("should use the configured mask size for IPv4", func() {
+			Expect(ComputeNodeCIDRMaskSizes(ipv4, &nodeCIDRMaskSize)).To(Equal(map[gardencorev1alpha1.IPFamily]int32{gardencorev1alpha1.IPFamilyIPv4: 25}))
+			Expect(ComputeNodeCIDRMaskSizes(nil, nil)).To(BeEmpty())
+		})
+
+		It("should use a mask size of 64 for IPv6", func() {
+			Expect(ComputeNodeCIDRMaskSizes(ipv6, &nodeCIDRMaskSize)).To(Equal(map[gardencorev1alpha1.IPFamily]int32{gardencorev1alpha1.IPFamilyIPv6: 64}))
+		})
+
+		It("should return the mask sizes of both IP families for dual-stack networks", func() {
+			Expect(ComputeNodeCIDRMaskSizes(dualStack, &nodeCIDRMaskSize)).To(Equal(map[gardencorev1alpha1.IPFamily]int32{
+				gardencorev1alpha1.IPFamilyIPv4: 25,
+				gardencorev1alpha1.IPFamilyIPv6: 64,
+			}))
+		})
+	})
+})
@@ -163,9 +163,9 @@ func (b *Botanist) deployOperatingSystemConfigsForWorker(machineTypes []gardenco
 		}
 	}
 
-	sshKey := b.Secrets[v1alpha1constants.SecretNameSSHKeyPair].Data[secrets.DataKeySSHAuthorizedKeys]
+	sshKey := secrets.SSHAuthorizedKeys(b.Secrets[v1alpha1constants.SecretNameSSHKeyPair].Data)
 
-	originalConfig["osc"] = map[string]interface{}{
+	osc := map[string]interface{}{
 		"type":                 machineImage.Name,
 		"purpose":              extensionsv1alpha1.OperatingSystemConfigPurposeReconcile,
 		"reloadConfigFilePath": common.CloudConfigFilePath,
@@ -174,6 +174,17 @@ func (b *Botanist) deployOperatingSystemConfigsForWorker(machineTypes []gardenco
 		"sshKey":               string(sshKey),
 	}
 
+	// The container runtime is only handed over to the operating system configs if it was explicitly selected, Docker
+	// is used otherwise.
+	if worker.CRI != nil {
+		cri := map[string]interface{}{
+			"name": string(worker.CRI.Name),
+		}
+		downloaderConfig["cri"] = cri
+		osc["cri"] = cri
+	}
+	originalConfig["osc"] = osc
+
 	if data := worker.CABundle; data != nil {
 		if existingCABundle, ok := originalConfig["caBundle"]; ok {
 			originalConfig["caBundle"] = fmt.Sprintf("%s\n%s", existingCABundle, *data)
@@ -284,10 +295,15 @@ func (b *Botanist) deployOperatingSystemConfigsForWorker(machineTypes []gardenco
 		"evictionMinimumReclaim":  evictionMinimumReclaim,
 	}
 
+	var kubeletFeatureGates map[string]bool
+	if kubeletConfig != nil {
+		kubeletFeatureGates = kubeletConfig.FeatureGates
+	}
+	if featureGates := ComputeIPFamiliesFeatureGates(b.Shoot.Info.Spec.Networking.IPFamilies, kubeletFeatureGates); featureGates != nil {
+		kubelet["featureGates"] = featureGates
+	}
+
 	if kubeletConfig := kubeletConfig; kubeletConfig != nil {
-		if featureGates := kubeletConfig.FeatureGates; featureGates != nil {
-			kubelet["featureGates"] = featureGates
-		}
 		if podPIDsLimit := kubeletConfig.PodPIDsLimit; podPIDsLimit != nil {
 			kubelet["podPIDsLimit"] = *podPIDsLimit
 		}
@@ -395,6 +411,10 @@ func (b *Botanist) generateCloudConfigExecutionChart() (*chartrenderer.RenderedC
 			w["command"] = *cmd
 		}
 
+		if worker.CRI != nil {
+			w["cri"] = string(worker.CRI.Name)
+		}
+
 		workers = append(workers, w)
 	}
 
@@ -404,6 +424,19 @@ func (b *Botanist) generateCloudConfigExecutionChart() (*chartrenderer.RenderedC
 		"workers":        workers,
 	}
 
+	// The credentials of the cloud-config-downloader and the kubelet's client certificate are renewed on the worker
+	// nodes when the certificate authorities of the cluster are rotated.
+	if downloaderSecret, ok := b.Secrets[common.CloudConfigDownloaderSecretName]; ok {
+		config["cloudConfigDownloader"] = map[string]interface{}{
+			"caCert":     string(downloaderSecret.Data[secrets.DataKeyCertificateCA]),
+			"clientCert": string(downloaderSecret.Data[fmt.Sprintf("%s.crt", common.CloudConfigDownloaderSecretName)]),
+			"clientKey":  string(downloaderSecret.Data[fmt.Sprintf("%s.key", common.CloudConfigDownloaderSecretName)]),
+		}
+	}
+	if signingCA := b.Secrets[v1alpha1constants.SecretNameCACluster].Data[secrets.DataKeyCertificateCASigning]; len(signingCA) > 0 {
+		config["kubeletClientCAChecksum"] = utils.ComputeSHA256Hex(signingCA)
+	}
+
 	config, err = b.InjectShootShootImages(config, common.HyperkubeImageName)
 	if err != nil {
 		return nil, err
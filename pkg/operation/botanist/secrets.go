@@ -367,9 +367,9 @@ func (b *Botanist) generateWantedSecrets(basicAuthAPIServer *secrets.BasicAuth,
 		// Secret definition for cloud-config-downloader
 		&secrets.ControlPlaneSecretConfig{
 			CertificateSecretConfig: &secrets.CertificateSecretConfig{
-				Name: "cloud-config-downloader",
+				Name: common.CloudConfigDownloaderSecretName,
 
-				CommonName:   "cloud-config-downloader",
+				CommonName:   common.CloudConfigDownloaderSecretName,
 				Organization: nil,
 				DNSNames:     nil,
 				IPAddresses:  nil,
@@ -711,7 +711,11 @@ func (b *Botanist) DeploySecrets(ctx context.Context) error {
 		return err
 	}
 
-	certificateAuthorities, err := b.generateCertificateAuthorities(existingSecretsMap)
+	if err := b.rotateStaticCredentials(ctx, existingSecretsMap); err != nil {
+		return err
+	}
+
+	certificateAuthorities, previousCertificateAuthorities, err := b.generateCertificateAuthorities(ctx, existingSecretsMap)
 	if err != nil {
 		return err
 	}
@@ -738,6 +742,13 @@ func (b *Botanist) DeploySecrets(ctx context.Context) error {
 		return err
 	}
 
+	// While the certificate authorities are rotated, certificates which were not signed by the CAs currently used for
+	// signing are regenerated.
+	signServerCertificatesWithPreviousCAs(wantedSecretsList, previousCertificateAuthorities)
+	if err := b.deleteOutdatedCertificateSecrets(ctx, existingSecretsMap, wantedSecretsList); err != nil {
+		return err
+	}
+
 	// Only necessary to renew certificates for Grafana, Kibana, Prometheus
 	// TODO: (timuthy) remove in future version.
 	var (
@@ -831,10 +842,68 @@ func (b *Botanist) fetchExistingSecrets(ctx context.Context) (map[string]*corev1
 	return existingSecretsMap, nil
 }
 
-func (b *Botanist) generateCertificateAuthorities(existingSecretsMap map[string]*corev1.Secret) (map[string]*secrets.Certificate, error) {
-	generatedSecrets, certificateAuthorities, err := secrets.GenerateCertificateAuthorities(b.K8sSeedClient, existingSecretsMap, wantedCertificateAuthorities, b.Shoot.SeedNamespace)
+// generateCertificateAuthorities generates or loads the certificate authorities of the Shoot and rotates them according
+// to the phase of the certificate authority rotation. It returns the current certificate authorities and, while a
+// rotation is prepared, the previous certificate authorities.
+func (b *Botanist) generateCertificateAuthorities(ctx context.Context, existingSecretsMap map[string]*corev1.Secret) (map[string]*secrets.Certificate, map[string]*secrets.Certificate, error) {
+	var (
+		store                    = b.secretStore()
+		generatedSecrets         map[string]*corev1.Secret
+		certificateAuthorityData = make(map[string]map[string][]byte, len(wantedCertificateAuthorities))
+		updateData               func(name string, data map[string][]byte) error
+		err                      error
+	)
+
+	if store == nil {
+		// The certificate authorities including their private keys are kept in the CA secrets themselves.
+		generatedSecrets, _, err = secrets.GenerateCertificateAuthorities(b.K8sSeedClient, existingSecretsMap, wantedCertificateAuthorities, b.Shoot.SeedNamespace)
+		if err != nil {
+			return nil, nil, err
+		}
+		for name, secret := range generatedSecrets {
+			certificateAuthorityData[name] = secret.Data
+		}
+		updateData = func(name string, data map[string][]byte) error {
+			secret := generatedSecrets[name]
+			secret.Data = data
+			return b.K8sSeedClient.Client().Update(ctx, secret)
+		}
+	} else {
+		// The certificate authorities including their private keys are kept in the store, the CA secrets only contain
+		// the public CA certificates.
+		if err := secrets.MigrateCertificateAuthoritiesToStore(ctx, store, existingSecretsMap, wantedCertificateAuthorities, b.Shoot.SeedNamespace); err != nil {
+			return nil, nil, err
+		}
+		if certificateAuthorityData, err = secrets.GenerateCertificateAuthorityDataInStore(ctx, store, wantedCertificateAuthorities, b.Shoot.SeedNamespace); err != nil {
+			return nil, nil, err
+		}
+		updateData = func(name string, data map[string][]byte) error {
+			return store.Update(ctx, b.Shoot.SeedNamespace, name, data)
+		}
+	}
+
+	phase, err := b.reconcileCARotationPhase(ctx, certificateAuthorityData)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+
+	certificateAuthorities, previousCertificateAuthorities, err := b.rotateCertificateAuthorities(phase, certificateAuthorityData, updateData)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if store != nil {
+		if generatedSecrets, err = secrets.DeployCertificateAuthorityCertificates(ctx, b.K8sSeedClient.Client(), certificateAuthorities, b.Shoot.SeedNamespace); err != nil {
+			return nil, nil, err
+		}
+
+		// The kube-controller-manager signs the certificates of the kubelets with the cluster CA and thus requires its
+		// private key which is not contained in the published CA secret.
+		signingSecret, err := secrets.DeployCertificateAuthoritySigningSecret(ctx, b.K8sSeedClient.Client(), v1alpha1constants.SecretNameCACluster, certificateAuthorities[v1alpha1constants.SecretNameCACluster], b.Shoot.SeedNamespace)
+		if err != nil {
+			return nil, nil, err
+		}
+		generatedSecrets[signingSecret.Name] = signingSecret
 	}
 
 	b.mutex.Lock()
@@ -844,7 +913,7 @@ func (b *Botanist) generateCertificateAuthorities(existingSecretsMap map[string]
 		b.Secrets[secretName] = caSecret
 	}
 
-	return certificateAuthorities, nil
+	return certificateAuthorities, previousCertificateAuthorities, nil
 }
 
 func (b *Botanist) generateBasicAuthAPIServer(ctx context.Context, existingSecretsMap map[string]*corev1.Secret) (*secrets.BasicAuth, error) {
@@ -894,8 +963,8 @@ func (b *Botanist) generateBasicAuthAPIServer(ctx context.Context, existingSecre
 	return basicAuth.(*secrets.BasicAuth), nil
 }
 
-func (b *Botanist) generateStaticToken(ctx context.Context, existingSecretsMap map[string]*corev1.Secret) (*secrets.StaticToken, error) {
-	staticTokenConfig := &secrets.StaticTokenSecretConfig{
+func newStaticTokenSecretConfig() *secrets.StaticTokenSecretConfig {
+	return &secrets.StaticTokenSecretConfig{
 		Name: common.StaticTokenSecretName,
 		Tokens: []secrets.TokenConfig{
 			{
@@ -909,6 +978,10 @@ func (b *Botanist) generateStaticToken(ctx context.Context, existingSecretsMap m
 			},
 		},
 	}
+}
+
+func (b *Botanist) generateStaticToken(ctx context.Context, existingSecretsMap map[string]*corev1.Secret) (*secrets.StaticToken, error) {
+	staticTokenConfig := newStaticTokenSecretConfig()
 
 	if existingSecret, ok := existingSecretsMap[staticTokenConfig.Name]; ok {
 		staticToken, err := secrets.LoadStaticTokenFromCSV(staticTokenConfig.Name, existingSecret.Data[secrets.DataKeyStaticTokenCSV])
@@ -1133,5 +1206,9 @@ func dnsNamesForEtcd(namespace string) []string {
 	}
 	names = append(names, dnsNamesForService(fmt.Sprintf("%s-client", v1alpha1constants.StatefulSetNameETCDMain), namespace)...)
 	names = append(names, dnsNamesForService(fmt.Sprintf("%s-client", v1alpha1constants.StatefulSetNameETCDEvents), namespace)...)
+	// The members of highly available etcd clusters address each other via their peer services.
+	for _, statefulSet := range []string{v1alpha1constants.StatefulSetNameETCDMain, v1alpha1constants.StatefulSetNameETCDEvents} {
+		names = append(names, fmt.Sprintf("*.%s-peer.%s.svc", statefulSet, namespace))
+	}
 	return names
 }
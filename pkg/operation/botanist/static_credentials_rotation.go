@@ -0,0 +1,136 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package botanist
+
+import (
+	"context"
+	"time"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
+	"github.com/gardener/gardener/pkg/operation/common"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+	"github.com/gardener/gardener/pkg/utils/secrets"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// StaticCredentialsRotationDue returns whether the static tokens and the basic authentication password must be rotated.
+// This is the case if a rotation is configured and the rotation period has passed since the last rotation or, if the
+// static token secret was created afterwards (e.g., by a manual rotation), since the creation of the secret.
+func StaticCredentialsRotationDue(cfg *gardencorev1alpha1.StaticCredentialsRotationConfig, rotation *gardencorev1alpha1.StaticCredentialsRotation, secretCreationTime, now time.Time) bool {
+	if cfg == nil || cfg.Period.Duration <= 0 {
+		return false
+	}
+
+	lastRotationTime := secretCreationTime
+	if rotation != nil && rotation.LastRotationTime != nil && rotation.LastRotationTime.After(lastRotationTime) {
+		lastRotationTime = rotation.LastRotationTime.Time
+	}
+
+	return !now.Before(lastRotationTime.Add(cfg.Period.Duration))
+}
+
+// PreviousStaticTokensExpired returns whether the static tokens which were replaced by the last rotation must no longer
+// be accepted. This is the case if the transition period has passed since the last rotation or if no rotation is
+// configured anymore.
+func PreviousStaticTokensExpired(cfg *gardencorev1alpha1.StaticCredentialsRotationConfig, rotation *gardencorev1alpha1.StaticCredentialsRotation, now time.Time) bool {
+	if cfg == nil || rotation == nil || rotation.LastRotationTime == nil {
+		return true
+	}
+
+	var transitionPeriod time.Duration
+	if cfg.TransitionPeriod != nil {
+		transitionPeriod = cfg.TransitionPeriod.Duration
+	}
+
+	return !now.Before(rotation.LastRotationTime.Add(transitionPeriod))
+}
+
+// rotateStaticCredentials rotates the static tokens and the basic authentication password of the kube-apiserver
+// according to the static credentials rotation configuration of the Shoot. The replaced static tokens are kept in the
+// token file until the transition period has passed so that clients can switch to the new kubeconfig without downtime.
+// The kube-apiserver only accepts one password per user, hence, the basic authentication password is replaced
+// immediately. The kubeconfig secrets are deleted so that they are regenerated with the new credentials.
+func (b *Botanist) rotateStaticCredentials(ctx context.Context, existingSecretsMap map[string]*corev1.Secret) error {
+	staticTokenSecret, ok := existingSecretsMap[common.StaticTokenSecretName]
+	if !ok {
+		return nil
+	}
+
+	var (
+		now      = Now()
+		cfg      *gardencorev1alpha1.StaticCredentialsRotationConfig
+		rotation *gardencorev1alpha1.StaticCredentialsRotation
+	)
+
+	if kubeAPIServer := b.Shoot.Info.Spec.Kubernetes.KubeAPIServer; kubeAPIServer != nil {
+		cfg = kubeAPIServer.StaticCredentialsRotation
+	}
+	if credentials := b.Shoot.Info.Status.Credentials; credentials != nil && credentials.Rotation != nil {
+		rotation = credentials.Rotation.StaticCredentials
+	}
+
+	staticToken, err := secrets.LoadStaticTokenFromCSV(common.StaticTokenSecretName, staticTokenSecret.Data[secrets.DataKeyStaticTokenCSV])
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case StaticCredentialsRotationDue(cfg, rotation, staticTokenSecret.CreationTimestamp.Time, now):
+		b.Logger.Infof("Rotating static credentials")
+
+		rotatedStaticToken, err := newStaticTokenSecretConfig().RotateStaticToken(staticToken.WithoutPreviousTokens())
+		if err != nil {
+			return err
+		}
+
+		// The rotation time is persisted first, otherwise a failure after the secret update would cause another rotation
+		// which would invalidate the tokens which are still in use.
+		if _, err := kutil.TryUpdateShootStatus(b.K8sGardenClient.GardenCore(), retry.DefaultRetry, b.Shoot.Info.ObjectMeta, func(shoot *gardencorev1alpha1.Shoot) (*gardencorev1alpha1.Shoot, error) {
+			gardencorev1alpha1helper.MutateShootStaticCredentialsRotation(shoot, func(rotation *gardencorev1alpha1.StaticCredentialsRotation) {
+				rotation.LastRotationTime = &metav1.Time{Time: now}
+			})
+			return shoot, nil
+		}); err != nil {
+			return err
+		}
+
+		staticTokenSecret.Data = rotatedStaticToken.SecretData()
+		if err := b.K8sSeedClient.Client().Update(ctx, staticTokenSecret); err != nil {
+			return err
+		}
+
+		for _, secretName := range []string{common.BasicAuthSecretName, common.KubecfgSecretName, common.KubecfgInternalSecretName} {
+			if err := b.K8sSeedClient.Client().Delete(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: secretName, Namespace: b.Shoot.SeedNamespace}}); client.IgnoreNotFound(err) != nil {
+				return err
+			}
+			delete(existingSecretsMap, secretName)
+		}
+
+	case len(staticToken.PreviousTokens) > 0 && PreviousStaticTokensExpired(cfg, rotation, now):
+		b.Logger.Infof("Removing previous static tokens as the transition period has passed")
+
+		staticTokenSecret.Data = staticToken.WithoutPreviousTokens().SecretData()
+		if err := b.K8sSeedClient.Client().Update(ctx, staticTokenSecret); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
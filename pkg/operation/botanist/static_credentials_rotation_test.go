@@ -0,0 +1,78 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package botanist_test
+
+import (
+	"time"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	. "github.com/gardener/gardener/pkg/operation/botanist"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("static credentials rotation", func() {
+	var (
+		now = time.Date(2019, 12, 31, 12, 0, 0, 0, time.UTC)
+
+		cfg = &gardencorev1alpha1.StaticCredentialsRotationConfig{
+			Period:           metav1.Duration{Duration: 30 * 24 * time.Hour},
+			TransitionPeriod: &metav1.Duration{Duration: 24 * time.Hour},
+		}
+
+		rotatedAt = func(t time.Time) *gardencorev1alpha1.StaticCredentialsRotation {
+			return &gardencorev1alpha1.StaticCredentialsRotation{LastRotationTime: &metav1.Time{Time: t}}
+		}
+	)
+
+	Describe("#StaticCredentialsRotationDue", func() {
+		It("should not rotate if no rotation is configured", func() {
+			Expect(StaticCredentialsRotationDue(nil, nil, now.Add(-365*24*time.Hour), now)).To(BeFalse())
+		})
+
+		It("should rotate if the period has passed since the creation of the secret", func() {
+			Expect(StaticCredentialsRotationDue(cfg, nil, now.Add(-29*24*time.Hour), now)).To(BeFalse())
+			Expect(StaticCredentialsRotationDue(cfg, nil, now.Add(-30*24*time.Hour), now)).To(BeTrue())
+		})
+
+		It("should rotate if the period has passed since the last rotation", func() {
+			secretCreationTime := now.Add(-365 * 24 * time.Hour)
+
+			Expect(StaticCredentialsRotationDue(cfg, rotatedAt(now.Add(-24*time.Hour)), secretCreationTime, now)).To(BeFalse())
+			Expect(StaticCredentialsRotationDue(cfg, rotatedAt(now.Add(-31*24*time.Hour)), secretCreationTime, now)).To(BeTrue())
+		})
+
+		It("should consider a secret which was recreated after the last rotation", func() {
+			Expect(StaticCredentialsRotationDue(cfg, rotatedAt(now.Add(-60*24*time.Hour)), now.Add(-24*time.Hour), now)).To(BeFalse())
+		})
+	})
+
+	Describe("#PreviousStaticTokensExpired", func() {
+		It("should keep the previous tokens during the transition period", func() {
+			Expect(PreviousStaticTokensExpired(cfg, rotatedAt(now.Add(-time.Hour)), now)).To(BeFalse())
+		})
+
+		It("should drop the previous tokens after the transition period", func() {
+			Expect(PreviousStaticTokensExpired(cfg, rotatedAt(now.Add(-24*time.Hour)), now)).To(BeTrue())
+		})
+
+		It("should drop the previous tokens if no rotation is configured or recorded", func() {
+			Expect(PreviousStaticTokensExpired(nil, rotatedAt(now.Add(-time.Hour)), now)).To(BeTrue())
+			Expect(PreviousStaticTokensExpired(cfg, nil, now)).To(BeTrue())
+		})
+	})
+})
@@ -25,6 +25,7 @@ import (
 	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
 	v1alpha1constants "github.com/gardener/gardener/pkg/apis/core/v1alpha1/constants"
 	"github.com/gardener/gardener/pkg/operation/common"
+	"github.com/gardener/gardener/pkg/utils"
 	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
 	"github.com/gardener/gardener/pkg/utils/retry"
 
@@ -60,7 +61,7 @@ func (b *Botanist) WaitUntilEtcdReady(ctx context.Context) error {
 		statefulSetList := &appsv1.StatefulSetList{}
 		err = b.K8sSeedClient.Client().List(ctx, statefulSetList,
 			client.InNamespace(b.Shoot.SeedNamespace),
-			client.MatchingLabels{"app": "etcd-statefulset"})
+			client.MatchingLabels{"app": etcdAppLabelValue})
 		if err != nil {
 			return retry.SevereError(err)
 		}
@@ -353,12 +354,14 @@ func (b *Botanist) WaitUntilNoPodRunning(ctx context.Context) error {
 func (b *Botanist) WaitUntilEndpointsDoNotContainPodIPs(ctx context.Context) error {
 	b.Logger.Info("waiting until there are no Endpoints containing Pod IPs in the shoot cluster...")
 
-	var podsNetwork *net.IPNet
+	var podsNetworks []*net.IPNet
 	if val := b.Shoot.Info.Spec.Networking.Pods; val != nil {
-		var err error
-		_, podsNetwork, err = net.ParseCIDR(*val)
-		if err != nil {
-			return fmt.Errorf("unable to check if there are still Endpoints containing Pod IPs in the shoot cluster. Shoots's Pods network could not be parsed: %+v", err)
+		for _, cidr := range utils.SplitCIDRs(*val) {
+			_, podsNetwork, err := net.ParseCIDR(cidr)
+			if err != nil {
+				return fmt.Errorf("unable to check if there are still Endpoints containing Pod IPs in the shoot cluster. Shoots's Pods network could not be parsed: %+v", err)
+			}
+			podsNetworks = append(podsNetworks, podsNetwork)
 		}
 	} else {
 		return fmt.Errorf("unable to check if there are still Endpoints containing Pod IPs in the shoot cluster. Shoot's Pods network is empty")
@@ -373,11 +376,13 @@ func (b *Botanist) WaitUntilEndpointsDoNotContainPodIPs(ctx context.Context) err
 		for _, endpoints := range endpointsList.Items {
 			for _, subset := range endpoints.Subsets {
 				for _, address := range subset.Addresses {
-					if podsNetwork.Contains(net.ParseIP(address.IP)) {
-						msg := fmt.Sprintf("waiting until there are no Endpoints containing Pod IPs in the shoot cluster..."+
-							"there is still at least one Endpoints containing a Pod's IP: %s/%s, IP: %s", endpoints.Namespace, endpoints.Name, address.IP)
-						b.Logger.Info(msg)
-						return retry.MinorError(fmt.Errorf(msg))
+					for _, podsNetwork := range podsNetworks {
+						if podsNetwork.Contains(net.ParseIP(address.IP)) {
+							msg := fmt.Sprintf("waiting until there are no Endpoints containing Pod IPs in the shoot cluster..."+
+								"there is still at least one Endpoints containing a Pod's IP: %s/%s, IP: %s", endpoints.Namespace, endpoints.Name, address.IP)
+							b.Logger.Info(msg)
+							return retry.MinorError(fmt.Errorf(msg))
+						}
 					}
 				}
 			}
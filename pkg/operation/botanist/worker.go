@@ -70,7 +70,15 @@ func (b *Botanist) DeployWorker(ctx context.Context) error {
 			}
 		}
 
+		var criConfig *extensionsv1alpha1.CRIConfig
+		if worker.CRI != nil {
+			criConfig = &extensionsv1alpha1.CRIConfig{
+				Name: extensionsv1alpha1.CRIName(worker.CRI.Name),
+			}
+		}
+
 		pools = append(pools, extensionsv1alpha1.WorkerPool{
+			CRIConfig:      criConfig,
 			Name:           worker.Name,
 			Minimum:        int(worker.Minimum),
 			Maximum:        int(worker.Maximum),
@@ -103,7 +111,7 @@ func (b *Botanist) DeployWorker(ctx context.Context) error {
 				Name:      v1alpha1constants.SecretNameCloudProvider,
 				Namespace: worker.Namespace,
 			},
-			SSHPublicKey: b.Secrets[v1alpha1constants.SecretNameSSHKeyPair].Data[secrets.DataKeySSHAuthorizedKeys],
+			SSHPublicKey: secrets.SSHAuthorizedKeys(b.Secrets[v1alpha1constants.SecretNameSSHKeyPair].Data),
 			InfrastructureProviderStatus: &runtime.RawExtension{
 				Raw: b.Shoot.InfrastructureStatus,
 			},
@@ -15,6 +15,7 @@
 package common
 
 import (
+	"fmt"
 	"net"
 )
 
@@ -102,6 +103,42 @@ func ExceptNetworks(networks []string, except ...string) ([]interface{}, error)
 	return ToExceptNetworks(ipNets, except...)
 }
 
+// ExceptBlockedNetworks works like ExceptNetworks but returns an error if one of the `networks` is entirely part of
+// one of the `blocked` CIDRs, as such a network cannot be allowed without also allowing blocked addresses.
+//
+// Calling
+// `ExceptBlockedNetworks([]string{"169.254.0.0/16"},"169.254.169.254/32")`
+// produces:
+//
+// [
+//		{"network": "169.254.0.0/16", "except": ["169.254.169.254/32"]},
+// ]
+func ExceptBlockedNetworks(networks []string, blocked ...string) ([]interface{}, error) {
+	ipNets := []net.IPNet{}
+	for _, n := range networks {
+		_, ipNet, err := net.ParseCIDR(n)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, b := range blocked {
+			_, blockedNet, err := net.ParseCIDR(b)
+			if err != nil {
+				return nil, err
+			}
+
+			blockedOnes, _ := blockedNet.Mask.Size()
+			ones, _ := ipNet.Mask.Size()
+			if blockedNet.Contains(ipNet.IP) && blockedOnes <= ones {
+				return nil, fmt.Errorf("network %s is part of blocked network %s", n, b)
+			}
+		}
+
+		ipNets = append(ipNets, *ipNet)
+	}
+	return ToExceptNetworks(ipNets, blocked...)
+}
+
 func excludeBlock(parentBlock *net.IPNet, cidrs ...string) ([]string, error) {
 	matchedCIDRs := []string{}
 
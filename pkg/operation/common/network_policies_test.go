@@ -61,4 +61,35 @@ var _ = Describe("networkpolicies", func() {
 			Expect(result).To(ConsistOf(expectedResult))
 		})
 	})
+
+	Describe("#ExceptBlockedNetworks", func() {
+		It("should return correct result", func() {
+			result, err := ExceptBlockedNetworks([]string{"169.254.0.0/16", "1.2.3.4/32"}, "169.254.169.254/32", "10.0.0.0/8")
+			expectedResult := []interface{}{
+				map[string]interface{}{
+					"network": "169.254.0.0/16",
+					"except":  []string{"169.254.169.254/32"},
+				},
+				map[string]interface{}{
+					"network": "1.2.3.4/32",
+					"except":  []string{},
+				},
+			}
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(ConsistOf(expectedResult))
+		})
+
+		It("should fail if a network is part of a blocked network", func() {
+			_, err := ExceptBlockedNetworks([]string{"1.2.3.4/32", "10.1.0.0/16"}, "10.0.0.0/8")
+
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should fail if a network equals a blocked network", func() {
+			_, err := ExceptBlockedNetworks([]string{"169.254.169.254/32"}, "169.254.169.254/32")
+
+			Expect(err).To(HaveOccurred())
+		})
+	})
 })
@@ -31,6 +31,9 @@ const (
 	// BasicAuthSecretName is the name of the secret containing basic authentication credentials for the kube-apiserver.
 	BasicAuthSecretName = "kube-apiserver-basic-auth"
 
+	// CloudConfigDownloaderSecretName is the name of the secret containing the credentials of the cloud-config-downloader.
+	CloudConfigDownloaderSecretName = "cloud-config-downloader"
+
 	// ChartPath is the path to the Helm charts.
 	ChartPath = "charts"
 
@@ -276,6 +279,35 @@ const (
 	// kubeconfig that is handed out to the user shall be rotated.
 	ShootOperationRotateKubeconfigCredentials = "rotate-kubeconfig-credentials"
 
+	// ShootOperationRotateCAStart is a constant for an annotation on a Shoot indicating that the rotation of the
+	// certificate authorities of the Shoot cluster shall be started.
+	ShootOperationRotateCAStart = "rotate-ca-start"
+
+	// ShootOperationRotateCAComplete is a constant for an annotation on a Shoot indicating that the rotation of the
+	// certificate authorities of the Shoot cluster shall be completed.
+	ShootOperationRotateCAComplete = "rotate-ca-complete"
+
+	// ShootOperationRotateETCDEncryptionKey is a constant for an annotation on a Shoot indicating that the key which is
+	// used to encrypt resources in etcd shall be rotated.
+	ShootOperationRotateETCDEncryptionKey = "rotate-etcd-encryption-key"
+
+	// ShootOperationTakeETCDSnapshot is a constant for an annotation on a Shoot indicating that a full snapshot of the
+	// etcd shall be taken immediately.
+	ShootOperationTakeETCDSnapshot = "take-etcd-snapshot"
+
+	// ShootOperationRestoreETCD is a constant for an annotation on a Shoot indicating that the etcd shall be restored
+	// from its backup.
+	ShootOperationRestoreETCD = "restore-etcd"
+
+	// ShootETCDRestoreTarget is a constant for an annotation on a Shoot which would specify the snapshot or revision to
+	// which the etcd shall be restored. The backup-restore sidecar of etcd always restores the latest state of the
+	// backup, hence, the annotation is rejected.
+	ShootETCDRestoreTarget = "shoot.gardener.cloud/etcd-restore-target"
+
+	// ETCDMainRestoredAt is a constant for an annotation on the persistent volume of a replaced etcd-main which contains
+	// the time at which the etcd was restored into a fresh volume.
+	ETCDMainRestoredAt = "shoot.gardener.cloud/etcd-restored-at"
+
 	// ShootTasks is a constant for an annotation on a Shoot which states that certain tasks should be done.
 	ShootTasks = "shoot.garden.sapcloud.io/tasks"
 
@@ -66,10 +66,13 @@ func GetSecretKeysWithPrefix(kind string, m map[string]*corev1.Secret) []string
 
 // ComputeClusterIP parses the provided <cidr> and sets the last byte to the value of <lastByte>.
 // For example, <cidr> = 100.64.0.0/11 and <lastByte> = 10 the result would be 100.64.0.10
+// If <cidr> contains multiple CIDRs separated by a comma (dual-stack networks), the first one is used.
 func ComputeClusterIP(cidr string, lastByte byte) string {
-	ip, _, _ := net.ParseCIDR(cidr)
-	ip = ip.To4()
-	ip[3] = lastByte
+	ip, _, _ := net.ParseCIDR(strings.TrimSpace(strings.Split(cidr, ",")[0]))
+	if ip4 := ip.To4(); ip4 != nil {
+		ip = ip4
+	}
+	ip[len(ip)-1] = lastByte
 	return ip.String()
 }
 
@@ -92,6 +95,12 @@ func GenerateBackupEntryName(seedNamespace string, shootUID types.UID) string {
 	return fmt.Sprintf("%s--%s", seedNamespace, shootUID)
 }
 
+// GenerateReplicaBackupBucketName returns the name of the bucket into which the objects of the BackupBucket with the
+// given <backupBucketName> are replicated.
+func GenerateReplicaBackupBucketName(backupBucketName string) string {
+	return fmt.Sprintf("%s-replica", backupBucketName)
+}
+
 // ExtractShootDetailsFromBackupEntryName returns Shoot resource technicalID its UID from provided <backupEntryName>.
 func ExtractShootDetailsFromBackupEntryName(backupEntryName string) (shootTechnicalID, shootUID string) {
 	tokens := strings.Split(backupEntryName, "--")
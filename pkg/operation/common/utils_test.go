@@ -47,6 +47,11 @@ var _ = Describe("common", func() {
 
 				Expect(result).To(Equal("100.64.0.10"))
 			})
+
+			It("should return a cluster IP of the primary network for dual-stack networks", func() {
+				Expect(ComputeClusterIP("100.64.0.0/13,fd00:100:64::/108", 10)).To(Equal("100.64.0.10"))
+				Expect(ComputeClusterIP("fd00:100:64::/108,100.64.0.0/13", 10)).To(Equal("fd00:100:64::a"))
+			})
 		})
 
 		Describe("#GenerateAddonConfig", func() {
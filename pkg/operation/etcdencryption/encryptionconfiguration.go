@@ -25,10 +25,12 @@ import (
 	"github.com/gardener/gardener/pkg/operation/common"
 
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/serializer/json"
 	"k8s.io/apimachinery/pkg/runtime/serializer/versioning"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
 	apiserverconfigv1 "k8s.io/apiserver/pkg/apis/config/v1"
 )
 
@@ -170,6 +172,15 @@ func findResourceConfigurationForResource(configs []apiserverconfigv1.ResourceCo
 	return nil, fmt.Errorf("no resource configuration found for resource %q", resource)
 }
 
+func findResourceConfigurationIndexForResource(configs []apiserverconfigv1.ResourceConfiguration, resource string) (int, error) {
+	for i := range configs {
+		if configContainsResource(&configs[i], resource) {
+			return i, nil
+		}
+	}
+	return -1, fmt.Errorf("no resource configuration found for resource %q", resource)
+}
+
 // SetResourceEncryption sets the EncryptionConfiguration to active or non-active (passive) state.
 // State active means that provider aescbc is the first in the list of providers.
 // State non-active (passive) means that provider identity is the first in the list of providers.
@@ -194,6 +205,192 @@ func SetResourceEncryption(c *apiserverconfigv1.EncryptionConfiguration, resourc
 	return fmt.Errorf("no encryption provider configuration found for to set encryption of resource %q to %t", resource, encrypted)
 }
 
+// GetEncryptionKeys returns the keys of the aescbc provider which is used to encrypt the given resource. The first key
+// is the primary key which is used for encryption, all keys are used for decryption.
+func GetEncryptionKeys(c *apiserverconfigv1.EncryptionConfiguration, resource string) ([]apiserverconfigv1.Key, error) {
+	conf, err := findResourceConfigurationForResource(c.Resources, resource)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, provider := range conf.Providers {
+		if provider.AESCBC != nil {
+			return provider.AESCBC.Keys, nil
+		}
+	}
+	return nil, fmt.Errorf("no aescbc provider configuration found for resource %q", resource)
+}
+
+// AddEncryptionKey adds the given key as non-primary key to all aescbc providers. The kube-apiserver can decrypt
+// resources with this key afterwards but does not yet use it for encryption.
+func AddEncryptionKey(c *apiserverconfigv1.EncryptionConfiguration, key apiserverconfigv1.Key) {
+	mutateEncryptionKeys(c, func(keys []apiserverconfigv1.Key) []apiserverconfigv1.Key {
+		for _, k := range keys {
+			if k.Name == key.Name {
+				return keys
+			}
+		}
+		return append(keys, key)
+	})
+}
+
+// PromoteEncryptionKey makes the key with the given name the primary key of all aescbc providers, i.e., the
+// kube-apiserver uses it for encryption afterwards.
+func PromoteEncryptionKey(c *apiserverconfigv1.EncryptionConfiguration, keyName string) error {
+	var err error
+	mutateEncryptionKeys(c, func(keys []apiserverconfigv1.Key) []apiserverconfigv1.Key {
+		for i, k := range keys {
+			if k.Name == keyName {
+				promoted := append([]apiserverconfigv1.Key{k}, keys[:i]...)
+				return append(promoted, keys[i+1:]...)
+			}
+		}
+		err = fmt.Errorf("no encryption key with name %q found", keyName)
+		return keys
+	})
+	return err
+}
+
+// RetireEncryptionKeys removes all non-primary keys from all aescbc providers. This must only be done after all
+// resources have been rewritten with the primary key.
+func RetireEncryptionKeys(c *apiserverconfigv1.EncryptionConfiguration) {
+	mutateEncryptionKeys(c, func(keys []apiserverconfigv1.Key) []apiserverconfigv1.Key {
+		if len(keys) > 1 {
+			return keys[:1]
+		}
+		return keys
+	})
+}
+
+func mutateEncryptionKeys(c *apiserverconfigv1.EncryptionConfiguration, mutate func([]apiserverconfigv1.Key) []apiserverconfigv1.Key) {
+	for i := range c.Resources {
+		for j := range c.Resources[i].Providers {
+			if aescbc := c.Resources[i].Providers[j].AESCBC; aescbc != nil {
+				aescbc.Keys = mutate(aescbc.Keys)
+			}
+		}
+	}
+}
+
+// SetEncryptedResources sets the resources which are encrypted with the same providers as secrets. Resources which
+// were encrypted before but are not contained in <resources> anymore are moved to a separate resource configuration
+// which writes them in plaintext but can still decrypt them, so that they can be rewritten. Once all resources have
+// been rewritten with the current configuration (<rewritten> is true), such resources are removed from the
+// configuration. It returns whether resources have been removed.
+func SetEncryptedResources(c *apiserverconfigv1.EncryptionConfiguration, resources []string, rewritten bool) (bool, error) {
+	conf, err := findResourceConfigurationForResource(c.Resources, common.EtcdEncryptionEncryptedResourceSecrets)
+	if err != nil {
+		return false, err
+	}
+
+	var (
+		wanted     = sets.NewString(resources...)
+		decrypting = sets.NewString()
+		removed    bool
+	)
+	wanted.Delete(common.EtcdEncryptionEncryptedResourceSecrets)
+
+	for _, config := range c.Resources {
+		for _, resource := range config.Resources {
+			if wanted.Has(resource) || resource == common.EtcdEncryptionEncryptedResourceSecrets {
+				continue
+			}
+
+			if configContainsResource(conf, resource) {
+				// The resource has been encrypted so far, hence, it must be rewritten in plaintext.
+				decrypting.Insert(resource)
+			} else if rewritten {
+				// The resource has already been rewritten in plaintext.
+				removed = true
+			} else {
+				decrypting.Insert(resource)
+			}
+		}
+	}
+
+	encrypted := apiserverconfigv1.ResourceConfiguration{
+		Resources: append([]string{common.EtcdEncryptionEncryptedResourceSecrets}, wanted.List()...),
+		Providers: conf.Providers,
+	}
+	c.Resources = []apiserverconfigv1.ResourceConfiguration{encrypted}
+
+	if decrypting.Len() > 0 {
+		providers := []apiserverconfigv1.ProviderConfiguration{{Identity: &apiserverconfigv1.IdentityConfiguration{}}}
+		for _, provider := range encrypted.Providers {
+			if isEncryptingProviderConfiguration(&provider) {
+				providers = append(providers, *provider.DeepCopy())
+			}
+		}
+
+		c.Resources = append(c.Resources, apiserverconfigv1.ResourceConfiguration{
+			Resources: decrypting.List(),
+			Providers: providers,
+		})
+	}
+
+	return removed, nil
+}
+
+// SetKMSProvider sets the KMS provider which is used to encrypt the resources configured for secrets. The KMS provider
+// is placed in front of all other encrypting providers so that it is used for encryption while resources which are
+// still encrypted with the aescbc provider remain decryptable.
+func SetKMSProvider(c *apiserverconfigv1.EncryptionConfiguration, kms *apiserverconfigv1.KMSConfiguration) error {
+	index, err := findResourceConfigurationIndexForResource(c.Resources, common.EtcdEncryptionEncryptedResourceSecrets)
+	if err != nil {
+		return err
+	}
+
+	var providers []apiserverconfigv1.ProviderConfiguration
+	for _, provider := range c.Resources[index].Providers {
+		if provider.KMS == nil {
+			providers = append(providers, provider)
+		}
+	}
+
+	position := len(providers)
+	for i := range providers {
+		if isEncryptingProviderConfiguration(&providers[i]) {
+			position = i
+			break
+		}
+	}
+
+	kmsProvider := apiserverconfigv1.ProviderConfiguration{KMS: kms.DeepCopy()}
+	c.Resources[index].Providers = append(providers[:position:position], append([]apiserverconfigv1.ProviderConfiguration{kmsProvider}, providers[position:]...)...)
+	return nil
+}
+
+// NewKMSConfiguration returns the configuration of a KMS provider for the given KMS plugin name and endpoint.
+func NewKMSConfiguration(name, endpoint string, cacheSize *int32, timeout *metav1.Duration) *apiserverconfigv1.KMSConfiguration {
+	kms := &apiserverconfigv1.KMSConfiguration{
+		Name:     name,
+		Endpoint: endpoint,
+		Timeout:  timeout,
+	}
+	if cacheSize != nil {
+		kms.CacheSize = *cacheSize
+	}
+	return kms
+}
+
+// GetResources returns all resources which are contained in the given encryption configuration.
+func GetResources(c *apiserverconfigv1.EncryptionConfiguration) []string {
+	var resources []string
+	for _, config := range c.Resources {
+		resources = append(resources, config.Resources...)
+	}
+	return resources
+}
+
+func configContainsResource(config *apiserverconfigv1.ResourceConfiguration, resource string) bool {
+	for _, r := range config.Resources {
+		if r == resource {
+			return true
+		}
+	}
+	return false
+}
+
 var errConfigurationNotFound = fmt.Errorf("no encryption configuration at %s", common.EtcdEncryptionSecretFileName)
 
 // IsConfigurationNotFoundError checks if the given error is an error when the encryption
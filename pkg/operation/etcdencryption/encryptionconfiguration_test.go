@@ -201,6 +201,185 @@ resources:
 		})
 	})
 
+	Describe("key rotation", func() {
+		var newKey apiserverconfigv1.Key
+
+		BeforeEach(func() {
+			newKey = apiserverconfigv1.Key{Name: NewEncryptionKeyName(t.Add(time.Hour)), Secret: "new"}
+		})
+
+		It("should add a new key as non-primary key", func() {
+			conf := activeConf.DeepCopy()
+			AddEncryptionKey(conf, newKey)
+			AddEncryptionKey(conf, newKey)
+
+			keys, err := GetEncryptionKeys(conf, common.EtcdEncryptionEncryptedResourceSecrets)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(keys).To(Equal([]apiserverconfigv1.Key{aescbcConfiguration.AESCBC.Keys[0], newKey}))
+		})
+
+		It("should promote the new key and retire the old key", func() {
+			conf := activeConf.DeepCopy()
+			AddEncryptionKey(conf, newKey)
+
+			Expect(PromoteEncryptionKey(conf, newKey.Name)).To(Succeed())
+			keys, err := GetEncryptionKeys(conf, common.EtcdEncryptionEncryptedResourceSecrets)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(keys).To(Equal([]apiserverconfigv1.Key{newKey, aescbcConfiguration.AESCBC.Keys[0]}))
+
+			RetireEncryptionKeys(conf)
+			keys, err = GetEncryptionKeys(conf, common.EtcdEncryptionEncryptedResourceSecrets)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(keys).To(Equal([]apiserverconfigv1.Key{newKey}))
+		})
+
+		It("should error if the key to promote does not exist", func() {
+			Expect(PromoteEncryptionKey(activeConf.DeepCopy(), newKey.Name)).To(HaveOccurred())
+		})
+
+		It("should error if there is no aescbc provider for a resource", func() {
+			conf := activeConf.DeepCopy()
+			conf.Resources[0].Providers = []apiserverconfigv1.ProviderConfiguration{identityConfiguration}
+
+			_, err := GetEncryptionKeys(conf, common.EtcdEncryptionEncryptedResourceSecrets)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("#SetEncryptedResources", func() {
+		decryptingConfiguration := func(resources ...string) apiserverconfigv1.ResourceConfiguration {
+			return apiserverconfigv1.ResourceConfiguration{
+				Resources: resources,
+				Providers: []apiserverconfigv1.ProviderConfiguration{identityConfiguration, aescbcConfiguration},
+			}
+		}
+
+		It("should add resources to the secrets configuration", func() {
+			conf := activeConf.DeepCopy()
+
+			removed, err := SetEncryptedResources(conf, []string{"foos.example.com", "configmaps", "secrets"}, false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(removed).To(BeFalse())
+			Expect(conf.Resources).To(Equal([]apiserverconfigv1.ResourceConfiguration{
+				{
+					Resources: []string{"secrets", "configmaps", "foos.example.com"},
+					Providers: []apiserverconfigv1.ProviderConfiguration{aescbcConfiguration, identityConfiguration},
+				},
+			}))
+			Expect(GetResources(conf)).To(Equal([]string{"secrets", "configmaps", "foos.example.com"}))
+		})
+
+		It("should keep removed resources decryptable until they have been rewritten", func() {
+			conf := activeConf.DeepCopy()
+			conf.Resources[0].Resources = []string{"secrets", "configmaps", "foos.example.com"}
+
+			removed, err := SetEncryptedResources(conf, []string{"configmaps"}, true)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(removed).To(BeFalse())
+			Expect(conf.Resources).To(Equal([]apiserverconfigv1.ResourceConfiguration{
+				{
+					Resources: []string{"secrets", "configmaps"},
+					Providers: []apiserverconfigv1.ProviderConfiguration{aescbcConfiguration, identityConfiguration},
+				},
+				decryptingConfiguration("foos.example.com"),
+			}))
+
+			By("waiting until the resources have been rewritten")
+			removed, err = SetEncryptedResources(conf, []string{"configmaps"}, false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(removed).To(BeFalse())
+			Expect(conf.Resources).To(HaveLen(2))
+
+			By("removing the rewritten resources")
+			removed, err = SetEncryptedResources(conf, []string{"configmaps"}, true)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(removed).To(BeTrue())
+			Expect(conf.Resources).To(Equal([]apiserverconfigv1.ResourceConfiguration{
+				{
+					Resources: []string{"secrets", "configmaps"},
+					Providers: []apiserverconfigv1.ProviderConfiguration{aescbcConfiguration, identityConfiguration},
+				},
+			}))
+		})
+
+		It("should encrypt a resource again which is being decrypted", func() {
+			conf := activeConf.DeepCopy()
+			conf.Resources = append(conf.Resources, decryptingConfiguration("configmaps"))
+
+			removed, err := SetEncryptedResources(conf, []string{"configmaps"}, true)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(removed).To(BeFalse())
+			Expect(conf.Resources).To(Equal([]apiserverconfigv1.ResourceConfiguration{
+				{
+					Resources: []string{"secrets", "configmaps"},
+					Providers: []apiserverconfigv1.ProviderConfiguration{aescbcConfiguration, identityConfiguration},
+				},
+			}))
+		})
+
+		It("should error if there is no configuration for secrets", func() {
+			conf := activeConf.DeepCopy()
+			conf.Resources[0].Resources = []string{"configmaps"}
+
+			_, err := SetEncryptedResources(conf, nil, false)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("#SetKMSProvider", func() {
+		var (
+			kms              *apiserverconfigv1.KMSConfiguration
+			kmsConfiguration apiserverconfigv1.ProviderConfiguration
+		)
+
+		BeforeEach(func() {
+			kms = NewKMSConfiguration("foo-kms", "unix:///var/run/kms-plugin/socket.sock", nil, &metav1.Duration{Duration: 5 * time.Second})
+			kmsConfiguration = apiserverconfigv1.ProviderConfiguration{KMS: kms}
+		})
+
+		It("should create the KMS configuration", func() {
+			cacheSize := int32(100)
+			Expect(NewKMSConfiguration("foo-kms", "unix:///foo.sock", &cacheSize, nil)).To(Equal(&apiserverconfigv1.KMSConfiguration{
+				Name:      "foo-kms",
+				Endpoint:  "unix:///foo.sock",
+				CacheSize: 100,
+			}))
+		})
+
+		It("should use the KMS provider for encryption in an active configuration", func() {
+			conf := activeConf.DeepCopy()
+
+			Expect(SetKMSProvider(conf, kms)).To(Succeed())
+			Expect(conf.Resources[0].Providers).To(Equal([]apiserverconfigv1.ProviderConfiguration{kmsConfiguration, aescbcConfiguration, identityConfiguration}))
+		})
+
+		It("should use the KMS provider for encryption once a passive configuration is activated", func() {
+			conf := passiveConf.DeepCopy()
+
+			Expect(SetKMSProvider(conf, kms)).To(Succeed())
+			Expect(conf.Resources[0].Providers).To(Equal([]apiserverconfigv1.ProviderConfiguration{identityConfiguration, kmsConfiguration, aescbcConfiguration}))
+
+			Expect(SetResourceEncryption(conf, common.EtcdEncryptionEncryptedResourceSecrets, true)).To(Succeed())
+			Expect(conf.Resources[0].Providers[0]).To(Equal(kmsConfiguration))
+		})
+
+		It("should update an existing KMS provider", func() {
+			conf := activeConf.DeepCopy()
+			Expect(SetKMSProvider(conf, kms)).To(Succeed())
+
+			updated := NewKMSConfiguration("foo-kms", kms.Endpoint, nil, nil)
+			Expect(SetKMSProvider(conf, updated)).To(Succeed())
+			Expect(conf.Resources[0].Providers).To(Equal([]apiserverconfigv1.ProviderConfiguration{{KMS: updated}, aescbcConfiguration, identityConfiguration}))
+		})
+
+		It("should error if there is no configuration for secrets", func() {
+			conf := activeConf.DeepCopy()
+			conf.Resources[0].Resources = []string{"configmaps"}
+
+			Expect(SetKMSProvider(conf, kms)).NotTo(Succeed())
+		})
+	})
+
 	Describe("#ReadSecret", func() {
 		It("should read the secret and validate it", func() {
 			passiveConf.TypeMeta = typeMeta
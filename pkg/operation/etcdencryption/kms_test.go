@@ -0,0 +1,150 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package encryptionconfiguration_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/gardener/gardener/pkg/operation/common"
+	. "github.com/gardener/gardener/pkg/operation/etcdencryption"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apiserver/pkg/server/options/encryptionconfig"
+	"k8s.io/apiserver/pkg/storage/value"
+	kmsapi "k8s.io/apiserver/pkg/storage/value/encrypt/envelope/v1beta1"
+)
+
+const fakeCipherPrefix = "fake-cipher:"
+
+// fakeKMSServer is a KMS plugin which "encrypts" data by prefixing it.
+type fakeKMSServer struct {
+	mutex    sync.Mutex
+	encrypts int
+}
+
+func (s *fakeKMSServer) Version(_ context.Context, _ *kmsapi.VersionRequest) (*kmsapi.VersionResponse, error) {
+	return &kmsapi.VersionResponse{Version: "v1beta1", RuntimeName: "fake", RuntimeVersion: "0.0.1"}, nil
+}
+
+func (s *fakeKMSServer) Encrypt(_ context.Context, req *kmsapi.EncryptRequest) (*kmsapi.EncryptResponse, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.encrypts++
+	return &kmsapi.EncryptResponse{Cipher: append([]byte(fakeCipherPrefix), req.Plain...)}, nil
+}
+
+func (s *fakeKMSServer) Decrypt(_ context.Context, req *kmsapi.DecryptRequest) (*kmsapi.DecryptResponse, error) {
+	if !bytes.HasPrefix(req.Cipher, []byte(fakeCipherPrefix)) {
+		return nil, fmt.Errorf("cipher was not encrypted by the fake KMS plugin")
+	}
+	return &kmsapi.DecryptResponse{Plain: bytes.TrimPrefix(req.Cipher, []byte(fakeCipherPrefix))}, nil
+}
+
+func (s *fakeKMSServer) encryptCalls() int {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.encrypts
+}
+
+var _ = Describe("KMS provider", func() {
+	var (
+		dir      string
+		endpoint string
+		server   *grpc.Server
+		kms      *fakeKMSServer
+
+		secrets    = schema.GroupResource{Resource: common.EtcdEncryptionEncryptedResourceSecrets}
+		plain      = []byte("top secret")
+		ctx        = value.DefaultContext([]byte("/registry/secrets/default/foo"))
+		kmsTimeout = &metav1.Duration{Duration: 5 * time.Second}
+	)
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "kms-plugin")
+		Expect(err).NotTo(HaveOccurred())
+
+		socket := filepath.Join(dir, "socket.sock")
+		endpoint = "unix://" + socket
+
+		listener, err := net.Listen("unix", socket)
+		Expect(err).NotTo(HaveOccurred())
+
+		kms = &fakeKMSServer{}
+		server = grpc.NewServer()
+		kmsapi.RegisterKeyManagementServiceServer(server, kms)
+		go func() {
+			defer GinkgoRecover()
+			Expect(server.Serve(listener)).To(Succeed())
+		}()
+	})
+
+	AfterEach(func() {
+		server.Stop()
+		Expect(os.RemoveAll(dir)).To(Succeed())
+	})
+
+	transformerFor := func(data []byte) value.Transformer {
+		transformers, err := encryptionconfig.ParseEncryptionConfiguration(bytes.NewReader(data))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(transformers).To(HaveKey(secrets))
+		return transformers[secrets]
+	}
+
+	It("should encrypt with the KMS plugin and still decrypt data encrypted with aescbc", func() {
+		conf, err := NewPassiveConfiguration(time.Now(), bytes.NewReader(bytes.Repeat([]byte{1}, common.EtcdEncryptionKeySecretLen)))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(SetResourceEncryption(conf, common.EtcdEncryptionEncryptedResourceSecrets, true)).To(Succeed())
+
+		data, err := Write(conf)
+		Expect(err).NotTo(HaveOccurred())
+		aescbcCipher, err := transformerFor(data).TransformToStorage(plain, ctx)
+		Expect(err).NotTo(HaveOccurred())
+
+		By("adding the KMS provider")
+		Expect(SetKMSProvider(conf, NewKMSConfiguration("fake", endpoint, nil, kmsTimeout))).To(Succeed())
+		data, err = Write(conf)
+		Expect(err).NotTo(HaveOccurred())
+		transformer := transformerFor(data)
+
+		kmsCipher, err := transformer.TransformToStorage(plain, ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(kmsCipher)).To(HavePrefix("k8s:enc:kms:v1:fake:"))
+		Expect(kms.encryptCalls()).To(Equal(1))
+
+		out, stale, err := transformer.TransformFromStorage(kmsCipher, ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(stale).To(BeFalse())
+		Expect(out).To(Equal(plain))
+
+		By("decrypting data which was encrypted with aescbc")
+		out, stale, err = transformer.TransformFromStorage(aescbcCipher, ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(stale).To(BeTrue())
+		Expect(out).To(Equal(plain))
+	})
+})
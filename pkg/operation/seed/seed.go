@@ -246,6 +246,18 @@ func deployCertificates(seed *Seed, k8sSeedClient kubernetes.Interface, existing
 	return utilsecrets.GenerateClusterSecrets(context.TODO(), k8sSeedClient, existingSecretsMap, wantedSecretsList, v1alpha1constants.GardenNamespace)
 }
 
+// GetEarliestCertificateExpiration returns the expiration of the certificate which expires first among the
+// certificates generated for the Seed cluster, i.e., among its certificate authorities and the certificates signed by
+// them. Other secrets in the garden namespace are not considered as they are not managed by the Gardenlet.
+func GetEarliestCertificateExpiration(ctx context.Context, k8sSeedClient client.Client) (*utilsecrets.CertificateExpiration, error) {
+	secretList := &corev1.SecretList{}
+	if err := k8sSeedClient.List(ctx, secretList, client.InNamespace(v1alpha1constants.GardenNamespace)); err != nil {
+		return nil, err
+	}
+
+	return utilsecrets.GetEarliestCertificateExpiration(utilsecrets.FilterGeneratedCertificateSecrets(secretList.Items, wantedCertificateAuthorities)), nil
+}
+
 // BootstrapCluster bootstraps a Seed cluster and deploys various required manifests.
 func BootstrapCluster(k8sGardenClient kubernetes.Interface, seed *Seed, config *config.GardenletConfiguration, secrets map[string]*corev1.Secret, imageVector imagevector.ImageVector, numberOfAssociatedShoots int) error {
 	const chartName = "seed-bootstrap"
@@ -504,9 +516,10 @@ func BootstrapCluster(k8sGardenClient kubernetes.Interface, seed *Seed, config *
 
 	privateNetworks, err := common.ToExceptNetworks(
 		common.AllPrivateNetworkBlocks(),
-		seed.Info.Spec.Networks.Nodes,
-		seed.Info.Spec.Networks.Pods,
-		seed.Info.Spec.Networks.Services)
+		utils.SplitCIDRs(
+			seed.Info.Spec.Networks.Nodes,
+			seed.Info.Spec.Networks.Pods,
+			seed.Info.Spec.Networks.Services)...)
 	if err != nil {
 		return err
 	}
@@ -16,18 +16,22 @@ package seed_test
 
 import (
 	"context"
+	"time"
 
 	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
 	mockclient "github.com/gardener/gardener/pkg/mock/controller-runtime/client"
 	mock "github.com/gardener/gardener/pkg/mock/gardener/kubernetes"
 	. "github.com/gardener/gardener/pkg/operation/seed"
+	"github.com/gardener/gardener/pkg/utils/secrets"
 
 	"github.com/golang/mock/gomock"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -77,6 +81,36 @@ var _ = Describe("seed", func() {
 		})
 	})
 
+	Describe("#GetEarliestCertificateExpiration", func() {
+		It("should only consider the certificates generated for the seed", func() {
+			ca, err := (&secrets.CertificateSecretConfig{Name: "ca-seed", CommonName: "kubernetes", CertType: secrets.CACert}).GenerateCertificate()
+			Expect(err).NotTo(HaveOccurred())
+			foreignCA, err := (&secrets.CertificateSecretConfig{Name: "foreign", CommonName: "foreign", CertType: secrets.CACert}).GenerateCertificate()
+			Expect(err).NotTo(HaveOccurred())
+
+			validity := time.Hour
+			generated, err := (&secrets.CertificateSecretConfig{Name: "grafana-tls", CommonName: "grafana", CertType: secrets.ServerCert, SigningCA: ca}).GenerateCertificate()
+			Expect(err).NotTo(HaveOccurred())
+			foreign, err := (&secrets.CertificateSecretConfig{Name: "foreign-tls", CommonName: "foreign", CertType: secrets.ServerCert, SigningCA: foreignCA, Validity: &validity}).GenerateCertificate()
+			Expect(err).NotTo(HaveOccurred())
+
+			runtimeClient.EXPECT().List(context.TODO(), gomock.AssignableToTypeOf(&corev1.SecretList{}), gomock.Any()).DoAndReturn(func(_ context.Context, list *corev1.SecretList, _ ...client.ListOption) error {
+				list.Items = []corev1.Secret{
+					{ObjectMeta: metav1.ObjectMeta{Name: "ca-seed"}, Data: ca.SecretData()},
+					{ObjectMeta: metav1.ObjectMeta{Name: "grafana-tls"}, Data: generated.SecretData()},
+					{ObjectMeta: metav1.ObjectMeta{Name: "foreign-tls"}, Data: foreign.SecretData()},
+				}
+				return nil
+			})
+
+			expiration, err := GetEarliestCertificateExpiration(context.TODO(), runtimeClient)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(expiration).NotTo(BeNil())
+			Expect(expiration.SecretName).NotTo(Equal("foreign-tls"))
+		})
+	})
+
 	Describe("#GetValidVolumeSize", func() {
 		It("should return the size because no minimum size was set", func() {
 			var (
@@ -97,9 +97,10 @@ func mustIncreaseGeneration(oldShoot, newShoot *garden.Shoot) bool {
 				if val == common.ShootOperationReconcile {
 					mustIncrease = true
 				}
-				if val == common.ShootOperationRotateKubeconfigCredentials {
+				if val == common.ShootOperationRotateKubeconfigCredentials || val == common.ShootOperationRotateCAStart || val == common.ShootOperationRotateCAComplete || val == common.ShootOperationRotateETCDEncryptionKey ||
+					val == common.ShootOperationTakeETCDSnapshot || val == common.ShootOperationRestoreETCD {
 					// We don't want to remove the annotation so that the controller-manager can pick it up and rotate
-					// the credentials. It has to remove the annotation after it is done.
+					// the credentials or operate on the etcd backup. It has to remove the annotation after it is done.
 					return true
 				}
 			}
@@ -305,6 +305,8 @@ func networksAreDisjointed(seed *gardencorev1alpha1.Seed, shoot *gardencorev1alp
 		errorMessages []string
 	)
 
+	errs = append(errs, schedulerutils.ValidateIPFamilies(seed.Spec.Networks.IPFamilies, shoot.Spec.Networking.IPFamilies, field.NewPath("ipFamilies"))...)
+
 	for _, e := range errs {
 		errorMessages = append(errorMessages, e.ErrorBody())
 	}
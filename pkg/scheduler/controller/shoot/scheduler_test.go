@@ -332,6 +332,18 @@ var _ = Describe("Scheduler_Control", func() {
 			Expect(bestSeed).To(BeNil())
 		})
 
+		It("should fail because it cannot find a seed cluster supporting the IP families of the shoot", func() {
+			gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+			gardenCoreInformerFactory.Core().V1alpha1().Seeds().Informer().GetStore().Add(&seed)
+
+			shoot.Spec.Networking.IPFamilies = []gardencorev1alpha1.IPFamily{gardencorev1alpha1.IPFamilyIPv4, gardencorev1alpha1.IPFamilyIPv6}
+
+			bestSeed, err := determineSeed(&shoot, gardenCoreInformerFactory.Core().V1alpha1().Seeds().Lister(), gardenCoreInformerFactory.Core().V1alpha1().Shoots().Lister(), gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Lister(), schedulerConfiguration.Schedulers.Shoot.Strategy)
+
+			Expect(err).To(HaveOccurred())
+			Expect(bestSeed).To(BeNil())
+		})
+
 		It("should fail because it cannot find a seed cluster due to region that no seed supports", func() {
 			gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
 			gardenCoreInformerFactory.Core().V1alpha1().Seeds().Informer().GetStore().Add(&seed)
@@ -15,9 +15,13 @@
 package utils
 
 import (
+	"fmt"
+
 	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
 	"github.com/gardener/gardener/pkg/utils"
 
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 )
 
@@ -35,7 +39,7 @@ func ValidateNetworkDisjointedness(seedNetworks gardencorev1alpha1.SeedNetworks,
 		if utils.NetworksIntersect(seedNetworks.Nodes, shootNodes) {
 			allErrs = append(allErrs, field.Invalid(pathNodes, shootNodes, "shoot node network intersects with seed node network"))
 		}
-	} else {
+	} else if seedNetworks.ShootPools == nil || seedNetworks.ShootPools.Nodes == nil {
 		allErrs = append(allErrs, field.Required(pathNodes, "no shoot node network specified"))
 	}
 
@@ -51,9 +55,28 @@ func ValidateNetworkDisjointedness(seedNetworks gardencorev1alpha1.SeedNetworks,
 		if utils.NetworksIntersect(seedNetworks.Pods, *shootPods) {
 			allErrs = append(allErrs, field.Invalid(pathPods, *shootPods, "shoot pod network intersects with seed pod network"))
 		}
-	} else if seedNetworks.ShootDefaults == nil || seedNetworks.ShootDefaults.Pods == nil {
+	} else if (seedNetworks.ShootDefaults == nil || seedNetworks.ShootDefaults.Pods == nil) && (seedNetworks.ShootPools == nil || seedNetworks.ShootPools.Pods == nil) {
 		allErrs = append(allErrs, field.Required(pathPods, "no shoot pod network specified"))
 	}
 
 	return allErrs
 }
+
+// ValidateIPFamilies validates that the Seed supports all IP families of the Shoot. Empty lists of IP families mean
+// IPv4 only.
+func ValidateIPFamilies(seedIPFamilies, shootIPFamilies []gardencorev1alpha1.IPFamily, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	supported := sets.NewString()
+	for _, ipFamily := range gardencorev1alpha1helper.GetIPFamilies(seedIPFamilies) {
+		supported.Insert(string(ipFamily))
+	}
+
+	for _, ipFamily := range gardencorev1alpha1helper.GetIPFamilies(shootIPFamilies) {
+		if !supported.Has(string(ipFamily)) {
+			allErrs = append(allErrs, field.Invalid(fldPath, ipFamily, fmt.Sprintf("IP family is not supported by the seed (supported: %v)", supported.List())))
+		}
+	}
+
+	return allErrs
+}
@@ -15,7 +15,9 @@
 package utils_test
 
 import (
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
 	"github.com/gardener/gardener/pkg/apis/garden"
+	schedulerutils "github.com/gardener/gardener/pkg/scheduler/utils"
 	. "github.com/gardener/gardener/plugin/pkg/utils"
 
 	"k8s.io/apimachinery/pkg/util/validation/field"
@@ -106,5 +108,46 @@ var _ = Describe("utils", func() {
 				"Field": Equal("[].pods"),
 			}))))
 		})
+
+		It("should not require networks which can be allocated from the pools of the seed", func() {
+			var (
+				servicesCIDR = "10.242.0.0/17"
+
+				seedNetworks = gardencorev1alpha1.SeedNetworks{
+					Pods:     seedPodsCIDR,
+					Services: seedServicesCIDR,
+					Nodes:    seedNodesCIDR,
+					ShootPools: &gardencorev1alpha1.ShootNetworkPools{
+						Nodes: &gardencorev1alpha1.NetworkPool{CIDR: "10.16.0.0/12", PrefixLength: 20},
+						Pods:  &gardencorev1alpha1.NetworkPool{CIDR: "100.64.0.0/10", PrefixLength: 16},
+					},
+				}
+			)
+
+			errorList := schedulerutils.ValidateNetworkDisjointedness(seedNetworks, "", nil, &servicesCIDR, field.NewPath(""))
+
+			Expect(errorList).To(BeEmpty())
+		})
+	})
+
+	Describe("#ValidateIPFamilies", func() {
+		It("should pass the validation", func() {
+			Expect(schedulerutils.ValidateIPFamilies(nil, nil, field.NewPath("ipFamilies"))).To(BeEmpty())
+			Expect(schedulerutils.ValidateIPFamilies(
+				[]gardencorev1alpha1.IPFamily{gardencorev1alpha1.IPFamilyIPv6, gardencorev1alpha1.IPFamilyIPv4},
+				[]gardencorev1alpha1.IPFamily{gardencorev1alpha1.IPFamilyIPv4},
+				field.NewPath("ipFamilies"),
+			)).To(BeEmpty())
+		})
+
+		It("should fail due to IP families not supported by the seed", func() {
+			errorList := schedulerutils.ValidateIPFamilies(nil, []gardencorev1alpha1.IPFamily{gardencorev1alpha1.IPFamilyIPv6}, field.NewPath("ipFamilies"))
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":     Equal(field.ErrorTypeInvalid),
+				"Field":    Equal("ipFamilies"),
+				"BadValue": Equal(gardencorev1alpha1.IPFamilyIPv6),
+			}))))
+		})
 	})
 })
@@ -18,6 +18,7 @@ import (
 	"io/ioutil"
 	"net"
 	"regexp"
+	"strings"
 	"time"
 
 	"github.com/sirupsen/logrus"
@@ -128,9 +129,33 @@ func TestEmail(email string) bool {
 	return match
 }
 
-// NetworksIntersect returns true if the given network CIDRs intersect.
+// NetworksIntersect returns true if the given network CIDRs intersect. Each of the given values may contain multiple
+// CIDRs separated by a comma (dual-stack networks); they intersect if any of their CIDRs intersect. Networks of
+// different IP families never intersect.
 func NetworksIntersect(cidr1, cidr2 string) bool {
-	_, net1, err1 := net.ParseCIDR(cidr1)
-	_, net2, err2 := net.ParseCIDR(cidr2)
-	return err1 != nil || err2 != nil || net2.Contains(net1.IP) || net1.Contains(net2.IP)
+	for _, c1 := range strings.Split(cidr1, ",") {
+		for _, c2 := range strings.Split(cidr2, ",") {
+			_, net1, err1 := net.ParseCIDR(strings.TrimSpace(c1))
+			_, net2, err2 := net.ParseCIDR(strings.TrimSpace(c2))
+			if err1 != nil || err2 != nil || net2.Contains(net1.IP) || net1.Contains(net2.IP) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// SplitCIDRs splits the given values, each of which may contain multiple CIDRs separated by a comma (dual-stack
+// networks), into a flat list of single CIDRs. Empty values are skipped.
+func SplitCIDRs(cidrs ...string) []string {
+	var out []string
+	for _, value := range cidrs {
+		if len(value) == 0 {
+			continue
+		}
+		for _, cidr := range strings.Split(value, ",") {
+			out = append(out, strings.TrimSpace(cidr))
+		}
+	}
+	return out
 }
@@ -57,4 +57,32 @@ var _ = Describe("utils", func() {
 			}))
 		})
 	})
+
+	Describe("#SplitCIDRs", func() {
+		It("should split and flatten the given CIDRs", func() {
+			Expect(SplitCIDRs("10.0.0.0/8", "", "100.64.0.0/13, fd00::/108")).To(Equal([]string{"10.0.0.0/8", "100.64.0.0/13", "fd00::/108"}))
+		})
+	})
+
+	Describe("#NetworksIntersect", func() {
+		It("should detect intersecting networks", func() {
+			Expect(NetworksIntersect("10.0.0.0/8", "10.1.0.0/16")).To(BeTrue())
+			Expect(NetworksIntersect("fd00::/64", "fd00::/108")).To(BeTrue())
+		})
+
+		It("should detect intersecting dual-stack networks", func() {
+			Expect(NetworksIntersect("10.0.0.0/16,fd00::/64", "10.1.0.0/16,fd00::/108")).To(BeTrue())
+		})
+
+		It("should not consider disjoint networks or networks of different IP families as intersecting", func() {
+			Expect(NetworksIntersect("10.0.0.0/16", "10.1.0.0/16")).To(BeFalse())
+			Expect(NetworksIntersect("10.0.0.0/16,fd00::/64", "10.1.0.0/16,fd01::/64")).To(BeFalse())
+			Expect(NetworksIntersect("0.0.0.0/0", "::/0")).To(BeFalse())
+		})
+
+		It("should consider invalid networks as intersecting", func() {
+			Expect(NetworksIntersect("10.0.0.0/16", "foo")).To(BeTrue())
+			Expect(NetworksIntersect("10.0.0.0/16", "")).To(BeTrue())
+		})
+	})
 })
@@ -0,0 +1,112 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secrets
+
+import (
+	"bytes"
+	"encoding/pem"
+	"fmt"
+
+	"github.com/gardener/gardener/pkg/utils"
+)
+
+// RotateCertificateAuthority generates a new certificate authority based on the given configuration and returns the
+// data of a CA secret which contains the new CA for signing and the given current CA as previous CA. The CA
+// certificate in the returned data is a bundle of both certificates so that consumers trust certificates signed by
+// either of them.
+func RotateCertificateAuthority(config *CertificateSecretConfig, current *Certificate) (map[string][]byte, error) {
+	newCA, err := config.GenerateCertificate()
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		newCertificatePEM     = firstCertificatePEM(newCA.CertificatePEM)
+		currentCertificatePEM = firstCertificatePEM(current.CertificatePEM)
+	)
+
+	return map[string][]byte{
+		DataKeyCertificateCA:         append(append([]byte{}, newCertificatePEM...), currentCertificatePEM...),
+		DataKeyPrivateKeyCA:          newCA.PrivateKeyPEM,
+		DataKeyCertificateCASigning:  newCertificatePEM,
+		DataKeyCertificateCAPrevious: currentCertificatePEM,
+		DataKeyPrivateKeyCAPrevious:  current.PrivateKeyPEM,
+	}, nil
+}
+
+// CompleteCertificateAuthorityRotation returns the data of a CA secret which only contains the given current CA, i.e.
+// the previous CA is dropped from the CA certificate bundle.
+func CompleteCertificateAuthorityRotation(current *Certificate) map[string][]byte {
+	return (&Certificate{
+		CertificatePEM: firstCertificatePEM(current.CertificatePEM),
+		PrivateKeyPEM:  current.PrivateKeyPEM,
+	}).SecretData()
+}
+
+// LoadCertificateAuthorities loads the current and, if the CA is being rotated, the previous CA from the given CA
+// secret data. The PEM certificates of both returned CAs are the CA certificate bundle of the secret so that all
+// certificates signed by them carry the full bundle. The previous CA is nil if the CA is not being rotated.
+func LoadCertificateAuthorities(name string, data map[string][]byte) (*Certificate, *Certificate, error) {
+	current, err := LoadCertificate(name, data[DataKeyPrivateKeyCA], data[DataKeyCertificateCA])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(data[DataKeyCertificateCAPrevious]) == 0 {
+		return current, nil, nil
+	}
+
+	previous, err := LoadCertificate(name, data[DataKeyPrivateKeyCAPrevious], data[DataKeyCertificateCAPrevious])
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not load previous certificate authority %q: %v", name, err)
+	}
+	previous.CertificatePEM = data[DataKeyCertificateCA]
+
+	return current, previous, nil
+}
+
+// IsOutdated returns true if the given data of an existing secret was not generated for the signing CA of the
+// configuration, i.e. if its CA certificate bundle differs or if its certificate was signed by another CA.
+func (s *CertificateSecretConfig) IsOutdated(data map[string][]byte) bool {
+	if s.SigningCA == nil {
+		return false
+	}
+	if !bytes.Equal(data[DataKeyCertificateCA], s.SigningCA.CertificatePEM) {
+		return true
+	}
+
+	certificatePEM, ok := data[DataKeyCertificate]
+	if !ok {
+		certificatePEM, ok = data[fmt.Sprintf("%s.crt", s.Name)]
+	}
+	if !ok || s.SigningCA.Certificate == nil || len(s.SigningCA.Certificate.Raw) == 0 {
+		return false
+	}
+
+	certificate, err := utils.DecodeCertificate(certificatePEM)
+	if err != nil {
+		return true
+	}
+	return certificate.CheckSignatureFrom(s.SigningCA.Certificate) != nil
+}
+
+// firstCertificatePEM returns the first PEM block of the given certificate bundle.
+func firstCertificatePEM(bundle []byte) []byte {
+	block, _ := pem.Decode(bundle)
+	if block == nil {
+		return bundle
+	}
+	return pem.EncodeToMemory(block)
+}
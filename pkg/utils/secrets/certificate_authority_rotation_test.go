@@ -0,0 +1,132 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secrets_test
+
+import (
+	"bytes"
+
+	. "github.com/gardener/gardener/pkg/utils/secrets"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("certificate authority rotation", func() {
+	var (
+		caConfig = &CertificateSecretConfig{
+			Name:       "ca",
+			CommonName: "kubernetes",
+			CertType:   CACert,
+		}
+
+		currentCA *Certificate
+	)
+
+	BeforeEach(func() {
+		generated, err := caConfig.GenerateCertificate()
+		Expect(err).NotTo(HaveOccurred())
+
+		currentCA, _, err = LoadCertificateAuthorities("ca", generated.SecretData())
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	Describe("#RotateCertificateAuthority", func() {
+		It("should add a new CA and keep the current CA as previous CA", func() {
+			data, err := RotateCertificateAuthority(caConfig, currentCA)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(data[DataKeyCertificateCAPrevious]).To(Equal(currentCA.CertificatePEM))
+			Expect(data[DataKeyPrivateKeyCAPrevious]).To(Equal(currentCA.PrivateKeyPEM))
+			Expect(data[DataKeyCertificateCASigning]).NotTo(Equal(currentCA.CertificatePEM))
+			Expect(data[DataKeyCertificateCA]).To(Equal(append(append([]byte{}, data[DataKeyCertificateCASigning]...), currentCA.CertificatePEM...)))
+
+			current, previous, err := LoadCertificateAuthorities("ca", data)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(current.PrivateKeyPEM).To(Equal(data[DataKeyPrivateKeyCA]))
+			Expect(previous.PrivateKeyPEM).To(Equal(currentCA.PrivateKeyPEM))
+			Expect(current.CertificatePEM).To(Equal(data[DataKeyCertificateCA]))
+			Expect(previous.CertificatePEM).To(Equal(data[DataKeyCertificateCA]))
+		})
+	})
+
+	Describe("#CompleteCertificateAuthorityRotation", func() {
+		It("should drop the previous CA", func() {
+			rotated, err := RotateCertificateAuthority(caConfig, currentCA)
+			Expect(err).NotTo(HaveOccurred())
+			current, _, err := LoadCertificateAuthorities("ca", rotated)
+			Expect(err).NotTo(HaveOccurred())
+
+			data := CompleteCertificateAuthorityRotation(current)
+
+			Expect(data).To(Equal(map[string][]byte{
+				DataKeyCertificateCA:        rotated[DataKeyCertificateCASigning],
+				DataKeyCertificateCASigning: rotated[DataKeyCertificateCASigning],
+				DataKeyPrivateKeyCA:         rotated[DataKeyPrivateKeyCA],
+			}))
+
+			_, previous, err := LoadCertificateAuthorities("ca", data)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(previous).To(BeNil())
+		})
+	})
+
+	Describe("#IsOutdated", func() {
+		var config *CertificateSecretConfig
+
+		BeforeEach(func() {
+			config = &CertificateSecretConfig{
+				Name:       "kube-apiserver",
+				CommonName: "kube-apiserver",
+				CertType:   ServerCert,
+				SigningCA:  currentCA,
+			}
+		})
+
+		It("should return false for a certificate signed by the signing CA", func() {
+			certificate, err := config.GenerateCertificate()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(config.IsOutdated(certificate.SecretData())).To(BeFalse())
+		})
+
+		It("should return true if the CA bundle changed", func() {
+			certificate, err := config.GenerateCertificate()
+			Expect(err).NotTo(HaveOccurred())
+
+			rotated, err := RotateCertificateAuthority(caConfig, currentCA)
+			Expect(err).NotTo(HaveOccurred())
+			_, config.SigningCA, err = LoadCertificateAuthorities("ca", rotated)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(config.IsOutdated(certificate.SecretData())).To(BeTrue())
+		})
+
+		It("should return true if the certificate was signed by another CA", func() {
+			rotated, err := RotateCertificateAuthority(caConfig, currentCA)
+			Expect(err).NotTo(HaveOccurred())
+			current, previous, err := LoadCertificateAuthorities("ca", rotated)
+			Expect(err).NotTo(HaveOccurred())
+
+			config.SigningCA = previous
+			certificate, err := config.GenerateCertificate()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(config.IsOutdated(certificate.SecretData())).To(BeFalse())
+
+			config.SigningCA = current
+			Expect(config.IsOutdated(certificate.SecretData())).To(BeTrue())
+			Expect(bytes.Equal(certificate.SecretData()[DataKeyCertificateCA], current.CertificatePEM)).To(BeTrue())
+		})
+	})
+})
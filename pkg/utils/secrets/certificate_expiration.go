@@ -0,0 +1,98 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secrets
+
+import (
+	"bytes"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gardener/gardener/pkg/utils"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// CertificateExpiration describes when a certificate stored in a secret expires.
+type CertificateExpiration struct {
+	// SecretName is the name of the secret holding the certificate.
+	SecretName string
+	// DataKey is the key in the secret data holding the certificate.
+	DataKey string
+	// CommonName is the common name of the certificate.
+	CommonName string
+	// NotAfter is the time after which the certificate is no longer valid.
+	NotAfter time.Time
+}
+
+// FilterGeneratedCertificateSecrets returns the given secrets which belong to the given certificate authorities, i.e.
+// the CA secrets themselves and all secrets whose CA certificate (bundle) equals the one of a CA secret. Other secrets,
+// e.g. secrets which were not generated by Gardener, are filtered out.
+func FilterGeneratedCertificateSecrets(secrets []corev1.Secret, certificateAuthorities map[string]*CertificateSecretConfig) []corev1.Secret {
+	var certificateAuthorityBundles [][]byte
+	for _, secret := range secrets {
+		if _, ok := certificateAuthorities[secret.Name]; ok && len(secret.Data[DataKeyCertificateCA]) > 0 {
+			certificateAuthorityBundles = append(certificateAuthorityBundles, secret.Data[DataKeyCertificateCA])
+		}
+	}
+
+	var generatedSecrets []corev1.Secret
+	for _, secret := range secrets {
+		for _, bundle := range certificateAuthorityBundles {
+			if bytes.Equal(secret.Data[DataKeyCertificateCA], bundle) {
+				generatedSecrets = append(generatedSecrets, secret)
+				break
+			}
+		}
+	}
+
+	return generatedSecrets
+}
+
+// GetEarliestCertificateExpiration returns the expiration of the certificate which expires first among all
+// certificates stored in the given secrets. Every secret data key with the suffix '.crt' is considered, except for the
+// previous CA of a certificate authority rotation. Data which cannot be decoded to a certificate is ignored. It
+// returns nil if the secrets do not contain any certificate.
+func GetEarliestCertificateExpiration(secrets []corev1.Secret) *CertificateExpiration {
+	var earliest *CertificateExpiration
+
+	for _, secret := range secrets {
+		keys := make([]string, 0, len(secret.Data))
+		for key := range secret.Data {
+			if strings.HasSuffix(key, ".crt") && key != DataKeyCertificateCAPrevious {
+				keys = append(keys, key)
+			}
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			certificate, err := utils.DecodeCertificate(secret.Data[key])
+			if err != nil {
+				continue
+			}
+
+			if earliest == nil || certificate.NotAfter.Before(earliest.NotAfter) {
+				earliest = &CertificateExpiration{
+					SecretName: secret.Name,
+					DataKey:    key,
+					CommonName: certificate.Subject.CommonName,
+					NotAfter:   certificate.NotAfter,
+				}
+			}
+		}
+	}
+
+	return earliest
+}
@@ -0,0 +1,112 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secrets_test
+
+import (
+	"time"
+
+	. "github.com/gardener/gardener/pkg/utils/secrets"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("certificate expiration", func() {
+	Describe("#GetEarliestCertificateExpiration", func() {
+		var (
+			ca     *Certificate
+			server *Certificate
+		)
+
+		BeforeEach(func() {
+			var err error
+
+			ca, err = (&CertificateSecretConfig{
+				Name:       "ca",
+				CommonName: "kubernetes",
+				CertType:   CACert,
+			}).GenerateCertificate()
+			Expect(err).NotTo(HaveOccurred())
+
+			validity := 24 * time.Hour
+			server, err = (&CertificateSecretConfig{
+				Name:       "kube-apiserver",
+				CommonName: "kube-apiserver",
+				CertType:   ServerCert,
+				SigningCA:  ca,
+				Validity:   &validity,
+			}).GenerateCertificate()
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should return nil if there are no certificates", func() {
+			Expect(GetEarliestCertificateExpiration(nil)).To(BeNil())
+			Expect(GetEarliestCertificateExpiration([]corev1.Secret{
+				{ObjectMeta: metav1.ObjectMeta{Name: "basic-auth"}, Data: map[string][]byte{"password": []byte("foo")}},
+				{ObjectMeta: metav1.ObjectMeta{Name: "invalid"}, Data: map[string][]byte{"tls.crt": []byte("foo")}},
+			})).To(BeNil())
+		})
+
+		It("should return the certificate which expires first", func() {
+			expiration := GetEarliestCertificateExpiration([]corev1.Secret{
+				{ObjectMeta: metav1.ObjectMeta{Name: "ca"}, Data: ca.SecretData()},
+				{ObjectMeta: metav1.ObjectMeta{Name: "kube-apiserver"}, Data: server.SecretData()},
+			})
+
+			Expect(expiration).NotTo(BeNil())
+			Expect(expiration.SecretName).To(Equal("kube-apiserver"))
+			Expect(expiration.DataKey).To(Equal(DataKeyCertificate))
+			Expect(expiration.CommonName).To(Equal("kube-apiserver"))
+			Expect(expiration.NotAfter).To(Equal(server.Certificate.NotAfter.UTC().Truncate(time.Second)))
+		})
+
+		It("should ignore the previous CA of a certificate authority rotation", func() {
+			expiration := GetEarliestCertificateExpiration([]corev1.Secret{
+				{ObjectMeta: metav1.ObjectMeta{Name: "ca"}, Data: map[string][]byte{
+					DataKeyCertificateCA:         ca.CertificatePEM,
+					DataKeyCertificateCAPrevious: server.CertificatePEM,
+				}},
+			})
+
+			Expect(expiration).NotTo(BeNil())
+			Expect(expiration.DataKey).To(Equal(DataKeyCertificateCA))
+			Expect(expiration.NotAfter).To(Equal(ca.Certificate.NotAfter.UTC().Truncate(time.Second)))
+		})
+	})
+
+	Describe("#FilterGeneratedCertificateSecrets", func() {
+		It("should only return the CA secrets and the secrets signed by them", func() {
+			ca, err := (&CertificateSecretConfig{Name: "ca", CommonName: "kubernetes", CertType: CACert}).GenerateCertificate()
+			Expect(err).NotTo(HaveOccurred())
+			server, err := (&CertificateSecretConfig{Name: "kube-apiserver", CommonName: "kube-apiserver", CertType: ServerCert, SigningCA: ca}).GenerateCertificate()
+			Expect(err).NotTo(HaveOccurred())
+			foreignCA, err := (&CertificateSecretConfig{Name: "foreign", CommonName: "foreign", CertType: CACert}).GenerateCertificate()
+			Expect(err).NotTo(HaveOccurred())
+
+			var (
+				caSecret      = corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "ca"}, Data: ca.SecretData()}
+				serverSecret  = corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "kube-apiserver"}, Data: server.SecretData()}
+				foreignSecret = corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "foreign"}, Data: foreignCA.SecretData()}
+			)
+
+			Expect(FilterGeneratedCertificateSecrets(
+				[]corev1.Secret{caSecret, serverSecret, foreignSecret},
+				map[string]*CertificateSecretConfig{"ca": {Name: "ca"}},
+			)).To(ConsistOf(caSecret, serverSecret))
+		})
+	})
+})
@@ -16,6 +16,7 @@ package secrets
 
 import (
 	"context"
+	"crypto"
 	"crypto/rand"
 	"crypto/rsa"
 	"crypto/x509"
@@ -30,8 +31,10 @@ import (
 	"github.com/gardener/gardener/pkg/utils"
 	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 )
 
 type certType string
@@ -54,6 +57,15 @@ const (
 	DataKeyCertificateCA = "ca.crt"
 	// DataKeyPrivateKeyCA is the key in a secret data holding the CA private key.
 	DataKeyPrivateKeyCA = "ca.key"
+	// DataKeyCertificateCASigning is the key in a secret data holding the certificate of the CA which is used for
+	// signing. In contrast to DataKeyCertificateCA it never contains a bundle of multiple certificates.
+	DataKeyCertificateCASigning = "ca-signing.crt"
+	// DataKeyCertificateCAPrevious is the key in a secret data holding the certificate of the previous CA while the CA
+	// is being rotated.
+	DataKeyCertificateCAPrevious = "ca-previous.crt"
+	// DataKeyPrivateKeyCAPrevious is the key in a secret data holding the private key of the previous CA while the CA
+	// is being rotated.
+	DataKeyPrivateKeyCAPrevious = "ca-previous.key"
 )
 
 const (
@@ -64,7 +76,7 @@ const (
 )
 
 // CertificateSecretConfig contains the specification a to-be-generated CA, server, or client certificate.
-// It always contains a 2048-bit RSA private key.
+// It contains a 2048-bit RSA private key unless another key algorithm is configured.
 type CertificateSecretConfig struct {
 	Name string
 
@@ -73,9 +85,10 @@ type CertificateSecretConfig struct {
 	DNSNames     []string
 	IPAddresses  []net.IP
 
-	CertType  certType
-	SigningCA *Certificate
-	PKCS      int
+	CertType     certType
+	SigningCA    *Certificate
+	PKCS         int
+	KeyAlgorithm KeyAlgorithm
 
 	Validity *time.Duration
 }
@@ -87,7 +100,11 @@ type Certificate struct {
 
 	CA *Certificate
 
-	PrivateKey    *rsa.PrivateKey
+	// PrivateKey is the RSA private key of the certificate. It is nil if the key is not an RSA key.
+	// Deprecated: Use Signer instead which supports all key algorithms.
+	PrivateKey *rsa.PrivateKey
+	// Signer is the private key of the certificate of any supported algorithm.
+	Signer        crypto.Signer
 	PrivateKeyPEM []byte
 
 	Certificate    *x509.Certificate
@@ -113,7 +130,7 @@ func (s *CertificateSecretConfig) GenerateCertificate() (*Certificate, error) {
 
 	// If no cert type is given then we only return a certificate object that contains the CA.
 	if s.CertType != "" {
-		privateKey, err := generateRSAPrivateKey(2048)
+		privateKey, err := generatePrivateKey(s.KeyAlgorithm, 2048)
 		if err != nil {
 			return nil, err
 		}
@@ -124,28 +141,28 @@ func (s *CertificateSecretConfig) GenerateCertificate() (*Certificate, error) {
 			privateKeySigner  = privateKey
 		)
 
+		if _, ok := privateKey.(*rsa.PrivateKey); !ok {
+			// Key encipherment is only applicable to RSA keys.
+			certificate.KeyUsage &^= x509.KeyUsageKeyEncipherment
+		}
+
 		if s.SigningCA != nil {
 			certificateSigner = s.SigningCA.Certificate
-			privateKeySigner = s.SigningCA.PrivateKey
+			privateKeySigner = s.SigningCA.signer()
 		}
 
-		certificatePEM, err := signCertificate(certificate, privateKey, certificateSigner, privateKeySigner)
+		certificatePEM, err := signCertificate(certificate, privateKey.Public(), certificateSigner, privateKeySigner)
 		if err != nil {
 			return nil, err
 		}
 
-		var pk []byte
-		if s.PKCS == PKCS1 {
-			pk = utils.EncodePrivateKey(privateKey)
-		} else if s.PKCS == PKCS8 {
-			pk, err = utils.EncodePrivateKeyInPKCS8(privateKey)
-
-			if err != nil {
-				return nil, err
-			}
+		pk, err := encodePrivateKey(privateKey, s.PKCS)
+		if err != nil {
+			return nil, err
 		}
 
-		certificateObj.PrivateKey = privateKey
+		certificateObj.Signer = privateKey
+		certificateObj.PrivateKey, _ = privateKey.(*rsa.PrivateKey)
 		certificateObj.PrivateKeyPEM = pk
 		certificateObj.Certificate = certificate
 		certificateObj.CertificatePEM = certificatePEM
@@ -154,6 +171,18 @@ func (s *CertificateSecretConfig) GenerateCertificate() (*Certificate, error) {
 	return certificateObj, nil
 }
 
+// signer returns the private key of the certificate. It falls back to the deprecated RSA private key for certificates
+// which were constructed without a signer.
+func (c *Certificate) signer() crypto.Signer {
+	if c.Signer != nil {
+		return c.Signer
+	}
+	if c.PrivateKey != nil {
+		return c.PrivateKey
+	}
+	return nil
+}
+
 // SecretData computes the data map which can be used in a Kubernetes secret.
 func (c *Certificate) SecretData() map[string][]byte {
 	data := map[string][]byte{}
@@ -164,6 +193,7 @@ func (c *Certificate) SecretData() map[string][]byte {
 		// compatibility).
 		data[DataKeyCertificateCA] = c.CertificatePEM
 		data[DataKeyPrivateKeyCA] = c.PrivateKeyPEM
+		data[DataKeyCertificateCASigning] = firstCertificatePEM(c.CertificatePEM)
 	case c.CA != nil:
 		// The certificate is not a CA certificate, so we add the signing CA certificate to it and use different
 		// keys in the secret data.
@@ -178,7 +208,7 @@ func (c *Certificate) SecretData() map[string][]byte {
 // LoadCertificate takes a byte slice representation of a certificate and the corresponding private key, and returns its de-serialized private
 // key, certificate template and PEM certificate which can be used to sign other x509 certificates.
 func LoadCertificate(name string, privateKeyPEM, certificatePEM []byte) (*Certificate, error) {
-	privateKey, err := utils.DecodePrivateKey(privateKeyPEM)
+	privateKey, err := decodePrivateKey(privateKeyPEM)
 	if err != nil {
 		return nil, err
 	}
@@ -187,10 +217,13 @@ func LoadCertificate(name string, privateKeyPEM, certificatePEM []byte) (*Certif
 		return nil, err
 	}
 
+	rsaPrivateKey, _ := privateKey.(*rsa.PrivateKey)
+
 	return &Certificate{
 		Name: name,
 
-		PrivateKey:    privateKey,
+		PrivateKey:    rsaPrivateKey,
+		Signer:        privateKey,
 		PrivateKeyPEM: privateKeyPEM,
 
 		Certificate:    certificate,
@@ -259,10 +292,10 @@ func (s *CertificateSecretConfig) generateCertificateTemplate() *x509.Certificat
 }
 
 // SignCertificate takes a <certificateTemplate> and a <certificateTemplateSigner> which is used to sign
-// the first. It also requires the corresponding private keys of both certificates. The created certificate
-// is returned as byte slice.
-func signCertificate(certificateTemplate *x509.Certificate, privateKey *rsa.PrivateKey, certificateTemplateSigner *x509.Certificate, privateKeySigner *rsa.PrivateKey) ([]byte, error) {
-	certificate, err := x509.CreateCertificate(rand.Reader, certificateTemplate, certificateTemplateSigner, &privateKey.PublicKey, privateKeySigner)
+// the first. It also requires the public key of the first and the private key of the signer. The created
+// certificate is returned as byte slice.
+func signCertificate(certificateTemplate *x509.Certificate, publicKey crypto.PublicKey, certificateTemplateSigner *x509.Certificate, privateKeySigner crypto.Signer) ([]byte, error) {
+	certificate, err := x509.CreateCertificate(rand.Reader, certificateTemplate, certificateTemplateSigner, publicKey, privateKeySigner)
 	if err != nil {
 		return nil, err
 	}
@@ -355,3 +388,101 @@ func GenerateCertificateAuthorities(k8sClusterClient kubernetes.Interface, exist
 
 	return generatedSecrets, certificateAuthorities, nil
 }
+
+// GenerateCertificateAuthoritiesInStore loads the wanted certificate authorities from the given store. Certificate
+// authorities which do not exist in the store yet are generated and stored. It returns a map with the wanted CA
+// certificates.
+func GenerateCertificateAuthoritiesInStore(ctx context.Context, store Store, wantedCertificateAuthorities map[string]*CertificateSecretConfig, namespace string) (map[string]*Certificate, error) {
+	certificateAuthorityData, err := GenerateCertificateAuthorityDataInStore(ctx, store, wantedCertificateAuthorities, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	certificateAuthorities := make(map[string]*Certificate, len(certificateAuthorityData))
+	for name, data := range certificateAuthorityData {
+		certificate, err := LoadCertificate(name, data[DataKeyPrivateKeyCA], data[DataKeyCertificateCA])
+		if err != nil {
+			return nil, err
+		}
+		certificateAuthorities[name] = certificate
+	}
+
+	return certificateAuthorities, nil
+}
+
+// GenerateCertificateAuthorityDataInStore loads the data of the wanted certificate authorities from the given store.
+// Certificate authorities which do not exist in the store yet are generated and stored. It returns a map with the
+// secret data of the wanted CAs (including their private keys).
+func GenerateCertificateAuthorityDataInStore(ctx context.Context, store Store, wantedCertificateAuthorities map[string]*CertificateSecretConfig, namespace string) (map[string]map[string][]byte, error) {
+	certificateAuthorityData := make(map[string]map[string][]byte, len(wantedCertificateAuthorities))
+
+	for name, config := range wantedCertificateAuthorities {
+		data, err := store.Get(ctx, namespace, name)
+		if err != nil && !apierrors.IsNotFound(err) {
+			return nil, err
+		}
+
+		if apierrors.IsNotFound(err) {
+			certificate, err := config.GenerateCertificate()
+			if err != nil {
+				return nil, err
+			}
+			data = certificate.SecretData()
+			if err := store.Create(ctx, namespace, name, data); err != nil {
+				return nil, err
+			}
+		}
+
+		certificateAuthorityData[name] = data
+	}
+
+	return certificateAuthorityData, nil
+}
+
+// DeployCertificateAuthorityCertificates creates or updates a Kubernetes secret per given certificate authority in
+// the given namespace which only contains the public CA certificate, but not the private key of the CA. It returns a
+// map with the deployed secrets.
+func DeployCertificateAuthorityCertificates(ctx context.Context, c client.Client, certificateAuthorities map[string]*Certificate, namespace string) (map[string]*corev1.Secret, error) {
+	deployedSecrets := make(map[string]*corev1.Secret, len(certificateAuthorities))
+
+	for name, certificate := range certificateAuthorities {
+		secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace}}
+		if _, err := controllerutil.CreateOrUpdate(ctx, c, secret, func() error {
+			secret.Type = corev1.SecretTypeOpaque
+			secret.Data = map[string][]byte{
+				DataKeyCertificateCA:        certificate.CertificatePEM,
+				DataKeyCertificateCASigning: firstCertificatePEM(certificate.CertificatePEM),
+			}
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+		deployedSecrets[name] = secret
+	}
+
+	return deployedSecrets, nil
+}
+
+// SigningSecretNameSuffix is appended to the name of a certificate authority to form the name of the secret which is
+// deployed by DeployCertificateAuthoritySigningSecret.
+const SigningSecretNameSuffix = "-signing"
+
+// DeployCertificateAuthoritySigningSecret creates or updates a Kubernetes secret in the given namespace which contains
+// the signing certificate and the private key of the given certificate authority. It is required by components which
+// sign certificates themselves (e.g. the kube-controller-manager) if the private key is kept in a store and, hence, not
+// contained in the secret deployed by DeployCertificateAuthorityCertificates.
+func DeployCertificateAuthoritySigningSecret(ctx context.Context, c client.Client, name string, certificate *Certificate, namespace string) (*corev1.Secret, error) {
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: name + SigningSecretNameSuffix, Namespace: namespace}}
+	if _, err := controllerutil.CreateOrUpdate(ctx, c, secret, func() error {
+		secret.Type = corev1.SecretTypeOpaque
+		secret.Data = map[string][]byte{
+			DataKeyCertificateCASigning: firstCertificatePEM(certificate.CertificatePEM),
+			DataKeyPrivateKeyCA:         certificate.PrivateKeyPEM,
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return secret, nil
+}
@@ -0,0 +1,136 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secrets
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+
+	"github.com/gardener/gardener/pkg/utils"
+)
+
+// KeyAlgorithm is the algorithm of a private key.
+type KeyAlgorithm string
+
+const (
+	// KeyAlgorithmRSA indicates that an RSA private key should be generated.
+	KeyAlgorithmRSA KeyAlgorithm = "RSA"
+	// KeyAlgorithmECDSAP256 indicates that an ECDSA private key on the NIST P-256 curve should be generated.
+	KeyAlgorithmECDSAP256 KeyAlgorithm = "ECDSA-P256"
+	// KeyAlgorithmECDSAP384 indicates that an ECDSA private key on the NIST P-384 curve should be generated.
+	KeyAlgorithmECDSAP384 KeyAlgorithm = "ECDSA-P384"
+	// KeyAlgorithmEd25519 indicates that an Ed25519 private key should be generated.
+	KeyAlgorithmEd25519 KeyAlgorithm = "Ed25519"
+)
+
+const (
+	pemTypeRSAPrivateKey   = "RSA PRIVATE KEY"
+	pemTypeECPrivateKey    = "EC PRIVATE KEY"
+	pemTypePKCS8PrivateKey = "PRIVATE KEY"
+)
+
+// generatePrivateKey generates a private key for the given <algorithm>. The number of <rsaBits> is only considered
+// for RSA keys. An empty algorithm defaults to RSA.
+func generatePrivateKey(algorithm KeyAlgorithm, rsaBits int) (crypto.Signer, error) {
+	switch algorithm {
+	case "", KeyAlgorithmRSA:
+		return generateRSAPrivateKey(rsaBits)
+	case KeyAlgorithmECDSAP256:
+		return ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	case KeyAlgorithmECDSAP384:
+		return ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	case KeyAlgorithmEd25519:
+		_, privateKey, err := ed25519.GenerateKey(rand.Reader)
+		return privateKey, err
+	}
+	return nil, fmt.Errorf("unsupported key algorithm %q", algorithm)
+}
+
+// encodePrivateKey encodes the given private key to the PEM format. RSA keys are encoded in the given <pkcs> format
+// (the PKCS8-encoded RSA keys keep the 'RSA PRIVATE KEY' type for backwards-compatibility). ECDSA keys are encoded
+// in the SEC 1 format unless PKCS8 is requested. Ed25519 keys are always encoded in the PKCS8 format.
+func encodePrivateKey(privateKey crypto.Signer, pkcs int) ([]byte, error) {
+	switch key := privateKey.(type) {
+	case *rsa.PrivateKey:
+		if pkcs == PKCS8 {
+			return utils.EncodePrivateKeyInPKCS8(key)
+		}
+		return utils.EncodePrivateKey(key), nil
+
+	case *ecdsa.PrivateKey:
+		if pkcs == PKCS8 {
+			return encodePKCS8PrivateKey(key)
+		}
+		bytes, err := x509.MarshalECPrivateKey(key)
+		if err != nil {
+			return nil, err
+		}
+		return pem.EncodeToMemory(&pem.Block{Type: pemTypeECPrivateKey, Bytes: bytes}), nil
+
+	case ed25519.PrivateKey:
+		return encodePKCS8PrivateKey(key)
+	}
+	return nil, fmt.Errorf("unsupported private key type %T", privateKey)
+}
+
+func encodePKCS8PrivateKey(privateKey crypto.Signer) ([]byte, error) {
+	bytes, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	if err != nil {
+		return nil, err
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: pemTypePKCS8PrivateKey, Bytes: bytes}), nil
+}
+
+// decodePrivateKey decodes a PEM-encoded RSA, ECDSA, or Ed25519 private key in the PKCS1, SEC 1, or PKCS8 format.
+func decodePrivateKey(privateKeyPEM []byte) (crypto.Signer, error) {
+	block, _ := pem.Decode(privateKeyPEM)
+	if block == nil {
+		return nil, errors.New("could not decode the PEM-encoded private key")
+	}
+
+	switch block.Type {
+	case pemTypeRSAPrivateKey:
+		if privateKey, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+			return privateKey, nil
+		}
+		// PKCS8-encoded RSA keys were written with the 'RSA PRIVATE KEY' type, see encodePrivateKey.
+		return parsePKCS8PrivateKey(block.Bytes)
+	case pemTypeECPrivateKey:
+		return x509.ParseECPrivateKey(block.Bytes)
+	case pemTypePKCS8PrivateKey:
+		return parsePKCS8PrivateKey(block.Bytes)
+	}
+	return nil, fmt.Errorf("unsupported PEM block type %q", block.Type)
+}
+
+func parsePKCS8PrivateKey(bytes []byte) (crypto.Signer, error) {
+	privateKey, err := x509.ParsePKCS8PrivateKey(bytes)
+	if err != nil {
+		return nil, err
+	}
+	signer, ok := privateKey.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("unsupported private key type %T", privateKey)
+	}
+	return signer, nil
+}
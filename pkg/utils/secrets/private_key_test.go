@@ -0,0 +1,103 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secrets_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+
+	. "github.com/gardener/gardener/pkg/utils/secrets"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"golang.org/x/crypto/ssh"
+)
+
+var _ = Describe("private keys", func() {
+	DescribeTable("#GenerateCertificate",
+		func(algorithm KeyAlgorithm, pkcs int, expectedKey interface{}, expectedKeyUsage x509.KeyUsage) {
+			ca, err := (&CertificateSecretConfig{
+				Name:         "ca",
+				CommonName:   "kubernetes",
+				CertType:     CACert,
+				KeyAlgorithm: algorithm,
+			}).GenerateCertificate()
+			Expect(err).NotTo(HaveOccurred())
+
+			loadedCA, err := LoadCertificate("ca", ca.PrivateKeyPEM, ca.CertificatePEM)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(loadedCA.Signer).To(BeAssignableToTypeOf(expectedKey))
+
+			server, err := (&CertificateSecretConfig{
+				Name:         "server",
+				CommonName:   "server",
+				CertType:     ServerCert,
+				SigningCA:    loadedCA,
+				PKCS:         pkcs,
+				KeyAlgorithm: algorithm,
+			}).GenerateCertificate()
+			Expect(err).NotTo(HaveOccurred())
+
+			loadedServer, err := LoadCertificate("server", server.PrivateKeyPEM, server.CertificatePEM)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(loadedServer.Signer).To(BeAssignableToTypeOf(expectedKey))
+			Expect(loadedServer.Certificate.KeyUsage).To(Equal(expectedKeyUsage))
+			Expect(loadedServer.Certificate.CheckSignatureFrom(loadedCA.Certificate)).To(Succeed())
+		},
+
+		Entry("RSA (default)", KeyAlgorithm(""), PKCS1, &rsa.PrivateKey{}, x509.KeyUsageDigitalSignature|x509.KeyUsageKeyEncipherment),
+		Entry("RSA in PKCS8", KeyAlgorithmRSA, PKCS8, &rsa.PrivateKey{}, x509.KeyUsageDigitalSignature|x509.KeyUsageKeyEncipherment),
+		Entry("ECDSA P-256", KeyAlgorithmECDSAP256, PKCS1, &ecdsa.PrivateKey{}, x509.KeyUsageDigitalSignature),
+		Entry("ECDSA P-384 in PKCS8", KeyAlgorithmECDSAP384, PKCS8, &ecdsa.PrivateKey{}, x509.KeyUsageDigitalSignature),
+		Entry("Ed25519", KeyAlgorithmEd25519, PKCS1, ed25519.PrivateKey{}, x509.KeyUsageDigitalSignature),
+	)
+
+	It("should fail for unsupported key algorithms", func() {
+		_, err := (&CertificateSecretConfig{Name: "ca", CommonName: "kubernetes", CertType: CACert, KeyAlgorithm: "DSA"}).GenerateCertificate()
+		Expect(err).To(HaveOccurred())
+	})
+
+	DescribeTable("#GenerateRSAKeys",
+		func(algorithm KeyAlgorithm, expectedSSHKeyType, expectedPrivateKeyDataKey, expectedAuthorizedKeysDataKey string) {
+			keys, err := (&RSASecretConfig{
+				Name:         "ssh-keypair",
+				Bits:         2048,
+				KeyAlgorithm: algorithm,
+				UsedForSSH:   true,
+			}).GenerateRSAKeys()
+			Expect(err).NotTo(HaveOccurred())
+
+			data := keys.SecretData()
+			Expect(data).To(HaveLen(2))
+
+			signer, err := ssh.ParsePrivateKey(data[expectedPrivateKeyDataKey])
+			Expect(err).NotTo(HaveOccurred())
+			Expect(signer.PublicKey().Type()).To(Equal(expectedSSHKeyType))
+
+			publicKey, _, _, _, err := ssh.ParseAuthorizedKey(data[expectedAuthorizedKeysDataKey])
+			Expect(err).NotTo(HaveOccurred())
+			Expect(publicKey.Marshal()).To(Equal(signer.PublicKey().Marshal()))
+			Expect(SSHAuthorizedKeys(data)).To(Equal(data[expectedAuthorizedKeysDataKey]))
+		},
+
+		Entry("RSA (default)", KeyAlgorithm(""), ssh.KeyAlgoRSA, DataKeyRSAPrivateKey, DataKeySSHAuthorizedKeys),
+		Entry("ECDSA P-256", KeyAlgorithmECDSAP256, ssh.KeyAlgoECDSA256, DataKeyECDSAPrivateKey, DataKeyECDSASSHAuthorizedKeys),
+		Entry("ECDSA P-384", KeyAlgorithmECDSAP384, ssh.KeyAlgoECDSA384, DataKeyECDSAPrivateKey, DataKeyECDSASSHAuthorizedKeys),
+		Entry("Ed25519", KeyAlgorithmEd25519, ssh.KeyAlgoED25519, DataKeyEd25519PrivateKey, DataKeyEd25519SSHAuthorizedKeys),
+	)
+})
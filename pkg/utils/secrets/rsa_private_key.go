@@ -16,34 +16,53 @@ package secrets
 
 import (
 	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
 	"crypto/rand"
 	"crypto/rsa"
 
-	"github.com/gardener/gardener/pkg/utils"
 	"golang.org/x/crypto/ssh"
 )
 
 const (
 	// DataKeyRSAPrivateKey is the key in a secret data holding the RSA private key.
 	DataKeyRSAPrivateKey = "id_rsa"
-	// DataKeySSHAuthorizedKeys is the key in a secret data holding the OpenSSH authorized keys.
+	// DataKeySSHAuthorizedKeys is the key in a secret data holding the OpenSSH authorized keys of an RSA key.
 	DataKeySSHAuthorizedKeys = "id_rsa.pub"
+	// DataKeyECDSAPrivateKey is the key in a secret data holding the ECDSA private key.
+	DataKeyECDSAPrivateKey = "id_ecdsa"
+	// DataKeyECDSASSHAuthorizedKeys is the key in a secret data holding the OpenSSH authorized keys of an ECDSA key.
+	DataKeyECDSASSHAuthorizedKeys = "id_ecdsa.pub"
+	// DataKeyEd25519PrivateKey is the key in a secret data holding the Ed25519 private key.
+	DataKeyEd25519PrivateKey = "id_ed25519"
+	// DataKeyEd25519SSHAuthorizedKeys is the key in a secret data holding the OpenSSH authorized keys of an Ed25519
+	// key.
+	DataKeyEd25519SSHAuthorizedKeys = "id_ed25519.pub"
 )
 
-// RSASecretConfig containing information about the number of bits which should be used for the to-be-created RSA private key.
+// RSASecretConfig containing information about the algorithm and the number of bits which should be used for the
+// to-be-created private key. The number of bits is only considered for RSA keys, which are generated by default.
 type RSASecretConfig struct {
 	Name string
 
-	Bits       int
-	UsedForSSH bool
+	Bits         int
+	KeyAlgorithm KeyAlgorithm
+	UsedForSSH   bool
 }
 
 // RSAKeys contains the private key, the public key, and optionally the OpenSSH-formatted authorized keys file data.
 type RSAKeys struct {
 	Name string
 
+	// PrivateKey is the RSA private key. It is nil if the key is not an RSA key.
+	// Deprecated: Use Signer instead which supports all key algorithms.
 	PrivateKey *rsa.PrivateKey
-	PublicKey  *rsa.PublicKey
+	// PublicKey is the RSA public key. It is nil if the key is not an RSA key.
+	// Deprecated: Use Signer.Public() instead which supports all key algorithms.
+	PublicKey *rsa.PublicKey
+	// Signer is the private key of any supported algorithm.
+	Signer crypto.Signer
 
 	OpenSSHAuthorizedKey []byte
 }
@@ -58,57 +77,91 @@ func (s *RSASecretConfig) Generate() (Interface, error) {
 	return s.GenerateRSAKeys()
 }
 
-// GenerateRSAKeys computes a RSA private key based on the configured number of bits.
+// GenerateRSAKeys computes a private key based on the configured algorithm and number of bits.
 func (s *RSASecretConfig) GenerateRSAKeys() (*RSAKeys, error) {
-	privateKey, err := generateRSAPrivateKey(s.Bits)
+	privateKey, err := generatePrivateKey(s.KeyAlgorithm, s.Bits)
 	if err != nil {
 		return nil, err
 	}
 
-	rsa := &RSAKeys{
+	keys := &RSAKeys{
 		Name: s.Name,
 
-		PrivateKey: privateKey,
-		PublicKey:  &privateKey.PublicKey,
+		Signer: privateKey,
+	}
+	if rsaPrivateKey, ok := privateKey.(*rsa.PrivateKey); ok {
+		keys.PrivateKey = rsaPrivateKey
+		keys.PublicKey = &rsaPrivateKey.PublicKey
 	}
 
 	if s.UsedForSSH {
-		sshPublicKey, err := generateSSHAuthorizedKeys(rsa.PrivateKey)
+		sshPublicKey, err := generateSSHAuthorizedKeys(privateKey.Public())
 		if err != nil {
 			return nil, err
 		}
-		rsa.OpenSSHAuthorizedKey = sshPublicKey
+		keys.OpenSSHAuthorizedKey = sshPublicKey
 	}
 
-	return rsa, nil
+	return keys, nil
 }
 
-// SecretData computes the data map which can be used in a Kubernetes secret.
+// SecretData computes the data map which can be used in a Kubernetes secret. The keys in the data map depend on the
+// algorithm of the private key, see PrivateKeyDataKeys.
 func (r *RSAKeys) SecretData() map[string][]byte {
+	signer := r.Signer
+	if signer == nil && r.PrivateKey != nil {
+		signer = r.PrivateKey
+	}
+
+	// Encoding a generated private key in the PKCS1 or SEC 1 format does not fail.
+	privateKeyPEM, _ := encodePrivateKey(signer, PKCS1)
+	privateKeyDataKey, sshAuthorizedKeysDataKey := PrivateKeyDataKeys(signer)
+
 	data := map[string][]byte{
-		DataKeyRSAPrivateKey: utils.EncodePrivateKey(r.PrivateKey),
+		privateKeyDataKey: privateKeyPEM,
 	}
 
 	if r.OpenSSHAuthorizedKey != nil {
-		data[DataKeySSHAuthorizedKeys] = r.OpenSSHAuthorizedKey
+		data[sshAuthorizedKeysDataKey] = r.OpenSSHAuthorizedKey
 	}
 
 	return data
 }
 
+// PrivateKeyDataKeys returns the keys in a secret data holding the given private key and its OpenSSH authorized keys,
+// following the file names used by OpenSSH, e.g. 'id_ed25519' and 'id_ed25519.pub' for Ed25519 keys.
+func PrivateKeyDataKeys(privateKey crypto.Signer) (string, string) {
+	switch privateKey.(type) {
+	case *ecdsa.PrivateKey:
+		return DataKeyECDSAPrivateKey, DataKeyECDSASSHAuthorizedKeys
+	case ed25519.PrivateKey:
+		return DataKeyEd25519PrivateKey, DataKeyEd25519SSHAuthorizedKeys
+	}
+	return DataKeyRSAPrivateKey, DataKeySSHAuthorizedKeys
+}
+
+// SSHAuthorizedKeys returns the serialized OpenSSH authorized key contained in the given secret <data>, independent
+// of the algorithm of the key pair.
+func SSHAuthorizedKeys(data map[string][]byte) []byte {
+	for _, key := range []string{DataKeySSHAuthorizedKeys, DataKeyECDSASSHAuthorizedKeys, DataKeyEd25519SSHAuthorizedKeys} {
+		if authorizedKeys, ok := data[key]; ok {
+			return authorizedKeys
+		}
+	}
+	return nil
+}
+
 // generateRSAPrivateKey generates a RSA private for the given number of <bits>.
 func generateRSAPrivateKey(bits int) (*rsa.PrivateKey, error) {
 	return rsa.GenerateKey(rand.Reader, bits)
 }
 
-// generateSSHAuthorizedKeys takes a RSA private key <privateKey> and generates the corresponding public key.
-// It serializes the public key for inclusion in an OpenSSH `authorized_keys` file and it trims the new-
-// line at the end.
-func generateSSHAuthorizedKeys(privateKey *rsa.PrivateKey) ([]byte, error) {
-	pubKey, err := ssh.NewPublicKey(&privateKey.PublicKey)
+// generateSSHAuthorizedKeys takes a public key <publicKey> and serializes it for inclusion in an OpenSSH
+// `authorized_keys` file and it trims the new-line at the end.
+func generateSSHAuthorizedKeys(publicKey crypto.PublicKey) ([]byte, error) {
+	pubKey, err := ssh.NewPublicKey(publicKey)
 	if err != nil {
 		return nil, err
 	}
-	publicKey := ssh.MarshalAuthorizedKey(pubKey)
-	return bytes.Trim(publicKey, "\x0a"), nil
+	return bytes.Trim(ssh.MarshalAuthorizedKey(pubKey), "\x0a"), nil
 }
@@ -21,6 +21,7 @@ import (
 
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 )
@@ -29,6 +30,11 @@ import (
 type Secrets struct {
 	CertificateSecretConfigs map[string]*CertificateSecretConfig
 	SecretConfigsFunc        func(map[string]*Certificate, string) []ConfigInterface
+
+	// Store is the backend in which the certificate authorities are kept. If it is nil, they are kept in Kubernetes
+	// secrets in the namespace. Otherwise, the Kubernetes secrets of the certificate authorities only contain their
+	// public certificates, i.e., the private keys of the certificate authorities do not leave the store.
+	Store Store
 }
 
 // Deploy generates and deploys the secrets into the given namespace, taking into account existing secrets.
@@ -46,9 +52,21 @@ func (s *Secrets) Deploy(
 	}
 
 	// Generate CAs
-	_, cas, err := GenerateCertificateAuthorities(gcs, existingSecrets, s.CertificateSecretConfigs, namespace)
-	if err != nil {
-		return nil, errors.Wrapf(err, "could not generate CA secrets in namespace '%s'", namespace)
+	var cas map[string]*Certificate
+	if s.Store != nil {
+		if err := MigrateCertificateAuthoritiesToStore(ctx, s.Store, existingSecrets, s.CertificateSecretConfigs, namespace); err != nil {
+			return nil, errors.Wrapf(err, "could not migrate CA secrets in namespace '%s' to store", namespace)
+		}
+		if cas, err = GenerateCertificateAuthoritiesInStore(ctx, s.Store, s.CertificateSecretConfigs, namespace); err != nil {
+			return nil, errors.Wrapf(err, "could not generate CAs for namespace '%s' in store", namespace)
+		}
+		if _, err := DeployCertificateAuthorityCertificates(ctx, gcs.Client(), cas, namespace); err != nil {
+			return nil, errors.Wrapf(err, "could not deploy CA certificates in namespace '%s'", namespace)
+		}
+	} else {
+		if _, cas, err = GenerateCertificateAuthorities(gcs, existingSecrets, s.CertificateSecretConfigs, namespace); err != nil {
+			return nil, errors.Wrapf(err, "could not generate CA secrets in namespace '%s'", namespace)
+		}
 	}
 
 	// Generate cluster secrets
@@ -68,6 +86,37 @@ func (s *Secrets) Delete(cs kubernetes.Interface, namespace string) error {
 			return err
 		}
 	}
+	if s.Store != nil {
+		for name := range s.CertificateSecretConfigs {
+			if err := s.Store.Delete(context.TODO(), namespace, name); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// MigrateCertificateAuthoritiesToStore copies existing CA secrets which still contain the private key of the CA into
+// the store unless the store already contains the respective CA. This way, the CAs are kept when switching the store.
+func MigrateCertificateAuthoritiesToStore(ctx context.Context, store Store, existingSecrets map[string]*corev1.Secret, certificateSecretConfigs map[string]*CertificateSecretConfig, namespace string) error {
+	for name := range certificateSecretConfigs {
+		secret, ok := existingSecrets[name]
+		if !ok || len(secret.Data[DataKeyPrivateKeyCA]) == 0 {
+			continue
+		}
+
+		_, err := store.Get(ctx, namespace, name)
+		if err == nil {
+			continue
+		}
+		if !apierrors.IsNotFound(err) {
+			return err
+		}
+
+		if err := store.Create(ctx, namespace, name, secret.Data); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
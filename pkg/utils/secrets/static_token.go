@@ -51,6 +51,8 @@ type StaticToken struct {
 	Name string
 
 	Tokens []Token
+	// PreviousTokens are tokens which were replaced by a rotation but are still accepted during a transition period.
+	PreviousTokens []Token
 }
 
 // Token contains fields of a generated token.
@@ -95,14 +97,35 @@ func (s *StaticTokenSecretConfig) GenerateStaticToken() (*StaticToken, error) {
 	}, nil
 }
 
-// SecretData computes the data map which can be used in a Kubernetes secret.
+// RotateStaticToken computes new random tokens for the configured users. The tokens of the <current> static token are
+// kept as previous tokens so that they are still accepted until they are removed with WithoutPreviousTokens.
+func (s *StaticTokenSecretConfig) RotateStaticToken(current *StaticToken) (*StaticToken, error) {
+	staticToken, err := s.GenerateStaticToken()
+	if err != nil {
+		return nil, err
+	}
+
+	staticToken.PreviousTokens = current.Tokens
+	return staticToken, nil
+}
+
+// WithoutPreviousTokens returns a copy of the static token which does no longer contain the previous tokens.
+func (b *StaticToken) WithoutPreviousTokens() *StaticToken {
+	return &StaticToken{
+		Name:   b.Name,
+		Tokens: b.Tokens,
+	}
+}
+
+// SecretData computes the data map which can be used in a Kubernetes secret. The previous tokens are written after the
+// current tokens, hence, the first token of a user in the CSV is always its current token.
 func (b *StaticToken) SecretData() map[string][]byte {
 	var (
 		data   = make(map[string][]byte, 1)
-		tokens = make([]string, 0, len(b.Tokens))
+		tokens = make([]string, 0, len(b.Tokens)+len(b.PreviousTokens))
 	)
 
-	for _, token := range b.Tokens {
+	for _, token := range append(append([]Token{}, b.Tokens...), b.PreviousTokens...) {
 		groups := strings.Join(token.Groups, ",")
 		if len(token.Groups) > 1 {
 			groups = fmt.Sprintf("%q", groups)
@@ -124,11 +147,14 @@ func (b *StaticToken) GetTokenForUsername(username string) (*Token, error) {
 	return nil, fmt.Errorf("could not find token for username %q", username)
 }
 
-// LoadStaticTokenFromCSV loads the static token data from the given CSV-formatted <data>.
+// LoadStaticTokenFromCSV loads the static token data from the given CSV-formatted <data>. The first token of a user is
+// loaded as its current token, all further tokens of the same user are loaded as previous tokens.
 func LoadStaticTokenFromCSV(name string, data []byte) (*StaticToken, error) {
 	var (
-		lines  = strings.Split(string(data), "\n")
-		tokens = make([]Token, 0, len(lines))
+		lines          = strings.Split(string(data), "\n")
+		tokens         = make([]Token, 0, len(lines))
+		previousTokens []Token
+		usernames      = make(map[string]bool, len(lines))
 	)
 
 	for _, token := range lines {
@@ -137,16 +163,24 @@ func LoadStaticTokenFromCSV(name string, data []byte) (*StaticToken, error) {
 			return nil, fmt.Errorf("invalid CSV for loading static token data: %s", string(data))
 		}
 
-		tokens = append(tokens, Token{
+		token := Token{
 			Username: csv[1],
 			UserID:   csv[2],
 			Groups:   strings.Split(csv[3], ","),
 			Token:    csv[0],
-		})
+		}
+
+		if usernames[token.Username] {
+			previousTokens = append(previousTokens, token)
+			continue
+		}
+		usernames[token.Username] = true
+		tokens = append(tokens, token)
 	}
 
 	return &StaticToken{
-		Name:   name,
-		Tokens: tokens,
+		Name:           name,
+		Tokens:         tokens,
+		PreviousTokens: previousTokens,
 	}, nil
 }
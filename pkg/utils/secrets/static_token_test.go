@@ -0,0 +1,78 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secrets_test
+
+import (
+	. "github.com/gardener/gardener/pkg/utils/secrets"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("static token", func() {
+	var config *StaticTokenSecretConfig
+
+	BeforeEach(func() {
+		config = &StaticTokenSecretConfig{
+			Name: "static-token",
+			Tokens: []TokenConfig{
+				{Username: "admin", UserID: "admin", Groups: []string{"system:masters"}},
+				{Username: "health-check", UserID: "health-check", Groups: []string{"health"}},
+			},
+		}
+	})
+
+	Describe("#RotateStaticToken", func() {
+		It("should generate new tokens and keep the current tokens as previous tokens", func() {
+			current, err := config.GenerateStaticToken()
+			Expect(err).NotTo(HaveOccurred())
+
+			rotated, err := config.RotateStaticToken(current)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(rotated.Tokens).To(HaveLen(2))
+			Expect(rotated.Tokens[0].Token).NotTo(Equal(current.Tokens[0].Token))
+			Expect(rotated.PreviousTokens).To(Equal(current.Tokens))
+			Expect(rotated.WithoutPreviousTokens().PreviousTokens).To(BeEmpty())
+		})
+	})
+
+	Describe("#LoadStaticTokenFromCSV", func() {
+		It("should load the first token of a user as current token and further tokens as previous tokens", func() {
+			current, err := config.GenerateStaticToken()
+			Expect(err).NotTo(HaveOccurred())
+			rotated, err := config.RotateStaticToken(current)
+			Expect(err).NotTo(HaveOccurred())
+
+			loaded, err := LoadStaticTokenFromCSV("static-token", rotated.SecretData()[DataKeyStaticTokenCSV])
+			Expect(err).NotTo(HaveOccurred())
+			Expect(loaded).To(Equal(rotated))
+
+			token, err := loaded.GetTokenForUsername("admin")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(token.Token).To(Equal(rotated.Tokens[0].Token))
+		})
+
+		It("should not load previous tokens if every user has only one token", func() {
+			current, err := config.GenerateStaticToken()
+			Expect(err).NotTo(HaveOccurred())
+
+			loaded, err := LoadStaticTokenFromCSV("static-token", current.SecretData()[DataKeyStaticTokenCSV])
+			Expect(err).NotTo(HaveOccurred())
+			Expect(loaded.Tokens).To(Equal(current.Tokens))
+			Expect(loaded.PreviousTokens).To(BeEmpty())
+		})
+	})
+})
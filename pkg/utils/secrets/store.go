@@ -0,0 +1,87 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secrets
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Store is a backend in which the data of generated secrets is kept.
+type Store interface {
+	// Get returns the data of the secret with the given name in the given namespace. If the secret does not exist,
+	// it returns an error for which apierrors.IsNotFound returns true.
+	Get(ctx context.Context, namespace, name string) (map[string][]byte, error)
+	// Create stores the data of a new secret with the given name in the given namespace. If the secret already
+	// exists, it returns an error for which apierrors.IsAlreadyExists returns true.
+	Create(ctx context.Context, namespace, name string, data map[string][]byte) error
+	// Update replaces the data of the existing secret with the given name in the given namespace.
+	Update(ctx context.Context, namespace, name string, data map[string][]byte) error
+	// Delete deletes the secret with the given name in the given namespace. It does not return an error if the
+	// secret does not exist.
+	Delete(ctx context.Context, namespace, name string) error
+}
+
+// KubernetesStoreSecretNameSuffix is appended to the names of the Kubernetes secrets in which the Kubernetes store
+// keeps the secret data. This way, they never collide with the published secrets of the same name, e.g. the CA secrets
+// which only contain the public CA certificates.
+const KubernetesStoreSecretNameSuffix = "-store"
+
+type kubernetesStore struct {
+	client client.Client
+}
+
+// NewKubernetesStore returns a Store which keeps the secret data in Kubernetes secrets whose names carry the
+// KubernetesStoreSecretNameSuffix.
+func NewKubernetesStore(c client.Client) Store {
+	return &kubernetesStore{c}
+}
+
+func (k *kubernetesStore) Get(ctx context.Context, namespace, name string) (map[string][]byte, error) {
+	secret := &corev1.Secret{}
+	if err := k.client.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name + KubernetesStoreSecretNameSuffix}, secret); err != nil {
+		return nil, err
+	}
+	return secret.Data, nil
+}
+
+func (k *kubernetesStore) Create(ctx context.Context, namespace, name string, data map[string][]byte) error {
+	return k.client.Create(ctx, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: name + KubernetesStoreSecretNameSuffix, Namespace: namespace},
+		Type:       corev1.SecretTypeOpaque,
+		Data:       data,
+	})
+}
+
+func (k *kubernetesStore) Update(ctx context.Context, namespace, name string, data map[string][]byte) error {
+	secret := &corev1.Secret{}
+	if err := k.client.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name + KubernetesStoreSecretNameSuffix}, secret); err != nil {
+		return err
+	}
+	secret.Data = data
+	return k.client.Update(ctx, secret)
+}
+
+func (k *kubernetesStore) Delete(ctx context.Context, namespace, name string) error {
+	err := k.client.Delete(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: name + KubernetesStoreSecretNameSuffix, Namespace: namespace}})
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
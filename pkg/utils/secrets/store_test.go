@@ -0,0 +1,243 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secrets_test
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync"
+
+	. "github.com/gardener/gardener/pkg/utils/secrets"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// fakeVault is a local stand-in for the key/value secrets engine (version 2) of a Vault server.
+type fakeVault struct {
+	lock    sync.Mutex
+	token   string
+	secrets map[string]map[string]string
+}
+
+func (f *fakeVault) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if r.Header.Get("X-Vault-Token") != f.token {
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte(`{"errors":["permission denied"]}`))
+		return
+	}
+
+	var (
+		dataPrefix     = "/v1/secret/data/"
+		metadataPrefix = "/v1/secret/metadata/"
+	)
+
+	switch {
+	case r.Method == http.MethodGet && strings.HasPrefix(r.URL.Path, dataPrefix):
+		data, ok := f.secrets[strings.TrimPrefix(r.URL.Path, dataPrefix)]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"errors":[]}`))
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"data": map[string]interface{}{"data": data}})
+
+	case r.Method == http.MethodPost && strings.HasPrefix(r.URL.Path, dataPrefix):
+		request := struct {
+			Options map[string]int    `json:"options"`
+			Data    map[string]string `json:"data"`
+		}{}
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		path := strings.TrimPrefix(r.URL.Path, dataPrefix)
+		if cas, ok := request.Options["cas"]; ok && cas == 0 {
+			if _, exists := f.secrets[path]; exists {
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(`{"errors":["check-and-set parameter did not match the current version"]}`))
+				return
+			}
+		}
+		f.secrets[path] = request.Data
+		_, _ = w.Write([]byte(`{"data":{"version":1}}`))
+
+	case r.Method == http.MethodDelete && strings.HasPrefix(r.URL.Path, metadataPrefix):
+		delete(f.secrets, strings.TrimPrefix(r.URL.Path, metadataPrefix))
+		w.WriteHeader(http.StatusNoContent)
+
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+var _ = Describe("store", func() {
+	var (
+		ctx    = context.TODO()
+		vault  *fakeVault
+		server *httptest.Server
+		store  Store
+	)
+
+	BeforeEach(func() {
+		vault = &fakeVault{token: "token", secrets: map[string]map[string]string{}}
+		server = httptest.NewServer(vault)
+		store = NewVaultStore(VaultStoreConfig{Address: server.URL, Token: "token", PathPrefix: "gardener"})
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	Describe("#NewVaultStore", func() {
+		It("should create, get, update and delete secrets", func() {
+			_, err := store.Get(ctx, "shoot--foo--bar", "ca")
+			Expect(apierrors.IsNotFound(err)).To(BeTrue())
+
+			Expect(store.Create(ctx, "shoot--foo--bar", "ca", map[string][]byte{"ca.key": []byte("\x00key")})).To(Succeed())
+			Expect(vault.secrets).To(HaveKey("gardener/shoot--foo--bar/ca"))
+
+			err = store.Create(ctx, "shoot--foo--bar", "ca", map[string][]byte{"ca.key": []byte("other")})
+			Expect(apierrors.IsAlreadyExists(err)).To(BeTrue())
+
+			data, err := store.Get(ctx, "shoot--foo--bar", "ca")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(data).To(Equal(map[string][]byte{"ca.key": []byte("\x00key")}))
+
+			Expect(store.Update(ctx, "shoot--foo--bar", "ca", map[string][]byte{"ca.key": []byte("new")})).To(Succeed())
+			data, err = store.Get(ctx, "shoot--foo--bar", "ca")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(data).To(Equal(map[string][]byte{"ca.key": []byte("new")}))
+
+			Expect(store.Delete(ctx, "shoot--foo--bar", "ca")).To(Succeed())
+			Expect(store.Delete(ctx, "shoot--foo--bar", "ca")).To(Succeed())
+			_, err = store.Get(ctx, "shoot--foo--bar", "ca")
+			Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		})
+
+		It("should read the token from the token file", func() {
+			tokenFile, err := ioutil.TempFile("", "vault-token")
+			Expect(err).NotTo(HaveOccurred())
+			defer os.Remove(tokenFile.Name())
+			_, err = tokenFile.WriteString("token\n")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(tokenFile.Close()).To(Succeed())
+
+			store = NewVaultStore(VaultStoreConfig{Address: server.URL, TokenFile: tokenFile.Name()})
+
+			_, err = store.Get(ctx, "shoot--foo--bar", "ca")
+			Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		})
+
+		It("should return an error if the request is not authorized", func() {
+			store = NewVaultStore(VaultStoreConfig{Address: server.URL, Token: "invalid"})
+
+			_, err := store.Get(ctx, "shoot--foo--bar", "ca")
+			Expect(err).To(MatchError(ContainSubstring("permission denied")))
+		})
+	})
+
+	Describe("#NewKubernetesStore", func() {
+		It("should keep the private keys when the CA certificates are published", func() {
+			var (
+				c               = fake.NewFakeClient()
+				kubernetesStore = NewKubernetesStore(c)
+				wantedCAs       = map[string]*CertificateSecretConfig{
+					"ca": {Name: "ca", CommonName: "kubernetes", CertType: CACert},
+				}
+			)
+
+			generated, err := GenerateCertificateAuthoritiesInStore(ctx, kubernetesStore, wantedCAs, "shoot--foo--bar")
+			Expect(err).NotTo(HaveOccurred())
+
+			published, err := DeployCertificateAuthorityCertificates(ctx, c, generated, "shoot--foo--bar")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(published["ca"].Data).NotTo(HaveKey(DataKeyPrivateKeyCA))
+
+			data, err := kubernetesStore.Get(ctx, "shoot--foo--bar", "ca")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(data[DataKeyPrivateKeyCA]).To(Equal(generated["ca"].PrivateKeyPEM))
+
+			secret := &corev1.Secret{}
+			Expect(c.Get(ctx, client.ObjectKey{Namespace: "shoot--foo--bar", Name: "ca" + KubernetesStoreSecretNameSuffix}, secret)).To(Succeed())
+		})
+	})
+
+	Describe("#DeployCertificateAuthoritySigningSecret", func() {
+		It("should publish the private key of a CA kept in the store for the kube-controller-manager", func() {
+			var (
+				c               = fake.NewFakeClient()
+				kubernetesStore = NewKubernetesStore(c)
+				wantedCAs       = map[string]*CertificateSecretConfig{
+					"ca": {Name: "ca", CommonName: "kubernetes", CertType: CACert},
+				}
+			)
+
+			generated, err := GenerateCertificateAuthoritiesInStore(ctx, kubernetesStore, wantedCAs, "shoot--foo--bar")
+			Expect(err).NotTo(HaveOccurred())
+
+			published, err := DeployCertificateAuthorityCertificates(ctx, c, generated, "shoot--foo--bar")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(published["ca"].Data).NotTo(HaveKey(DataKeyPrivateKeyCA))
+
+			_, err = DeployCertificateAuthoritySigningSecret(ctx, c, "ca", generated["ca"], "shoot--foo--bar")
+			Expect(err).NotTo(HaveOccurred())
+
+			// The kube-controller-manager mounts this secret for its --cluster-signing-{cert,key}-file flags.
+			secret := &corev1.Secret{}
+			Expect(c.Get(ctx, client.ObjectKey{Namespace: "shoot--foo--bar", Name: "ca" + SigningSecretNameSuffix}, secret)).To(Succeed())
+			Expect(secret.Data).To(Equal(map[string][]byte{
+				DataKeyCertificateCASigning: generated["ca"].CertificatePEM,
+				DataKeyPrivateKeyCA:         generated["ca"].PrivateKeyPEM,
+			}))
+		})
+	})
+
+	Describe("#GenerateCertificateAuthoritiesInStore", func() {
+		var wantedCertificateAuthorities = map[string]*CertificateSecretConfig{
+			"ca": {
+				Name:       "ca",
+				CommonName: "kubernetes",
+				CertType:   CACert,
+			},
+		}
+
+		It("should generate missing CAs and load existing CAs", func() {
+			generated, err := GenerateCertificateAuthoritiesInStore(ctx, store, wantedCertificateAuthorities, "shoot--foo--bar")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(generated).To(HaveKey("ca"))
+
+			data, err := store.Get(ctx, "shoot--foo--bar", "ca")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(data[DataKeyPrivateKeyCA]).To(Equal(generated["ca"].PrivateKeyPEM))
+
+			loaded, err := GenerateCertificateAuthoritiesInStore(ctx, store, wantedCertificateAuthorities, "shoot--foo--bar")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(loaded["ca"].CertificatePEM).To(Equal(generated["ca"].CertificatePEM))
+		})
+	})
+})
@@ -0,0 +1,195 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secrets
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"path"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+// VaultStoreConfig contains the configuration of a Store which keeps the secret data in the key/value secrets engine
+// (version 2) of a Vault-compatible server.
+type VaultStoreConfig struct {
+	// Address is the URL of the server, e.g. https://vault.example.com:8200.
+	Address string
+	// Token is the token used to authenticate against the server.
+	Token string
+	// TokenFile is the path to a file containing the token used to authenticate against the server. It is only used
+	// if Token is empty and read for every request so that the token can be renewed.
+	TokenFile string
+	// MountPath is the path at which the key/value secrets engine is mounted. Defaults to 'secret'.
+	MountPath string
+	// PathPrefix is prepended to the '<namespace>/<name>' path of every secret.
+	PathPrefix string
+	// HTTPClient is the client used for the requests. Defaults to http.DefaultClient.
+	HTTPClient *http.Client
+}
+
+type vaultStore struct {
+	config VaultStoreConfig
+}
+
+// NewVaultStore returns a Store which keeps the secret data in the key/value secrets engine (version 2) of a
+// Vault-compatible server. The values of the secret data are stored base64-encoded.
+func NewVaultStore(config VaultStoreConfig) Store {
+	if config.MountPath == "" {
+		config.MountPath = "secret"
+	}
+	if config.HTTPClient == nil {
+		config.HTTPClient = http.DefaultClient
+	}
+	return &vaultStore{config}
+}
+
+type vaultRequest struct {
+	Options map[string]interface{} `json:"options,omitempty"`
+	Data    map[string]string      `json:"data"`
+}
+
+type vaultResponse struct {
+	Data struct {
+		Data map[string]string `json:"data"`
+	} `json:"data"`
+	Errors []string `json:"errors"`
+}
+
+func (v *vaultStore) Get(ctx context.Context, namespace, name string) (map[string][]byte, error) {
+	response, err := v.do(ctx, http.MethodGet, "data", namespace, name, nil)
+	if err != nil {
+		return nil, err
+	}
+	if response == nil {
+		return nil, apierrors.NewNotFound(corev1.Resource("secrets"), name)
+	}
+
+	data := make(map[string][]byte, len(response.Data.Data))
+	for key, value := range response.Data.Data {
+		decoded, err := base64.StdEncoding.DecodeString(value)
+		if err != nil {
+			return nil, fmt.Errorf("could not decode key %q of secret %s/%s: %v", key, namespace, name, err)
+		}
+		data[key] = decoded
+	}
+	return data, nil
+}
+
+func (v *vaultStore) Create(ctx context.Context, namespace, name string, data map[string][]byte) error {
+	// The check-and-set version 0 only allows the write if the secret does not exist yet.
+	response, err := v.do(ctx, http.MethodPost, "data", namespace, name, &vaultRequest{
+		Options: map[string]interface{}{"cas": 0},
+		Data:    encodeVaultData(data),
+	})
+	if err != nil && strings.Contains(err.Error(), "check-and-set") {
+		return apierrors.NewAlreadyExists(corev1.Resource("secrets"), name)
+	}
+	if err == nil && response == nil {
+		return fmt.Errorf("could not create secret %s/%s: path not found", namespace, name)
+	}
+	return err
+}
+
+func (v *vaultStore) Update(ctx context.Context, namespace, name string, data map[string][]byte) error {
+	response, err := v.do(ctx, http.MethodPost, "data", namespace, name, &vaultRequest{
+		Data: encodeVaultData(data),
+	})
+	if err == nil && response == nil {
+		return fmt.Errorf("could not update secret %s/%s: path not found", namespace, name)
+	}
+	return err
+}
+
+func (v *vaultStore) Delete(ctx context.Context, namespace, name string) error {
+	// Deleting the metadata removes all versions of the secret.
+	_, err := v.do(ctx, http.MethodDelete, "metadata", namespace, name, nil)
+	return err
+}
+
+// do sends a request to the key/value secrets engine. It returns a nil response if the path was not found.
+func (v *vaultStore) do(ctx context.Context, method, kind, namespace, name string, body *vaultRequest) (*vaultResponse, error) {
+	var reader io.Reader
+	if body != nil {
+		raw, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+		reader = bytes.NewReader(raw)
+	}
+
+	url := strings.TrimSuffix(v.config.Address, "/") + "/" + path.Join("v1", v.config.MountPath, kind, v.config.PathPrefix, namespace, name)
+	request, err := http.NewRequest(method, url, reader)
+	if err != nil {
+		return nil, err
+	}
+	request = request.WithContext(ctx)
+
+	token := v.config.Token
+	if len(token) == 0 && len(v.config.TokenFile) > 0 {
+		raw, err := ioutil.ReadFile(v.config.TokenFile)
+		if err != nil {
+			return nil, fmt.Errorf("could not read token file: %v", err)
+		}
+		token = strings.TrimSpace(string(raw))
+	}
+	request.Header.Set("X-Vault-Token", token)
+	if body != nil {
+		request.Header.Set("Content-Type", "application/json")
+	}
+
+	response, err := v.config.HTTPClient.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	raw, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if response.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+
+	result := &vaultResponse{}
+	if len(raw) > 0 {
+		if err := json.Unmarshal(raw, result); err != nil {
+			return nil, fmt.Errorf("could not decode response for secret %s/%s: %v", namespace, name, err)
+		}
+	}
+
+	if response.StatusCode >= http.StatusBadRequest {
+		return nil, fmt.Errorf("request for secret %s/%s failed with status code %d: %s", namespace, name, response.StatusCode, strings.Join(result.Errors, ", "))
+	}
+	return result, nil
+}
+
+func encodeVaultData(data map[string][]byte) map[string]string {
+	encoded := make(map[string]string, len(data))
+	for key, value := range data {
+		encoded[key] = base64.StdEncoding.EncodeToString(value)
+	}
+	return encoded
+}
@@ -31,9 +31,11 @@ import (
 	"github.com/gardener/gardener/plugin/pkg/utils"
 
 	rbacv1 "k8s.io/api/rbac/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apiserver/pkg/admission"
 	"k8s.io/apiserver/pkg/authorization/authorizer"
 	kubeinformers "k8s.io/client-go/informers"
@@ -277,6 +279,14 @@ func (r *ReferenceManager) Admit(a admission.Attributes, o admission.ObjectInter
 			}
 		}
 		if a.GetOperation() == admission.Update {
+			oldProject, ok := a.GetOldObject().(*garden.Project)
+			if !ok {
+				return apierrors.NewBadRequest("could not convert old resource into Project object")
+			}
+			if err := r.ensureProjectMemberRolesCanBeManaged(a, oldProject, project); err != nil {
+				return admission.NewForbidden(a, err)
+			}
+
 			if createdBy, ok := project.Annotations[common.GardenCreatedBy]; ok {
 				project.Spec.CreatedBy = &rbacv1.Subject{
 					APIGroup: "rbac.authorization.k8s.io",
@@ -309,6 +319,47 @@ func (r *ReferenceManager) Admit(a admission.Attributes, o admission.ObjectInter
 	return nil
 }
 
+// ensureProjectMemberRolesCanBeManaged ensures that users which are only allowed to manage the viewers and user access
+// managers of a project (i.e., the `uam` role) cannot grant or revoke any other role or change the owner. Such changes
+// require the `escalate` verb for the project which is only granted to the owner and the admins of the project.
+func (r *ReferenceManager) ensureProjectMemberRolesCanBeManaged(attributes admission.Attributes, oldProject, project *garden.Project) error {
+	if apiequality.Semantic.DeepEqual(oldProject.Spec.Owner, project.Spec.Owner) && !projectMemberRolesEscalated(oldProject.Spec.ProjectMembers, project.Spec.ProjectMembers) {
+		return nil
+	}
+
+	escalateAttributes := authorizer.AttributesRecord{
+		User:            attributes.GetUserInfo(),
+		Verb:            "escalate",
+		APIGroup:        attributes.GetResource().Group,
+		APIVersion:      attributes.GetResource().Version,
+		Resource:        "projects",
+		Name:            project.Name,
+		ResourceRequest: true,
+	}
+	if decision, _, _ := r.authorizer.Authorize(escalateAttributes); decision != authorizer.DecisionAllow {
+		return fmt.Errorf("only the owner and the admins of the project may change its owner or grant and revoke roles other than %q and %q", garden.ProjectMemberViewer, garden.ProjectMemberUserAccessManager)
+	}
+	return nil
+}
+
+// projectMemberRolesEscalated returns true if any role other than `viewer` and `uam` has been granted to or revoked
+// from any member.
+func projectMemberRolesEscalated(oldMembers, members []garden.ProjectMember) bool {
+	memberRoles := func(members []garden.ProjectMember) sets.String {
+		roles := sets.NewString()
+		for _, member := range members {
+			for _, role := range append([]string{member.Role}, member.Roles...) {
+				if role != garden.ProjectMemberViewer && role != garden.ProjectMemberUserAccessManager {
+					roles.Insert(fmt.Sprintf("%s/%s/%s/%s", member.Kind, member.Namespace, member.Name, role))
+				}
+			}
+		}
+		return roles
+	}
+
+	return !memberRoles(oldMembers).Equal(memberRoles(members))
+}
+
 func (r *ReferenceManager) ensureSecretBindingReferences(attributes admission.Attributes, binding *garden.SecretBinding) error {
 	readAttributes := authorizer.AttributesRecord{
 		User:            attributes.GetUserInfo(),
@@ -413,6 +464,12 @@ func (r *ReferenceManager) ensureShootReferences(shoot *garden.Shoot) error {
 		kubeAPIServer.AuditConfig.AuditPolicy.ConfigMapRef.ResourceVersion = auditPolicy.ResourceVersion
 	}
 
+	for _, secretName := range webhookSecretNames(kubeAPIServer) {
+		if err := r.lookupSecret(shoot.Namespace, secretName); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -424,6 +481,36 @@ func hasAuditPolicy(apiServerConfig *garden.KubeAPIServerConfig) bool {
 		len(apiServerConfig.AuditConfig.AuditPolicy.ConfigMapRef.Name) != 0
 }
 
+// webhookSecretNames returns the names of the secrets containing the kubeconfigs of the webhooks which are called by
+// the kube-apiserver, i.e., the audit, authentication, authorization and admission webhooks.
+func webhookSecretNames(apiServerConfig *garden.KubeAPIServerConfig) []string {
+	if apiServerConfig == nil {
+		return nil
+	}
+
+	var secretNames []string
+	addSecretName := func(name string) {
+		if len(name) != 0 {
+			secretNames = append(secretNames, name)
+		}
+	}
+
+	if auditConfig := apiServerConfig.AuditConfig; auditConfig != nil && auditConfig.Backend != nil && auditConfig.Backend.Webhook != nil {
+		addSecretName(auditConfig.Backend.Webhook.SecretRef.Name)
+	}
+	if apiServerConfig.AuthenticationWebhook != nil {
+		addSecretName(apiServerConfig.AuthenticationWebhook.SecretRef.Name)
+	}
+	if apiServerConfig.AuthorizationWebhook != nil {
+		addSecretName(apiServerConfig.AuthorizationWebhook.SecretRef.Name)
+	}
+	if apiServerConfig.AdmissionWebhookConfig != nil {
+		addSecretName(apiServerConfig.AdmissionWebhookConfig.SecretRef.Name)
+	}
+
+	return secretNames
+}
+
 func (r *ReferenceManager) lookupSecret(namespace, name string) error {
 	// First try to detect the secret in the cache.
 	var err error
@@ -26,6 +26,7 @@ import (
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apiserver/pkg/admission"
@@ -531,6 +532,53 @@ var _ = Describe("resourcereferencemanager", func() {
 
 				Expect(err).To(HaveOccurred())
 			})
+
+			Context("webhooks", func() {
+				BeforeEach(func() {
+					shoot.Spec.Kubernetes.KubeAPIServer = shootBase.Spec.Kubernetes.KubeAPIServer.DeepCopy()
+					shoot.Spec.Kubernetes.KubeAPIServer.AuditConfig.Backend = &garden.AuditBackend{
+						Webhook: &garden.AuditWebhookBackend{
+							SecretRef: corev1.LocalObjectReference{Name: secretName},
+						},
+					}
+					shoot.Spec.Kubernetes.KubeAPIServer.AuthenticationWebhook = &garden.AuthenticationWebhook{
+						SecretRef: corev1.LocalObjectReference{Name: secretName},
+					}
+					shoot.Spec.Kubernetes.KubeAPIServer.AuthorizationWebhook = &garden.AuthorizationWebhook{
+						SecretRef: corev1.LocalObjectReference{Name: secretName},
+					}
+					shoot.Spec.Kubernetes.KubeAPIServer.AdmissionWebhookConfig = &garden.AdmissionWebhookConfig{
+						SecretRef: corev1.LocalObjectReference{Name: secretName},
+					}
+
+					gardenInformerFactory.Garden().InternalVersion().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+					gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&seed)
+					gardenInformerFactory.Garden().InternalVersion().SecretBindings().Informer().GetStore().Add(&secretBinding)
+					kubeInformerFactory.Core().V1().ConfigMaps().Informer().GetStore().Add(&configMap)
+				})
+
+				It("should accept because the referenced secrets of the webhooks have been found", func() {
+					kubeInformerFactory.Core().V1().Secrets().Informer().GetStore().Add(&secret)
+
+					attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, defaultUserInfo)
+
+					err := admissionHandler.Admit(attrs, nil)
+
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				It("should reject because the referenced secrets of the webhooks do not exist", func() {
+					kubeClient.AddReactor("get", "secrets", func(action testing.Action) (bool, runtime.Object, error) {
+						return true, nil, fmt.Errorf("nope, out of luck")
+					})
+
+					attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, defaultUserInfo)
+
+					err := admissionHandler.Admit(attrs, nil)
+
+					Expect(err).To(HaveOccurred())
+				})
+			})
 		})
 
 		Context("tests for Project objects", func() {
@@ -581,6 +629,67 @@ var _ = Describe("resourcereferencemanager", func() {
 					Role: garden.ProjectMemberAdmin,
 				})))
 			})
+
+			Context("member roles", func() {
+				var (
+					owner      = rbacv1.Subject{APIGroup: "rbac.authorization.k8s.io", Kind: rbacv1.UserKind, Name: "owner"}
+					member     = rbacv1.Subject{APIGroup: "rbac.authorization.k8s.io", Kind: rbacv1.UserKind, Name: "member"}
+					oldProject garden.Project
+				)
+
+				BeforeEach(func() {
+					oldProject = *project.DeepCopy()
+					oldProject.Spec.Owner = &owner
+					oldProject.Spec.ProjectMembers = []garden.ProjectMember{
+						{Subject: owner, Role: garden.ProjectMemberAdmin},
+					}
+				})
+
+				test := func(mutate func(*garden.Project), userName string, expectForbidden bool) {
+					newProject := oldProject.DeepCopy()
+					mutate(newProject)
+
+					attrs := admission.NewAttributesRecord(newProject, &oldProject, garden.Kind("Project").WithVersion("version"), newProject.Namespace, newProject.Name, garden.Resource("projects").WithVersion("version"), "", admission.Update, false, &user.DefaultInfo{Name: userName})
+
+					err := admissionHandler.Admit(attrs, nil)
+
+					if expectForbidden {
+						Expect(apierrors.IsForbidden(err)).To(BeTrue())
+					} else {
+						Expect(err).NotTo(HaveOccurred())
+					}
+				}
+
+				It("should allow users without escalate permission to grant the viewer and uam roles", func() {
+					test(func(p *garden.Project) {
+						p.Spec.ProjectMembers = append(p.Spec.ProjectMembers, garden.ProjectMember{Subject: member, Role: garden.ProjectMemberViewer, Roles: []string{garden.ProjectMemberUserAccessManager}})
+					}, defaultUserName, false)
+				})
+
+				It("should forbid users without escalate permission to grant the admin role", func() {
+					test(func(p *garden.Project) {
+						p.Spec.ProjectMembers = append(p.Spec.ProjectMembers, garden.ProjectMember{Subject: member, Role: garden.ProjectMemberAdmin})
+					}, defaultUserName, true)
+				})
+
+				It("should forbid users without escalate permission to grant extension roles", func() {
+					test(func(p *garden.Project) {
+						p.Spec.ProjectMembers = append(p.Spec.ProjectMembers, garden.ProjectMember{Subject: member, Role: garden.ProjectMemberViewer, Roles: []string{"extension:foo"}})
+					}, defaultUserName, true)
+				})
+
+				It("should forbid users without escalate permission to change the owner", func() {
+					test(func(p *garden.Project) {
+						p.Spec.Owner = &member
+					}, defaultUserName, true)
+				})
+
+				It("should allow users with escalate permission to grant the admin role", func() {
+					test(func(p *garden.Project) {
+						p.Spec.ProjectMembers = append(p.Spec.ProjectMembers, garden.ProjectMember{Subject: member, Role: garden.ProjectMemberAdmin})
+					}, allowedUser, false)
+				})
+			})
 		})
 	})
 })
@@ -15,9 +15,11 @@
 package validator
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
+	"net"
 	"reflect"
 	"strings"
 	"time"
@@ -35,6 +37,7 @@ import (
 	admissionutils "github.com/gardener/gardener/plugin/pkg/utils"
 
 	"github.com/Masterminds/semver"
+	corev1 "k8s.io/api/core/v1"
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -42,6 +45,8 @@ import (
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/apiserver/pkg/admission"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/util/retry"
 )
 
 const (
@@ -63,11 +68,13 @@ type ValidateShoot struct {
 	seedLister         listers.SeedLister
 	shootLister        listers.ShootLister
 	projectLister      listers.ProjectLister
+	kubeClient         kubernetes.Interface
 	readyFunc          admission.ReadyFunc
 }
 
 var (
 	_ = admissioninitializer.WantsInternalGardenInformerFactory(&ValidateShoot{})
+	_ = admissioninitializer.WantsKubeClientset(&ValidateShoot{})
 
 	readyFuncs = []admission.ReadyFunc{}
 )
@@ -102,6 +109,11 @@ func (v *ValidateShoot) SetInternalGardenInformerFactory(f informers.SharedInfor
 	readyFuncs = append(readyFuncs, seedInformer.Informer().HasSynced, shootInformer.Informer().HasSynced, cloudProfileInformer.Informer().HasSynced, projectInformer.Informer().HasSynced)
 }
 
+// SetKubeClientset gets the clientset from the Kubernetes client.
+func (v *ValidateShoot) SetKubeClientset(c kubernetes.Interface) {
+	v.kubeClient = c
+}
+
 // ValidateInitialization checks whether the plugin was correctly initialized.
 func (v *ValidateShoot) ValidateInitialization() error {
 	if v.cloudProfileLister == nil {
@@ -116,6 +128,9 @@ func (v *ValidateShoot) ValidateInitialization() error {
 	if v.projectLister == nil {
 		return errors.New("missing project lister")
 	}
+	if v.kubeClient == nil {
+		return errors.New("missing kubernetes client")
+	}
 	return nil
 }
 
@@ -262,6 +277,14 @@ func (v *ValidateShoot) Admit(a admission.Attributes, o admission.ObjectInterfac
 		oldShoot = old
 	}
 
+	// The backup-restore sidecar of etcd always restores the latest state of the backup, hence, restore targets cannot be
+	// supported. Shoots which already carry the annotation are not rejected as long as it is not changed.
+	if target, ok := shoot.Annotations[common.ShootETCDRestoreTarget]; ok {
+		if oldTarget, oldOk := oldShoot.Annotations[common.ShootETCDRestoreTarget]; !oldOk || target != oldTarget {
+			return admission.NewForbidden(a, fmt.Errorf("the annotation %q is not supported: the etcd is always restored to the latest state of its backup", common.ShootETCDRestoreTarget))
+		}
+	}
+
 	var (
 		validationContext = &validationContext{
 			cloudProfile: cloudProfile,
@@ -328,8 +351,11 @@ func (v *ValidateShoot) Admit(a admission.Attributes, o admission.ObjectInterfac
 		}
 	}
 
+	// The pod network is allocated from the pool of the Seed at the end, hence, the defaults of the Seed do not apply.
+	podsFromPool := seed != nil && shoot.DeletionTimestamp == nil && allocatesPodsFromPool(shoot, seed)
+
 	if seed != nil {
-		if shoot.Spec.Networking.Pods == nil && seed.Spec.Networks.ShootDefaults != nil {
+		if shoot.Spec.Networking.Pods == nil && !podsFromPool && seed.Spec.Networks.ShootDefaults != nil {
 			shoot.Spec.Networking.Pods = seed.Spec.Networks.ShootDefaults.Pods
 		}
 		if shoot.Spec.Networking.Services == nil && seed.Spec.Networks.ShootDefaults != nil {
@@ -550,7 +576,7 @@ func (v *ValidateShoot) Admit(a admission.Attributes, o admission.ObjectInterfac
 	}
 
 	if seed != nil {
-		if shoot.Spec.Networking.Pods == nil {
+		if shoot.Spec.Networking.Pods == nil && !podsFromPool {
 			if seed.Spec.Networks.ShootDefaults != nil {
 				shoot.Spec.Networking.Pods = seed.Spec.Networks.ShootDefaults.Pods
 			} else {
@@ -569,6 +595,10 @@ func (v *ValidateShoot) Admit(a admission.Attributes, o admission.ObjectInterfac
 
 	allErrs = append(allErrs, validateProvider(validationContext)...)
 
+	if seed != nil && shoot.Spec.ControlPlane != nil {
+		allErrs = append(allErrs, admissionutils.ValidateNetworkPolicyRulesNotBlocked(seed.Spec.Networks, shoot.Spec.ControlPlane.NetworkPolicyRules, field.NewPath("spec", "controlPlane", "networkPolicyRules"))...)
+	}
+
 	dnsErrors, err := validateDNSDomainUniqueness(v.shootLister, shoot.Name, shoot.Spec.DNS)
 	if err != nil {
 		return apierrors.NewInternalError(err)
@@ -579,6 +609,17 @@ func (v *ValidateShoot) Admit(a admission.Attributes, o admission.ObjectInterfac
 		return admission.NewForbidden(a, fmt.Errorf("%+v", allErrs))
 	}
 
+	// Networks are allocated as the last step so that only Shoots which pass all other checks reserve networks.
+	if seed != nil && shoot.DeletionTimestamp == nil {
+		allocationErrs, err := v.allocateNetworks(shoot, seed, a.IsDryRun())
+		if err != nil {
+			return apierrors.NewInternalError(err)
+		}
+		if len(allocationErrs) > 0 {
+			return admission.NewForbidden(a, fmt.Errorf("%+v", allocationErrs))
+		}
+	}
+
 	return nil
 }
 
@@ -918,7 +959,27 @@ func validateProvider(c *validationContext) field.ErrorList {
 	)
 
 	if c.seed != nil {
-		allErrs = append(allErrs, admissionutils.ValidateNetworkDisjointedness(c.seed.Spec.Networks, garden.K8SNetworks{Nodes: &c.shoot.Spec.Networking.Nodes, Pods: c.shoot.Spec.Networking.Pods, Services: c.shoot.Spec.Networking.Services}, path.Child("networking"))...)
+		var nodes *string
+		if len(c.shoot.Spec.Networking.Nodes) > 0 {
+			nodes = &c.shoot.Spec.Networking.Nodes
+		}
+
+		// Networks which are allocated from the pools of the Seed are only set at the end of the admission, hence, they
+		// are not required here.
+		allocatedFromPool := sets.NewString()
+		if c.shoot.DeletionTimestamp == nil && allocatesNodesFromPool(c.shoot, c.seed) {
+			allocatedFromPool.Insert(path.Child("networking", "nodes").String())
+		}
+		if c.shoot.DeletionTimestamp == nil && allocatesPodsFromPool(c.shoot, c.seed) {
+			allocatedFromPool.Insert(path.Child("networking", "pods").String())
+		}
+		for _, err := range admissionutils.ValidateNetworkDisjointedness(c.seed.Spec.Networks, garden.K8SNetworks{Nodes: nodes, Pods: c.shoot.Spec.Networking.Pods, Services: c.shoot.Spec.Networking.Services}, path.Child("networking")) {
+			if err.Type == field.ErrorTypeRequired && allocatedFromPool.Has(err.Field) {
+				continue
+			}
+			allErrs = append(allErrs, err)
+		}
+		allErrs = append(allErrs, admissionutils.ValidateIPFamilies(c.seed.Spec.Networks.IPFamilies, c.shoot.Spec.Networking.IPFamilies, field.NewPath("spec", "networking", "ipFamilies"))...)
 	}
 
 	ok, validKubernetesVersions, versionDefault := validateKubernetesVersionConstraints(c.cloudProfile.Spec.Kubernetes.Versions, c.shoot.Spec.Kubernetes.Version, c.oldShoot.Spec.Kubernetes.Version)
@@ -963,6 +1024,216 @@ func validateProvider(c *validationContext) field.ErrorList {
 	return allErrs
 }
 
+const (
+	// networkReservationsConfigMapName is the name of the config map in the garden namespace which contains the networks
+	// that have recently been allocated from the shoot network pools of all Seeds.
+	networkReservationsConfigMapName = "shoot-network-reservations"
+	// networkReservationsDataKey is the data key of the network reservations config map.
+	networkReservationsDataKey = "reservations"
+	// networkReservationTTL is the duration for which allocated networks stay reserved. It must be long enough for the
+	// admitted Shoot to be persisted and to show up in the informer cache.
+	networkReservationTTL = 5 * time.Minute
+)
+
+// networkReservation contains the networks which have been allocated for a Shoot from the pools of a Seed.
+type networkReservation struct {
+	Networks   []string    `json:"networks"`
+	ReservedAt metav1.Time `json:"reservedAt"`
+}
+
+// allocatesNodesFromPool returns true if the node network of the given Shoot is allocated from the pool of the given
+// Seed.
+func allocatesNodesFromPool(shoot *garden.Shoot, seed *garden.Seed) bool {
+	pools := seed.Spec.Networks.ShootPools
+	return pools != nil && pools.Nodes != nil && len(shoot.Spec.Networking.Nodes) == 0 && poolMatchesIPFamilies(*pools.Nodes, shoot.Spec.Networking.IPFamilies)
+}
+
+// allocatesPodsFromPool returns true if the pod network of the given Shoot is allocated from the pool of the given Seed.
+func allocatesPodsFromPool(shoot *garden.Shoot, seed *garden.Seed) bool {
+	pools := seed.Spec.Networks.ShootPools
+	return pools != nil && pools.Pods != nil && shoot.Spec.Networking.Pods == nil && poolMatchesIPFamilies(*pools.Pods, shoot.Spec.Networking.IPFamilies)
+}
+
+// allocateNetworks allocates the node and pod networks of the given Shoot from the network pools of the given Seed if
+// they are not specified. The allocated networks intersect neither with the networks of the Seed nor with the networks
+// of any other Shoot in the system. Networks are only allocated for single-stack Shoots whose IP family matches the one
+// of the pool.
+// As the Shoot cache may not yet contain recently admitted Shoots, the allocated networks are reserved in a config map
+// in the garden namespace which is shared by all Seeds. The config map is updated with optimistic locking, hence,
+// concurrent allocations are serialized across Seeds and retried on conflicts. Dry-run requests do not reserve networks.
+func (v *ValidateShoot) allocateNetworks(shoot *garden.Shoot, seed *garden.Seed, dryRun bool) (field.ErrorList, error) {
+	var (
+		allErrs    = field.ErrorList{}
+		pools      = seed.Spec.Networks.ShootPools
+		networking = &shoot.Spec.Networking
+	)
+
+	if pools == nil || (pools.Nodes == nil || len(networking.Nodes) > 0) && (pools.Pods == nil || networking.Pods != nil) {
+		return allErrs, nil
+	}
+
+	var (
+		nodes string
+		pods  *string
+	)
+
+	if err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		configMap, err := v.kubeClient.CoreV1().ConfigMaps(v1alpha1constants.GardenNamespace).Get(networkReservationsConfigMapName, metav1.GetOptions{})
+		exists := err == nil
+		if err != nil {
+			if !apierrors.IsNotFound(err) {
+				return err
+			}
+			configMap = &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      networkReservationsConfigMapName,
+					Namespace: v1alpha1constants.GardenNamespace,
+				},
+			}
+		}
+
+		shoots, err := v.shootLister.Shoots(metav1.NamespaceAll).List(labels.Everything())
+		if err != nil {
+			return err
+		}
+
+		var reservations map[string]networkReservation
+		allErrs, nodes, pods, reservations = computeNetworkAllocation(shoot, seed, shoots, configMap.Data[networkReservationsDataKey], time.Now())
+		if _, ok := reservations[fmt.Sprintf("%s/%s", shoot.Namespace, shoot.Name)]; len(allErrs) > 0 || !ok || dryRun {
+			return nil
+		}
+
+		data, err := json.Marshal(reservations)
+		if err != nil {
+			return err
+		}
+		configMap.Data = map[string]string{networkReservationsDataKey: string(data)}
+
+		if !exists {
+			_, err = v.kubeClient.CoreV1().ConfigMaps(v1alpha1constants.GardenNamespace).Create(configMap)
+			if apierrors.IsAlreadyExists(err) {
+				// The config map has been created by a concurrent allocation, hence, the allocation is retried.
+				return apierrors.NewConflict(corev1.Resource("configmaps"), networkReservationsConfigMapName, err)
+			}
+			return err
+		}
+
+		_, err = v.kubeClient.CoreV1().ConfigMaps(v1alpha1constants.GardenNamespace).Update(configMap)
+		return err
+	}); err != nil {
+		return allErrs, err
+	}
+
+	if len(allErrs) == 0 {
+		networking.Nodes = nodes
+		networking.Pods = pods
+	}
+	return allErrs, nil
+}
+
+// computeNetworkAllocation allocates the missing networks of the given Shoot from the pools of the given Seed, taking
+// the networks of the other <shoots> and the unexpired <reservationsData> into account. It returns the resulting node
+// and pod networks as well as the reservations which shall be stored. Reservations of Shoots whose networks are
+// contained in <shoots> are dropped as these networks are considered anyway.
+func computeNetworkAllocation(shoot *garden.Shoot, seed *garden.Seed, shoots []*garden.Shoot, reservationsData string, now time.Time) (field.ErrorList, string, *string, map[string]networkReservation) {
+	var (
+		allErrs      = field.ErrorList{}
+		pools        = seed.Spec.Networks.ShootPools
+		networking   = shoot.Spec.Networking
+		path         = field.NewPath("spec", "networking")
+		key          = fmt.Sprintf("%s/%s", shoot.Namespace, shoot.Name)
+		known        = map[string]sets.String{}
+		reservations = map[string]networkReservation{}
+		usedNetworks = []string{seed.Spec.Networks.Nodes, seed.Spec.Networks.Pods, seed.Spec.Networks.Services}
+	)
+
+	addNetworks := func(networking garden.Networking) {
+		usedNetworks = append(usedNetworks, networking.Nodes)
+		if networking.Pods != nil {
+			usedNetworks = append(usedNetworks, *networking.Pods)
+		}
+		if networking.Services != nil {
+			usedNetworks = append(usedNetworks, *networking.Services)
+		}
+	}
+
+	for _, other := range shoots {
+		if other.Namespace == shoot.Namespace && other.Name == shoot.Name {
+			continue
+		}
+		networks := sets.NewString(other.Spec.Networking.Nodes)
+		if other.Spec.Networking.Pods != nil {
+			networks.Insert(*other.Spec.Networking.Pods)
+		}
+		known[fmt.Sprintf("%s/%s", other.Namespace, other.Name)] = networks
+		addNetworks(other.Spec.Networking)
+	}
+	addNetworks(networking)
+
+	if len(reservationsData) > 0 {
+		existing := map[string]networkReservation{}
+		// Unreadable reservations are dropped, they are only a safeguard until the cache has caught up.
+		if err := json.Unmarshal([]byte(reservationsData), &existing); err == nil {
+			for name, reservation := range existing {
+				if name == key || known[name].HasAll(reservation.Networks...) || now.Sub(reservation.ReservedAt.Time) > networkReservationTTL {
+					continue
+				}
+				reservations[name] = reservation
+				usedNetworks = append(usedNetworks, reservation.Networks...)
+			}
+		}
+	}
+
+	var allocated []string
+	allocate := func(pool *garden.NetworkPool, fldPath *field.Path) *string {
+		if !poolMatchesIPFamilies(*pool, networking.IPFamilies) {
+			return nil
+		}
+
+		network, err := admissionutils.AllocateNetwork(*pool, usedNetworks)
+		if err != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath, fmt.Sprintf("could not allocate network from pool of seed %s: %v", seed.Name, err)))
+			return nil
+		}
+
+		usedNetworks = append(usedNetworks, network)
+		allocated = append(allocated, network)
+		return &network
+	}
+
+	nodes, pods := networking.Nodes, networking.Pods
+	if pools.Nodes != nil && len(nodes) == 0 {
+		if network := allocate(pools.Nodes, path.Child("nodes")); network != nil {
+			nodes = *network
+		}
+	}
+	if pools.Pods != nil && pods == nil {
+		pods = allocate(pools.Pods, path.Child("pods"))
+	}
+
+	if len(allocated) > 0 {
+		reservations[key] = networkReservation{Networks: allocated, ReservedAt: metav1.NewTime(now)}
+	}
+	return allErrs, nodes, pods, reservations
+}
+
+// poolMatchesIPFamilies returns true if the given <ipFamilies> denote single-stack networking with the IP family of the
+// given <pool>.
+func poolMatchesIPFamilies(pool garden.NetworkPool, ipFamilies []garden.IPFamily) bool {
+	ip, _, err := net.ParseCIDR(pool.CIDR)
+	if err != nil {
+		return false
+	}
+
+	poolIPFamily := garden.IPFamilyIPv6
+	if ip.To4() != nil {
+		poolIPFamily = garden.IPFamilyIPv4
+	}
+
+	ipFamilies = helper.GetIPFamilies(ipFamilies)
+	return len(ipFamilies) == 1 && ipFamilies[0] == poolIPFamily
+}
+
 func validateDNSDomainUniqueness(shootLister listers.ShootLister, name string, dns *garden.DNS) (field.ErrorList, error) {
 	var (
 		allErrs = field.ErrorList{}
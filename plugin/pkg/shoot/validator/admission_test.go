@@ -39,6 +39,8 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apiserver/pkg/admission"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/testing"
 	"k8s.io/utils/pointer"
 )
 
@@ -47,6 +49,7 @@ var _ = Describe("validator", func() {
 		var (
 			admissionHandler      *ValidateShoot
 			gardenInformerFactory gardeninformers.SharedInformerFactory
+			kubeClient            *fake.Clientset
 			cloudProfile          garden.CloudProfile
 			seed                  garden.Seed
 			project               garden.Project
@@ -220,6 +223,8 @@ var _ = Describe("validator", func() {
 			admissionHandler.AssignReadyFunc(func() bool { return true })
 			gardenInformerFactory = gardeninformers.NewSharedInformerFactory(nil, 0)
 			admissionHandler.SetInternalGardenInformerFactory(gardenInformerFactory)
+			kubeClient = fake.NewSimpleClientset()
+			admissionHandler.SetKubeClientset(kubeClient)
 		})
 
 		AfterEach(func() {
@@ -654,6 +659,50 @@ var _ = Describe("validator", func() {
 			})
 		})
 
+		Context("etcd restore target checks", func() {
+			var oldShoot *garden.Shoot
+
+			BeforeEach(func() {
+				shoot = *shootBase.DeepCopy()
+				oldShoot = shoot.DeepCopy()
+
+				_ = gardenInformerFactory.Garden().InternalVersion().Projects().Informer().GetStore().Add(&project)
+				_ = gardenInformerFactory.Garden().InternalVersion().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+				_ = gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&seed)
+			})
+
+			It("should reject adding the etcd restore target annotation", func() {
+				shoot.Annotations = map[string]string{common.ShootETCDRestoreTarget: "42"}
+
+				attrs := admission.NewAttributesRecord(&shoot, oldShoot, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Update, false, nil)
+
+				err := admissionHandler.Admit(attrs, nil)
+				Expect(err).To(HaveOccurred())
+				Expect(apierrors.IsForbidden(err)).To(BeTrue())
+			})
+
+			It("should reject changing the etcd restore target annotation", func() {
+				oldShoot.Annotations = map[string]string{common.ShootETCDRestoreTarget: "42"}
+				shoot.Annotations = map[string]string{common.ShootETCDRestoreTarget: "23"}
+
+				attrs := admission.NewAttributesRecord(&shoot, oldShoot, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Update, false, nil)
+
+				err := admissionHandler.Admit(attrs, nil)
+				Expect(err).To(HaveOccurred())
+				Expect(apierrors.IsForbidden(err)).To(BeTrue())
+			})
+
+			It("should allow updating shoots which already carry the etcd restore target annotation", func() {
+				oldShoot.Annotations = map[string]string{common.ShootETCDRestoreTarget: "42"}
+				shoot.Annotations = map[string]string{common.ShootETCDRestoreTarget: "42"}
+
+				attrs := admission.NewAttributesRecord(&shoot, oldShoot, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Update, false, nil)
+
+				err := admissionHandler.Admit(attrs, nil)
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
 		It("should reject because the referenced cloud profile was not found", func() {
 			attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
 
@@ -850,6 +899,25 @@ var _ = Describe("validator", func() {
 				Expect(apierrors.IsForbidden(err)).To(BeTrue())
 			})
 
+			It("should reject because a network policy rule of the control plane targets a blocked network of the seed", func() {
+				seedWithBlockedCIDRs := seed.DeepCopy()
+				seedWithBlockedCIDRs.Spec.Networks.BlockCIDRs = []string{"169.254.169.254/32"}
+				shoot.Spec.ControlPlane = &garden.ControlPlane{NetworkPolicyRules: []garden.NetworkPolicyRule{
+					{Name: "metadata", CIDRs: []string{"169.254.0.0/16"}},
+				}}
+
+				gardenInformerFactory.Garden().InternalVersion().Projects().Informer().GetStore().Add(&project)
+				gardenInformerFactory.Garden().InternalVersion().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+				gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(seedWithBlockedCIDRs)
+				attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).To(HaveOccurred())
+				Expect(apierrors.IsForbidden(err)).To(BeTrue())
+				Expect(err.Error()).To(ContainSubstring("spec.controlPlane.networkPolicyRules[0].cidrs[0]"))
+			})
+
 			It("should reject because the specified domain is already used by another shoot", func() {
 				anotherShoot := shoot.DeepCopy()
 				anotherShoot.Name = "another-shoot"
@@ -2596,6 +2664,167 @@ var _ = Describe("validator", func() {
 				Expect(apierrors.IsForbidden(err)).To(BeTrue())
 			})
 
+			Context("network allocation", func() {
+				reservationsConfigMap := func(reservations string) *corev1.ConfigMap {
+					return &corev1.ConfigMap{
+						ObjectMeta: metav1.ObjectMeta{Name: "shoot-network-reservations", Namespace: "garden"},
+						Data:       map[string]string{"reservations": reservations},
+					}
+				}
+
+				reservation := func(name, network string, reservedAt time.Time) string {
+					return fmt.Sprintf(`{"%s/%s":{"networks":["%s"],"reservedAt":"%s"}}`, shoot.Namespace, name, network, reservedAt.UTC().Format(time.RFC3339))
+				}
+
+				BeforeEach(func() {
+					seed.Spec.Networks.ShootPools = &garden.ShootNetworkPools{
+						Nodes: &garden.NetworkPool{CIDR: "10.16.0.0/12", PrefixLength: 16},
+					}
+					shoot.Spec.Networking.Nodes = ""
+
+					gardenInformerFactory.Garden().InternalVersion().Projects().Informer().GetStore().Add(&project)
+					gardenInformerFactory.Garden().InternalVersion().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+					gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&seed)
+				})
+
+				It("should allocate the node and pod networks from the pools of the seed", func() {
+					seed.Spec.Networks.ShootPools.Pods = &garden.NetworkPool{CIDR: "100.96.0.0/11", PrefixLength: 16}
+					anotherShoot := shoot.DeepCopy()
+					anotherShoot.Name = "another-shoot"
+					anotherShoot.Spec.DNS = nil
+					anotherShoot.Spec.Networking.Nodes = "10.16.0.0/16"
+					anotherShoot.Spec.Networking.Pods = test.MakeStrPointer("100.96.0.0/16")
+					shoot.Spec.Networking.Pods = nil
+
+					gardenInformerFactory.Garden().InternalVersion().Shoots().Informer().GetStore().Add(anotherShoot)
+					attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+
+					err := admissionHandler.Admit(attrs, nil)
+
+					Expect(err).NotTo(HaveOccurred())
+					Expect(shoot.Spec.Networking.Nodes).To(Equal("10.17.0.0/16"))
+					Expect(shoot.Spec.Networking.Pods).To(Equal(test.MakeStrPointer("100.97.0.0/16")))
+
+					configMap, err := kubeClient.CoreV1().ConfigMaps("garden").Get("shoot-network-reservations", metav1.GetOptions{})
+					Expect(err).NotTo(HaveOccurred())
+					Expect(configMap.Data["reservations"]).To(ContainSubstring(`"garden-my-project/shoot":{"networks":["10.17.0.0/16","100.97.0.0/16"]`))
+				})
+
+				It("should not allocate networks which are reserved for shoots missing in the cache", func() {
+					_, err := kubeClient.CoreV1().ConfigMaps("garden").Create(reservationsConfigMap(reservation("another-shoot", "10.16.0.0/16", time.Now())))
+					Expect(err).NotTo(HaveOccurred())
+					attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+
+					err = admissionHandler.Admit(attrs, nil)
+
+					Expect(err).NotTo(HaveOccurred())
+					Expect(shoot.Spec.Networking.Nodes).To(Equal("10.17.0.0/16"))
+
+					configMap, err := kubeClient.CoreV1().ConfigMaps("garden").Get("shoot-network-reservations", metav1.GetOptions{})
+					Expect(err).NotTo(HaveOccurred())
+					Expect(configMap.Data["reservations"]).To(And(ContainSubstring("another-shoot"), ContainSubstring("10.17.0.0/16")))
+				})
+
+				It("should not allocate networks which are reserved for shoots of other seeds", func() {
+					// The reservations are shared by all seeds as the networks must be unique in the whole system.
+					anotherSeed := seed.DeepCopy()
+					anotherSeed.Name = "another-seed"
+					shoot.Spec.SeedName = &anotherSeed.Name
+
+					gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(anotherSeed)
+					_, err := kubeClient.CoreV1().ConfigMaps("garden").Create(reservationsConfigMap(reservation("another-shoot", "10.16.0.0/16", time.Now())))
+					Expect(err).NotTo(HaveOccurred())
+					attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+
+					err = admissionHandler.Admit(attrs, nil)
+
+					Expect(err).NotTo(HaveOccurred())
+					Expect(shoot.Spec.Networking.Nodes).To(Equal("10.17.0.0/16"))
+				})
+
+				It("should ignore expired network reservations", func() {
+					_, err := kubeClient.CoreV1().ConfigMaps("garden").Create(reservationsConfigMap(reservation("another-shoot", "10.16.0.0/16", time.Now().Add(-time.Hour))))
+					Expect(err).NotTo(HaveOccurred())
+					attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+
+					err = admissionHandler.Admit(attrs, nil)
+
+					Expect(err).NotTo(HaveOccurred())
+					Expect(shoot.Spec.Networking.Nodes).To(Equal("10.16.0.0/16"))
+				})
+
+				It("should retry the allocation if the reservations were updated concurrently", func() {
+					_, err := kubeClient.CoreV1().ConfigMaps("garden").Create(reservationsConfigMap("{}"))
+					Expect(err).NotTo(HaveOccurred())
+
+					// Simulate a concurrent allocation which has reserved the first network of the pool.
+					concurrentConfigMap := reservationsConfigMap(reservation("another-shoot", "10.16.0.0/16", time.Now()))
+					conflicts := 0
+					kubeClient.PrependReactor("get", "configmaps", func(action testing.Action) (bool, runtime.Object, error) {
+						if conflicts == 0 {
+							return false, nil, nil
+						}
+						return true, concurrentConfigMap.DeepCopy(), nil
+					})
+					kubeClient.PrependReactor("update", "configmaps", func(action testing.Action) (bool, runtime.Object, error) {
+						if conflicts > 0 {
+							return false, nil, nil
+						}
+						conflicts++
+						return true, nil, apierrors.NewConflict(corev1.Resource("configmaps"), concurrentConfigMap.Name, fmt.Errorf("conflict"))
+					})
+					attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+
+					err = admissionHandler.Admit(attrs, nil)
+
+					Expect(err).NotTo(HaveOccurred())
+					Expect(conflicts).To(Equal(1))
+					Expect(shoot.Spec.Networking.Nodes).To(Equal("10.17.0.0/16"))
+				})
+
+				It("should allocate networks but not reserve them for dry-run requests", func() {
+					attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, true, nil)
+
+					err := admissionHandler.Admit(attrs, nil)
+
+					Expect(err).NotTo(HaveOccurred())
+					Expect(shoot.Spec.Networking.Nodes).To(Equal("10.16.0.0/16"))
+
+					_, err = kubeClient.CoreV1().ConfigMaps("garden").Get("shoot-network-reservations", metav1.GetOptions{})
+					Expect(apierrors.IsNotFound(err)).To(BeTrue())
+				})
+
+				It("should not reserve networks for shoots which are rejected by other checks", func() {
+					shoot.Spec.Networking.Services = &seedServicesCIDR
+
+					attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+
+					err := admissionHandler.Admit(attrs, nil)
+
+					Expect(err).To(HaveOccurred())
+					Expect(apierrors.IsForbidden(err)).To(BeTrue())
+
+					_, err = kubeClient.CoreV1().ConfigMaps("garden").Get("shoot-network-reservations", metav1.GetOptions{})
+					Expect(apierrors.IsNotFound(err)).To(BeTrue())
+				})
+
+				It("should reject because the network pool of the seed is exhausted", func() {
+					seed.Spec.Networks.ShootPools.Nodes = &garden.NetworkPool{CIDR: "10.16.0.0/16", PrefixLength: 16}
+					anotherShoot := shoot.DeepCopy()
+					anotherShoot.Name = "another-shoot"
+					anotherShoot.Spec.DNS = nil
+					anotherShoot.Spec.Networking.Nodes = "10.16.0.0/16"
+
+					gardenInformerFactory.Garden().InternalVersion().Shoots().Informer().GetStore().Add(anotherShoot)
+					attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+
+					err := admissionHandler.Admit(attrs, nil)
+
+					Expect(err).To(HaveOccurred())
+					Expect(apierrors.IsForbidden(err)).To(BeTrue())
+				})
+			})
+
 			It("should reject because the specified domain is already used by another shoot", func() {
 				anotherShoot := shoot.DeepCopy()
 				anotherShoot.Name = "another-shoot"
@@ -107,4 +107,112 @@ var _ = Describe("utils", func() {
 			}))))
 		})
 	})
+
+	Describe("#ValidateNetworkPolicyRulesNotBlocked", func() {
+		var seedNetworks = garden.SeedNetworks{
+			Nodes:      "10.240.0.0/16",
+			Pods:       "100.96.0.0/11",
+			Services:   "100.64.0.0/13",
+			BlockCIDRs: []string{"169.254.169.254/32", "10.250.0.0/16"},
+		}
+
+		It("should pass the validation", func() {
+			rules := []garden.NetworkPolicyRule{
+				{Name: "foo", CIDRs: []string{"1.2.3.4/32", "10.251.0.0/16"}},
+				{Name: "bar", CIDRs: []string{"invalid"}},
+			}
+
+			errorList := ValidateNetworkPolicyRulesNotBlocked(seedNetworks, rules, field.NewPath("rules"))
+
+			Expect(errorList).To(BeEmpty())
+		})
+
+		It("should fail due to destinations intersecting with blocked networks", func() {
+			rules := []garden.NetworkPolicyRule{
+				{Name: "foo", CIDRs: []string{"1.2.3.4/32", "169.254.0.0/16"}},
+				{Name: "bar", CIDRs: []string{"10.250.1.0/24"}},
+			}
+
+			errorList := ValidateNetworkPolicyRulesNotBlocked(seedNetworks, rules, field.NewPath("rules"))
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("rules[0].cidrs[1]"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("rules[1].cidrs[0]"),
+			}))))
+		})
+
+		It("should fail due to destinations intersecting with the networks of the seed", func() {
+			rules := []garden.NetworkPolicyRule{
+				{Name: "foo", CIDRs: []string{"10.240.1.0/24"}},
+				{Name: "bar", CIDRs: []string{"100.64.0.0/10"}},
+			}
+
+			errorList := ValidateNetworkPolicyRulesNotBlocked(seedNetworks, rules, field.NewPath("rules"))
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(field.ErrorTypeInvalid),
+				"Field":  Equal("rules[0].cidrs[0]"),
+				"Detail": ContainSubstring("node network"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(field.ErrorTypeInvalid),
+				"Field":  Equal("rules[1].cidrs[0]"),
+				"Detail": ContainSubstring("pod network"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(field.ErrorTypeInvalid),
+				"Field":  Equal("rules[1].cidrs[0]"),
+				"Detail": ContainSubstring("service network"),
+			}))))
+		})
+	})
+
+	Describe("#ValidateIPFamilies", func() {
+		It("should pass the validation", func() {
+			errorList := ValidateIPFamilies([]garden.IPFamily{garden.IPFamilyIPv4, garden.IPFamilyIPv6}, []garden.IPFamily{garden.IPFamilyIPv6}, field.NewPath("ipFamilies"))
+
+			Expect(errorList).To(BeEmpty())
+		})
+
+		It("should fail due to IP families not supported by the seed", func() {
+			errorList := ValidateIPFamilies(nil, []garden.IPFamily{garden.IPFamilyIPv4, garden.IPFamilyIPv6}, field.NewPath("ipFamilies"))
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":     Equal(field.ErrorTypeInvalid),
+				"Field":    Equal("ipFamilies"),
+				"BadValue": Equal(garden.IPFamilyIPv6),
+			}))))
+		})
+	})
+
+	Describe("#AllocateNetwork", func() {
+		var pool = garden.NetworkPool{CIDR: "10.0.0.0/14", PrefixLength: 16}
+
+		It("should allocate the first network of the pool", func() {
+			Expect(AllocateNetwork(pool, nil)).To(Equal("10.0.0.0/16"))
+		})
+
+		It("should skip networks which are already in use", func() {
+			Expect(AllocateNetwork(pool, []string{"10.0.0.0/16", "10.1.128.0/24,fd00::/64", "invalid", "192.168.0.0/16"})).To(Equal("10.2.0.0/16"))
+		})
+
+		It("should skip networks covered by larger used networks", func() {
+			Expect(AllocateNetwork(pool, []string{"10.0.0.0/15"})).To(Equal("10.2.0.0/16"))
+		})
+
+		It("should allocate IPv6 networks", func() {
+			Expect(AllocateNetwork(garden.NetworkPool{CIDR: "fd00:10::/48", PrefixLength: 64}, []string{"fd00:10::/64"})).To(Equal("fd00:10:0:1::/64"))
+		})
+
+		It("should fail if the pool is exhausted", func() {
+			_, err := AllocateNetwork(pool, []string{"10.0.0.0/8"})
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should fail if the prefix length does not fit into the pool", func() {
+			_, err := AllocateNetwork(garden.NetworkPool{CIDR: "10.0.0.0/16", PrefixLength: 14}, nil)
+			Expect(err).To(HaveOccurred())
+		})
+	})
 })
@@ -15,9 +15,16 @@
 package utils
 
 import (
+	"fmt"
+	"math/big"
 	"net"
+	"strings"
 
 	"github.com/gardener/gardener/pkg/apis/garden"
+	"github.com/gardener/gardener/pkg/apis/garden/helper"
+	"github.com/gardener/gardener/pkg/utils"
+
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 )
 
@@ -32,7 +39,7 @@ func ValidateNetworkDisjointedness(seedNetworks garden.SeedNetworks, k8sNetworks
 	)
 
 	if nodes := k8sNetworks.Nodes; nodes != nil {
-		if networksIntersect(seedNetworks.Nodes, *nodes) {
+		if utils.NetworksIntersect(seedNetworks.Nodes, *nodes) {
 			allErrs = append(allErrs, field.Invalid(pathNodes, *nodes, "shoot node network intersects with seed node network"))
 		}
 	} else {
@@ -40,7 +47,7 @@ func ValidateNetworkDisjointedness(seedNetworks garden.SeedNetworks, k8sNetworks
 	}
 
 	if services := k8sNetworks.Services; services != nil {
-		if networksIntersect(seedNetworks.Services, *services) {
+		if utils.NetworksIntersect(seedNetworks.Services, *services) {
 			allErrs = append(allErrs, field.Invalid(pathServices, *services, "shoot service network intersects with seed service network"))
 		}
 	} else {
@@ -48,7 +55,7 @@ func ValidateNetworkDisjointedness(seedNetworks garden.SeedNetworks, k8sNetworks
 	}
 
 	if pods := k8sNetworks.Pods; pods != nil {
-		if networksIntersect(seedNetworks.Pods, *pods) {
+		if utils.NetworksIntersect(seedNetworks.Pods, *pods) {
 			allErrs = append(allErrs, field.Invalid(pathPods, *pods, "shoot pod network intersects with seed pod network"))
 		}
 	} else {
@@ -58,8 +65,134 @@ func ValidateNetworkDisjointedness(seedNetworks garden.SeedNetworks, k8sNetworks
 	return allErrs
 }
 
-func networksIntersect(cidr1, cidr2 string) bool {
-	_, net1, err1 := net.ParseCIDR(cidr1)
-	_, net2, err2 := net.ParseCIDR(cidr2)
-	return err1 != nil || err2 != nil || net2.Contains(net1.IP) || net1.Contains(net2.IP)
+// ValidateNetworkPolicyRulesNotBlocked validates that the destination networks of the given network policy <rules> do
+// not overlap with the blocked CIDRs or with the node, pod and service networks of the given <seedNetworks>, as the
+// rules must neither allow traffic to blocked addresses nor to other components running in the seed. Destinations
+// which cannot be parsed are ignored as they are rejected by the static validation of the Shoot.
+func ValidateNetworkPolicyRulesNotBlocked(seedNetworks garden.SeedNetworks, rules []garden.NetworkPolicyRule, fldPath *field.Path) field.ErrorList {
+	var (
+		allErrs  = field.ErrorList{}
+		excluded = []struct {
+			kind  string
+			cidrs []string
+		}{
+			{"blocked network", seedNetworks.BlockCIDRs},
+			{"node network", utils.SplitCIDRs(seedNetworks.Nodes)},
+			{"pod network", utils.SplitCIDRs(seedNetworks.Pods)},
+			{"service network", utils.SplitCIDRs(seedNetworks.Services)},
+		}
+	)
+
+	for i, rule := range rules {
+		for j, cidr := range rule.CIDRs {
+			if _, _, err := net.ParseCIDR(cidr); err != nil {
+				continue
+			}
+
+			for _, networks := range excluded {
+				for _, excludedCIDR := range networks.cidrs {
+					if utils.NetworksIntersect(excludedCIDR, cidr) {
+						allErrs = append(allErrs, field.Invalid(fldPath.Index(i).Child("cidrs").Index(j), cidr, fmt.Sprintf("destination network intersects with %s %s of the seed", networks.kind, excludedCIDR)))
+					}
+				}
+			}
+		}
+	}
+
+	return allErrs
+}
+
+// ValidateIPFamilies validates that the Seed supports all IP families of the Shoot. Empty lists of IP families mean
+// IPv4 only.
+func ValidateIPFamilies(seedIPFamilies, shootIPFamilies []garden.IPFamily, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	supported := sets.NewString()
+	for _, ipFamily := range helper.GetIPFamilies(seedIPFamilies) {
+		supported.Insert(string(ipFamily))
+	}
+
+	for _, ipFamily := range helper.GetIPFamilies(shootIPFamilies) {
+		if !supported.Has(string(ipFamily)) {
+			allErrs = append(allErrs, field.Invalid(fldPath, ipFamily, fmt.Sprintf("IP family is not supported by the seed (supported: %v)", supported.List())))
+		}
+	}
+
+	return allErrs
+}
+
+// AllocateNetwork returns the first network with the prefix length of the given <pool> which lies within the CIDR of
+// the pool and which does not intersect with any of the <usedNetworks>. The used networks may contain comma-separated
+// lists of CIDRs, values which cannot be parsed are ignored.
+func AllocateNetwork(pool garden.NetworkPool, usedNetworks []string) (string, error) {
+	_, poolNet, err := net.ParseCIDR(pool.CIDR)
+	if err != nil {
+		return "", err
+	}
+
+	ones, bits := poolNet.Mask.Size()
+	prefixLength := int(pool.PrefixLength)
+	if prefixLength < ones || prefixLength > bits {
+		return "", fmt.Errorf("prefix length %d does not fit into network pool %s", prefixLength, pool.CIDR)
+	}
+
+	var used []*net.IPNet
+	for _, networks := range usedNetworks {
+		for _, cidr := range strings.Split(networks, ",") {
+			if _, ipNet, err := net.ParseCIDR(strings.TrimSpace(cidr)); err == nil {
+				used = append(used, ipNet)
+			}
+		}
+	}
+
+	var (
+		size      = new(big.Int).Lsh(big.NewInt(1), uint(bits-prefixLength))
+		candidate = ipToInt(poolNet.IP)
+		end       = new(big.Int).Add(candidate, new(big.Int).Lsh(big.NewInt(1), uint(bits-ones)))
+	)
+
+	for candidate.Cmp(end) < 0 {
+		var (
+			network = &net.IPNet{IP: intToIP(candidate, bits), Mask: net.CIDRMask(prefixLength, bits)}
+			next    = new(big.Int).Add(candidate, size)
+			free    = true
+		)
+
+		for _, usedNet := range used {
+			if !network.Contains(usedNet.IP) && !usedNet.Contains(network.IP) {
+				continue
+			}
+			free = false
+
+			// Skip all candidates which are covered by a used network that is larger than the allocated networks.
+			usedOnes, usedBits := usedNet.Mask.Size()
+			usedEnd := new(big.Int).Add(ipToInt(usedNet.IP), new(big.Int).Lsh(big.NewInt(1), uint(usedBits-usedOnes)))
+			if usedEnd.Cmp(next) > 0 {
+				next = usedEnd
+			}
+		}
+
+		if free {
+			return network.String(), nil
+		}
+		candidate = next
+	}
+
+	return "", fmt.Errorf("network pool %s is exhausted", pool.CIDR)
+}
+
+func ipToInt(ip net.IP) *big.Int {
+	if ipv4 := ip.To4(); ipv4 != nil {
+		ip = ipv4
+	}
+	return new(big.Int).SetBytes(ip)
+}
+
+func intToIP(i *big.Int, bits int) net.IP {
+	var (
+		ip    = make(net.IP, bits/8)
+		bytes = i.Bytes()
+	)
+	copy(ip[len(ip)-len(bytes):], bytes)
+	return ip
 }